@@ -2,26 +2,60 @@ package main
 
 import (
 	"context"
+	"flag"
+	"fmt"
 	"log/slog"
+	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/daisuke-harada/date-courses-go/internal/config"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/master"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
 	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/db"
 	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external/gemini"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external/google_places"
 	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external/hotpepper"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external/httpclient"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external/nominatim"
 	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external/wikimedia"
 	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/persistence"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	"github.com/daisuke-harada/date-courses-go/pkg/logger"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
 )
 
+// batch は都道府県 × ジャンルごとに実在スポットを取得して登録します。
+// 実行と組み合わせごとの結果は台帳（batch_runs・batch_tasks）に残し、次の実行はまだ成功していない組み合わせから続けます。
+// -refresh を付けると新しくは取得せず、取り込み済みの hotpepper スポットを店舗 ID で取り直します。
 func main() {
+	var prefectureIDs, genreIDs idList
+	flag.Var(&prefectureIDs, "prefectures", "対象の都道府県 ID（カンマ区切り）。省略するとすべて")
+	flag.Var(&genreIDs, "genres", "対象のジャンル ID（カンマ区切り）。省略するとすべて")
+	force := flag.Bool("force", false, "台帳を見ずに、対象の組み合わせを先頭から実行する")
+	dryRun := flag.Bool("dry-run", false, "何も書き込まず、登録するはずのスポットをログに出す")
+	refresh := flag.Bool("refresh", false, "取り込み済みの hotpepper スポットを取得元から取り直す")
+	flag.Parse()
+
 	logger.Init("date-courses-go-batch", false)
 	defer logger.Close()
 
+	if *refresh && *dryRun {
+		slog.Error("batch: -dry-run is not supported with -refresh")
+		os.Exit(1)
+	}
+
+	// 止められたら実行中の組み合わせを終えたところで抜け、残りは次の実行に回す
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
 	cfg := config.Get()
-	ctx := context.Background()
 
 	gormDB, err := db.Connect(ctx, cfg.DB)
 	if err != nil {
@@ -29,48 +63,117 @@ func main() {
 		os.Exit(1)
 	}
 
-	repo := persistence.NewDateSpotRepository(gormDB)
-	hotpepperClient := hotpepper.NewClient(cfg.Recruit.APIKey)
-	wikimediaClient := wikimedia.NewClient()
-	fetcher := external.NewSpotFetcher(hotpepperClient, wikimediaClient)
+	// 外部 API の呼び出しはすべて1つの Transport を通し、ホストごとのレート制限とサーキットブレーカーを共有する
+	outbound := newOutboundTransport(cfg)
+
+	if *refresh {
+		if err := runRefresh(ctx, cfg, gormDB, outbound); err != nil {
+			slog.ErrorContext(ctx, "batch: refresh failed", "err", err)
+			os.Exit(1)
+		}
+		return
+	}
 
-	interactor := usecase.NewBatchCreateDateSpotsInteractor(
-		repo,
+	registry := newSpotProviderRegistry(cfg, outbound)
+
+	// 取得元の設定の書き間違いは、API を呼び始める前に止める
+	for _, genre := range master.Genres() {
+		if _, err := registry.Resolve(cfg.Batch.SpotProvidersForGenre(genre.ID)); err != nil {
+			slog.Error("batch: invalid spot providers", "genre", genre.Name, "err", err)
+			os.Exit(1)
+		}
+	}
+
+	wikimediaClient := wikimedia.NewClient(wikimedia.WithTransport(outbound))
+	googlePlacesClient := google_places.NewClient(cfg.GoogleMaps.APIKey, google_places.WithTransport(outbound))
+	fetcher := external.NewSpotFetcher(
+		registry,
+		cfg.Batch.SpotProvidersForGenre,
+		wikimediaClient,
+		googlePlacesClient,
+	)
+
+	collector := usecase.NewBatchCreateDateSpotsInteractor(
+		persistence.NewUnitOfWork(gormDB),
+		persistence.NewDateSpotRepository(gormDB),
+		persistence.NewSpotSourceRepository(gormDB),
+		service.NewSpotMatcher(),
 		fetcher,
 		cfg.Batch.MinExistingSpots,
 		cfg.Batch.SpotsPerCombination,
 	)
+	interactor := usecase.NewRunDateSpotBatchInteractor(
+		persistence.NewBatchRunRepository(gormDB),
+		collector,
+		usecase.DateSpotBatchPolicy{
+			MaxTasks:   cfg.Batch.MaxTasksPerRun,
+			RerunAfter: cfg.Batch.TaskRerunAfter,
+		},
+	)
 
-	prefectures := master.Prefectures()
-	genres := master.Genres()
-
-	taskCount := 0
-	for _, pref := range prefectures {
-		for _, genre := range genres {
-			if taskCount >= cfg.Batch.MaxTasksPerRun {
-				slog.InfoContext(ctx, "batch: reached max tasks per run", "max", cfg.Batch.MaxTasksPerRun)
-				return
-			}
-
-			input := usecase.BatchCreateDateSpotsInput{
-				PrefectureID:   pref.ID,
-				PrefectureName: pref.Name,
-				PrefCode:       pref.PrefCode,
-				GenreID:        genre.ID,
-				GenreName:      genre.Name,
-			}
-
-			if err := interactor.Execute(ctx, input); err != nil {
-				slog.ErrorContext(ctx, "batch: execute failed, skipping",
-					"prefecture", pref.Name,
-					"genre", genre.Name,
-					"err", err,
-				)
-			}
-
-			taskCount++
+	if _, err := interactor.Execute(ctx, usecase.RunDateSpotBatchInput{
+		Now:           time.Now(),
+		PrefectureIDs: prefectureIDs,
+		GenreIDs:      genreIDs,
+		Force:         *force,
+		DryRun:        *dryRun,
+	}); err != nil {
+		slog.ErrorContext(ctx, "batch: failed", "err", err)
+		os.Exit(1)
+	}
+}
+
+// idList はカンマ区切りの ID を受け取る flag.Value です。
+type idList []int
+
+func (l *idList) String() string {
+	return strings.Join(lo.Map(*l, func(id int, _ int) string { return strconv.Itoa(id) }), ",")
+}
+
+func (l *idList) Set(v string) error {
+	for _, s := range strings.Split(v, ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil {
+			return fmt.Errorf("invalid id %q", s)
 		}
+		*l = append(*l, id)
 	}
+	return nil
+}
+
+// runRefresh は取り込み済みの hotpepper スポットを店舗 ID で取り直し、
+// 変わった属性の書き込みと、続けて見つからないスポットの閉店扱いを行います。
+func runRefresh(ctx context.Context, cfg *config.Config, gormDB *gorm.DB, outbound http.RoundTripper) error {
+	interactor := usecase.NewRefreshDateSpotsInteractor(
+		persistence.NewUnitOfWork(gormDB),
+		persistence.NewDateSpotRepository(gormDB),
+		persistence.NewSpotSourceRepository(gormDB),
+		persistence.NewSpotRefreshRepository(gormDB),
+		hotpepper.NewProvider(hotpepper.NewClient(cfg.Recruit.APIKey, hotpepper.WithTransport(outbound))),
+		cfg.Batch.RefreshMissesToClose,
+	)
+	return interactor.Execute(ctx, usecase.RefreshDateSpotsInput{Now: time.Now()})
+}
+
+// newSpotProviderRegistry は使えるスポット取得元を登録します。
+// gemini は API キーが設定されているときだけ登録します。
+func newSpotProviderRegistry(cfg *config.Config, outbound http.RoundTripper) *external.SpotProviderRegistry {
+	providers := []external.SpotProvider{
+		hotpepper.NewProvider(hotpepper.NewClient(cfg.Recruit.APIKey, hotpepper.WithTransport(outbound))),
+	}
+	if cfg.Gemini.APIKey != "" {
+		providers = append(providers, gemini.NewProvider(
+			gemini.NewClient(cfg.Gemini.APIKey, cfg.Gemini.Model, gemini.WithTransport(outbound)),
+			nominatim.NewClient(nominatim.WithTransport(outbound)),
+		))
+	}
+	return external.NewSpotProviderRegistry(providers...)
+}
 
-	slog.InfoContext(ctx, "batch: completed", "tasks", taskCount)
+// newOutboundTransport は外部 API の呼び出しを通す Transport を作ります。
+// ホストごとに毎分 BATCH_MAX_REQUESTS_PER_MINUTE 回までに抑え、Nominatim は利用規約に合わせて毎秒1回までにします。
+func newOutboundTransport(cfg *config.Config) *httpclient.Transport {
+	c := httpclient.DefaultConfig()
+	c.RequestsPerMinute = cfg.Batch.MaxRequestsPerMinute
+	return httpclient.NewTransport(c)
 }
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/daisuke-harada/date-courses-go/internal/config"
+	"github.com/daisuke-harada/date-courses-go/internal/di"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/daisuke-harada/date-courses-go/pkg/logger"
+)
+
+// relay の Lambda 版です。EventBridge のスケジュールから呼ばれ、cmd/relay の -once と同じく
+// 今配るべきイベントを配り切ったところで終わります。
+func main() {
+	logger.Init("date-courses-go-relay", false)
+	defer logger.Close()
+
+	container := di.NewContainer()
+	container.MustProvide(config.Get)
+	container.MustProvide(di.ProvideDB)
+	di.BuildContainer(container)
+	relay := di.MustInvoke[usecase.RelayOutboxEventsInputPort](container)
+
+	lambda.Start(func(ctx context.Context) error {
+		total, err := usecase.DrainOutboxEvents(ctx, relay, time.Now)
+		if total.Processed() > 0 {
+			slog.InfoContext(ctx, "relay: relayed events",
+				"delivered", total.Delivered,
+				"retried", total.Retried,
+				"dead_lettered", total.DeadLettered,
+			)
+		}
+		return err
+	})
+}
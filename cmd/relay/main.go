@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/config"
+	"github.com/daisuke-harada/date-courses-go/internal/di"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/daisuke-harada/date-courses-go/pkg/logger"
+)
+
+// relay は outbox に溜まったドメインイベントを購読者へ配ります。
+// 既定では常駐して OUTBOX_POLL_INTERVAL ごとに outbox を読みに行き、
+// -once を付けると今配るべきイベントを配り切ったところで終了します（スケジューラーから定期実行するとき）。
+func main() {
+	once := flag.Bool("once", false, "配るべきイベントを配り切ったら終了する")
+	flag.Parse()
+
+	logger.Init("date-courses-go-relay", false)
+	defer logger.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	cfg := config.Get()
+	container := di.NewContainer()
+	container.MustProvide(config.Get)
+	container.MustProvide(di.ProvideDB)
+	di.BuildContainer(container)
+	relay := di.MustInvoke[usecase.RelayOutboxEventsInputPort](container)
+
+	for {
+		if err := drain(ctx, relay); err != nil {
+			slog.ErrorContext(ctx, "relay: failed", "err", err)
+			if *once {
+				os.Exit(1)
+			}
+		}
+		if *once {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "relay: stopped")
+			return
+		case <-time.After(cfg.Outbox.PollInterval):
+		}
+	}
+}
+
+// drain は今配るべきイベントを配り切り、配った件数をログに残します。
+func drain(ctx context.Context, relay usecase.RelayOutboxEventsInputPort) error {
+	total, err := usecase.DrainOutboxEvents(ctx, relay, time.Now)
+	if total.Processed() > 0 {
+		slog.InfoContext(ctx, "relay: relayed events",
+			"delivered", total.Delivered,
+			"retried", total.Retried,
+			"dead_lettered", total.DeadLettered,
+		)
+	}
+	return err
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"log/slog"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,14 +15,33 @@ var (
 )
 
 type Config struct {
+	App        AppConfig
 	DB         DBConfig
 	GoogleMaps GoogleMapsConfig
 	Recruit    RecruitConfig
+	Gemini     GeminiConfig
 	Batch      BatchConfig
 	JWT        JWTConfig
 	CORS       CORSConfig
+	Proxy      ProxyConfig
 	RateLimit  RateLimitConfig
 	Demo       DemoConfig
+	Storage    StorageConfig
+	Moderation ModerationConfig
+	Mail       MailConfig
+	Login      LoginConfig
+	Outbox     OutboxConfig
+}
+
+// AppConfig は実行環境の設定です。
+type AppConfig struct {
+	// Env は実行環境です。"local" のときだけ、ローカルディスクへの保存などの開発用の設定で起動できます。
+	Env string `envconfig:"APP_ENV" default:"local"`
+}
+
+// IsLocal はローカル開発環境で動いているかを返します。
+func (c AppConfig) IsLocal() bool {
+	return c.Env == "local"
 }
 
 type GoogleMapsConfig struct {
@@ -32,15 +52,57 @@ type RecruitConfig struct {
 	APIKey string `envconfig:"RECRUIT_API_KEY" required:"true"`
 }
 
+// GeminiConfig は API キーが空なら、バッチの取得元に gemini を登録しません。
+type GeminiConfig struct {
+	APIKey string `envconfig:"GEMINI_API_KEY"`
+	Model  string `envconfig:"GEMINI_MODEL" default:"gemini-2.0-flash"`
+}
+
 type BatchConfig struct {
-	SpotsPerCombination  int `envconfig:"BATCH_SPOTS_PER_COMBINATION" default:"5"`
-	MinExistingSpots     int `envconfig:"BATCH_MIN_EXISTING_SPOTS" default:"5"`
-	MaxTasksPerRun       int `envconfig:"BATCH_MAX_TASKS_PER_RUN" default:"50"`
+	SpotsPerCombination int `envconfig:"BATCH_SPOTS_PER_COMBINATION" default:"5"`
+	MinExistingSpots    int `envconfig:"BATCH_MIN_EXISTING_SPOTS" default:"5"`
+	MaxTasksPerRun      int `envconfig:"BATCH_MAX_TASKS_PER_RUN" default:"50"`
+	// MaxRequestsPerMinute は外部 API のホストごとの毎分のリクエスト数の上限です。Nominatim はこれより緩くても毎秒1回までにします。
 	MaxRequestsPerMinute int `envconfig:"BATCH_MAX_REQUESTS_PER_MINUTE" default:"60"`
+	// SpotProviders はスポットの取得元の名前を、使う順に並べたものです。
+	SpotProviders []string `envconfig:"BATCH_SPOT_PROVIDERS" default:"hotpepper"`
+	// GenreSpotProviders はジャンル ID ごとに取得元を差し替えます。"3:gemini|hotpepper,12:gemini" の形で書きます。
+	GenreSpotProviders map[int]string `envconfig:"BATCH_GENRE_SPOT_PROVIDERS"`
+	// RefreshMissesToClose は取り直しで取得元に何回続けて見つからなければ閉店とみなすかです。
+	// 一時的な掲載停止で閉店扱いにしないよう、1回では閉じません。
+	RefreshMissesToClose int `envconfig:"BATCH_REFRESH_MISSES_TO_CLOSE" default:"3"`
+	// TaskRerunAfter は成功・スキップした都道府県 × ジャンルを、次に実行するまで空ける時間です。
+	TaskRerunAfter time.Duration `envconfig:"BATCH_TASK_RERUN_AFTER" default:"720h"`
+}
+
+// SpotProvidersForGenre は genreID のスポットを取るときに使う取得元の名前を、使う順に返します。
+func (c BatchConfig) SpotProvidersForGenre(genreID int) []string {
+	if v, ok := c.GenreSpotProviders[genreID]; ok {
+		return strings.Split(v, "|")
+	}
+	return c.SpotProviders
 }
 
 type JWTConfig struct {
-	SecretKey string `envconfig:"JWT_SECRET_KEY" required:"true"`
+	// SigningKey は署名に使う PEM 形式の秘密鍵（RSA なら RS256、P-256 なら ES256）です。
+	// 未設定のときは SecretKey で HS256 署名します（ローカル開発用）。
+	SigningKey string `envconfig:"JWT_SIGNING_KEY"`
+	// SigningKeyID はトークンのヘッダーの kid に入れる、署名鍵の ID です。
+	SigningKeyID string `envconfig:"JWT_SIGNING_KEY_ID"`
+	// VerificationKeys は署名鍵のほかに検証で受け付ける鍵を、kid ヘッダー付きの PEM ブロックで並べたものです。
+	// 鍵の入れ替えの前後で、新しい公開鍵・古い公開鍵をここに置きます。
+	VerificationKeys string `envconfig:"JWT_VERIFICATION_KEYS"`
+	SecretKey        string `envconfig:"JWT_SECRET_KEY"`
+	Issuer           string `envconfig:"JWT_ISSUER" default:"https://api.datecourses.com"`
+	Audience         string `envconfig:"JWT_AUDIENCE" default:"date-courses"`
+	// LegacyTokensUntil までは、SigningKey に切り替える前に SecretKey の HS256 で発行したトークンも受け付けます（RFC 3339）。
+	// 切り替えのデプロイで全員をログアウトさせないよう、デプロイ時刻に AccessTokenTTL を足した時刻を設定します。
+	LegacyTokensUntil time.Time `envconfig:"JWT_LEGACY_TOKENS_UNTIL"`
+	// AccessTokenTTL はアクセストークンの有効期間です。
+	// 失効させたいトークンも期限までは署名だけで通ってしまうため、短くしてリフレッシュトークンで再発行させます。
+	AccessTokenTTL time.Duration `envconfig:"JWT_ACCESS_TOKEN_TTL" default:"15m"`
+	// RefreshTokenTTL はリフレッシュトークンの有効期間です。使うたびに新しいトークンと差し替えます。
+	RefreshTokenTTL time.Duration `envconfig:"JWT_REFRESH_TOKEN_TTL" default:"720h"`
 }
 
 type DemoConfig struct {
@@ -49,9 +111,94 @@ type DemoConfig struct {
 	UserName string `envconfig:"DEMO_USER_NAME" default:"guest"`
 }
 
+// StorageConfig はアップロードされた画像の保存先の設定です。
+type StorageConfig struct {
+	// Driver は保存先です。"local"（ローカルのディレクトリ）か "s3"（S3 互換ストレージ）を指定します。
+	// local は APP_ENV が local のときだけ使えます。
+	Driver string `envconfig:"STORAGE_DRIVER" default:"local"`
+	// LocalDir は Driver が local のときの保存先ディレクトリです。API サーバーが /uploads で配信します。
+	LocalDir string `envconfig:"STORAGE_LOCAL_DIR" default:"./uploads"`
+	// PublicBaseURL は保存した画像の公開 URL の基点です。
+	// 空のとき、local ではローカル開発用の http://localhost:1099/uploads、s3 ではオブジェクトの URL を使います。
+	PublicBaseURL string `envconfig:"STORAGE_PUBLIC_BASE_URL"`
+
+	S3Endpoint        string `envconfig:"STORAGE_S3_ENDPOINT"`
+	S3Region          string `envconfig:"STORAGE_S3_REGION" default:"ap-northeast-1"`
+	S3Bucket          string `envconfig:"STORAGE_S3_BUCKET"`
+	S3AccessKeyID     string `envconfig:"STORAGE_S3_ACCESS_KEY_ID"`
+	S3SecretAccessKey string `envconfig:"STORAGE_S3_SECRET_ACCESS_KEY"`
+	S3UsePathStyle    bool   `envconfig:"STORAGE_S3_USE_PATH_STYLE" default:"false"`
+
+	// AWSAccessKeyID・AWSSecretAccessKey・AWSSessionToken は S3AccessKeyID が空のときに署名に使う AWS の認証情報です。
+	// Lambda では実行ロールの一時的な認証情報が入るため、AWS の S3 には S3AccessKeyID を渡さず、R2 や MinIO などにだけ渡します。
+	AWSAccessKeyID     string `envconfig:"AWS_ACCESS_KEY_ID"`
+	AWSSecretAccessKey string `envconfig:"AWS_SECRET_ACCESS_KEY"`
+	AWSSessionToken    string `envconfig:"AWS_SESSION_TOKEN"`
+}
+
+// ModerationConfig はレビューの自動判定と通報の設定です。
+type ModerationConfig struct {
+	// NGWords は投稿を受け付けない語です。カンマ区切りで指定します。
+	NGWords []string `envconfig:"MODERATION_NG_WORDS"`
+	// HoldWords は管理者が確認するまで公開しない語です。カンマ区切りで指定します。
+	HoldWords []string `envconfig:"MODERATION_HOLD_WORDS"`
+	// ReportThreshold は公開中のレビューを自動で保留にする、未対応の通報の件数です。
+	ReportThreshold int `envconfig:"MODERATION_REPORT_THRESHOLD" default:"3"`
+}
+
+// MailConfig はメール送信と、メールで送るリンクの設定です。
+type MailConfig struct {
+	// Driver は送信方法です。"smtp"（SMTP サーバー経由）か "file"（FileDir に書き出すだけ）を指定します。
+	Driver string `envconfig:"MAIL_DRIVER" default:"file"`
+	From   string `envconfig:"MAIL_FROM" default:"DateCourses <no-reply@datecourses.com>"`
+	// FileDir は Driver が file のときの書き出し先ディレクトリです。
+	FileDir string `envconfig:"MAIL_FILE_DIR" default:"./tmp/mail"`
+
+	SMTPHost     string `envconfig:"MAIL_SMTP_HOST"`
+	SMTPPort     int    `envconfig:"MAIL_SMTP_PORT" default:"587"`
+	SMTPUsername string `envconfig:"MAIL_SMTP_USERNAME"`
+	SMTPPassword string `envconfig:"MAIL_SMTP_PASSWORD"`
+
+	// LinkBaseURL はメールに載せるリンクの基点（フロントエンドの URL）です。
+	LinkBaseURL string `envconfig:"MAIL_LINK_BASE_URL" default:"http://localhost:3000"`
+	// VerifyEmailTokenTTL はメールアドレス確認のリンクの有効期間です。
+	VerifyEmailTokenTTL time.Duration `envconfig:"MAIL_VERIFY_EMAIL_TOKEN_TTL" default:"24h"`
+	// ResetPasswordTokenTTL はパスワード再設定のリンクの有効期間です。
+	// 盗まれたメールからアカウントを乗っ取られないよう短くします。
+	ResetPasswordTokenTTL time.Duration `envconfig:"MAIL_RESET_PASSWORD_TOKEN_TTL" default:"1h"`
+}
+
 type RateLimitConfig struct {
-	// LoginAttemptsPerMinute はログイン・新規登録の1分あたりの試行上限（IP ごと）です。
+	// Store はトークンバケットの置き場所です。memory か mysql を指定します。
+	// Lambda では実行環境ごとにメモリが分かれ、スケールアウトすると制限が緩むため mysql を使います。
+	Store string `envconfig:"RATE_LIMIT_STORE" default:"memory"`
+	// LoginAttemptsPerMinute はログイン・新規登録・メール送信などの1分あたりの試行上限（IP ごと）です。
 	LoginAttemptsPerMinute int `envconfig:"RATE_LIMIT_LOGIN_ATTEMPTS_PER_MINUTE" default:"10"`
+	// WritesPerMinute は投稿・更新・削除の1分あたりの上限（ユーザーごと）です。0 のときは制限しません。
+	WritesPerMinute int `envconfig:"RATE_LIMIT_WRITES_PER_MINUTE" default:"60"`
+	// ReadsPerMinute は閲覧の1分あたりの上限（ユーザーごと）です。0 のときは制限しません。
+	ReadsPerMinute int `envconfig:"RATE_LIMIT_READS_PER_MINUTE" default:"0"`
+}
+
+type LoginConfig struct {
+	// MaxFailedAttempts はアカウントをロックするまでに続けて失敗できる回数です。0 のときはロックしません。
+	MaxFailedAttempts uint `envconfig:"LOGIN_MAX_FAILED_ATTEMPTS" default:"5"`
+	// LockoutDuration はロックしてからログインを受け付けない期間です。
+	LockoutDuration time.Duration `envconfig:"LOGIN_LOCKOUT_DURATION" default:"15m"`
+}
+
+// OutboxConfig は cmd/relay が outbox のイベントを購読者へ配るときの設定です。
+type OutboxConfig struct {
+	// BatchSize は1回に読み込むイベントの件数です。
+	BatchSize int `envconfig:"OUTBOX_BATCH_SIZE" default:"100"`
+	// MaxAttempts はイベントを dead にするまでに配ろうとする回数です。
+	MaxAttempts uint `envconfig:"OUTBOX_MAX_ATTEMPTS" default:"10"`
+	// RetryBaseDelay は1回目の失敗の後に待つ時間です。失敗するたびに倍にします。
+	RetryBaseDelay time.Duration `envconfig:"OUTBOX_RETRY_BASE_DELAY" default:"30s"`
+	// RetryMaxDelay は配り直すまでに待つ時間の上限です。
+	RetryMaxDelay time.Duration `envconfig:"OUTBOX_RETRY_MAX_DELAY" default:"1h"`
+	// PollInterval は常駐させたときに outbox を読みに行く間隔です。
+	PollInterval time.Duration `envconfig:"OUTBOX_POLL_INTERVAL" default:"5s"`
 }
 
 type CORSConfig struct {
@@ -60,6 +207,13 @@ type CORSConfig struct {
 	AllowOrigins []string `envconfig:"CORS_ALLOW_ORIGINS" default:"http://localhost:3000,http://localhost:8080"`
 }
 
+// ProxyConfig はクライアントの IP アドレスを X-Forwarded-For から読むときに信頼するプロキシの設定です。
+type ProxyConfig struct {
+	// TrustedCIDRs は、ループバック・プライベートアドレスのほかに信頼するプロキシのアドレス範囲です。カンマ区切りで指定します。
+	// ここに無いアドレスから届いた X-Forwarded-For は、クライアントが書き換えられるため使いません。
+	TrustedCIDRs []string `envconfig:"TRUSTED_PROXY_CIDRS"`
+}
+
 type DBConfig struct {
 	Host     string `envconfig:"DB_HOST" required:"true"`
 	Port     int    `envconfig:"DB_PORT" default:"3306"`
@@ -76,6 +230,9 @@ type DBConfig struct {
 func Get() *Config {
 	once.Do(func() {
 		cfg = &Config{}
+		if e := envconfig.Process("", &cfg.App); e != nil {
+			slog.Error("failed to process environment app", "err", e)
+		}
 		if e := envconfig.Process("", &cfg.DB); e != nil {
 			slog.Error("failed to process environment db", "err", e)
 		}
@@ -88,18 +245,39 @@ func Get() *Config {
 		if e := envconfig.Process("", &cfg.Recruit); e != nil {
 			slog.Error("failed to process environment recruit", "err", e)
 		}
+		if e := envconfig.Process("", &cfg.Gemini); e != nil {
+			slog.Error("failed to process environment gemini", "err", e)
+		}
 		if e := envconfig.Process("", &cfg.Batch); e != nil {
 			slog.Error("failed to process environment batch", "err", e)
 		}
 		if e := envconfig.Process("", &cfg.CORS); e != nil {
 			slog.Error("failed to process environment cors", "err", e)
 		}
+		if e := envconfig.Process("", &cfg.Proxy); e != nil {
+			slog.Error("failed to process environment proxy", "err", e)
+		}
 		if e := envconfig.Process("", &cfg.RateLimit); e != nil {
 			slog.Error("failed to process environment rate limit", "err", e)
 		}
+		if e := envconfig.Process("", &cfg.Login); e != nil {
+			slog.Error("failed to process environment login", "err", e)
+		}
 		if e := envconfig.Process("", &cfg.Demo); e != nil {
 			slog.Error("failed to process environment demo", "err", e)
 		}
+		if e := envconfig.Process("", &cfg.Storage); e != nil {
+			slog.Error("failed to process environment storage", "err", e)
+		}
+		if e := envconfig.Process("", &cfg.Moderation); e != nil {
+			slog.Error("failed to process environment moderation", "err", e)
+		}
+		if e := envconfig.Process("", &cfg.Mail); e != nil {
+			slog.Error("failed to process environment mail", "err", e)
+		}
+		if e := envconfig.Process("", &cfg.Outbox); e != nil {
+			slog.Error("failed to process environment outbox", "err", e)
+		}
 	})
 
 	return cfg
@@ -2,11 +2,19 @@ package di
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/daisuke-harada/date-courses-go/internal/config"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
 	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/db"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/eventbus"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/mail"
 	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/persistence"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/ratelimit"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/storage"
+	jwtpkg "github.com/daisuke-harada/date-courses-go/internal/pkg/jwt"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	"gorm.io/gorm"
 )
@@ -16,25 +24,182 @@ func ProvideDB(cfg *config.Config) (*gorm.DB, error) {
 	return db.Connect(context.Background(), cfg.DB)
 }
 
+// localStorageBaseURL は STORAGE_PUBLIC_BASE_URL が未指定のときの、ローカル保存した画像の公開 URL の基点です。
+const localStorageBaseURL = "http://localhost:1099/uploads"
+
+// ProvideBlobStore は設定の STORAGE_DRIVER に応じた BlobStore を提供します。
+// Lambda のようにローカルディスクに書けない環境で画像の保存だけが失敗し続けないよう、
+// local 以外の環境で local ドライバーを選んだときは起動させません。
+func ProvideBlobStore(cfg *config.Config) (repository.BlobStore, error) {
+	sc := cfg.Storage
+	if sc.Driver == "s3" {
+		s3cfg := storage.S3Config{
+			Endpoint:        sc.S3Endpoint,
+			Region:          sc.S3Region,
+			Bucket:          sc.S3Bucket,
+			AccessKeyID:     sc.S3AccessKeyID,
+			SecretAccessKey: sc.S3SecretAccessKey,
+			UsePathStyle:    sc.S3UsePathStyle,
+			PublicBaseURL:   sc.PublicBaseURL,
+		}
+		// キーを渡されていなければ、AWS の S3 として実行ロールの一時的な認証情報で署名する
+		if s3cfg.AccessKeyID == "" {
+			s3cfg.AccessKeyID = sc.AWSAccessKeyID
+			s3cfg.SecretAccessKey = sc.AWSSecretAccessKey
+			s3cfg.SessionToken = sc.AWSSessionToken
+		}
+		return storage.NewS3BlobStore(s3cfg), nil
+	}
+	if !cfg.App.IsLocal() {
+		return nil, fmt.Errorf("di: STORAGE_DRIVER=%q is only allowed when APP_ENV is local (APP_ENV=%q)", sc.Driver, cfg.App.Env)
+	}
+	baseURL := sc.PublicBaseURL
+	if baseURL == "" {
+		baseURL = localStorageBaseURL
+	}
+	return storage.NewLocalBlobStore(sc.LocalDir, baseURL), nil
+}
+
+// ProvideMailer は設定の MAIL_DRIVER に応じた Mailer を提供します。
+func ProvideMailer(cfg *config.Config) (repository.Mailer, error) {
+	mc := cfg.Mail
+	if mc.Driver == "smtp" {
+		return mail.NewSMTPMailer(mail.SMTPConfig{
+			Host:     mc.SMTPHost,
+			Port:     mc.SMTPPort,
+			Username: mc.SMTPUsername,
+			Password: mc.SMTPPassword,
+			From:     mc.From,
+		}), nil
+	}
+	// file はメールを送らないため、ローカル以外で使うと誰も確認メールを受け取れない
+	if !cfg.App.IsLocal() {
+		return nil, fmt.Errorf("di: MAIL_DRIVER=%q is only allowed when APP_ENV is local (APP_ENV=%q)", mc.Driver, cfg.App.Env)
+	}
+	return mail.NewFileMailer(mc.FileDir, mc.From), nil
+}
+
+// ProvideRateLimitStore は設定の RATE_LIMIT_STORE に応じた RateLimitStore を提供します。
+func ProvideRateLimitStore(cfg *config.Config, db *gorm.DB) repository.RateLimitStore {
+	if cfg.RateLimit.Store == "mysql" {
+		return ratelimit.NewMySQLStore(db)
+	}
+	return ratelimit.NewMemoryStore()
+}
+
+// ProvideNotificationChannels はアプリ内通知のほかに通知を届ける手段を提供します。
+// 今はアプリ内通知だけのため空で、メールや Web Push はここに足します。
+func ProvideNotificationChannels(cfg *config.Config) []repository.NotificationChannel {
+	return nil
+}
+
+// ProvideEventPublisher はユースケースが発行したイベントを outbox に書き込む Publisher を提供します。
+func ProvideEventPublisher(outbox repository.OutboxRepository) event.Publisher {
+	return outbox
+}
+
 // ProvideRepositories は全リポジトリのコンストラクタを Container に登録します。
 func ProvideRepositories(ct *Container) {
 	ct.MustProvide(persistence.NewUserRepository)
 	ct.MustProvide(persistence.NewDateSpotRepository)
+	ct.MustProvide(persistence.NewDateSpotSearchIndex)
+	ct.MustProvide(persistence.NewSpotSourceRepository)
 	ct.MustProvide(persistence.NewCourseRepository)
 	ct.MustProvide(persistence.NewDateSpotReviewRepository)
 	ct.MustProvide(persistence.NewDuringSpotRepository)
 	ct.MustProvide(persistence.NewRelationshipRepository)
+	ct.MustProvide(persistence.NewUserBlockRepository)
+	ct.MustProvide(persistence.NewUserMuteRepository)
+	ct.MustProvide(persistence.NewCourseFavoriteRepository)
+	ct.MustProvide(persistence.NewFeedRepository)
+	ct.MustProvide(persistence.NewNotificationRepository)
+	ct.MustProvide(persistence.NewOutboxRepository)
+	ct.MustProvide(ProvideEventPublisher)
+	ct.MustProvide(persistence.NewRefreshTokenRepository)
+	ct.MustProvide(persistence.NewEmailTokenRepository)
+	ct.MustProvide(persistence.NewLoginHistoryRepository)
+	ct.MustProvide(persistence.NewUnitOfWork)
+	ct.MustProvide(ProvideBlobStore)
+	ct.MustProvide(ProvideMailer)
+	ct.MustProvide(ProvideRateLimitStore)
+	ct.MustProvide(ProvideNotificationChannels)
+}
+
+// ProvideContentFilter は設定の NG ワードでレビュー本文を判定する ContentFilter を提供します。
+func ProvideContentFilter(cfg *config.Config) service.ContentFilter {
+	return service.NewNGWordFilter(cfg.Moderation.NGWords, cfg.Moderation.HoldWords)
 }
 
 // ProvideServices は全ドメインサービスのコンストラクタを Container に登録します。
 func ProvideServices(ct *Container) {
 	ct.MustProvide(service.NewAuthService)
 	ct.MustProvide(service.NewUserService)
+	ct.MustProvide(service.NewEstimatedRouteEngine)
+	ct.MustProvide(service.NewCourseRouteService)
+	ct.MustProvide(service.NewImageService)
+	ct.MustProvide(ProvideContentFilter)
 }
 
-// ProvideJWTSecretKey は設定から JWT シークレットキーを提供します。
-func ProvideJWTSecretKey(cfg *config.Config) usecase.JWTSecretKey {
-	return usecase.JWTSecretKey(cfg.JWT.SecretKey)
+// hmacKeyID は JWT_SIGNING_KEY が未設定のとき、JWT_SECRET_KEY で HS256 署名する鍵の ID です。
+const hmacKeyID = "local-hs256"
+
+// ProvideKeyring は設定から JWT の署名・検証に使う鍵を提供します。
+// JWT_SIGNING_KEY があればその秘密鍵で署名し、無ければ JWT_SECRET_KEY による HS256 にフォールバックします。
+// HS256 の鍵は JWKS で公開できないため、フォールバックはローカル環境でだけ許します。
+func ProvideKeyring(cfg *config.Config) (*jwtpkg.Keyring, error) {
+	jc := cfg.JWT
+	var signing *jwtpkg.Key
+	var err error
+	switch {
+	case jc.SigningKey != "":
+		signing, err = jwtpkg.ParsePrivateKeyPEM(jc.SigningKeyID, []byte(jc.SigningKey))
+	case cfg.App.IsLocal():
+		signing, err = jwtpkg.NewHMACKey(hmacKeyID, []byte(jc.SecretKey))
+	default:
+		err = fmt.Errorf("di: JWT_SIGNING_KEY is required when APP_ENV is not local (APP_ENV=%q)", cfg.App.Env)
+	}
+	if err != nil {
+		return nil, err
+	}
+	verification, err := jwtpkg.ParseVerificationKeysPEM([]byte(jc.VerificationKeys))
+	if err != nil {
+		return nil, err
+	}
+	keyring, err := jwtpkg.NewKeyring(jc.Issuer, jc.Audience, signing, verification...)
+	if err != nil {
+		return nil, err
+	}
+	if !jc.LegacyTokensUntil.IsZero() {
+		if err := keyring.AcceptLegacyTokens([]byte(jc.SecretKey), jc.LegacyTokensUntil); err != nil {
+			return nil, err
+		}
+	}
+	return keyring, nil
+}
+
+// ProvideSessionTTL は設定からアクセストークンとリフレッシュトークンの有効期間を提供します。
+func ProvideSessionTTL(cfg *config.Config) usecase.SessionTTL {
+	return usecase.SessionTTL{
+		AccessToken:  cfg.JWT.AccessTokenTTL,
+		RefreshToken: cfg.JWT.RefreshTokenTTL,
+	}
+}
+
+// ProvideEmailTokenSettings は設定からメールで送るリンクの基点と有効期間を提供します。
+func ProvideEmailTokenSettings(cfg *config.Config) usecase.EmailTokenSettings {
+	return usecase.EmailTokenSettings{
+		LinkBaseURL:      cfg.Mail.LinkBaseURL,
+		VerifyEmailTTL:   cfg.Mail.VerifyEmailTokenTTL,
+		ResetPasswordTTL: cfg.Mail.ResetPasswordTokenTTL,
+	}
+}
+
+// ProvideLoginLockoutPolicy は設定からログインの失敗が続いたアカウントをロックする条件を提供します。
+func ProvideLoginLockoutPolicy(cfg *config.Config) usecase.LoginLockoutPolicy {
+	return usecase.LoginLockoutPolicy{
+		MaxFailedAttempts: cfg.Login.MaxFailedAttempts,
+		Duration:          cfg.Login.LockoutDuration,
+	}
 }
 
 // ProvideDemoUserName は設定からデモ用アカウント名を提供します。
@@ -42,10 +207,42 @@ func ProvideDemoUserName(cfg *config.Config) usecase.DemoUserName {
 	return usecase.DemoUserName(cfg.Demo.UserName)
 }
 
+// ProvideReviewReportThreshold は設定からレビューを自動で保留にする通報件数を提供します。
+func ProvideReviewReportThreshold(cfg *config.Config) usecase.ReviewReportThreshold {
+	return usecase.ReviewReportThreshold(cfg.Moderation.ReportThreshold)
+}
+
+// ProvideEventDispatcher は cmd/relay が outbox から読んだイベントを購読者に振り分ける Dispatcher を提供します。
+// 購読者を足すときはここで Subscribe します。
+func ProvideEventDispatcher(notificationHandler *usecase.NotificationEventHandler) event.Dispatcher {
+	dispatcher := eventbus.NewInProcessDispatcher()
+	for _, name := range usecase.NotificationEventNames {
+		dispatcher.Subscribe(name, notificationHandler)
+	}
+	return dispatcher
+}
+
+// ProvideOutboxRelayPolicy は設定から outbox のイベントを配る件数と、配り直す間隔・回数を提供します。
+func ProvideOutboxRelayPolicy(cfg *config.Config) usecase.OutboxRelayPolicy {
+	return usecase.OutboxRelayPolicy{
+		BatchSize:      cfg.Outbox.BatchSize,
+		MaxAttempts:    cfg.Outbox.MaxAttempts,
+		RetryBaseDelay: cfg.Outbox.RetryBaseDelay,
+		RetryMaxDelay:  cfg.Outbox.RetryMaxDelay,
+	}
+}
+
 // ProvideUsecases は全ユースケースのコンストラクタを Container に登録します。
 func ProvideUsecases(ct *Container) {
-	ct.MustProvide(ProvideJWTSecretKey)
+	ct.MustProvide(ProvideKeyring)
+	ct.MustProvide(ProvideSessionTTL)
+	ct.MustProvide(ProvideEmailTokenSettings)
+	ct.MustProvide(ProvideLoginLockoutPolicy)
 	ct.MustProvide(ProvideDemoUserName)
+	ct.MustProvide(ProvideReviewReportThreshold)
+	ct.MustProvide(usecase.NewNotificationEventHandler)
+	ct.MustProvide(ProvideEventDispatcher)
+	ct.MustProvide(ProvideOutboxRelayPolicy)
 	ct.MustProvide(usecase.NewGetDateSpotUsecase)
 	ct.MustProvide(usecase.NewGetDateSpotsUsecase)
 	ct.MustProvide(usecase.NewCreateDateSpotUsecase)
@@ -53,6 +250,13 @@ func ProvideUsecases(ct *Container) {
 	ct.MustProvide(usecase.NewDeleteDateSpotUsecase)
 	ct.MustProvide(usecase.NewSignupUsecase)
 	ct.MustProvide(usecase.NewLoginUsecase)
+	ct.MustProvide(usecase.NewRefreshTokenUsecase)
+	ct.MustProvide(usecase.NewLogoutUsecase)
+	ct.MustProvide(usecase.NewGetLoginHistoriesUsecase)
+	ct.MustProvide(usecase.NewRequestEmailVerificationUsecase)
+	ct.MustProvide(usecase.NewVerifyEmailUsecase)
+	ct.MustProvide(usecase.NewRequestPasswordResetUsecase)
+	ct.MustProvide(usecase.NewResetPasswordUsecase)
 	ct.MustProvide(usecase.NewGetUsersUsecase)
 	ct.MustProvide(usecase.NewGetUserUsecase)
 	ct.MustProvide(usecase.NewUpdateUserUsecase)
@@ -61,11 +265,29 @@ func ProvideUsecases(ct *Container) {
 	ct.MustProvide(usecase.NewGetUserFollowersUsecase)
 	ct.MustProvide(usecase.NewCreateRelationshipUsecase)
 	ct.MustProvide(usecase.NewDeleteRelationshipUsecase)
+	ct.MustProvide(usecase.NewCreateUserBlockUsecase)
+	ct.MustProvide(usecase.NewDeleteUserBlockUsecase)
+	ct.MustProvide(usecase.NewCreateUserMuteUsecase)
+	ct.MustProvide(usecase.NewDeleteUserMuteUsecase)
 	ct.MustProvide(usecase.NewGetCoursesUsecase)
 	ct.MustProvide(usecase.NewGetCourseUsecase)
 	ct.MustProvide(usecase.NewCreateDateSpotReviewUsecase)
 	ct.MustProvide(usecase.NewDeleteDateSpotReviewUsecase)
 	ct.MustProvide(usecase.NewUpdateDateSpotReviewUsecase)
+	ct.MustProvide(usecase.NewReportDateSpotReviewUsecase)
+	ct.MustProvide(usecase.NewGetModerationReviewsUsecase)
+	ct.MustProvide(usecase.NewModerateDateSpotReviewUsecase)
+	ct.MustProvide(usecase.NewGetSpotMatchesUsecase)
+	ct.MustProvide(usecase.NewResolveSpotMatchUsecase)
 	ct.MustProvide(usecase.NewCreateCourseUsecase)
+	ct.MustProvide(usecase.NewUpdateCourseUsecase)
 	ct.MustProvide(usecase.NewDeleteCourseUsecase)
+	ct.MustProvide(usecase.NewCreateCourseFavoriteUsecase)
+	ct.MustProvide(usecase.NewDeleteCourseFavoriteUsecase)
+	ct.MustProvide(usecase.NewGetUserFavoriteCoursesUsecase)
+	ct.MustProvide(usecase.NewGetFeedUsecase)
+	ct.MustProvide(usecase.NewGetNotificationsUsecase)
+	ct.MustProvide(usecase.NewGetUnreadNotificationCountUsecase)
+	ct.MustProvide(usecase.NewMarkNotificationsReadUsecase)
+	ct.MustProvide(usecase.NewRelayOutboxEventsUsecase)
 }
@@ -0,0 +1,162 @@
+// Package event はユースケースが書き込みと同じトランザクションで発行するドメインイベントと、それを配る仕組みを定義します。
+//
+// 通知や集計のような付随する処理はイベントを購読して行い、コースの作成やフォローなどの本来の書き込みからは切り離します。
+// ユースケースは Publisher でイベントを outbox に書き込むだけで、購読者へは cmd/relay が後から配ります。
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+)
+
+// Event はドメインイベントです。Name は購読するときのキーで、outbox に保存するときの種類にもなります。
+// outbox には JSON で保存するため、フィールドには json タグを付けます。
+type Event interface {
+	Name() string
+}
+
+const (
+	NameUserFollowed     = "user.followed"
+	NameUserDeleted      = "user.deleted"
+	NameCourseCreated    = "course.created"
+	NameCourseUpdated    = "course.updated"
+	NameCourseDeleted    = "course.deleted"
+	NameCourseFavorited  = "course.favorited"
+	NameDateSpotReviewed = "date_spot.reviewed"
+)
+
+// UserFollowed はユーザーが他のユーザーをフォローしたことを表します。
+type UserFollowed struct {
+	FollowerID     uint `json:"follower_id"`
+	FollowedUserID uint `json:"followed_user_id"`
+}
+
+func (UserFollowed) Name() string { return NameUserFollowed }
+
+// UserDeleted はユーザーが退会したことを表します。ユーザーのコース・レビューも同時に消えています。
+type UserDeleted struct {
+	UserID uint `json:"user_id"`
+}
+
+func (UserDeleted) Name() string { return NameUserDeleted }
+
+// CourseCreated はデートコースが作成されたことを表します。
+// 非公開のコースも発行するため、公開中かどうかは購読する側で Authority を見て確かめます。
+type CourseCreated struct {
+	CourseID  uint                  `json:"course_id"`
+	UserID    uint                  `json:"user_id"`
+	Authority model.CourseAuthority `json:"authority"`
+}
+
+func (CourseCreated) Name() string { return NameCourseCreated }
+
+// CourseUpdated はデートコースの立ち寄り先や公開範囲が変わったことを表します。
+type CourseUpdated struct {
+	CourseID  uint                  `json:"course_id"`
+	UserID    uint                  `json:"user_id"`
+	Authority model.CourseAuthority `json:"authority"`
+}
+
+func (CourseUpdated) Name() string { return NameCourseUpdated }
+
+// CourseDeleted はデートコースが削除されたことを表します。
+type CourseDeleted struct {
+	CourseID uint `json:"course_id"`
+	UserID   uint `json:"user_id"`
+}
+
+func (CourseDeleted) Name() string { return NameCourseDeleted }
+
+// CourseFavorited はユーザーがコースをお気に入りに入れたことを表します。
+// 既にお気に入りに入れていたコースをもう一度入れたときは発行しません。
+type CourseFavorited struct {
+	CourseID      uint `json:"course_id"`
+	CourseOwnerID uint `json:"course_owner_id"`
+	UserID        uint `json:"user_id"`
+}
+
+func (CourseFavorited) Name() string { return NameCourseFavorited }
+
+// DateSpotReviewed はデートスポットにレビューが投稿されたことを表します。
+// Status が保留のレビューも発行するため、公開中かどうかは購読する側で確かめます。
+type DateSpotReviewed struct {
+	ReviewID   uint               `json:"review_id"`
+	DateSpotID uint               `json:"date_spot_id"`
+	ReviewerID uint               `json:"reviewer_id"`
+	Status     model.ReviewStatus `json:"status"`
+}
+
+func (DateSpotReviewed) Name() string { return NameDateSpotReviewed }
+
+// decoders は outbox から読んだイベントを Name ごとの型に戻すための対応表です。イベントを足したらここにも足します。
+var decoders = map[string]func(payload []byte) (Event, error){
+	NameUserFollowed:     decodeAs[UserFollowed],
+	NameUserDeleted:      decodeAs[UserDeleted],
+	NameCourseCreated:    decodeAs[CourseCreated],
+	NameCourseUpdated:    decodeAs[CourseUpdated],
+	NameCourseDeleted:    decodeAs[CourseDeleted],
+	NameCourseFavorited:  decodeAs[CourseFavorited],
+	NameDateSpotReviewed: decodeAs[DateSpotReviewed],
+}
+
+// ErrUnknownEvent は Decode に知らない Name が渡されたときのエラーです。
+var ErrUnknownEvent = errors.New("unknown event")
+
+// Decode は outbox に JSON で保存したイベントを、name に対応する型に戻します。
+func Decode(name string, payload []byte) (Event, error) {
+	decode, ok := decoders[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEvent, name)
+	}
+	return decode(payload)
+}
+
+func decodeAs[T Event](payload []byte) (Event, error) {
+	var e T
+	if err := json.Unmarshal(payload, &e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Publisher はイベントを発行します。
+// ctx のトランザクションで outbox に書き込むため、書き込みが取り消されればイベントも残りません。
+// UnitOfWork.Do の中で呼び、失敗したら書き込みごと取り消します。
+type Publisher interface {
+	Publish(ctx context.Context, events ...Event) error
+}
+
+// Handler はイベントを受け取って処理します。
+// outbox からは少なくとも1回配るため、同じイベントを2回以上受け取っても困らないように作ります。
+type Handler interface {
+	Handle(ctx context.Context, e Event) error
+}
+
+// HandlerFunc は関数を Handler として使うための型です。
+type HandlerFunc func(ctx context.Context, e Event) error
+
+func (f HandlerFunc) Handle(ctx context.Context, e Event) error { return f(ctx, e) }
+
+type outboxIDKey struct{}
+
+// WithOutboxID は配っているイベントの outbox の ID を ctx に載せます。
+// 同じイベントを配り直しても ID は変わらないため、ハンドラーは ID で2回目の処理を見分けられます。
+func WithOutboxID(ctx context.Context, id uint) context.Context {
+	return context.WithValue(ctx, outboxIDKey{}, id)
+}
+
+// OutboxIDFromContext は WithOutboxID で載せた ID を返します。outbox から配っていなければ false を返します。
+func OutboxIDFromContext(ctx context.Context) (uint, bool) {
+	id, ok := ctx.Value(outboxIDKey{}).(uint)
+	return id, ok
+}
+
+// Dispatcher はイベントを購読しているハンドラーに配ります。
+// 失敗したハンドラーがあればエラーを返し、呼び出し側（cmd/relay）が後でイベントごと配り直します。
+type Dispatcher interface {
+	Dispatch(ctx context.Context, e Event) error
+}
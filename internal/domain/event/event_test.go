@@ -0,0 +1,47 @@
+package event_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecode(t *testing.T) {
+	// outbox に書いた JSON から、発行したときと同じ型・値に戻せることを確認する
+	t.Run("success_round_trips_every_event", func(t *testing.T) {
+		events := []event.Event{
+			event.UserFollowed{FollowerID: 1, FollowedUserID: 2},
+			event.UserDeleted{UserID: 1},
+			event.CourseCreated{CourseID: 10, UserID: 1, Authority: model.CourseAuthorityPrivate},
+			event.CourseUpdated{CourseID: 10, UserID: 1, Authority: model.CourseAuthorityPublic},
+			event.CourseDeleted{CourseID: 10, UserID: 1},
+			event.CourseFavorited{CourseID: 10, CourseOwnerID: 1, UserID: 2},
+			event.DateSpotReviewed{ReviewID: 5, DateSpotID: 3, ReviewerID: 2, Status: model.ReviewStatusPending},
+		}
+		for _, e := range events {
+			payload, err := json.Marshal(e)
+			require.NoError(t, err)
+
+			decoded, err := event.Decode(e.Name(), payload)
+
+			require.NoError(t, err, e.Name())
+			assert.Equal(t, e, decoded)
+		}
+	})
+
+	t.Run("error_unknown_event_name", func(t *testing.T) {
+		_, err := event.Decode("course.archived", []byte(`{}`))
+
+		assert.ErrorIs(t, err, event.ErrUnknownEvent)
+	})
+
+	t.Run("error_malformed_payload", func(t *testing.T) {
+		_, err := event.Decode(event.NameUserFollowed, []byte(`{"follower_id":`))
+
+		assert.Error(t, err)
+	})
+}
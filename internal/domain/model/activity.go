@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// ActivityType はフィードに並べる出来事の種類です。
+type ActivityType string
+
+const (
+	// ActivityTypeCourseCreated はフォロー中のユーザーが公開コースを作成したことを表します。
+	ActivityTypeCourseCreated ActivityType = "course_created"
+	// ActivityTypeReviewCreated はフォロー中のユーザーがレビューを投稿したことを表します。
+	ActivityTypeReviewCreated ActivityType = "review_created"
+	// ActivityTypeUserFollowed はフォロー中のユーザーが他のユーザーをフォローしたことを表します。
+	ActivityTypeUserFollowed ActivityType = "user_followed"
+)
+
+// Activity はフィードの1件です。Type に応じて Course・DateSpotReview・FollowedUser のいずれか1つが入ります。
+// テーブルには保存せず、フィードを読むたびに courses・date_spot_reviews・relationships から組み立てます。
+type Activity struct {
+	Type      ActivityType
+	CreatedAt time.Time
+	// Actor は出来事を起こしたフォロー中のユーザーです。
+	Actor          *User
+	Course         *Course
+	DateSpotReview *DateSpotReview
+	FollowedUser   *User
+}
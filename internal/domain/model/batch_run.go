@@ -0,0 +1,77 @@
+package model
+
+import "time"
+
+// BatchRunStatus はスポット収集バッチの1回の実行の状態です。
+type BatchRunStatus string
+
+const (
+	// BatchRunStatusRunning は実行中です。終了を記録する前にプロセスが落ちた実行もこの状態のまま残ります。
+	BatchRunStatusRunning BatchRunStatus = "running"
+	// BatchRunStatusCompleted は予定した組み合わせを最後まで実行した状態です。個々の組み合わせの失敗は含みます。
+	BatchRunStatusCompleted BatchRunStatus = "completed"
+	// BatchRunStatusInterrupted はシグナルを受けて途中で止めた状態です。残りは次の実行で続けます。
+	BatchRunStatusInterrupted BatchRunStatus = "interrupted"
+)
+
+// BatchTaskStatus は1つの都道府県 × ジャンルの実行結果です。
+type BatchTaskStatus string
+
+const (
+	BatchTaskStatusSucceeded BatchTaskStatus = "succeeded"
+	// BatchTaskStatusSkipped は既存のスポットが十分にあり、取得しなかった状態です。
+	BatchTaskStatusSkipped BatchTaskStatus = "skipped"
+	BatchTaskStatusFailed  BatchTaskStatus = "failed"
+)
+
+// CompletedBatchTaskStatuses は実行済みとみなし、次の実行で後回しにする組み合わせの状態です。
+// 失敗した組み合わせは次の実行で先にやり直します。
+var CompletedBatchTaskStatuses = []BatchTaskStatus{BatchTaskStatusSucceeded, BatchTaskStatusSkipped}
+
+// BatchRun はスポット収集バッチの1回の実行と、その中の組み合わせの件数の合計です。
+type BatchRun struct {
+	ID         uint           `gorm:"primaryKey;autoIncrement"`
+	Status     BatchRunStatus `gorm:"not null;default:running"`
+	StartedAt  time.Time      `gorm:"not null"`
+	FinishedAt *time.Time
+	// TaskCount は実行した組み合わせの数で、Succeeded・Skipped・Failed はその内訳です。
+	TaskCount      int `gorm:"column:task_count;not null;default:0"`
+	SucceededCount int `gorm:"column:succeeded_count;not null;default:0"`
+	SkippedCount   int `gorm:"column:skipped_count;not null;default:0"`
+	FailedCount    int `gorm:"column:failed_count;not null;default:0"`
+	CreatedCount   int `gorm:"column:created_count;not null;default:0"`
+	MergedCount    int `gorm:"column:merged_count;not null;default:0"`
+	PendingCount   int `gorm:"column:pending_count;not null;default:0"`
+}
+
+// AddTask は task の結果を実行の件数に足します。
+func (r *BatchRun) AddTask(task *BatchTask) {
+	r.TaskCount++
+	switch task.Status {
+	case BatchTaskStatusSucceeded:
+		r.SucceededCount++
+	case BatchTaskStatusSkipped:
+		r.SkippedCount++
+	case BatchTaskStatusFailed:
+		r.FailedCount++
+	}
+	r.CreatedCount += task.CreatedCount
+	r.MergedCount += task.MergedCount
+	r.PendingCount += task.PendingCount
+}
+
+// BatchTask は1回の実行の中で、1つの都道府県 × ジャンルを処理した結果です。
+type BatchTask struct {
+	ID           uint            `gorm:"primaryKey;autoIncrement"`
+	RunID        uint            `gorm:"not null;index"`
+	PrefectureID int             `gorm:"not null"`
+	GenreID      int             `gorm:"not null"`
+	Status       BatchTaskStatus `gorm:"not null"`
+	CreatedCount int             `gorm:"column:created_count;not null;default:0"`
+	MergedCount  int             `gorm:"column:merged_count;not null;default:0"`
+	PendingCount int             `gorm:"column:pending_count;not null;default:0"`
+	// Error は失敗したときのエラーメッセージです。
+	Error      *string
+	StartedAt  time.Time `gorm:"not null"`
+	FinishedAt time.Time `gorm:"not null"`
+}
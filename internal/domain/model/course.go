@@ -19,4 +19,14 @@ type Course struct {
 	UpdatedAt   time.Time       `gorm:"not null;autoUpdateTime"`
 	User        *User           `gorm:"foreignKey:UserID"`
 	DuringSpots []*DuringSpot   `gorm:"foreignKey:CourseID"`
+
+	// AverageRate・ReviewTotalNumber は立ち寄り先の公開中レビューの平均評価・件数です。
+	// 評価・レビュー数の順で一覧を引いたときだけ値が入ります（読み取り専用）。
+	AverageRate       float64 `gorm:"column:average_rate;<-:false"`
+	ReviewTotalNumber int     `gorm:"column:review_total_number;<-:false"`
+
+	// Route は立ち寄り先間の移動距離・所要時間の見積もりです（DB には保存しない）。
+	Route *CourseRoute `gorm:"-"`
+	// Favorites はお気に入りの件数と、閲覧者がお気に入りに入れているかです（DB には保存しない）。
+	Favorites *CourseFavoriteSummary `gorm:"-"`
 }
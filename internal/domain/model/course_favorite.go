@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// CourseFavorite はユーザーがあとで見返すために保存したコースです。
+type CourseFavorite struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement"`
+	UserID    uint      `gorm:"not null;index"`
+	CourseID  uint      `gorm:"not null;index"`
+	CreatedAt time.Time `gorm:"not null;autoCreateTime"`
+	Course    *Course   `gorm:"foreignKey:CourseID"`
+}
+
+// CourseFavoriteSummary はコースのお気に入りの集計です。
+type CourseFavoriteSummary struct {
+	Count int
+	// FavoritedByViewer は閲覧しているユーザーがお気に入りに入れているかです。未ログインなら常に false です。
+	FavoritedByViewer bool
+}
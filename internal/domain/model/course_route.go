@@ -0,0 +1,19 @@
+package model
+
+// CourseRoute はコースの立ち寄り先を訪問順に結んだ経路の見積もりです。
+// DB には保存せず、コースを返すたびに CourseRouteService で組み立てます。
+type CourseRoute struct {
+	// TotalDistanceMeters / TotalDurationSeconds は距離が求まった区間だけの合計です。
+	TotalDistanceMeters  int
+	TotalDurationSeconds int
+	Legs                 []*CourseRouteLeg
+}
+
+// CourseRouteLeg は隣り合う2つの立ち寄り先の間の1区間です。
+type CourseRouteLeg struct {
+	FromDateSpotID uint
+	ToDateSpotID   uint
+	// 緯度経度が登録されていないスポットを含む区間は距離を求められないため nil になります。
+	DistanceMeters  *int
+	DurationSeconds *int
+}
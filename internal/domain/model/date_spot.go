@@ -8,6 +8,7 @@ const (
 	DateSpotSourceManual    DateSpotSource = "manual"
 	DateSpotSourceHotPepper DateSpotSource = "hotpepper"
 	DateSpotSourceJalan     DateSpotSource = "jalan"
+	DateSpotSourceGemini    DateSpotSource = "gemini"
 )
 
 type DateSpot struct {
@@ -17,15 +18,37 @@ type DateSpot struct {
 	Name           string `gorm:"not null"`
 	CityName       string `gorm:"not null"`
 	Image          *string
+	ImageThumbnail *string
 	Latitude       *float64
 	Longitude      *float64
 	Source         DateSpotSource `gorm:"not null;default:manual"`
 	MapsURL        *string        `gorm:"column:maps_url"`
 	NormalizedName string
-	CreatedAt      time.Time `gorm:"not null;autoCreateTime"`
-	UpdatedAt      time.Time `gorm:"not null;autoUpdateTime"`
+	// LastSeenAt は取得元で最後に掲載を確認した日時です。手動登録のスポットは nil です。
+	LastSeenAt *time.Time
+	// MissedRefreshes は取り直しで取得元に見つからなかった連続回数です。
+	MissedRefreshes int `gorm:"not null;default:0"`
+	// ClosedAt は閉店とみなした日時です。nil でなければ検索に出しません。
+	ClosedAt     *time.Time
+	CreatedAt    time.Time              `gorm:"not null;autoCreateTime"`
+	UpdatedAt    time.Time              `gorm:"not null;autoUpdateTime"`
+	OpeningHours []*DateSpotOpeningHour `gorm:"foreignKey:DateSpotID"`
+	Closures     []*DateSpotClosure     `gorm:"foreignKey:DateSpotID"`
 
 	// DB集計フィールド (SELECT時のみ使用、マイグレーション対象外)
 	AverageRate       float64 `gorm:"column:average_rate;<-:false"`
 	ReviewTotalNumber int     `gorm:"column:review_total_number;<-:false"`
+	// 現在地から探したときだけ値が入る、中心からの直線距離（メートル）
+	DistanceMeters *float64 `gorm:"column:distance_meters;<-:false"`
+	// キーワードで探したときだけ値が入る、検索語との関連度
+	Relevance *float64 `gorm:"-"`
+}
+
+// SetImage はスポットの画像を差し替えます。image が nil の場合は何もしません。
+func (ds *DateSpot) SetImage(image *Image) {
+	if image == nil {
+		return
+	}
+	ds.Image = &image.URL
+	ds.ImageThumbnail = &image.ThumbnailURL
 }
@@ -0,0 +1,52 @@
+package model
+
+import "time"
+
+// MinutesPerDay は1日の分数です。営業時間は曜日の0時からの経過分で表します。
+const MinutesPerDay = 24 * 60
+
+// JST はスポットの営業時間を解釈するタイムゾーンです。
+// 掲載スポットは国内のみで、日本に夏時間はないため固定オフセットで扱います。
+var JST = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+// DateSpotOpeningHour はデートスポットの曜日ごとの営業時間帯です。
+// 昼営業と夜営業のように、1つの曜日に複数の時間帯を持てます。
+// 日をまたぐ営業は CloseMinute が MinutesPerDay を超え、翌日の分まで続きます。
+type DateSpotOpeningHour struct {
+	ID          uint         `gorm:"primaryKey;autoIncrement"`
+	DateSpotID  uint         `gorm:"not null;index"`
+	Weekday     time.Weekday `gorm:"not null"`
+	OpenMinute  int          `gorm:"not null"`
+	CloseMinute int          `gorm:"not null"`
+}
+
+// DateSpotClosure は祝日や年末年始など、曜日の営業時間によらず休業する日です。
+type DateSpotClosure struct {
+	ID         uint      `gorm:"primaryKey;autoIncrement"`
+	DateSpotID uint      `gorm:"not null;index"`
+	ClosedOn   time.Time `gorm:"type:date;not null"`
+}
+
+// OpenAt は「この日時に営業しているか」で絞り込む条件です。
+// 前日の営業が日付をまたいで続いている場合も拾えるよう、当日と前日の両方を持ちます。
+type OpenAt struct {
+	Weekday     time.Weekday
+	Minute      int
+	Date        time.Time
+	PrevWeekday time.Weekday
+	PrevDate    time.Time
+}
+
+// NewOpenAt は t を JST に直し、曜日と0時からの経過分に分解します。
+func NewOpenAt(t time.Time) OpenAt {
+	local := t.In(JST)
+	date := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, JST)
+	prev := date.AddDate(0, 0, -1)
+	return OpenAt{
+		Weekday:     local.Weekday(),
+		Minute:      local.Hour()*60 + local.Minute(),
+		Date:        date,
+		PrevWeekday: prev.Weekday(),
+		PrevDate:    prev,
+	}
+}
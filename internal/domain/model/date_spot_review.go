@@ -2,14 +2,61 @@ package model
 
 import "time"
 
+// ReviewStatus はレビューの公開状態です。
+type ReviewStatus string
+
+const (
+	// ReviewStatusPublished は投稿されてそのまま公開されている状態です。
+	ReviewStatusPublished ReviewStatus = "published"
+	// ReviewStatusPending は NG ワードや通報で保留になり、管理者の確認を待っている状態です。確認までは公開しません。
+	ReviewStatusPending ReviewStatus = "pending"
+	// ReviewStatusApproved は管理者が確認して公開を認めた状態です。通報が続いても自動では保留にしません。
+	ReviewStatusApproved ReviewStatus = "approved"
+	// ReviewStatusHidden は管理者が非公開にした状態です。
+	ReviewStatusHidden ReviewStatus = "hidden"
+)
+
+// VisibleReviewStatuses は一般の利用者に見せ、評価の平均や件数に数えるレビューの状態です。
+var VisibleReviewStatuses = []ReviewStatus{ReviewStatusPublished, ReviewStatusApproved}
+
+// IsVisible は一般の利用者に見せる状態かどうかを返します。
+func (s ReviewStatus) IsVisible() bool {
+	return s == ReviewStatusPublished || s == ReviewStatusApproved
+}
+
 type DateSpotReview struct {
 	ID         uint `gorm:"primaryKey;autoIncrement"`
 	Rate       *float64
 	Content    *string
-	UserID     uint      `gorm:"not null;index"`
-	DateSpotID uint      `gorm:"not null;index"`
-	CreatedAt  time.Time `gorm:"not null;autoCreateTime"`
-	UpdatedAt  time.Time `gorm:"not null;autoUpdateTime"`
-	User       *User     `gorm:"foreignKey:UserID"`
-	DateSpot   *DateSpot `gorm:"foreignKey:DateSpotID"`
+	UserID     uint         `gorm:"not null;index"`
+	DateSpotID uint         `gorm:"not null;index"`
+	Status     ReviewStatus `gorm:"not null;default:published"`
+	CreatedAt  time.Time    `gorm:"not null;autoCreateTime"`
+	UpdatedAt  time.Time    `gorm:"not null;autoUpdateTime"`
+	User       *User        `gorm:"foreignKey:UserID"`
+	DateSpot   *DateSpot    `gorm:"foreignKey:DateSpotID"`
+	// Reports は管理者の確認待ちの通報です。モデレーションの一覧でだけ読み込みます。
+	Reports []*DateSpotReviewReport `gorm:"foreignKey:DateSpotReviewID"`
+}
+
+// ReportReason は通報の理由です。
+type ReportReason string
+
+const (
+	ReportReasonSpam          ReportReason = "spam"
+	ReportReasonAbuse         ReportReason = "abuse"
+	ReportReasonInappropriate ReportReason = "inappropriate"
+	ReportReasonOther         ReportReason = "other"
+)
+
+// DateSpotReviewReport は利用者からのレビューの通報です。
+// 管理者がレビューを承認または非公開にすると ResolvedAt が入り、確認待ちではなくなります。
+type DateSpotReviewReport struct {
+	ID               uint         `gorm:"primaryKey;autoIncrement"`
+	DateSpotReviewID uint         `gorm:"not null"`
+	UserID           uint         `gorm:"not null"`
+	Reason           ReportReason `gorm:"not null"`
+	Comment          *string
+	ResolvedAt       *time.Time
+	CreatedAt        time.Time `gorm:"not null;autoCreateTime"`
 }
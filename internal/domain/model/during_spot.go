@@ -2,12 +2,22 @@ package model
 
 import "time"
 
+// DuringSpot はデートコースに含まれる1つの立ち寄り先です。
+// コース内の訪問順は Position（1 始まり）で表します。
+// DB の返す行順に頼ると、タイムライン表示で立ち寄り先の順番が入れ替わってしまうためです。
 type DuringSpot struct {
-	ID         uint      `gorm:"primaryKey;autoIncrement"`
-	CourseID   uint      `gorm:"not null;index"`
-	DateSpotID uint      `gorm:"not null;index"`
-	CreatedAt  time.Time `gorm:"not null;autoCreateTime"`
-	UpdatedAt  time.Time `gorm:"not null;autoUpdateTime"`
-	Course     *Course   `gorm:"foreignKey:CourseID"`
-	DateSpot   *DateSpot `gorm:"foreignKey:DateSpotID"`
+	ID         uint `gorm:"primaryKey;autoIncrement"`
+	CourseID   uint `gorm:"not null;index"`
+	DateSpotID uint `gorm:"not null;index"`
+	Position   int  `gorm:"not null;default:0"`
+	// ArrivalTime は到着予定時刻です（"HH:MM" 形式）。未設定の場合は nil です。
+	ArrivalTime *string
+	// StayMinutes は滞在予定時間（分）です。未設定の場合は nil です。
+	StayMinutes *int
+	// Memo はその立ち寄り先を選んだ理由などの自由記述です。
+	Memo      *string
+	CreatedAt time.Time `gorm:"not null;autoCreateTime"`
+	UpdatedAt time.Time `gorm:"not null;autoUpdateTime"`
+	Course    *Course   `gorm:"foreignKey:CourseID"`
+	DateSpot  *DateSpot `gorm:"foreignKey:DateSpotID"`
 }
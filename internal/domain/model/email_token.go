@@ -0,0 +1,68 @@
+package model
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+)
+
+// EmailTokenPurpose はメールで送るトークンの用途です。
+// 用途ごとに照合するため、確認用のトークンでパスワードを再設定するといった取り違えは起きません。
+type EmailTokenPurpose string
+
+const (
+	// EmailTokenPurposeVerifyEmail はメールアドレスの確認に使うトークンです。
+	EmailTokenPurposeVerifyEmail EmailTokenPurpose = "verify_email"
+	// EmailTokenPurposeResetPassword はパスワードの再設定に使うトークンです。
+	EmailTokenPurposeResetPassword EmailTokenPurpose = "reset_password"
+)
+
+// EmailToken はメールのリンクで本人確認するための一度きりのトークンです。
+// RefreshToken と同じく、DB には平文ではなく SHA-256 のハッシュだけを保存します。
+type EmailToken struct {
+	ID      uint              `gorm:"primaryKey;autoIncrement"`
+	UserID  uint              `gorm:"not null"`
+	Purpose EmailTokenPurpose `gorm:"not null"`
+	// Email はトークンを送ったメールアドレスです。
+	// 送った後にユーザーがアドレスを変えていたら、古いアドレス宛てのリンクでは確認済みにしません。
+	Email     string    `gorm:"not null"`
+	TokenHash string    `gorm:"not null;uniqueIndex"`
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time `gorm:"not null;autoCreateTime"`
+}
+
+// emailTokenBytes はメール用トークンの乱数のバイト数です。
+const emailTokenBytes = 32
+
+// NewEmailToken は user の現在のメールアドレス宛てに purpose のトークンを生成します。
+// 戻り値の文字列がメールに載せる平文のトークンで、保存するのは *EmailToken のほうです。
+func NewEmailToken(user *User, purpose EmailTokenPurpose, expiresAt time.Time) (*EmailToken, string, error) {
+	b := make([]byte, emailTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return nil, "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	return &EmailToken{
+		UserID:    user.ID,
+		Purpose:   purpose,
+		Email:     user.Email,
+		TokenHash: HashEmailToken(token),
+		ExpiresAt: expiresAt,
+	}, token, nil
+}
+
+// HashEmailToken は平文のトークンを保存・照合に使うハッシュにします。
+func HashEmailToken(token string) string {
+	return HashRefreshToken(token)
+}
+
+// IsUsed は使用済みかを返します。
+func (t *EmailToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
+
+// IsExpired は now の時点で有効期限が切れているかを返します。
+func (t *EmailToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
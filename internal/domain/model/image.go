@@ -0,0 +1,16 @@
+package model
+
+// ImageKind は画像を使う対象の種類です。保存先のキーの先頭に使います。
+type ImageKind string
+
+const (
+	ImageKindUser     ImageKind = "users"
+	ImageKindDateSpot ImageKind = "date_spots"
+)
+
+// Image はアップロードされて保存済みの画像です。
+// URL は表示用に縮小・再エンコードした本体、ThumbnailURL は一覧向けの小さい画像を指します。
+type Image struct {
+	URL          string
+	ThumbnailURL string
+}
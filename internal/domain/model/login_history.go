@@ -0,0 +1,42 @@
+package model
+
+import "time"
+
+// LoginResult はログインを試した結果です。
+type LoginResult string
+
+const (
+	LoginResultSucceeded LoginResult = "succeeded"
+	// LoginResultFailed はパスワードが誤っていたことを表します。
+	LoginResultFailed LoginResult = "failed"
+	// LoginResultLocked はロック中のためパスワードを確かめずに拒否したことを表します。
+	LoginResultLocked LoginResult = "locked"
+)
+
+// LoginHistory はアカウントへのログインの試行を1回分記録したものです。
+// 本人が身に覚えのないログインや失敗に気づけるよう、成功だけでなく失敗も残します。
+type LoginHistory struct {
+	ID        uint        `gorm:"primaryKey;autoIncrement"`
+	UserID    uint        `gorm:"not null"`
+	Result    LoginResult `gorm:"not null"`
+	IPAddress string      `gorm:"not null"`
+	UserAgent string      `gorm:"not null"`
+	CreatedAt time.Time   `gorm:"not null;autoCreateTime"`
+}
+
+// maxUserAgentLength は保存する User-Agent の最大文字数です。カラムの長さに合わせています。
+const maxUserAgentLength = 512
+
+// NewLoginHistory はログインの試行の記録を生成します。
+// User-Agent はクライアントが自由に送れるため、カラムに収まる長さに切り詰めます。
+func NewLoginHistory(userID uint, result LoginResult, ipAddress string, userAgent string) *LoginHistory {
+	if r := []rune(userAgent); len(r) > maxUserAgentLength {
+		userAgent = string(r[:maxUserAgentLength])
+	}
+	return &LoginHistory{
+		UserID:    userID,
+		Result:    result,
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+	}
+}
@@ -0,0 +1,36 @@
+package model
+
+import "time"
+
+// NotificationType は通知の種類です。
+type NotificationType string
+
+const (
+	// NotificationTypeFollowed は他のユーザーにフォローされたことを表します。
+	NotificationTypeFollowed NotificationType = "followed"
+	// NotificationTypeCourseFavorited は自分のコースがお気に入りに入れられたことを表します。
+	NotificationTypeCourseFavorited NotificationType = "course_favorited"
+	// NotificationTypeCourseSpotReviewed は自分のコースに含まれるデートスポットにレビューが投稿されたことを表します。
+	NotificationTypeCourseSpotReviewed NotificationType = "course_spot_reviewed"
+)
+
+// Notification はユーザーへのアプリ内通知です。
+// CourseID・DateSpotID・DateSpotReviewID は Type に応じて入り、対象が後で削除されても通知は残します。
+type Notification struct {
+	ID uint `gorm:"primaryKey;autoIncrement"`
+	// UserID は通知を受け取るユーザーの ID です。
+	UserID uint `gorm:"not null"`
+	// ActorID は通知のもとになった操作をしたユーザーの ID です。
+	ActorID          uint             `gorm:"not null"`
+	Type             NotificationType `gorm:"not null"`
+	CourseID         *uint
+	DateSpotID       *uint
+	DateSpotReviewID *uint
+	// OutboxEventID は通知のもとになった outbox のイベントの ID です。
+	// 同じイベントを配り直しても、受け取る人ごとに1件しか作らないために使います。
+	OutboxEventID *uint
+	// ReadAt は既読にした日時です。未読のあいだは nil です。
+	ReadAt    *time.Time
+	CreatedAt time.Time `gorm:"not null;autoCreateTime"`
+	Actor     *User     `gorm:"foreignKey:ActorID"`
+}
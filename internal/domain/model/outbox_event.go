@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+// OutboxEventStatus は outbox のイベントを購読者へ配れたかどうかです。
+type OutboxEventStatus string
+
+const (
+	// OutboxEventStatusPending はまだ配っていないか、配り直しを待っている状態です。
+	OutboxEventStatusPending OutboxEventStatus = "pending"
+	// OutboxEventStatusDelivered はすべての購読者へ配り終えた状態です。
+	OutboxEventStatusDelivered OutboxEventStatus = "delivered"
+	// OutboxEventStatusDead は配り直しても失敗が続き、配るのをあきらめた状態です。LastError を見て手で対応します。
+	OutboxEventStatusDead OutboxEventStatus = "dead"
+)
+
+// OutboxEvent は発行元の書き込みと同じトランザクションで保存したドメインイベントです。
+// Payload はイベントを JSON にしたもので、Name と合わせて event.Decode で元の型に戻します。
+type OutboxEvent struct {
+	ID      uint              `gorm:"primaryKey;autoIncrement"`
+	Name    string            `gorm:"not null"`
+	Payload []byte            `gorm:"type:json;not null"`
+	Status  OutboxEventStatus `gorm:"not null"`
+	// Attempts は配ろうとして失敗した回数です。
+	Attempts uint `gorm:"not null"`
+	// NextAttemptAt を過ぎたら配ります。失敗したときは待つ時間を延ばしてずらします。
+	NextAttemptAt time.Time `gorm:"not null"`
+	LastError     *string
+	CreatedAt     time.Time `gorm:"not null;autoCreateTime"`
+	DeliveredAt   *time.Time
+}
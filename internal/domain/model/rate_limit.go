@@ -0,0 +1,75 @@
+package model
+
+import (
+	"math"
+	"time"
+)
+
+// RateLimitRule は Window あたり Limit 回までのリクエストを許すレート制限の条件です。
+// トークンバケットで数えるため、上限まで使い切っても Window を待たずに少しずつ回復します。
+type RateLimitRule struct {
+	Limit  int
+	Window time.Duration
+}
+
+// refillPerSecond は1秒あたりに回復するトークンの数です。
+func (r RateLimitRule) refillPerSecond() float64 {
+	return float64(r.Limit) / r.Window.Seconds()
+}
+
+// RateLimitResult はリクエストを1回数えた結果です。レスポンスヘッダーに載せる値を持ちます。
+type RateLimitResult struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// ResetAt はトークンが上限まで回復する時刻です。
+	ResetAt time.Time
+	// RetryAfter は拒否したときに、次の1回が許されるまでの時間です。
+	RetryAfter time.Duration
+}
+
+// RateLimitBucket はキーごとのトークンバケットの状態です。
+// 時刻の区切りで一斉にリセットする固定窓と違い、窓の境目に2倍のリクエストを通してしまうことがありません。
+type RateLimitBucket struct {
+	BucketKey string  `gorm:"primaryKey"`
+	Tokens    float64 `gorm:"not null"`
+	// RefilledAt は Tokens を計算した時刻です。次に数えるときは、ここからの経過時間の分だけ回復させます。
+	RefilledAt time.Time `gorm:"not null"`
+	// FullAt はトークンが上限まで回復する時刻です。過ぎたバケットは新しいバケットと同じなので消してかまいません。
+	FullAt time.Time `gorm:"not null"`
+}
+
+// NewRateLimitBucket はトークンが上限まで入ったバケットを生成します。
+func NewRateLimitBucket(key string, rule RateLimitRule, now time.Time) *RateLimitBucket {
+	return &RateLimitBucket{
+		BucketKey:  key,
+		Tokens:     float64(rule.Limit),
+		RefilledAt: now,
+		FullAt:     now,
+	}
+}
+
+// Take は now までの回復分を足してから、トークンを1つ取り出します。
+// トークンが足りなければ取り出さずに拒否し、次に取り出せるまでの時間を返します。
+func (b *RateLimitBucket) Take(rule RateLimitRule, now time.Time) RateLimitResult {
+	rate := rule.refillPerSecond()
+	capacity := float64(rule.Limit)
+
+	if elapsed := now.Sub(b.RefilledAt).Seconds(); elapsed > 0 {
+		b.Tokens = math.Min(capacity, b.Tokens+elapsed*rate)
+		b.RefilledAt = now
+	}
+
+	result := RateLimitResult{Limit: rule.Limit}
+	if b.Tokens >= 1 {
+		b.Tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = time.Duration((1 - b.Tokens) / rate * float64(time.Second))
+	}
+	result.Remaining = int(math.Floor(b.Tokens))
+
+	b.FullAt = now.Add(time.Duration((capacity - b.Tokens) / rate * float64(time.Second)))
+	result.ResetAt = b.FullAt
+	return result
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+)
+
+// RefreshToken はアクセストークンを再発行するためのトークンです。
+// クライアントに渡すのはランダムな文字列そのもので、DB には SHA-256 のハッシュだけを保存します。
+// DB が漏れてもトークンとしては使えないようにするためです。
+// 一度使うと失効させ、新しいトークンと差し替えます（ローテーション）。
+type RefreshToken struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement"`
+	UserID    uint      `gorm:"not null"`
+	TokenHash string    `gorm:"not null;uniqueIndex"`
+	ExpiresAt time.Time `gorm:"not null"`
+	RevokedAt *time.Time
+	CreatedAt time.Time `gorm:"not null;autoCreateTime"`
+}
+
+// refreshTokenBytes はリフレッシュトークンの乱数のバイト数です。
+const refreshTokenBytes = 32
+
+// NewRefreshToken は userID 用のリフレッシュトークンを生成します。
+// 戻り値の文字列がクライアントに渡す平文のトークンで、保存するのは *RefreshToken のほうです。
+func NewRefreshToken(userID uint, expiresAt time.Time) (*RefreshToken, string, error) {
+	b := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return nil, "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	return &RefreshToken{
+		UserID:    userID,
+		TokenHash: HashRefreshToken(token),
+		ExpiresAt: expiresAt,
+	}, token, nil
+}
+
+// HashRefreshToken は平文のトークンを保存・照合に使うハッシュにします。
+// トークンは十分な長さの乱数なので、パスワードと違ってソルトや bcrypt は要りません。
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsRevoked は失効済み（使用済み・ログアウト済み）かを返します。
+func (t *RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+// IsExpired は now の時点で有効期限が切れているかを返します。
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
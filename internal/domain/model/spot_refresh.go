@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// SpotRefreshRun は取り込み済みスポットを取得元から取り直した1回分の実行記録です。
+type SpotRefreshRun struct {
+	ID         uint           `gorm:"primaryKey;autoIncrement"`
+	Source     DateSpotSource `gorm:"not null"`
+	StartedAt  time.Time      `gorm:"not null"`
+	FinishedAt *time.Time
+	// Checked は取り直したスポットの数、Updated はそのうち属性が変わったスポットの数です。
+	Checked int `gorm:"column:checked_count;not null;default:0"`
+	Updated int `gorm:"column:updated_count;not null;default:0"`
+	// Missing は取得元に見つからなかったスポットの数、Closed はそのうち今回閉店とみなしたスポットの数です。
+	Missing int `gorm:"column:missing_count;not null;default:0"`
+	Closed  int `gorm:"column:closed_count;not null;default:0"`
+	// Reopened は閉店とみなしていたが、取得元にまた載っていたスポットの数です。
+	Reopened int `gorm:"column:reopened_count;not null;default:0"`
+	// Failed は取得元の呼び出しに失敗して確認できなかったスポットの数です。見つからなかった回数には数えません。
+	Failed int `gorm:"column:failed_count;not null;default:0"`
+}
+
+// SpotRefreshChange は取り直しで変わったスポットの属性1つ分の差分です。
+// 閉店・再開は Field が closed_at の変更として残します。値が無い側は nil です。
+type SpotRefreshChange struct {
+	ID         uint      `gorm:"primaryKey;autoIncrement"`
+	RunID      uint      `gorm:"not null;index"`
+	DateSpotID uint      `gorm:"not null;index"`
+	Field      string    `gorm:"not null"`
+	Before     *string   `gorm:"column:before_value"`
+	After      *string   `gorm:"column:after_value"`
+	CreatedAt  time.Time `gorm:"not null;autoCreateTime"`
+}
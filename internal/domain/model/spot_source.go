@@ -0,0 +1,38 @@
+package model
+
+import "time"
+
+// SpotSourceStatus は取り込んだ取得結果がスポットに統合されたかどうかです。
+type SpotSourceStatus string
+
+const (
+	// SpotSourceStatusLinked は DateSpotID のスポットに統合済みの状態です。
+	SpotSourceStatusLinked SpotSourceStatus = "linked"
+	// SpotSourceStatusPending は既存スポットと同じ場所か判断がつかず、管理者の確認を待っている状態です。
+	// DateSpotID は一致しそうな既存スポットを指し、確認までは新しいスポットを作りません。
+	SpotSourceStatusPending SpotSourceStatus = "pending"
+)
+
+// SpotSource は外部の取得元から取り込んだスポット1件分の記録です。
+// 同じ場所を複数の取得元から取り込んだときは、1つの DateSpot に複数の SpotSource がつながります。
+type SpotSource struct {
+	ID           uint           `gorm:"primaryKey;autoIncrement"`
+	DateSpotID   uint           `gorm:"not null;index"`
+	GenreID      int            `gorm:"not null"`
+	PrefectureID int            `gorm:"not null"`
+	Source       DateSpotSource `gorm:"not null"`
+	// ExternalID は取得元でのスポットの ID です。ID を持たない取得元（gemini）では nil です。
+	ExternalID *string
+	Name       string `gorm:"not null"`
+	CityName   string `gorm:"not null"`
+	Latitude   *float64
+	Longitude  *float64
+	Image      *string
+	PageURL    *string `gorm:"column:page_url"`
+	// MatchScore は取り込んだときの DateSpotID のスポットとの一致度（0〜1）です。新しく作ったスポットなら 1 です。
+	MatchScore float64          `gorm:"not null"`
+	Status     SpotSourceStatus `gorm:"not null;default:linked"`
+	CreatedAt  time.Time        `gorm:"not null;autoCreateTime"`
+	UpdatedAt  time.Time        `gorm:"not null;autoUpdateTime"`
+	DateSpot   *DateSpot        `gorm:"foreignKey:DateSpotID"`
+}
@@ -19,45 +19,85 @@ type User struct {
 	Email          string `gorm:"not null;uniqueIndex"`
 	Gender         Gender `gorm:"not null"`
 	Image          *string
-	Admin          bool      `gorm:"not null;default:false"`
-	PasswordDigest string    `gorm:"not null"`
-	CreatedAt      time.Time `gorm:"not null;autoCreateTime"`
-	UpdatedAt      time.Time `gorm:"not null;autoUpdateTime"`
+	ImageThumbnail *string
+	Admin          bool   `gorm:"not null;default:false"`
+	PasswordDigest string `gorm:"not null"`
+	// TokenVersion はアクセストークンの世代です。上げるとそれより前に発行したトークンが使えなくなります。
+	TokenVersion uint `gorm:"not null;default:0"`
+	// EmailVerifiedAt はメールアドレスを確認した日時です。未確認の間は nil です。
+	EmailVerifiedAt *time.Time
+	// FailedLoginCount は最後にログインに成功してから続けて失敗した回数です。ロックしたら 0 に戻します。
+	FailedLoginCount uint `gorm:"not null;default:0"`
+	// LockedUntil はログインの失敗が続いたためにログインを受け付けない期限です。
+	LockedUntil *time.Time
+	LastLoginAt *time.Time
+	LastLoginIP *string
+	CreatedAt   time.Time `gorm:"not null;autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"not null;autoUpdateTime"`
 }
 
 // NewUser は新規ユーザーを生成します。
 // passwordDigest にはハッシュ化済みのパスワードを渡してください。
 // image は nil を許容します。
-func NewUser(name string, email string, gender Gender, image *string, passwordDigest string) *User {
-	return &User{
+func NewUser(name string, email string, gender Gender, image *Image, passwordDigest string) *User {
+	u := &User{
 		Name:           name,
 		Email:          email,
 		Gender:         gender,
-		Image:          image,
 		PasswordDigest: passwordDigest,
 	}
+	u.SetImage(image)
+	return u
 }
 
 // ApplyUpdate はユーザーの更新可能なフィールドを上書きします。
 // password が空文字の場合はパスワードを更新しません。
+// メールアドレスを変えたときは、新しいアドレスを確認するまで未確認に戻します。
 // image は nil の場合は更新しません。
-func (u *User) ApplyUpdate(name string, email string, gender Gender, image *string, password string) error {
+func (u *User) ApplyUpdate(name string, email string, gender Gender, image *Image, password string) error {
 	u.Name = name
+	if u.Email != email {
+		u.EmailVerifiedAt = nil
+	}
 	u.Email = email
 	u.Gender = gender
-	if image != nil {
-		u.Image = image
-	}
+	u.SetImage(image)
 	if password != "" {
-		hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-		if err != nil {
-			return err
-		}
-		u.PasswordDigest = string(hashed)
+		return u.SetPassword(password)
 	}
 	return nil
 }
 
+// SetPassword はパスワードを変えます。
+// 発行済みのアクセストークンの無効化は、同時に上げても消えないよう UserRepository.IncrementTokenVersion で行います。
+func (u *User) SetPassword(password string) error {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	u.PasswordDigest = string(hashed)
+	return nil
+}
+
+// IsEmailVerified はメールアドレスを確認済みかを返します。
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// IsLocked は now の時点でログインを受け付けない状態かを返します。
+func (u *User) IsLocked(now time.Time) bool {
+	return u.LockedUntil != nil && now.Before(*u.LockedUntil)
+}
+
+// SetImage はプロフィール画像を差し替えます。image が nil の場合は何もしません。
+func (u *User) SetImage(image *Image) {
+	if image == nil {
+		return
+	}
+	u.Image = &image.URL
+	u.ImageThumbnail = &image.ThumbnailURL
+}
+
 // UserWithRelations はユーザーと関連データをまとめた中間型です。
 type UserWithRelations struct {
 	User         *User
@@ -65,4 +105,6 @@ type UserWithRelations struct {
 	FollowingIDs []int
 	Courses      []*Course
 	Reviews      []*DateSpotReview
+	// FavoriteCourses はお気に入りのコースの先頭ページです。ユーザー詳細でだけ取得し、一覧では nil のままです。
+	FavoriteCourses []*Course
 }
@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// UserBlock は UserID のユーザーが BlockedUserID のユーザーをブロックしたことを表します。
+// ブロックは双方向に効き、どちらの側からもフォローできず、互いのコース・レビューが一覧に出なくなります。
+type UserBlock struct {
+	ID            uint      `gorm:"primaryKey;autoIncrement"`
+	UserID        uint      `gorm:"not null"`
+	BlockedUserID uint      `gorm:"not null;index"`
+	CreatedAt     time.Time `gorm:"not null;autoCreateTime"`
+}
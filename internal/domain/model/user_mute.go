@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// UserMute は UserID のユーザーが MutedUserID のユーザーをミュートしたことを表します。
+// ミュートした本人の一覧から相手のコンテンツを除くだけで、相手からは気付けないようにします。
+type UserMute struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	UserID      uint      `gorm:"not null"`
+	MutedUserID uint      `gorm:"not null;index"`
+	CreatedAt   time.Time `gorm:"not null;autoCreateTime"`
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+)
+
+type BatchRunRepository interface {
+	CreateRun(ctx context.Context, run *model.BatchRun) error
+	// FinishRun は run の状態・終了日時・件数を書き込みます。
+	FinishRun(ctx context.Context, run *model.BatchRun) error
+	CreateTask(ctx context.Context, task *model.BatchTask) error
+	// FindLastCompletedTasks は都道府県 × ジャンルごとに、成功またはスキップで最後に終わった日時を返します。
+	// 返す BatchTask には PrefectureID・GenreID・FinishedAt だけが入ります。
+	FindLastCompletedTasks(ctx context.Context) ([]*model.BatchTask, error)
+	// FindLastFailedTasks は都道府県 × ジャンルごとに、失敗で最後に終わった日時を返します。
+	// 返す BatchTask には PrefectureID・GenreID・FinishedAt だけが入ります。
+	FindLastFailedTasks(ctx context.Context) ([]*model.BatchTask, error)
+}
@@ -0,0 +1,12 @@
+package repository
+
+import "context"
+
+// BlobStore はアップロードされた画像などのファイルを保存するストレージです。
+// ローカルのファイルシステムと S3 互換のオブジェクトストレージを差し替えられるよう interface にしています。
+type BlobStore interface {
+	// Put は data を key に保存し、公開 URL を返します。同じ key があれば上書きします。
+	Put(ctx context.Context, key string, contentType string, data []byte) (string, error)
+	// Delete は key のファイルを削除します。存在しない場合もエラーにしません。
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+)
+
+type CourseFavoriteSearchParams struct {
+	UserID uint
+	Page   pagination.Params
+}
+
+type CourseFavoriteRepository interface {
+	// Create はお気に入りを保存し、新しく保存したかを返します。既に保存済みなら何もせず false を返します。
+	Create(ctx context.Context, favorite *model.CourseFavorite) (bool, error)
+	DeleteByUserIDAndCourseID(ctx context.Context, userID, courseID uint) error
+	// SearchCoursesByUserID はユーザーがお気に入りに入れた公開コースを、入れた日時の新しい順に返します。
+	// お気に入りに入れた後で非公開になったコースは返しません。
+	SearchCoursesByUserID(ctx context.Context, params CourseFavoriteSearchParams) (pagination.Page[*model.Course], error)
+	// SummarizeByCourseIDs はコースごとのお気に入り件数と、viewerID がお気に入りに入れているかを返します。
+	// viewerID は閲覧しているユーザーの ID で、未ログインの場合は 0 を渡します。
+	// お気に入りが1件もないコースも、件数 0 として結果に含めます。
+	SummarizeByCourseIDs(ctx context.Context, courseIDs []uint, viewerID uint) (map[uint]*model.CourseFavoriteSummary, error)
+}
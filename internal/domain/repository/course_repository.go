@@ -4,10 +4,17 @@ import (
 	"context"
 
 	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 )
 
 type CourseSearchParams struct {
 	PrefectureID *int
+	// Sort は pagination.SortNewest・SortRating・SortReviewCount・SortName に対応します。SortName は作成者の名前で並べます。
+	Sort pagination.Sort
+	Page pagination.Params
+	// ViewerID は閲覧しているユーザーの ID で、未ログインの場合は 0 です。
+	// ブロック・ミュートしている相手のコースを除きます。
+	ViewerID uint
 }
 
 // 非公開コースは作成者本人にしか見せないため、取得系はデフォルトで公開コースだけを返します。
@@ -19,9 +26,15 @@ type CourseRepository interface {
 	FindPublicByUserIDs(ctx context.Context, userIDs []uint) (map[uint][]*model.Course, error)
 	// FindAllByUserID は非公開コースも含めて返します。本人のマイページ専用です。
 	FindAllByUserID(ctx context.Context, userID uint) ([]*model.Course, error)
-	Search(ctx context.Context, params CourseSearchParams) ([]*model.Course, error)
+	// FindOwnerIDsByDateSpotID は dateSpotID を立ち寄り先に含むコースの作成者の ID を返します。
+	// 自分のコースのスポットにレビューが付いたことを知らせる相手を探すため、非公開コースも含めます。
+	FindOwnerIDsByDateSpotID(ctx context.Context, dateSpotID uint) ([]uint, error)
+	Search(ctx context.Context, params CourseSearchParams) (pagination.Page[*model.Course], error)
 	// FindByID は公開コース、または viewerID 自身が作成した非公開コースを返します。
 	// viewerID は閲覧しているユーザーの ID で、未ログインの場合は 0 を渡します。
 	FindByID(ctx context.Context, id, viewerID uint) (*model.Course, error)
+	// Update はコースの移動手段・公開設定を更新し、立ち寄り先を duringSpots で置き換えます。
+	// 立ち寄り先の並び替え・追加・削除をまとめて反映するため、1トランザクションで行います。
+	Update(ctx context.Context, course *model.Course, duringSpots []*model.DuringSpot) error
 	DeleteByID(ctx context.Context, id uint) error
 }
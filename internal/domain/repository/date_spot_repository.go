@@ -4,23 +4,58 @@ import (
 	"context"
 
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 )
 
 // DateSpotSearchParams はdate_spotsの検索条件を表します。
 type DateSpotSearchParams struct {
-	Name         *string
+	// Matches はキーワード検索でヒットしたスポットです。nil でなければこのスポットだけに絞り込みます。
+	Matches      []DateSpotSearchHit
 	PrefectureID *int
 	GenreID      *int
-	ComeTime     *string
+	// OpenAt を指定すると、その日時に営業しているスポットだけを返します。
+	// 営業時間が登録されていないスポットは営業しているか判断できないため含めません。
+	OpenAt *model.OpenAt
+	// Latitude・Longitude・RadiusMeters がすべて指定されたときは、
+	// 中心から半径内のスポットだけを距離の近い順で返します。
+	Latitude     *float64
+	Longitude    *float64
+	RadiusMeters *int
+	// Sort は newest・rating・review_count・name に対応し、
+	// 現在地から検索するときは distance、キーワードで検索するときは relevance（Matches の順）も使えます。
+	Sort pagination.Sort
+	Page pagination.Params
+}
+
+// HasMatches はキーワード検索の結果で絞り込むかを返します。
+func (p DateSpotSearchParams) HasMatches() bool {
+	return p.Matches != nil
+}
+
+// HasNear は現在地からの距離で絞り込む条件が揃っているかを返します。
+func (p DateSpotSearchParams) HasNear() bool {
+	return p.Latitude != nil && p.Longitude != nil && p.RadiusMeters != nil
 }
 
 type DateSpotRepository interface {
 	Create(ctx context.Context, dateSpot *model.DateSpot) error
 	FindByID(ctx context.Context, id uint) (*model.DateSpot, error)
-	Search(ctx context.Context, params DateSpotSearchParams) ([]*model.DateSpot, error)
+	// FindExistingIDs は ids のうち実在するデートスポットの ID だけを返します。
+	// 参照先の存在確認を書き込み前にまとめて行うために使います。
+	FindExistingIDs(ctx context.Context, ids []uint) ([]uint, error)
+	Search(ctx context.Context, params DateSpotSearchParams) (pagination.Page[*model.DateSpot], error)
 	Update(ctx context.Context, id uint, dateSpot *model.DateSpot) error
 	Delete(ctx context.Context, id uint) error
-	ExistsByNormalizedNameAndPrefecture(ctx context.Context, normalizedName string, prefectureID int) (bool, error)
+	// FindByPrefecture は重複判定の照合相手として、都道府県内のスポットを名前・市区町村・座標だけ読み込んで返します。
+	// 閉店とみなしたスポットは検索に出ないため、統合先にならないよう除きます。
+	FindByPrefecture(ctx context.Context, prefectureID int) ([]*model.DateSpot, error)
+	// FillMissing は id のスポットの空いている属性（画像・座標・地図 URL・営業時間・休業日）を from の値で埋めます。
+	// 既に値がある属性は上書きしません。
+	FillMissing(ctx context.Context, id uint, from *model.DateSpot) error
+	// UpdateRefreshed は取得元から取り直した属性（名前・市区町村・画像・座標・地図 URL）と、
+	// 掲載の確認状況（最終確認日時・見つからなかった回数・閉店日時）を書き込みます。値が nil の属性も nil で上書きします。
+	UpdateRefreshed(ctx context.Context, dateSpot *model.DateSpot) error
+	// CountByPrefectureAndGenre は閉店とみなしたスポットを数えません。
 	CountByPrefectureAndGenre(ctx context.Context, prefectureID, genreID int) (int64, error)
 	CreateBatch(ctx context.Context, dateSpots []*model.DateSpot) error
 }
@@ -2,16 +2,45 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 )
 
+type ReviewModerationParams struct {
+	// Status が nil のときは確認待ちの一覧として、保留中のレビューと未対応の通報があるレビューを返します。
+	Status *model.ReviewStatus
+	// Sort は pagination.SortOldest と pagination.SortNewest に対応します。
+	Sort pagination.Sort
+	Page pagination.Params
+}
+
+// 一覧系の取得（FindByUserIDs・FindByDateSpotID）は公開中のレビューだけを返します。
+// 非公開・保留中のレビューも扱うのは FindByID とモデレーション用のメソッドです。
 type DateSpotReviewRepository interface {
 	Create(ctx context.Context, review *model.DateSpotReview) error
 	FindByID(ctx context.Context, id uint) (*model.DateSpotReview, error)
 	// FindByUserIDs は指定ユーザーたちのレビューを userID ごとにまとめて返します。
 	FindByUserIDs(ctx context.Context, userIDs []uint) (map[uint][]*model.DateSpotReview, error)
-	FindByDateSpotID(ctx context.Context, dateSpotID uint) ([]*model.DateSpotReview, error)
+	// FindByDateSpotID は viewerID がブロック・ミュートしている相手のレビューを除いて返します。
+	// viewerID は閲覧しているユーザーの ID で、未ログインの場合は 0 を渡します。
+	FindByDateSpotID(ctx context.Context, dateSpotID, viewerID uint) ([]*model.DateSpotReview, error)
+	// DeleteByID はレビューへの通報ごと削除します。
 	DeleteByID(ctx context.Context, id uint) error
+	// UpdateByID は指定 ID のレビューを更新します。nil・空のフィールドは更新しません。
 	UpdateByID(ctx context.Context, id uint, review *model.DateSpotReview) error
+	// UpdateStatus はレビューの公開状態だけを変更します。
+	UpdateStatus(ctx context.Context, id uint, status model.ReviewStatus) error
+
+	// CreateReport は通報を登録します。同じユーザーが同じレビューを2回通報すると一意キー違反になります。
+	CreateReport(ctx context.Context, report *model.DateSpotReviewReport) error
+	// ExistsReport は userID がすでに reviewID を通報しているかを返します。
+	ExistsReport(ctx context.Context, reviewID, userID uint) (bool, error)
+	// CountOpenReports は管理者がまだ対応していない通報の件数を返します。
+	CountOpenReports(ctx context.Context, reviewID uint) (int64, error)
+	// ResolveReports は未対応の通報をすべて対応済みにします。
+	ResolveReports(ctx context.Context, reviewID uint, at time.Time) error
+	// SearchForModeration は管理者向けに、User・DateSpot・未対応の通報を含めたレビューを返します。
+	SearchForModeration(ctx context.Context, params ReviewModerationParams) (pagination.Page[*model.DateSpotReview], error)
 }
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+)
+
+// DateSpotSearchHit はキーワード検索でヒットしたデートスポットと、検索語との関連度です。
+type DateSpotSearchHit struct {
+	DateSpotID uint
+	Score      float64
+}
+
+// DateSpotSearchIndex はデートスポットの名前・ジャンル名・市区町村をキーワードで引く検索インデックスです。
+// 全角・半角やひらがな・カタカナの違いを無視し、関連度の高い順に返します。
+type DateSpotSearchIndex interface {
+	// Search は検索語に一致するスポットを関連度の高い順に最大 limit 件返します。limit が 0 以下なら全件返します。
+	Search(ctx context.Context, query string, limit int) ([]DateSpotSearchHit, error)
+	// Put はスポットを登録します。登録済みなら内容を置き換えます。
+	Put(ctx context.Context, dateSpot *model.DateSpot) error
+	Remove(ctx context.Context, id uint) error
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+)
+
+type EmailTokenRepository interface {
+	Create(ctx context.Context, token *model.EmailToken) error
+	FindByHash(ctx context.Context, purpose model.EmailTokenPurpose, tokenHash string) (*model.EmailToken, error)
+	// MarkUsed はまだ使われていないトークンを at の時刻で使用済みにします。
+	// 同じリンクが同時に開かれたときに1回だけ成功させるため、使用済みにしたかどうかを返します。
+	MarkUsed(ctx context.Context, id uint, at time.Time) (bool, error)
+	// InvalidateByUserID はユーザーの purpose のまだ使われていないトークンをすべて使用済みにします。
+	// 送り直したときや再設定を終えたときに、古いメールのリンクを使えなくするためです。
+	InvalidateByUserID(ctx context.Context, userID uint, purpose model.EmailTokenPurpose, at time.Time) error
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+)
+
+// FeedSearchParams はフィードを読むユーザーとページ指定です。並び順は新しい順だけです。
+type FeedSearchParams struct {
+	UserID uint
+	Page   pagination.Params
+}
+
+// FeedRepository はフォロー中のユーザーの出来事をまとめたフィードを返します。
+//
+// 出来事を activities テーブルへ書き出しておく方式（fan-out-on-write）ではなく、
+// 読むたびに courses・date_spot_reviews・relationships を引き直す方式（fan-out-on-read）です。
+// 書き込み側に手を入れずに済み、コースの非公開化・レビューの非公開・フォロー解除・退会が
+// 次の読み込みからそのまま反映されます。代わりにフォロー数が多いほど読み込みが重くなるため、
+// 重くなったら activities テーブルに切り替えます。その場合もこのインターフェースは変えません。
+type FeedRepository interface {
+	// Search は params.UserID がフォローしているユーザーの公開コースの作成・公開中のレビューの投稿・フォローを、
+	// 新しい順に1ページ分返します。非公開のコースと、ブロック・ミュートしている相手の出来事は含めません。
+	Search(ctx context.Context, params FeedSearchParams) (pagination.Page[*model.Activity], error)
+}
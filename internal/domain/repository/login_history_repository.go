@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+)
+
+type LoginHistorySearchParams struct {
+	UserID uint
+	Page   pagination.Params
+}
+
+type LoginHistoryRepository interface {
+	Create(ctx context.Context, history *model.LoginHistory) error
+	// SearchByUserID はユーザーのログイン履歴を新しい順に返します。
+	SearchByUserID(ctx context.Context, params LoginHistorySearchParams) (pagination.Page[*model.LoginHistory], error)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import "context"
+
+// MailMessage は送信するメールです。本文はプレーンテキストです。
+type MailMessage struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer はメールを送信します。
+// 本番の SMTP と、ローカル開発でファイルに書き出すだけの実装を差し替えられるよう interface にしています。
+type Mailer interface {
+	Send(ctx context.Context, msg MailMessage) error
+}
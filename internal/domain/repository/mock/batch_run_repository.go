@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/batch_run_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/batch_run_repository.go -destination=internal/domain/repository/mock/batch_run_repository.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBatchRunRepository is a mock of BatchRunRepository interface.
+type MockBatchRunRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchRunRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBatchRunRepositoryMockRecorder is the mock recorder for MockBatchRunRepository.
+type MockBatchRunRepositoryMockRecorder struct {
+	mock *MockBatchRunRepository
+}
+
+// NewMockBatchRunRepository creates a new mock instance.
+func NewMockBatchRunRepository(ctrl *gomock.Controller) *MockBatchRunRepository {
+	mock := &MockBatchRunRepository{ctrl: ctrl}
+	mock.recorder = &MockBatchRunRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchRunRepository) EXPECT() *MockBatchRunRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateRun mocks base method.
+func (m *MockBatchRunRepository) CreateRun(ctx context.Context, run *model.BatchRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRun", ctx, run)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRun indicates an expected call of CreateRun.
+func (mr *MockBatchRunRepositoryMockRecorder) CreateRun(ctx, run any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRun", reflect.TypeOf((*MockBatchRunRepository)(nil).CreateRun), ctx, run)
+}
+
+// CreateTask mocks base method.
+func (m *MockBatchRunRepository) CreateTask(ctx context.Context, task *model.BatchTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTask", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTask indicates an expected call of CreateTask.
+func (mr *MockBatchRunRepositoryMockRecorder) CreateTask(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTask", reflect.TypeOf((*MockBatchRunRepository)(nil).CreateTask), ctx, task)
+}
+
+// FindLastCompletedTasks mocks base method.
+func (m *MockBatchRunRepository) FindLastCompletedTasks(ctx context.Context) ([]*model.BatchTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLastCompletedTasks", ctx)
+	ret0, _ := ret[0].([]*model.BatchTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLastCompletedTasks indicates an expected call of FindLastCompletedTasks.
+func (mr *MockBatchRunRepositoryMockRecorder) FindLastCompletedTasks(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLastCompletedTasks", reflect.TypeOf((*MockBatchRunRepository)(nil).FindLastCompletedTasks), ctx)
+}
+
+// FindLastFailedTasks mocks base method.
+func (m *MockBatchRunRepository) FindLastFailedTasks(ctx context.Context) ([]*model.BatchTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLastFailedTasks", ctx)
+	ret0, _ := ret[0].([]*model.BatchTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLastFailedTasks indicates an expected call of FindLastFailedTasks.
+func (mr *MockBatchRunRepositoryMockRecorder) FindLastFailedTasks(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLastFailedTasks", reflect.TypeOf((*MockBatchRunRepository)(nil).FindLastFailedTasks), ctx)
+}
+
+// FinishRun mocks base method.
+func (m *MockBatchRunRepository) FinishRun(ctx context.Context, run *model.BatchRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishRun", ctx, run)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishRun indicates an expected call of FinishRun.
+func (mr *MockBatchRunRepositoryMockRecorder) FinishRun(ctx, run any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishRun", reflect.TypeOf((*MockBatchRunRepository)(nil).FinishRun), ctx, run)
+}
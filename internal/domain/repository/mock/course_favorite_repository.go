@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/course_favorite_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/course_favorite_repository.go -destination=internal/domain/repository/mock/course_favorite_repository.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repository "github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	pagination "github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCourseFavoriteRepository is a mock of CourseFavoriteRepository interface.
+type MockCourseFavoriteRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCourseFavoriteRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCourseFavoriteRepositoryMockRecorder is the mock recorder for MockCourseFavoriteRepository.
+type MockCourseFavoriteRepositoryMockRecorder struct {
+	mock *MockCourseFavoriteRepository
+}
+
+// NewMockCourseFavoriteRepository creates a new mock instance.
+func NewMockCourseFavoriteRepository(ctrl *gomock.Controller) *MockCourseFavoriteRepository {
+	mock := &MockCourseFavoriteRepository{ctrl: ctrl}
+	mock.recorder = &MockCourseFavoriteRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCourseFavoriteRepository) EXPECT() *MockCourseFavoriteRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCourseFavoriteRepository) Create(ctx context.Context, favorite *model.CourseFavorite) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, favorite)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCourseFavoriteRepositoryMockRecorder) Create(ctx, favorite any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCourseFavoriteRepository)(nil).Create), ctx, favorite)
+}
+
+// DeleteByUserIDAndCourseID mocks base method.
+func (m *MockCourseFavoriteRepository) DeleteByUserIDAndCourseID(ctx context.Context, userID, courseID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserIDAndCourseID", ctx, userID, courseID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserIDAndCourseID indicates an expected call of DeleteByUserIDAndCourseID.
+func (mr *MockCourseFavoriteRepositoryMockRecorder) DeleteByUserIDAndCourseID(ctx, userID, courseID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserIDAndCourseID", reflect.TypeOf((*MockCourseFavoriteRepository)(nil).DeleteByUserIDAndCourseID), ctx, userID, courseID)
+}
+
+// SearchCoursesByUserID mocks base method.
+func (m *MockCourseFavoriteRepository) SearchCoursesByUserID(ctx context.Context, params repository.CourseFavoriteSearchParams) (pagination.Page[*model.Course], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchCoursesByUserID", ctx, params)
+	ret0, _ := ret[0].(pagination.Page[*model.Course])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchCoursesByUserID indicates an expected call of SearchCoursesByUserID.
+func (mr *MockCourseFavoriteRepositoryMockRecorder) SearchCoursesByUserID(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCoursesByUserID", reflect.TypeOf((*MockCourseFavoriteRepository)(nil).SearchCoursesByUserID), ctx, params)
+}
+
+// SummarizeByCourseIDs mocks base method.
+func (m *MockCourseFavoriteRepository) SummarizeByCourseIDs(ctx context.Context, courseIDs []uint, viewerID uint) (map[uint]*model.CourseFavoriteSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SummarizeByCourseIDs", ctx, courseIDs, viewerID)
+	ret0, _ := ret[0].(map[uint]*model.CourseFavoriteSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SummarizeByCourseIDs indicates an expected call of SummarizeByCourseIDs.
+func (mr *MockCourseFavoriteRepositoryMockRecorder) SummarizeByCourseIDs(ctx, courseIDs, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SummarizeByCourseIDs", reflect.TypeOf((*MockCourseFavoriteRepository)(nil).SummarizeByCourseIDs), ctx, courseIDs, viewerID)
+}
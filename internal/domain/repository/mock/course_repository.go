@@ -15,6 +15,7 @@ import (
 
 	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	repository "github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	pagination "github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -100,6 +101,21 @@ func (mr *MockCourseRepositoryMockRecorder) FindByID(ctx, id, viewerID any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockCourseRepository)(nil).FindByID), ctx, id, viewerID)
 }
 
+// FindOwnerIDsByDateSpotID mocks base method.
+func (m *MockCourseRepository) FindOwnerIDsByDateSpotID(ctx context.Context, dateSpotID uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOwnerIDsByDateSpotID", ctx, dateSpotID)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOwnerIDsByDateSpotID indicates an expected call of FindOwnerIDsByDateSpotID.
+func (mr *MockCourseRepositoryMockRecorder) FindOwnerIDsByDateSpotID(ctx, dateSpotID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOwnerIDsByDateSpotID", reflect.TypeOf((*MockCourseRepository)(nil).FindOwnerIDsByDateSpotID), ctx, dateSpotID)
+}
+
 // FindPublicByUserIDs mocks base method.
 func (m *MockCourseRepository) FindPublicByUserIDs(ctx context.Context, userIDs []uint) (map[uint][]*model.Course, error) {
 	m.ctrl.T.Helper()
@@ -116,10 +132,10 @@ func (mr *MockCourseRepositoryMockRecorder) FindPublicByUserIDs(ctx, userIDs any
 }
 
 // Search mocks base method.
-func (m *MockCourseRepository) Search(ctx context.Context, params repository.CourseSearchParams) ([]*model.Course, error) {
+func (m *MockCourseRepository) Search(ctx context.Context, params repository.CourseSearchParams) (pagination.Page[*model.Course], error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Search", ctx, params)
-	ret0, _ := ret[0].([]*model.Course)
+	ret0, _ := ret[0].(pagination.Page[*model.Course])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -129,3 +145,17 @@ func (mr *MockCourseRepositoryMockRecorder) Search(ctx, params any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockCourseRepository)(nil).Search), ctx, params)
 }
+
+// Update mocks base method.
+func (m *MockCourseRepository) Update(ctx context.Context, course *model.Course, duringSpots []*model.DuringSpot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, course, duringSpots)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCourseRepositoryMockRecorder) Update(ctx, course, duringSpots any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCourseRepository)(nil).Update), ctx, course, duringSpots)
+}
@@ -15,6 +15,7 @@ import (
 
 	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	repository "github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	pagination "github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -99,19 +100,18 @@ func (mr *MockDateSpotRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDateSpotRepository)(nil).Delete), ctx, id)
 }
 
-// ExistsByNormalizedNameAndPrefecture mocks base method.
-func (m *MockDateSpotRepository) ExistsByNormalizedNameAndPrefecture(ctx context.Context, normalizedName string, prefectureID int) (bool, error) {
+// FillMissing mocks base method.
+func (m *MockDateSpotRepository) FillMissing(ctx context.Context, id uint, from *model.DateSpot) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExistsByNormalizedNameAndPrefecture", ctx, normalizedName, prefectureID)
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "FillMissing", ctx, id, from)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ExistsByNormalizedNameAndPrefecture indicates an expected call of ExistsByNormalizedNameAndPrefecture.
-func (mr *MockDateSpotRepositoryMockRecorder) ExistsByNormalizedNameAndPrefecture(ctx, normalizedName, prefectureID any) *gomock.Call {
+// FillMissing indicates an expected call of FillMissing.
+func (mr *MockDateSpotRepositoryMockRecorder) FillMissing(ctx, id, from any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsByNormalizedNameAndPrefecture", reflect.TypeOf((*MockDateSpotRepository)(nil).ExistsByNormalizedNameAndPrefecture), ctx, normalizedName, prefectureID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FillMissing", reflect.TypeOf((*MockDateSpotRepository)(nil).FillMissing), ctx, id, from)
 }
 
 // FindByID mocks base method.
@@ -129,11 +129,41 @@ func (mr *MockDateSpotRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockDateSpotRepository)(nil).FindByID), ctx, id)
 }
 
+// FindByPrefecture mocks base method.
+func (m *MockDateSpotRepository) FindByPrefecture(ctx context.Context, prefectureID int) ([]*model.DateSpot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByPrefecture", ctx, prefectureID)
+	ret0, _ := ret[0].([]*model.DateSpot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByPrefecture indicates an expected call of FindByPrefecture.
+func (mr *MockDateSpotRepositoryMockRecorder) FindByPrefecture(ctx, prefectureID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPrefecture", reflect.TypeOf((*MockDateSpotRepository)(nil).FindByPrefecture), ctx, prefectureID)
+}
+
+// FindExistingIDs mocks base method.
+func (m *MockDateSpotRepository) FindExistingIDs(ctx context.Context, ids []uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExistingIDs", ctx, ids)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindExistingIDs indicates an expected call of FindExistingIDs.
+func (mr *MockDateSpotRepositoryMockRecorder) FindExistingIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExistingIDs", reflect.TypeOf((*MockDateSpotRepository)(nil).FindExistingIDs), ctx, ids)
+}
+
 // Search mocks base method.
-func (m *MockDateSpotRepository) Search(ctx context.Context, params repository.DateSpotSearchParams) ([]*model.DateSpot, error) {
+func (m *MockDateSpotRepository) Search(ctx context.Context, params repository.DateSpotSearchParams) (pagination.Page[*model.DateSpot], error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Search", ctx, params)
-	ret0, _ := ret[0].([]*model.DateSpot)
+	ret0, _ := ret[0].(pagination.Page[*model.DateSpot])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -157,3 +187,17 @@ func (mr *MockDateSpotRepositoryMockRecorder) Update(ctx, id, dateSpot any) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDateSpotRepository)(nil).Update), ctx, id, dateSpot)
 }
+
+// UpdateRefreshed mocks base method.
+func (m *MockDateSpotRepository) UpdateRefreshed(ctx context.Context, dateSpot *model.DateSpot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRefreshed", ctx, dateSpot)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRefreshed indicates an expected call of UpdateRefreshed.
+func (mr *MockDateSpotRepositoryMockRecorder) UpdateRefreshed(ctx, dateSpot any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRefreshed", reflect.TypeOf((*MockDateSpotRepository)(nil).UpdateRefreshed), ctx, dateSpot)
+}
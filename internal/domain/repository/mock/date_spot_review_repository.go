@@ -12,8 +12,11 @@ package repositorymock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repository "github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	pagination "github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,6 +44,21 @@ func (m *MockDateSpotReviewRepository) EXPECT() *MockDateSpotReviewRepositoryMoc
 	return m.recorder
 }
 
+// CountOpenReports mocks base method.
+func (m *MockDateSpotReviewRepository) CountOpenReports(ctx context.Context, reviewID uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOpenReports", ctx, reviewID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOpenReports indicates an expected call of CountOpenReports.
+func (mr *MockDateSpotReviewRepositoryMockRecorder) CountOpenReports(ctx, reviewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOpenReports", reflect.TypeOf((*MockDateSpotReviewRepository)(nil).CountOpenReports), ctx, reviewID)
+}
+
 // Create mocks base method.
 func (m *MockDateSpotReviewRepository) Create(ctx context.Context, review *model.DateSpotReview) error {
 	m.ctrl.T.Helper()
@@ -55,6 +73,20 @@ func (mr *MockDateSpotReviewRepositoryMockRecorder) Create(ctx, review any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDateSpotReviewRepository)(nil).Create), ctx, review)
 }
 
+// CreateReport mocks base method.
+func (m *MockDateSpotReviewRepository) CreateReport(ctx context.Context, report *model.DateSpotReviewReport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReport", ctx, report)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateReport indicates an expected call of CreateReport.
+func (mr *MockDateSpotReviewRepositoryMockRecorder) CreateReport(ctx, report any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReport", reflect.TypeOf((*MockDateSpotReviewRepository)(nil).CreateReport), ctx, report)
+}
+
 // DeleteByID mocks base method.
 func (m *MockDateSpotReviewRepository) DeleteByID(ctx context.Context, id uint) error {
 	m.ctrl.T.Helper()
@@ -69,19 +101,34 @@ func (mr *MockDateSpotReviewRepositoryMockRecorder) DeleteByID(ctx, id any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockDateSpotReviewRepository)(nil).DeleteByID), ctx, id)
 }
 
+// ExistsReport mocks base method.
+func (m *MockDateSpotReviewRepository) ExistsReport(ctx context.Context, reviewID, userID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsReport", ctx, reviewID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsReport indicates an expected call of ExistsReport.
+func (mr *MockDateSpotReviewRepositoryMockRecorder) ExistsReport(ctx, reviewID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsReport", reflect.TypeOf((*MockDateSpotReviewRepository)(nil).ExistsReport), ctx, reviewID, userID)
+}
+
 // FindByDateSpotID mocks base method.
-func (m *MockDateSpotReviewRepository) FindByDateSpotID(ctx context.Context, dateSpotID uint) ([]*model.DateSpotReview, error) {
+func (m *MockDateSpotReviewRepository) FindByDateSpotID(ctx context.Context, dateSpotID, viewerID uint) ([]*model.DateSpotReview, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByDateSpotID", ctx, dateSpotID)
+	ret := m.ctrl.Call(m, "FindByDateSpotID", ctx, dateSpotID, viewerID)
 	ret0, _ := ret[0].([]*model.DateSpotReview)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByDateSpotID indicates an expected call of FindByDateSpotID.
-func (mr *MockDateSpotReviewRepositoryMockRecorder) FindByDateSpotID(ctx, dateSpotID any) *gomock.Call {
+func (mr *MockDateSpotReviewRepositoryMockRecorder) FindByDateSpotID(ctx, dateSpotID, viewerID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByDateSpotID", reflect.TypeOf((*MockDateSpotReviewRepository)(nil).FindByDateSpotID), ctx, dateSpotID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByDateSpotID", reflect.TypeOf((*MockDateSpotReviewRepository)(nil).FindByDateSpotID), ctx, dateSpotID, viewerID)
 }
 
 // FindByID mocks base method.
@@ -114,6 +161,35 @@ func (mr *MockDateSpotReviewRepositoryMockRecorder) FindByUserIDs(ctx, userIDs a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserIDs", reflect.TypeOf((*MockDateSpotReviewRepository)(nil).FindByUserIDs), ctx, userIDs)
 }
 
+// ResolveReports mocks base method.
+func (m *MockDateSpotReviewRepository) ResolveReports(ctx context.Context, reviewID uint, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveReports", ctx, reviewID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveReports indicates an expected call of ResolveReports.
+func (mr *MockDateSpotReviewRepositoryMockRecorder) ResolveReports(ctx, reviewID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveReports", reflect.TypeOf((*MockDateSpotReviewRepository)(nil).ResolveReports), ctx, reviewID, at)
+}
+
+// SearchForModeration mocks base method.
+func (m *MockDateSpotReviewRepository) SearchForModeration(ctx context.Context, params repository.ReviewModerationParams) (pagination.Page[*model.DateSpotReview], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchForModeration", ctx, params)
+	ret0, _ := ret[0].(pagination.Page[*model.DateSpotReview])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchForModeration indicates an expected call of SearchForModeration.
+func (mr *MockDateSpotReviewRepositoryMockRecorder) SearchForModeration(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchForModeration", reflect.TypeOf((*MockDateSpotReviewRepository)(nil).SearchForModeration), ctx, params)
+}
+
 // UpdateByID mocks base method.
 func (m *MockDateSpotReviewRepository) UpdateByID(ctx context.Context, id uint, review *model.DateSpotReview) error {
 	m.ctrl.T.Helper()
@@ -127,3 +203,17 @@ func (mr *MockDateSpotReviewRepositoryMockRecorder) UpdateByID(ctx, id, review a
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByID", reflect.TypeOf((*MockDateSpotReviewRepository)(nil).UpdateByID), ctx, id, review)
 }
+
+// UpdateStatus mocks base method.
+func (m *MockDateSpotReviewRepository) UpdateStatus(ctx context.Context, id uint, status model.ReviewStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockDateSpotReviewRepositoryMockRecorder) UpdateStatus(ctx, id, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockDateSpotReviewRepository)(nil).UpdateStatus), ctx, id, status)
+}
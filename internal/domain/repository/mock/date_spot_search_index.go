@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/date_spot_search_index.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/date_spot_search_index.go -destination=internal/domain/repository/mock/date_spot_search_index.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repository "github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDateSpotSearchIndex is a mock of DateSpotSearchIndex interface.
+type MockDateSpotSearchIndex struct {
+	ctrl     *gomock.Controller
+	recorder *MockDateSpotSearchIndexMockRecorder
+	isgomock struct{}
+}
+
+// MockDateSpotSearchIndexMockRecorder is the mock recorder for MockDateSpotSearchIndex.
+type MockDateSpotSearchIndexMockRecorder struct {
+	mock *MockDateSpotSearchIndex
+}
+
+// NewMockDateSpotSearchIndex creates a new mock instance.
+func NewMockDateSpotSearchIndex(ctrl *gomock.Controller) *MockDateSpotSearchIndex {
+	mock := &MockDateSpotSearchIndex{ctrl: ctrl}
+	mock.recorder = &MockDateSpotSearchIndexMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDateSpotSearchIndex) EXPECT() *MockDateSpotSearchIndexMockRecorder {
+	return m.recorder
+}
+
+// Put mocks base method.
+func (m *MockDateSpotSearchIndex) Put(ctx context.Context, dateSpot *model.DateSpot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, dateSpot)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockDateSpotSearchIndexMockRecorder) Put(ctx, dateSpot any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockDateSpotSearchIndex)(nil).Put), ctx, dateSpot)
+}
+
+// Remove mocks base method.
+func (m *MockDateSpotSearchIndex) Remove(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockDateSpotSearchIndexMockRecorder) Remove(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockDateSpotSearchIndex)(nil).Remove), ctx, id)
+}
+
+// Search mocks base method.
+func (m *MockDateSpotSearchIndex) Search(ctx context.Context, query string, limit int) ([]repository.DateSpotSearchHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, query, limit)
+	ret0, _ := ret[0].([]repository.DateSpotSearchHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockDateSpotSearchIndexMockRecorder) Search(ctx, query, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockDateSpotSearchIndex)(nil).Search), ctx, query, limit)
+}
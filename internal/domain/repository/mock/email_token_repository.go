@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/email_token_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/email_token_repository.go -destination=internal/domain/repository/mock/email_token_repository.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEmailTokenRepository is a mock of EmailTokenRepository interface.
+type MockEmailTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailTokenRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockEmailTokenRepositoryMockRecorder is the mock recorder for MockEmailTokenRepository.
+type MockEmailTokenRepositoryMockRecorder struct {
+	mock *MockEmailTokenRepository
+}
+
+// NewMockEmailTokenRepository creates a new mock instance.
+func NewMockEmailTokenRepository(ctrl *gomock.Controller) *MockEmailTokenRepository {
+	mock := &MockEmailTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockEmailTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailTokenRepository) EXPECT() *MockEmailTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockEmailTokenRepository) Create(ctx context.Context, token *model.EmailToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockEmailTokenRepositoryMockRecorder) Create(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockEmailTokenRepository)(nil).Create), ctx, token)
+}
+
+// FindByHash mocks base method.
+func (m *MockEmailTokenRepository) FindByHash(ctx context.Context, purpose model.EmailTokenPurpose, tokenHash string) (*model.EmailToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHash", ctx, purpose, tokenHash)
+	ret0, _ := ret[0].(*model.EmailToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHash indicates an expected call of FindByHash.
+func (mr *MockEmailTokenRepositoryMockRecorder) FindByHash(ctx, purpose, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHash", reflect.TypeOf((*MockEmailTokenRepository)(nil).FindByHash), ctx, purpose, tokenHash)
+}
+
+// InvalidateByUserID mocks base method.
+func (m *MockEmailTokenRepository) InvalidateByUserID(ctx context.Context, userID uint, purpose model.EmailTokenPurpose, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateByUserID", ctx, userID, purpose, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateByUserID indicates an expected call of InvalidateByUserID.
+func (mr *MockEmailTokenRepositoryMockRecorder) InvalidateByUserID(ctx, userID, purpose, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateByUserID", reflect.TypeOf((*MockEmailTokenRepository)(nil).InvalidateByUserID), ctx, userID, purpose, at)
+}
+
+// MarkUsed mocks base method.
+func (m *MockEmailTokenRepository) MarkUsed(ctx context.Context, id uint, at time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUsed", ctx, id, at)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkUsed indicates an expected call of MarkUsed.
+func (mr *MockEmailTokenRepositoryMockRecorder) MarkUsed(ctx, id, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUsed", reflect.TypeOf((*MockEmailTokenRepository)(nil).MarkUsed), ctx, id, at)
+}
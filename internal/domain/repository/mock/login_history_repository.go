@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/login_history_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/login_history_repository.go -destination=internal/domain/repository/mock/login_history_repository.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repository "github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	pagination "github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLoginHistoryRepository is a mock of LoginHistoryRepository interface.
+type MockLoginHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginHistoryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLoginHistoryRepositoryMockRecorder is the mock recorder for MockLoginHistoryRepository.
+type MockLoginHistoryRepositoryMockRecorder struct {
+	mock *MockLoginHistoryRepository
+}
+
+// NewMockLoginHistoryRepository creates a new mock instance.
+func NewMockLoginHistoryRepository(ctrl *gomock.Controller) *MockLoginHistoryRepository {
+	mock := &MockLoginHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockLoginHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginHistoryRepository) EXPECT() *MockLoginHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockLoginHistoryRepository) Create(ctx context.Context, history *model.LoginHistory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, history)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLoginHistoryRepositoryMockRecorder) Create(ctx, history any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLoginHistoryRepository)(nil).Create), ctx, history)
+}
+
+// SearchByUserID mocks base method.
+func (m *MockLoginHistoryRepository) SearchByUserID(ctx context.Context, params repository.LoginHistorySearchParams) (pagination.Page[*model.LoginHistory], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchByUserID", ctx, params)
+	ret0, _ := ret[0].(pagination.Page[*model.LoginHistory])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchByUserID indicates an expected call of SearchByUserID.
+func (mr *MockLoginHistoryRepositoryMockRecorder) SearchByUserID(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchByUserID", reflect.TypeOf((*MockLoginHistoryRepository)(nil).SearchByUserID), ctx, params)
+}
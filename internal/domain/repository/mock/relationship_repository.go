@@ -14,6 +14,8 @@ import (
 	reflect "reflect"
 
 	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repository "github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	pagination "github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -70,31 +72,31 @@ func (mr *MockRelationshipRepositoryMockRecorder) DeleteByUserIDs(ctx, userID, f
 }
 
 // FindFollowersByUserID mocks base method.
-func (m *MockRelationshipRepository) FindFollowersByUserID(ctx context.Context, userID uint) ([]*model.User, error) {
+func (m *MockRelationshipRepository) FindFollowersByUserID(ctx context.Context, userID uint, params repository.FollowSearchParams) (pagination.Page[*model.User], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindFollowersByUserID", ctx, userID)
-	ret0, _ := ret[0].([]*model.User)
+	ret := m.ctrl.Call(m, "FindFollowersByUserID", ctx, userID, params)
+	ret0, _ := ret[0].(pagination.Page[*model.User])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindFollowersByUserID indicates an expected call of FindFollowersByUserID.
-func (mr *MockRelationshipRepositoryMockRecorder) FindFollowersByUserID(ctx, userID any) *gomock.Call {
+func (mr *MockRelationshipRepositoryMockRecorder) FindFollowersByUserID(ctx, userID, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFollowersByUserID", reflect.TypeOf((*MockRelationshipRepository)(nil).FindFollowersByUserID), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFollowersByUserID", reflect.TypeOf((*MockRelationshipRepository)(nil).FindFollowersByUserID), ctx, userID, params)
 }
 
 // FindFollowingsByUserID mocks base method.
-func (m *MockRelationshipRepository) FindFollowingsByUserID(ctx context.Context, userID uint) ([]*model.User, error) {
+func (m *MockRelationshipRepository) FindFollowingsByUserID(ctx context.Context, userID uint, params repository.FollowSearchParams) (pagination.Page[*model.User], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindFollowingsByUserID", ctx, userID)
-	ret0, _ := ret[0].([]*model.User)
+	ret := m.ctrl.Call(m, "FindFollowingsByUserID", ctx, userID, params)
+	ret0, _ := ret[0].(pagination.Page[*model.User])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindFollowingsByUserID indicates an expected call of FindFollowingsByUserID.
-func (mr *MockRelationshipRepositoryMockRecorder) FindFollowingsByUserID(ctx, userID any) *gomock.Call {
+func (mr *MockRelationshipRepositoryMockRecorder) FindFollowingsByUserID(ctx, userID, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFollowingsByUserID", reflect.TypeOf((*MockRelationshipRepository)(nil).FindFollowingsByUserID), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFollowingsByUserID", reflect.TypeOf((*MockRelationshipRepository)(nil).FindFollowingsByUserID), ctx, userID, params)
 }
@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/spot_refresh_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/spot_refresh_repository.go -destination=internal/domain/repository/mock/spot_refresh_repository.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSpotRefreshRepository is a mock of SpotRefreshRepository interface.
+type MockSpotRefreshRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSpotRefreshRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSpotRefreshRepositoryMockRecorder is the mock recorder for MockSpotRefreshRepository.
+type MockSpotRefreshRepositoryMockRecorder struct {
+	mock *MockSpotRefreshRepository
+}
+
+// NewMockSpotRefreshRepository creates a new mock instance.
+func NewMockSpotRefreshRepository(ctrl *gomock.Controller) *MockSpotRefreshRepository {
+	mock := &MockSpotRefreshRepository{ctrl: ctrl}
+	mock.recorder = &MockSpotRefreshRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSpotRefreshRepository) EXPECT() *MockSpotRefreshRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateChanges mocks base method.
+func (m *MockSpotRefreshRepository) CreateChanges(ctx context.Context, changes []*model.SpotRefreshChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChanges", ctx, changes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateChanges indicates an expected call of CreateChanges.
+func (mr *MockSpotRefreshRepositoryMockRecorder) CreateChanges(ctx, changes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChanges", reflect.TypeOf((*MockSpotRefreshRepository)(nil).CreateChanges), ctx, changes)
+}
+
+// CreateRun mocks base method.
+func (m *MockSpotRefreshRepository) CreateRun(ctx context.Context, run *model.SpotRefreshRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRun", ctx, run)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRun indicates an expected call of CreateRun.
+func (mr *MockSpotRefreshRepositoryMockRecorder) CreateRun(ctx, run any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRun", reflect.TypeOf((*MockSpotRefreshRepository)(nil).CreateRun), ctx, run)
+}
+
+// FinishRun mocks base method.
+func (m *MockSpotRefreshRepository) FinishRun(ctx context.Context, run *model.SpotRefreshRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishRun", ctx, run)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishRun indicates an expected call of FinishRun.
+func (mr *MockSpotRefreshRepositoryMockRecorder) FinishRun(ctx, run any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishRun", reflect.TypeOf((*MockSpotRefreshRepository)(nil).FinishRun), ctx, run)
+}
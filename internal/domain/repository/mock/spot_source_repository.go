@@ -0,0 +1,147 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/spot_source_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/spot_source_repository.go -destination=internal/domain/repository/mock/spot_source_repository.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repository "github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	pagination "github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSpotSourceRepository is a mock of SpotSourceRepository interface.
+type MockSpotSourceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSpotSourceRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSpotSourceRepositoryMockRecorder is the mock recorder for MockSpotSourceRepository.
+type MockSpotSourceRepositoryMockRecorder struct {
+	mock *MockSpotSourceRepository
+}
+
+// NewMockSpotSourceRepository creates a new mock instance.
+func NewMockSpotSourceRepository(ctrl *gomock.Controller) *MockSpotSourceRepository {
+	mock := &MockSpotSourceRepository{ctrl: ctrl}
+	mock.recorder = &MockSpotSourceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSpotSourceRepository) EXPECT() *MockSpotSourceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateBatch mocks base method.
+func (m *MockSpotSourceRepository) CreateBatch(ctx context.Context, sources []*model.SpotSource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, sources)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockSpotSourceRepositoryMockRecorder) CreateBatch(ctx, sources any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockSpotSourceRepository)(nil).CreateBatch), ctx, sources)
+}
+
+// FindByID mocks base method.
+func (m *MockSpotSourceRepository) FindByID(ctx context.Context, id uint) (*model.SpotSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*model.SpotSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockSpotSourceRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockSpotSourceRepository)(nil).FindByID), ctx, id)
+}
+
+// FindImportedExternalIDs mocks base method.
+func (m *MockSpotSourceRepository) FindImportedExternalIDs(ctx context.Context, source model.DateSpotSource, externalIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindImportedExternalIDs", ctx, source, externalIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindImportedExternalIDs indicates an expected call of FindImportedExternalIDs.
+func (mr *MockSpotSourceRepositoryMockRecorder) FindImportedExternalIDs(ctx, source, externalIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindImportedExternalIDs", reflect.TypeOf((*MockSpotSourceRepository)(nil).FindImportedExternalIDs), ctx, source, externalIDs)
+}
+
+// FindImportedNames mocks base method.
+func (m *MockSpotSourceRepository) FindImportedNames(ctx context.Context, source model.DateSpotSource, prefectureID int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindImportedNames", ctx, source, prefectureID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindImportedNames indicates an expected call of FindImportedNames.
+func (mr *MockSpotSourceRepositoryMockRecorder) FindImportedNames(ctx, source, prefectureID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindImportedNames", reflect.TypeOf((*MockSpotSourceRepository)(nil).FindImportedNames), ctx, source, prefectureID)
+}
+
+// FindRefreshTargets mocks base method.
+func (m *MockSpotSourceRepository) FindRefreshTargets(ctx context.Context, source model.DateSpotSource, afterID uint, limit int) ([]*model.SpotSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRefreshTargets", ctx, source, afterID, limit)
+	ret0, _ := ret[0].([]*model.SpotSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRefreshTargets indicates an expected call of FindRefreshTargets.
+func (mr *MockSpotSourceRepositoryMockRecorder) FindRefreshTargets(ctx, source, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRefreshTargets", reflect.TypeOf((*MockSpotSourceRepository)(nil).FindRefreshTargets), ctx, source, afterID, limit)
+}
+
+// Link mocks base method.
+func (m *MockSpotSourceRepository) Link(ctx context.Context, id, dateSpotID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Link", ctx, id, dateSpotID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Link indicates an expected call of Link.
+func (mr *MockSpotSourceRepositoryMockRecorder) Link(ctx, id, dateSpotID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Link", reflect.TypeOf((*MockSpotSourceRepository)(nil).Link), ctx, id, dateSpotID)
+}
+
+// Search mocks base method.
+func (m *MockSpotSourceRepository) Search(ctx context.Context, params repository.SpotSourceSearchParams) (pagination.Page[*model.SpotSource], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, params)
+	ret0, _ := ret[0].(pagination.Page[*model.SpotSource])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockSpotSourceRepositoryMockRecorder) Search(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSpotSourceRepository)(nil).Search), ctx, params)
+}
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/user_block_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/user_block_repository.go -destination=internal/domain/repository/mock/user_block_repository.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserBlockRepository is a mock of UserBlockRepository interface.
+type MockUserBlockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserBlockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUserBlockRepositoryMockRecorder is the mock recorder for MockUserBlockRepository.
+type MockUserBlockRepositoryMockRecorder struct {
+	mock *MockUserBlockRepository
+}
+
+// NewMockUserBlockRepository creates a new mock instance.
+func NewMockUserBlockRepository(ctrl *gomock.Controller) *MockUserBlockRepository {
+	mock := &MockUserBlockRepository{ctrl: ctrl}
+	mock.recorder = &MockUserBlockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserBlockRepository) EXPECT() *MockUserBlockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUserBlockRepository) Create(ctx context.Context, block *model.UserBlock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, block)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUserBlockRepositoryMockRecorder) Create(ctx, block any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserBlockRepository)(nil).Create), ctx, block)
+}
+
+// DeleteByUserIDs mocks base method.
+func (m *MockUserBlockRepository) DeleteByUserIDs(ctx context.Context, userID, blockedUserID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserIDs", ctx, userID, blockedUserID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserIDs indicates an expected call of DeleteByUserIDs.
+func (mr *MockUserBlockRepositoryMockRecorder) DeleteByUserIDs(ctx, userID, blockedUserID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserIDs", reflect.TypeOf((*MockUserBlockRepository)(nil).DeleteByUserIDs), ctx, userID, blockedUserID)
+}
+
+// ExistsBetween mocks base method.
+func (m *MockUserBlockRepository) ExistsBetween(ctx context.Context, userID, otherUserID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsBetween", ctx, userID, otherUserID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsBetween indicates an expected call of ExistsBetween.
+func (mr *MockUserBlockRepositoryMockRecorder) ExistsBetween(ctx, userID, otherUserID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsBetween", reflect.TypeOf((*MockUserBlockRepository)(nil).ExistsBetween), ctx, userID, otherUserID)
+}
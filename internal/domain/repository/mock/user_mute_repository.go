@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/repository/user_mute_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/repository/user_mute_repository.go -destination=internal/domain/repository/mock/user_mute_repository.go -package=repositorymock
+//
+
+// Package repositorymock is a generated GoMock package.
+package repositorymock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserMuteRepository is a mock of UserMuteRepository interface.
+type MockUserMuteRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserMuteRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUserMuteRepositoryMockRecorder is the mock recorder for MockUserMuteRepository.
+type MockUserMuteRepositoryMockRecorder struct {
+	mock *MockUserMuteRepository
+}
+
+// NewMockUserMuteRepository creates a new mock instance.
+func NewMockUserMuteRepository(ctrl *gomock.Controller) *MockUserMuteRepository {
+	mock := &MockUserMuteRepository{ctrl: ctrl}
+	mock.recorder = &MockUserMuteRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserMuteRepository) EXPECT() *MockUserMuteRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUserMuteRepository) Create(ctx context.Context, mute *model.UserMute) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, mute)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUserMuteRepositoryMockRecorder) Create(ctx, mute any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserMuteRepository)(nil).Create), ctx, mute)
+}
+
+// DeleteByUserIDs mocks base method.
+func (m *MockUserMuteRepository) DeleteByUserIDs(ctx context.Context, userID, mutedUserID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserIDs", ctx, userID, mutedUserID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserIDs indicates an expected call of DeleteByUserIDs.
+func (mr *MockUserMuteRepositoryMockRecorder) DeleteByUserIDs(ctx, userID, mutedUserID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserIDs", reflect.TypeOf((*MockUserMuteRepository)(nil).DeleteByUserIDs), ctx, userID, mutedUserID)
+}
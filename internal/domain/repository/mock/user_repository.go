@@ -12,8 +12,11 @@ package repositorymock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repository "github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	pagination "github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -84,6 +87,21 @@ func (mr *MockUserRepositoryMockRecorder) ExistsByEmail(ctx, email any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsByEmail", reflect.TypeOf((*MockUserRepository)(nil).ExistsByEmail), ctx, email)
 }
 
+// FindByEmail mocks base method.
+func (m *MockUserRepository) FindByEmail(ctx context.Context, email string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEmail", ctx, email)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEmail indicates an expected call of FindByEmail.
+func (mr *MockUserRepositoryMockRecorder) FindByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockUserRepository)(nil).FindByEmail), ctx, email)
+}
+
 // FindByID mocks base method.
 func (m *MockUserRepository) FindByID(ctx context.Context, id uint) (*model.User, error) {
 	m.ctrl.T.Helper()
@@ -144,19 +162,81 @@ func (mr *MockUserRepositoryMockRecorder) FindFollowingIDsByUserIDs(ctx, userIDs
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFollowingIDsByUserIDs", reflect.TypeOf((*MockUserRepository)(nil).FindFollowingIDsByUserIDs), ctx, userIDs)
 }
 
+// IncrementTokenVersion mocks base method.
+func (m *MockUserRepository) IncrementTokenVersion(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementTokenVersion", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementTokenVersion indicates an expected call of IncrementTokenVersion.
+func (mr *MockUserRepositoryMockRecorder) IncrementTokenVersion(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementTokenVersion", reflect.TypeOf((*MockUserRepository)(nil).IncrementTokenVersion), ctx, id)
+}
+
+// LockForUpdate mocks base method.
+func (m *MockUserRepository) LockForUpdate(ctx context.Context, ids ...uint) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LockForUpdate", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockForUpdate indicates an expected call of LockForUpdate.
+func (mr *MockUserRepositoryMockRecorder) LockForUpdate(ctx any, ids ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, ids...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockForUpdate", reflect.TypeOf((*MockUserRepository)(nil).LockForUpdate), varargs...)
+}
+
+// RecordLoginFailure mocks base method.
+func (m *MockUserRepository) RecordLoginFailure(ctx context.Context, id, maxAttempts uint, lockedUntil time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordLoginFailure", ctx, id, maxAttempts, lockedUntil)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordLoginFailure indicates an expected call of RecordLoginFailure.
+func (mr *MockUserRepositoryMockRecorder) RecordLoginFailure(ctx, id, maxAttempts, lockedUntil any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordLoginFailure", reflect.TypeOf((*MockUserRepository)(nil).RecordLoginFailure), ctx, id, maxAttempts, lockedUntil)
+}
+
+// RecordLoginSuccess mocks base method.
+func (m *MockUserRepository) RecordLoginSuccess(ctx context.Context, id uint, at time.Time, ipAddress string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordLoginSuccess", ctx, id, at, ipAddress)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordLoginSuccess indicates an expected call of RecordLoginSuccess.
+func (mr *MockUserRepositoryMockRecorder) RecordLoginSuccess(ctx, id, at, ipAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordLoginSuccess", reflect.TypeOf((*MockUserRepository)(nil).RecordLoginSuccess), ctx, id, at, ipAddress)
+}
+
 // Search mocks base method.
-func (m *MockUserRepository) Search(ctx context.Context, name *string) ([]*model.User, error) {
+func (m *MockUserRepository) Search(ctx context.Context, params repository.UserSearchParams) (pagination.Page[*model.User], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Search", ctx, name)
-	ret0, _ := ret[0].([]*model.User)
+	ret := m.ctrl.Call(m, "Search", ctx, params)
+	ret0, _ := ret[0].(pagination.Page[*model.User])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Search indicates an expected call of Search.
-func (mr *MockUserRepositoryMockRecorder) Search(ctx, name any) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) Search(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockUserRepository)(nil).Search), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockUserRepository)(nil).Search), ctx, params)
 }
 
 // Update mocks base method.
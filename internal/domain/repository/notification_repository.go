@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+)
+
+// NotificationSearchParams は通知一覧を読むユーザーとページ指定です。並び順は新しい順だけです。
+type NotificationSearchParams struct {
+	UserID uint
+	Page   pagination.Params
+}
+
+type NotificationRepository interface {
+	// CreateBatch は通知をまとめて保存します。同じ OutboxEventID・UserID の通知が既にあれば、その通知は保存しません。
+	CreateBatch(ctx context.Context, notifications []*model.Notification) error
+	// Search はユーザー宛ての通知を新しい順に返します。操作したユーザーも読み込みます。
+	Search(ctx context.Context, params NotificationSearchParams) (pagination.Page[*model.Notification], error)
+	CountUnread(ctx context.Context, userID uint) (int, error)
+	// MarkRead はユーザー宛ての未読の通知を readAt で既読にします。
+	// ids が空のときは未読の通知をすべて既読にします。他のユーザー宛ての ID は無視します。
+	MarkRead(ctx context.Context, userID uint, ids []uint, readAt time.Time) error
+}
+
+// NotificationChannel はアプリ内通知として保存した後に、別の手段で通知を届けます。
+// メールや Web Push を足すときは、この interface を実装して DI で登録します。
+type NotificationChannel interface {
+	Deliver(ctx context.Context, notifications []*model.Notification) error
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+)
+
+// OutboxRepository はドメインイベントの outbox です。
+// Publish は ctx のトランザクションで outbox_events に追記するため、ユースケースは UnitOfWork.Do の中で呼びます。
+// 残りのメソッドは cmd/relay が配るときに使います。
+type OutboxRepository interface {
+	event.Publisher
+	// FindDue は now までに配るべき pending のイベントを、古い順に limit 件まで返します。
+	FindDue(ctx context.Context, now time.Time, limit int) ([]*model.OutboxEvent, error)
+	MarkDelivered(ctx context.Context, id uint, deliveredAt time.Time) error
+	// MarkRetry は配れなかったイベントを、nextAttemptAt に配り直すよう pending のまま残します。
+	MarkRetry(ctx context.Context, id uint, attempts uint, nextAttemptAt time.Time, lastError string) error
+	// MarkDead は配るのをあきらめたイベントを dead にします。行は消さずに残します。
+	MarkDead(ctx context.Context, id uint, attempts uint, lastError string) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+)
+
+// RateLimitStore はレート制限のトークンバケットを保持します。
+// 1プロセス内で数えるメモリ上の実装と、Lambda の実行環境をまたいで数える DB の実装を差し替えられるよう interface にしています。
+type RateLimitStore interface {
+	// Take は key のバケットからトークンを1つ取り出します。
+	// 同じ key に同時にリクエストが来ても数え漏れないよう、読み出しから書き戻しまでを不可分に行います。
+	Take(ctx context.Context, key string, rule model.RateLimitRule, now time.Time) (model.RateLimitResult, error)
+}
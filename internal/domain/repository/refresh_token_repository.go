@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+)
+
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, token *model.RefreshToken) error
+	FindByHash(ctx context.Context, tokenHash string) (*model.RefreshToken, error)
+	// Revoke はまだ失効していないトークンを at の時刻で失効させます。
+	// 同じトークンで同時に再発行されたときに1回だけ成功させるため、失効させたかどうかを返します。
+	Revoke(ctx context.Context, id uint, at time.Time) (bool, error)
+	// RevokeAllByUserID はユーザーのまだ失効していないトークンをすべて失効させます。
+	RevokeAllByUserID(ctx context.Context, userID uint, at time.Time) error
+}
@@ -2,13 +2,28 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 )
 
+// FollowSearchParams はフォロー・フォロワー一覧の並び順とページ指定です。
+// Sort は pagination.SortNewest（フォローした日時の新しい順）と pagination.SortName に対応します。
+type FollowSearchParams struct {
+	Sort pagination.Sort
+	Page pagination.Params
+	// ViewerID は閲覧しているユーザーの ID です。ブロック・ミュートしている相手を一覧から除きます。
+	ViewerID uint
+}
+
+// ErrBlocked はどちらかがもう一方をブロックしているためフォローできないことを表します。
+var ErrBlocked = errors.New("relationship: blocked")
+
 type RelationshipRepository interface {
+	// Create はフォローを保存します。どちらかがもう一方をブロックしている場合は保存せず ErrBlocked を返します。
 	Create(ctx context.Context, relationship *model.Relationship) error
-	FindFollowingsByUserID(ctx context.Context, userID uint) ([]*model.User, error)
-	FindFollowersByUserID(ctx context.Context, userID uint) ([]*model.User, error)
+	FindFollowingsByUserID(ctx context.Context, userID uint, params FollowSearchParams) (pagination.Page[*model.User], error)
+	FindFollowersByUserID(ctx context.Context, userID uint, params FollowSearchParams) (pagination.Page[*model.User], error)
 	DeleteByUserIDs(ctx context.Context, userID uint, followID uint) error
 }
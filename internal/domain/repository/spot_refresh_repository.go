@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+)
+
+type SpotRefreshRepository interface {
+	CreateRun(ctx context.Context, run *model.SpotRefreshRun) error
+	// FinishRun は run の終了日時と件数を書き込みます。
+	FinishRun(ctx context.Context, run *model.SpotRefreshRun) error
+	CreateChanges(ctx context.Context, changes []*model.SpotRefreshChange) error
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+)
+
+// SpotSourceSearchParams は取り込み記録の一覧の条件です。
+type SpotSourceSearchParams struct {
+	Status model.SpotSourceStatus
+	// Sort は pagination.SortOldest と pagination.SortNewest に対応します。
+	Sort pagination.Sort
+	Page pagination.Params
+}
+
+// ErrSpotSourceNotPending は取り込み記録が確認待ちでなくなっていて、統合先を決められないことを表します。
+var ErrSpotSourceNotPending = errors.New("spot source: not pending")
+
+type SpotSourceRepository interface {
+	CreateBatch(ctx context.Context, sources []*model.SpotSource) error
+	// FindByID は照合相手のスポットも読み込んで返します。
+	FindByID(ctx context.Context, id uint) (*model.SpotSource, error)
+	// FindImportedExternalIDs は externalIDs のうち、source から取り込み済みのものを返します。
+	FindImportedExternalIDs(ctx context.Context, source model.DateSpotSource, externalIDs []string) ([]string, error)
+	// FindImportedNames は source から prefectureID の都道府県で取り込んだ記録の名前を返します。
+	// 取得元の ID を持たない取得元（gemini）で、取り込み済みかを名前で見分けるために使います。
+	FindImportedNames(ctx context.Context, source model.DateSpotSource, prefectureID int) ([]string, error)
+	// Search は照合相手のスポットも読み込んで返します。
+	Search(ctx context.Context, params SpotSourceSearchParams) (pagination.Page[*model.SpotSource], error)
+	// Link は確認待ちの id の取り込み記録を dateSpotID のスポットに統合済みにします。
+	// 別の管理者が先に判断して確認待ちでなくなっていれば、何も変えずに ErrSpotSourceNotPending を返します。
+	Link(ctx context.Context, id, dateSpotID uint) error
+	// FindRefreshTargets は source から取り込んでスポットに統合済みの記録を、つなぎ先のスポットの ID が afterDateSpotID より後ろから
+	// スポット limit 件分返します。1つのスポットにつながった記録はすべて同じ結果に入れ、スポットの ID 順・記録の ID 順に並べます。
+	// 取得元の値で上書きしてよいのはその取得元から作ったスポットだけなので、スポットの source も同じものに絞り、スポットも読み込みます。
+	FindRefreshTargets(ctx context.Context, source model.DateSpotSource, afterDateSpotID uint, limit int) ([]*model.SpotSource, error)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import "context"
+
+// UnitOfWork は複数リポジトリにまたがる書き込みを1トランザクションにまとめます。
+// usecase はトランザクションの実体（gorm.DB など）を知らずに、
+// 「ここからここまでは全部成功するか全部なかったことにするか」を表現できます。
+type UnitOfWork interface {
+	// Do は fn をトランザクション内で実行します。
+	// fn に渡される ctx でリポジトリを呼ぶと、同じトランザクションに参加します。
+	// fn がエラーを返すとロールバックし、そのエラーをそのまま返します。
+	// トランザクションの開始・コミット自体に失敗した場合は 500 の apperror を返します。
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
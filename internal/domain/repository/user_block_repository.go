@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+)
+
+type UserBlockRepository interface {
+	// Create はブロックを保存します。既にブロック済みなら何もしません。
+	Create(ctx context.Context, block *model.UserBlock) error
+	DeleteByUserIDs(ctx context.Context, userID, blockedUserID uint) error
+	// ExistsBetween は2人のどちらかがもう一方をブロックしているかを返します。
+	ExistsBetween(ctx context.Context, userID, otherUserID uint) (bool, error)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+)
+
+type UserMuteRepository interface {
+	// Create はミュートを保存します。既にミュート済みなら何もしません。
+	Create(ctx context.Context, mute *model.UserMute) error
+	DeleteByUserIDs(ctx context.Context, userID, mutedUserID uint) error
+}
@@ -2,20 +2,46 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 )
 
+// UserSearchParams はユーザー一覧の検索条件です。
+// Sort は pagination.SortNewest と pagination.SortName に対応します。
+type UserSearchParams struct {
+	Name *string
+	Sort pagination.Sort
+	Page pagination.Params
+	// ViewerID は閲覧しているユーザーの ID で、未ログインの場合は 0 です。
+	// ブロック・ミュートしている相手を除きます。
+	ViewerID uint
+}
+
 type UserRepository interface {
 	Create(ctx context.Context, user *model.User) error
 	FindByID(ctx context.Context, id uint) (*model.User, error)
 	FindByName(ctx context.Context, name string) (*model.User, error)
-	Search(ctx context.Context, name *string) ([]*model.User, error)
+	FindByEmail(ctx context.Context, email string) (*model.User, error)
+	Search(ctx context.Context, params UserSearchParams) (pagination.Page[*model.User], error)
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
 	// FindFollowerIDsByUserIDs / FindFollowingIDsByUserIDs は
 	// 指定ユーザーたちのフォロワー・フォロー中の ID を userID ごとにまとめて返します。
 	FindFollowerIDsByUserIDs(ctx context.Context, userIDs []uint) (map[uint][]int, error)
 	FindFollowingIDsByUserIDs(ctx context.Context, userIDs []uint) (map[uint][]int, error)
+	// Update はユーザー情報を保存します。トークンバージョンは保存しないため、上げるときは IncrementTokenVersion を使います。
+	// ログインの失敗回数・ロック・最終ログインも保存しないため、RecordLoginFailure・RecordLoginSuccess で変えます。
 	Update(ctx context.Context, user *model.User) error
+	// IncrementTokenVersion はトークンバージョンを上げ、発行済みのアクセストークンを無効にします。
+	IncrementTokenVersion(ctx context.Context, id uint) error
+	// RecordLoginFailure はログインの失敗を1回数え、maxAttempts 回続いたら lockedUntil までロックします。
+	// 今回の失敗でロックしたかどうかを返します。
+	RecordLoginFailure(ctx context.Context, id uint, maxAttempts uint, lockedUntil time.Time) (bool, error)
+	// RecordLoginSuccess は失敗回数とロックを解除し、最終ログイン日時と IP アドレスを記録します。
+	RecordLoginSuccess(ctx context.Context, id uint, at time.Time, ipAddress string) error
 	Delete(ctx context.Context, id uint) error
+	// LockForUpdate はトランザクションが終わるまで指定したユーザーの行をロックします。
+	// 2人の間の書き込み（フォローとブロック）を1つずつ順に通すために使います。
+	LockForUpdate(ctx context.Context, ids ...uint) error
 }
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/textsearch"
+)
+
+// ContentVerdict は投稿内容を確かめた結果です。
+type ContentVerdict int
+
+const (
+	// ContentAllowed はそのまま公開してよい内容です。
+	ContentAllowed ContentVerdict = iota
+	// ContentHeld は公開前に管理者の確認が必要な内容です。
+	ContentHeld
+	// ContentRejected は投稿を受け付けない内容です。
+	ContentRejected
+)
+
+// ContentFilter は利用者が投稿した文章を公開してよいか判定するドメインサービスです。
+// NG ワードの一覧で判定する実装のほか、外部の判定 API を使う実装にも差し替えられるよう、error を返せるようにしています。
+type ContentFilter interface {
+	Check(ctx context.Context, text string) (ContentVerdict, error)
+}
+
+// ngWordFilter は NG ワードを含むかで判定する ContentFilter です。
+// 全角・半角、カタカナ・ひらがな、大文字・小文字、空白の違いでは言い換えられないよう、
+// 検索と同じく textsearch.Normalize で正規化してから部分一致で比べます。
+type ngWordFilter struct {
+	rejectWords []string
+	holdWords   []string
+}
+
+// NewNGWordFilter は rejectWords を含む文章を拒否し、holdWords を含む文章を保留にする ContentFilter を返します。
+func NewNGWordFilter(rejectWords, holdWords []string) ContentFilter {
+	return &ngWordFilter{rejectWords: normalizeWords(rejectWords), holdWords: normalizeWords(holdWords)}
+}
+
+func (f *ngWordFilter) Check(_ context.Context, text string) (ContentVerdict, error) {
+	normalized := textsearch.Normalize(text)
+	if containsAny(normalized, f.rejectWords) {
+		return ContentRejected, nil
+	}
+	if containsAny(normalized, f.holdWords) {
+		return ContentHeld, nil
+	}
+	return ContentAllowed, nil
+}
+
+func normalizeWords(words []string) []string {
+	out := make([]string, 0, len(words))
+	for _, w := range words {
+		if n := textsearch.Normalize(w); n != "" {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+func containsAny(text string, words []string) bool {
+	for _, w := range words {
+		if strings.Contains(text, w) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,40 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNGWordFilter_Check(t *testing.T) {
+	ctx := context.Background()
+	filter := service.NewNGWordFilter([]string{"バカ", "spam"}, []string{"最悪", ""})
+
+	tests := []struct {
+		name string
+		text string
+		want service.ContentVerdict
+	}{
+		{"allowed", "夜景がきれいでした", service.ContentAllowed},
+		{"rejected", "店員がバカだった", service.ContentRejected},
+		// 半角カナ・ひらがな・空白を挟んでも正規化して一致させる
+		{"rejected_half_width_kana", "ﾊﾞｶ", service.ContentRejected},
+		{"rejected_hiragana", "ば か", service.ContentRejected},
+		{"rejected_full_width_upper_case", "ＳＰＡＭ", service.ContentRejected},
+		{"held", "最悪の接客", service.ContentHeld},
+		{"reject_wins_over_hold", "最悪でバカ", service.ContentRejected},
+		// 空の NG ワードはすべての文章に一致してしまうため無視する
+		{"empty_word_is_ignored", "普通", service.ContentAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := filter.Check(ctx, tt.text)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"math"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/geo"
+)
+
+// RouteEngine は2地点間の移動距離（メートル）と所要時間（秒）を求める経路エンジンです。
+// 現状は大圏距離からの見積もり（NewEstimatedRouteEngine）だけですが、
+// 道路網を使う経路エンジンに差し替えられるよう interface にしています。
+type RouteEngine interface {
+	Leg(ctx context.Context, travelMode string, fromLat, fromLng, toLat, toLng float64) (distanceMeters, durationSeconds int, err error)
+}
+
+// travelProfile は移動手段ごとの見積もり条件です。
+type travelProfile struct {
+	// metersPerMinute は信号待ちなどを含めた平均の移動速度です。
+	metersPerMinute float64
+	// detourFactor は直線距離に対する実際の道のりの比率です。
+	detourFactor float64
+}
+
+// travelProfiles は移動手段ごとの見積もり条件です。
+// 徒歩は一般的な不動産表示の 80m/分、車は市街地の平均旅行速度（約 30km/h）を使います。
+var travelProfiles = map[string]travelProfile{
+	"WALKING": {metersPerMinute: 80, detourFactor: 1.25},
+	"DRIVING": {metersPerMinute: 500, detourFactor: 1.4},
+}
+
+type estimatedRouteEngine struct{}
+
+// NewEstimatedRouteEngine は大圏距離に移動手段ごとの迂回係数・速度を掛けて見積もる RouteEngine を返します。
+// 外部 API を呼ばないため、コース一覧のように件数が多い場面でも気軽に使えます。
+func NewEstimatedRouteEngine() RouteEngine {
+	return &estimatedRouteEngine{}
+}
+
+func (e *estimatedRouteEngine) Leg(_ context.Context, travelMode string, fromLat, fromLng, toLat, toLng float64) (int, int, error) {
+	profile, ok := travelProfiles[travelMode]
+	if !ok {
+		profile = travelProfiles["WALKING"]
+	}
+	distance := geo.DistanceMeters(fromLat, fromLng, toLat, toLng) * profile.detourFactor
+	duration := distance / profile.metersPerMinute * 60
+	return int(math.Round(distance)), int(math.Round(duration)), nil
+}
+
+// CourseRouteService はコースの立ち寄り先を訪問順に結んだ経路を組み立てるドメインサービスです。
+type CourseRouteService interface {
+	BuildRoute(ctx context.Context, course *model.Course) (*model.CourseRoute, error)
+}
+
+type courseRouteService struct {
+	RouteEngine RouteEngine
+}
+
+func NewCourseRouteService(routeEngine RouteEngine) CourseRouteService {
+	return &courseRouteService{RouteEngine: routeEngine}
+}
+
+// BuildRoute は course.DuringSpots の並び順（訪問順）で隣り合うスポット間の区間を求めます。
+// DuringSpots とその DateSpot が読み込み済みである前提です。
+func (s *courseRouteService) BuildRoute(ctx context.Context, course *model.Course) (*model.CourseRoute, error) {
+	route := &model.CourseRoute{Legs: []*model.CourseRouteLeg{}}
+
+	for idx := 1; idx < len(course.DuringSpots); idx++ {
+		from := course.DuringSpots[idx-1]
+		to := course.DuringSpots[idx]
+		leg := &model.CourseRouteLeg{FromDateSpotID: from.DateSpotID, ToDateSpotID: to.DateSpotID}
+		route.Legs = append(route.Legs, leg)
+
+		if !hasCoordinates(from.DateSpot) || !hasCoordinates(to.DateSpot) {
+			continue
+		}
+		distance, duration, err := s.RouteEngine.Leg(ctx, course.TravelMode,
+			*from.DateSpot.Latitude, *from.DateSpot.Longitude,
+			*to.DateSpot.Latitude, *to.DateSpot.Longitude)
+		if err != nil {
+			return nil, err
+		}
+		leg.DistanceMeters = &distance
+		leg.DurationSeconds = &duration
+		route.TotalDistanceMeters += distance
+		route.TotalDurationSeconds += duration
+	}
+	return route, nil
+}
+
+func hasCoordinates(dateSpot *model.DateSpot) bool {
+	return dateSpot != nil && dateSpot.Latitude != nil && dateSpot.Longitude != nil
+}
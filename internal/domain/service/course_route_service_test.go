@@ -0,0 +1,136 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
+	servicemock "github.com/daisuke-harada/date-courses-go/internal/domain/service/mock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newStop(dateSpotID uint, lat, lng *float64) *model.DuringSpot {
+	return &model.DuringSpot{
+		DateSpotID: dateSpotID,
+		DateSpot:   &model.DateSpot{ID: dateSpotID, Latitude: lat, Longitude: lng},
+	}
+}
+
+func TestEstimatedRouteEngine_Leg(t *testing.T) {
+	ctx := context.Background()
+	engine := service.NewEstimatedRouteEngine()
+
+	// 東京駅 → 新宿駅（直線でおよそ 6.1km）
+	t.Run("walking_applies_detour_and_walking_speed", func(t *testing.T) {
+		distance, duration, err := engine.Leg(ctx, "WALKING", 35.681236, 139.767125, 35.690921, 139.700258)
+
+		require.NoError(t, err)
+		assert.InDelta(t, 6100*1.25, distance, 150)
+		assert.InDelta(t, float64(distance)/80*60, duration, 1)
+	})
+
+	t.Run("driving_is_faster_than_walking", func(t *testing.T) {
+		walkDistance, walkDuration, err := engine.Leg(ctx, "WALKING", 35.681236, 139.767125, 35.690921, 139.700258)
+		require.NoError(t, err)
+		driveDistance, driveDuration, err := engine.Leg(ctx, "DRIVING", 35.681236, 139.767125, 35.690921, 139.700258)
+		require.NoError(t, err)
+
+		assert.Greater(t, driveDistance, walkDistance, "車は迂回係数が大きい")
+		assert.Less(t, driveDuration, walkDuration)
+	})
+}
+
+func TestCourseRouteService_BuildRoute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("builds_legs_in_visit_order_and_sums_totals", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		engine := servicemock.NewMockRouteEngine(ctrl)
+		gomock.InOrder(
+			engine.EXPECT().Leg(ctx, "WALKING", 35.0, 139.0, 35.1, 139.1).Return(1000, 750, nil),
+			engine.EXPECT().Leg(ctx, "WALKING", 35.1, 139.1, 35.2, 139.2).Return(500, 375, nil),
+		)
+
+		route, err := service.NewCourseRouteService(engine).BuildRoute(ctx, &model.Course{
+			TravelMode: "WALKING",
+			DuringSpots: []*model.DuringSpot{
+				newStop(1, lo.ToPtr(35.0), lo.ToPtr(139.0)),
+				newStop(2, lo.ToPtr(35.1), lo.ToPtr(139.1)),
+				newStop(3, lo.ToPtr(35.2), lo.ToPtr(139.2)),
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1500, route.TotalDistanceMeters)
+		assert.Equal(t, 1125, route.TotalDurationSeconds)
+		require.Len(t, route.Legs, 2)
+		assert.Equal(t, uint(1), route.Legs[0].FromDateSpotID)
+		assert.Equal(t, uint(2), route.Legs[0].ToDateSpotID)
+		assert.Equal(t, uint(3), route.Legs[1].ToDateSpotID)
+	})
+
+	// 緯度経度が無いスポットを含む区間は距離を出さず、合計からも除く
+	t.Run("skips_legs_without_coordinates", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		engine := servicemock.NewMockRouteEngine(ctrl)
+		engine.EXPECT().Leg(ctx, "DRIVING", 35.1, 139.1, 35.2, 139.2).Return(800, 96, nil)
+
+		route, err := service.NewCourseRouteService(engine).BuildRoute(ctx, &model.Course{
+			TravelMode: "DRIVING",
+			DuringSpots: []*model.DuringSpot{
+				newStop(1, nil, nil),
+				newStop(2, lo.ToPtr(35.1), lo.ToPtr(139.1)),
+				newStop(3, lo.ToPtr(35.2), lo.ToPtr(139.2)),
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, route.Legs, 2)
+		assert.Nil(t, route.Legs[0].DistanceMeters)
+		assert.Nil(t, route.Legs[0].DurationSeconds)
+		assert.Equal(t, 800, *route.Legs[1].DistanceMeters)
+		assert.Equal(t, 800, route.TotalDistanceMeters)
+	})
+
+	t.Run("single_stop_has_no_legs", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		route, err := service.NewCourseRouteService(servicemock.NewMockRouteEngine(ctrl)).BuildRoute(ctx, &model.Course{
+			TravelMode:  "WALKING",
+			DuringSpots: []*model.DuringSpot{newStop(1, lo.ToPtr(35.0), lo.ToPtr(139.0))},
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, route.Legs)
+		assert.Equal(t, 0, route.TotalDistanceMeters)
+	})
+
+	t.Run("error_route_engine_failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		engine := servicemock.NewMockRouteEngine(ctrl)
+		engine.EXPECT().Leg(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(0, 0, errors.New("timeout"))
+
+		_, err := service.NewCourseRouteService(engine).BuildRoute(ctx, &model.Course{
+			TravelMode: "WALKING",
+			DuringSpots: []*model.DuringSpot{
+				newStop(1, lo.ToPtr(35.0), lo.ToPtr(139.0)),
+				newStop(2, lo.ToPtr(35.1), lo.ToPtr(139.1)),
+			},
+		})
+
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/imaging"
+)
+
+// MaxImageBytes はアップロードを受け付ける画像ファイルの大きさの上限です。
+const MaxImageBytes = 5 << 20
+
+const (
+	// imageMaxEdge は表示用に保存する画像の長辺です。スマートフォンの写真をそのまま置かないよう縮小します。
+	imageMaxEdge = 1600
+	// thumbnailMaxEdge は一覧向けのサムネイルの長辺です。
+	thumbnailMaxEdge = 320
+	imageQuality     = 85
+)
+
+// ImageService はアップロードされた画像を検証・変換して BlobStore に保存するドメインサービスです。
+type ImageService interface {
+	// Store は data を画像として検証し、縮小した本体とサムネイルを保存して URL を返します。
+	// 画像として受け付けられないときは 422 のエラーを返します。
+	Store(ctx context.Context, kind model.ImageKind, data []byte) (*model.Image, error)
+}
+
+type imageService struct {
+	BlobStore repository.BlobStore
+}
+
+func NewImageService(blobStore repository.BlobStore) ImageService {
+	return &imageService{BlobStore: blobStore}
+}
+
+func (s *imageService) Store(ctx context.Context, kind model.ImageKind, data []byte) (*model.Image, error) {
+	if len(data) > MaxImageBytes {
+		return nil, apperror.UnprocessableEntity("画像は5MB以下のファイルを指定してください")
+	}
+	img, err := imaging.Decode(data)
+	if err != nil {
+		switch {
+		case errors.Is(err, imaging.ErrUnsupportedFormat):
+			return nil, apperror.UnprocessableEntity("画像は JPEG・PNG・GIF のいずれかを指定してください")
+		case errors.Is(err, imaging.ErrTooManyPixels):
+			return nil, apperror.UnprocessableEntity("画像の縦横の大きさが上限を超えています")
+		default:
+			return nil, apperror.UnprocessableEntity("画像を読み込めませんでした")
+		}
+	}
+
+	full, err := imaging.EncodeJPEG(imaging.Fit(img, imageMaxEdge), imageQuality)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	thumb, err := imaging.EncodeJPEG(imaging.Fit(img, thumbnailMaxEdge), imageQuality)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	// 元ファイルの内容から決まるキーにすることで、同じ画像は同じ URL になり、長期間キャッシュさせられる
+	sum := sha256.Sum256(data)
+	base := fmt.Sprintf("%s/%s", kind, hex.EncodeToString(sum[:16]))
+
+	url, err := s.BlobStore.Put(ctx, base+".jpg", "image/jpeg", full)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	thumbURL, err := s.BlobStore.Put(ctx, base+"_thumb.jpg", "image/jpeg", thumb)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	return &model.Image{URL: url, ThumbnailURL: thumbURL}, nil
+}
@@ -0,0 +1,110 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func pngBytes(t *testing.T, w, h int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, w, h))))
+	return buf.Bytes()
+}
+
+func TestImageService_Store(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success_stores_resized_jpeg_and_thumbnail", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		store := repositorymock.NewMockBlobStore(ctrl)
+		sizes := map[string]image.Point{}
+		store.EXPECT().Put(gomock.Any(), gomock.Any(), "image/jpeg", gomock.Any()).
+			DoAndReturn(func(_ context.Context, key, _ string, data []byte) (string, error) {
+				img, err := jpeg.Decode(bytes.NewReader(data))
+				require.NoError(t, err)
+				sizes[key] = img.Bounds().Size()
+				return "https://cdn.example.com/" + key, nil
+			}).Times(2)
+
+		got, err := service.NewImageService(store).Store(ctx, model.ImageKindDateSpot, pngBytes(t, 3200, 1600))
+
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(got.URL, "https://cdn.example.com/date_spots/"))
+		assert.True(t, strings.HasSuffix(got.URL, ".jpg"))
+		assert.Equal(t, strings.TrimSuffix(got.URL, ".jpg")+"_thumb.jpg", got.ThumbnailURL)
+
+		fullKey := strings.TrimPrefix(got.URL, "https://cdn.example.com/")
+		thumbKey := strings.TrimPrefix(got.ThumbnailURL, "https://cdn.example.com/")
+		assert.Equal(t, image.Pt(1600, 800), sizes[fullKey])
+		assert.Equal(t, image.Pt(320, 160), sizes[thumbKey])
+	})
+
+	t.Run("success_same_content_same_key", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		store := repositorymock.NewMockBlobStore(ctrl)
+		var keys []string
+		store.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, key, _ string, _ []byte) (string, error) {
+				keys = append(keys, key)
+				return key, nil
+			}).Times(4)
+		svc := service.NewImageService(store)
+		data := pngBytes(t, 10, 10)
+
+		_, err := svc.Store(ctx, model.ImageKindUser, data)
+		require.NoError(t, err)
+		_, err = svc.Store(ctx, model.ImageKindUser, data)
+		require.NoError(t, err)
+
+		assert.Equal(t, keys[:2], keys[2:])
+	})
+
+	t.Run("error_too_large", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		store := repositorymock.NewMockBlobStore(ctrl)
+
+		_, err := service.NewImageService(store).Store(ctx, model.ImageKindUser, make([]byte, service.MaxImageBytes+1))
+
+		status, msgs, _, _ := apperror.HTTPStatus(err)
+		assert.Equal(t, http.StatusUnprocessableEntity, status)
+		assert.Equal(t, []string{"画像は5MB以下のファイルを指定してください"}, msgs)
+	})
+
+	t.Run("error_unsupported_format", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		store := repositorymock.NewMockBlobStore(ctrl)
+
+		_, err := service.NewImageService(store).Store(ctx, model.ImageKindUser, []byte("%PDF-1.4 not an image"))
+
+		status, msgs, _, _ := apperror.HTTPStatus(err)
+		assert.Equal(t, http.StatusUnprocessableEntity, status)
+		assert.Equal(t, []string{"画像は JPEG・PNG・GIF のいずれかを指定してください"}, msgs)
+	})
+
+	t.Run("error_blob_store_failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		store := repositorymock.NewMockBlobStore(ctrl)
+		store.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("disk full"))
+
+		_, err := service.NewImageService(store).Store(ctx, model.ImageKindUser, pngBytes(t, 10, 10))
+
+		status, _, _, _ := apperror.HTTPStatus(err)
+		assert.Equal(t, http.StatusInternalServerError, status)
+	})
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/service/content_filter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/service/content_filter.go -destination=internal/domain/service/mock/content_filter.go -package=servicemock
+//
+
+// Package servicemock is a generated GoMock package.
+package servicemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	service "github.com/daisuke-harada/date-courses-go/internal/domain/service"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockContentFilter is a mock of ContentFilter interface.
+type MockContentFilter struct {
+	ctrl     *gomock.Controller
+	recorder *MockContentFilterMockRecorder
+	isgomock struct{}
+}
+
+// MockContentFilterMockRecorder is the mock recorder for MockContentFilter.
+type MockContentFilterMockRecorder struct {
+	mock *MockContentFilter
+}
+
+// NewMockContentFilter creates a new mock instance.
+func NewMockContentFilter(ctrl *gomock.Controller) *MockContentFilter {
+	mock := &MockContentFilter{ctrl: ctrl}
+	mock.recorder = &MockContentFilterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContentFilter) EXPECT() *MockContentFilterMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockContentFilter) Check(ctx context.Context, text string) (service.ContentVerdict, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx, text)
+	ret0, _ := ret[0].(service.ContentVerdict)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockContentFilterMockRecorder) Check(ctx, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockContentFilter)(nil).Check), ctx, text)
+}
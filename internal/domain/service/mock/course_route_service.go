@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/service/course_route_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/service/course_route_service.go -destination=internal/domain/service/mock/course_route_service.go -package=servicemock
+//
+
+// Package servicemock is a generated GoMock package.
+package servicemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRouteEngine is a mock of RouteEngine interface.
+type MockRouteEngine struct {
+	ctrl     *gomock.Controller
+	recorder *MockRouteEngineMockRecorder
+	isgomock struct{}
+}
+
+// MockRouteEngineMockRecorder is the mock recorder for MockRouteEngine.
+type MockRouteEngineMockRecorder struct {
+	mock *MockRouteEngine
+}
+
+// NewMockRouteEngine creates a new mock instance.
+func NewMockRouteEngine(ctrl *gomock.Controller) *MockRouteEngine {
+	mock := &MockRouteEngine{ctrl: ctrl}
+	mock.recorder = &MockRouteEngineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRouteEngine) EXPECT() *MockRouteEngineMockRecorder {
+	return m.recorder
+}
+
+// Leg mocks base method.
+func (m *MockRouteEngine) Leg(ctx context.Context, travelMode string, fromLat, fromLng, toLat, toLng float64) (int, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Leg", ctx, travelMode, fromLat, fromLng, toLat, toLng)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Leg indicates an expected call of Leg.
+func (mr *MockRouteEngineMockRecorder) Leg(ctx, travelMode, fromLat, fromLng, toLat, toLng any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Leg", reflect.TypeOf((*MockRouteEngine)(nil).Leg), ctx, travelMode, fromLat, fromLng, toLat, toLng)
+}
+
+// MockCourseRouteService is a mock of CourseRouteService interface.
+type MockCourseRouteService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCourseRouteServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCourseRouteServiceMockRecorder is the mock recorder for MockCourseRouteService.
+type MockCourseRouteServiceMockRecorder struct {
+	mock *MockCourseRouteService
+}
+
+// NewMockCourseRouteService creates a new mock instance.
+func NewMockCourseRouteService(ctrl *gomock.Controller) *MockCourseRouteService {
+	mock := &MockCourseRouteService{ctrl: ctrl}
+	mock.recorder = &MockCourseRouteServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCourseRouteService) EXPECT() *MockCourseRouteServiceMockRecorder {
+	return m.recorder
+}
+
+// BuildRoute mocks base method.
+func (m *MockCourseRouteService) BuildRoute(ctx context.Context, course *model.Course) (*model.CourseRoute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildRoute", ctx, course)
+	ret0, _ := ret[0].(*model.CourseRoute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildRoute indicates an expected call of BuildRoute.
+func (mr *MockCourseRouteServiceMockRecorder) BuildRoute(ctx, course any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildRoute", reflect.TypeOf((*MockCourseRouteService)(nil).BuildRoute), ctx, course)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/service/spot_matcher.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/domain/service/spot_matcher.go -destination=internal/domain/service/mock/spot_matcher.go -package=servicemock
+//
+
+// Package servicemock is a generated GoMock package.
+package servicemock
+
+import (
+	reflect "reflect"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	service "github.com/daisuke-harada/date-courses-go/internal/domain/service"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSpotMatcher is a mock of SpotMatcher interface.
+type MockSpotMatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockSpotMatcherMockRecorder
+	isgomock struct{}
+}
+
+// MockSpotMatcherMockRecorder is the mock recorder for MockSpotMatcher.
+type MockSpotMatcherMockRecorder struct {
+	mock *MockSpotMatcher
+}
+
+// NewMockSpotMatcher creates a new mock instance.
+func NewMockSpotMatcher(ctrl *gomock.Controller) *MockSpotMatcher {
+	mock := &MockSpotMatcher{ctrl: ctrl}
+	mock.recorder = &MockSpotMatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSpotMatcher) EXPECT() *MockSpotMatcherMockRecorder {
+	return m.recorder
+}
+
+// Match mocks base method.
+func (m *MockSpotMatcher) Match(candidate *model.DateSpot, spots []*model.DateSpot) service.SpotMatch {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Match", candidate, spots)
+	ret0, _ := ret[0].(service.SpotMatch)
+	return ret0
+}
+
+// Match indicates an expected call of Match.
+func (mr *MockSpotMatcherMockRecorder) Match(candidate, spots any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Match", reflect.TypeOf((*MockSpotMatcher)(nil).Match), candidate, spots)
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"math"
+	"strings"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/geo"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/textsearch"
+)
+
+// SpotMatchDecision は取り込むスポットを既存スポットに対してどう扱うかです。
+type SpotMatchDecision int
+
+const (
+	// SpotMatchNew は別の場所として新しく登録します。
+	SpotMatchNew SpotMatchDecision = iota
+	// SpotMatchReview は同じ場所か判断がつかないため、管理者の確認に回します。
+	SpotMatchReview
+	// SpotMatchMerge は同じ場所として既存スポットに統合します。
+	SpotMatchMerge
+)
+
+const (
+	// SpotMatchMergeScore 以上の一致度なら同じ場所とみなします。
+	SpotMatchMergeScore = 0.8
+	// SpotMatchReviewScore 以上 SpotMatchMergeScore 未満の一致度は管理者の確認に回します。
+	SpotMatchReviewScore = 0.6
+)
+
+// 一致度の内訳の重みです。座標がどちらかに無いときは距離を除いた重みで割り直します。
+const (
+	spotMatchNameWeight     = 0.6
+	spotMatchDistanceWeight = 0.3
+	spotMatchCityWeight     = 0.1
+)
+
+// 座標の距離がこの範囲なら同じ建物とみなし、これ以上離れていれば距離の点は 0 にします。
+const (
+	spotMatchNearMeters = 50
+	spotMatchFarMeters  = 300
+)
+
+// SpotMatch は既存スポットのうち最も一致度が高いものと、その判定です。
+// Decision が SpotMatchNew のときは Spot が nil です。
+type SpotMatch struct {
+	Spot     *model.DateSpot
+	Score    float64
+	Decision SpotMatchDecision
+}
+
+// SpotMatcher は外部から取り込むスポットが既存スポットと同じ場所かを判定するドメインサービスです。
+// 「スターバックス 渋谷店」と「スターバックスコーヒー渋谷店」のような表記ゆれを、
+// 名前の近さ・座標の近さ・市区町村の一致から一致度（0〜1）を求めて見分けます。
+type SpotMatcher interface {
+	Match(candidate *model.DateSpot, spots []*model.DateSpot) SpotMatch
+}
+
+type spotMatcher struct{}
+
+func NewSpotMatcher() SpotMatcher {
+	return &spotMatcher{}
+}
+
+func (m *spotMatcher) Match(candidate *model.DateSpot, spots []*model.DateSpot) SpotMatch {
+	var best SpotMatch
+	for _, s := range spots {
+		if score := ScoreSpotMatch(candidate, s); best.Spot == nil || score > best.Score {
+			best = SpotMatch{Spot: s, Score: score}
+		}
+	}
+
+	switch {
+	case best.Spot != nil && best.Score >= SpotMatchMergeScore:
+		best.Decision = SpotMatchMerge
+	case best.Spot != nil && best.Score >= SpotMatchReviewScore:
+		best.Decision = SpotMatchReview
+	default:
+		best.Spot = nil
+		best.Decision = SpotMatchNew
+	}
+	return best
+}
+
+// ScoreSpotMatch は a と b が同じ場所である度合いを 0〜1 で返します。
+func ScoreSpotMatch(a, b *model.DateSpot) float64 {
+	score := spotMatchNameWeight*nameSimilarity(a.Name, b.Name) + spotMatchCityWeight*cityMatch(a.CityName, b.CityName)
+	if a.Latitude == nil || a.Longitude == nil || b.Latitude == nil || b.Longitude == nil {
+		return score / (spotMatchNameWeight + spotMatchCityWeight)
+	}
+	distance := geo.DistanceMeters(*a.Latitude, *a.Longitude, *b.Latitude, *b.Longitude)
+	return score + spotMatchDistanceWeight*proximity(distance)
+}
+
+// nameSimilarity は正規化した名前の2文字 n-gram の Dice 係数です。
+// 「コーヒー」の有無のような語の足し引きがあっても、残りの部分が同じなら高くなります。
+func nameSimilarity(a, b string) float64 {
+	a, b = textsearch.Normalize(a), textsearch.Normalize(b)
+	if a == b {
+		return 1
+	}
+	ga, gb := bigrams(a), bigrams(b)
+	if len(ga) == 0 || len(gb) == 0 {
+		return 0
+	}
+
+	counts := make(map[string]int, len(ga))
+	for _, g := range ga {
+		counts[g]++
+	}
+	common := 0
+	for _, g := range gb {
+		if counts[g] > 0 {
+			counts[g]--
+			common++
+		}
+	}
+	return 2 * float64(common) / float64(len(ga)+len(gb))
+}
+
+func bigrams(s string) []string {
+	runes := []rune(s)
+	if len(runes) < 2 {
+		return nil
+	}
+	grams := make([]string, 0, len(runes)-1)
+	for i := 0; i+1 < len(runes); i++ {
+		grams = append(grams, string(runes[i:i+2]))
+	}
+	return grams
+}
+
+// cityMatch は市区町村が一致すれば 1 を返します。
+// 取得元によって「渋谷区」だけのものと住所全体のものがあるため、片方がもう片方を含めば一致とみなします。
+func cityMatch(a, b string) float64 {
+	a, b = textsearch.Normalize(a), textsearch.Normalize(b)
+	if a == "" || b == "" {
+		return 0
+	}
+	if strings.Contains(a, b) || strings.Contains(b, a) {
+		return 1
+	}
+	return 0
+}
+
+// proximity は距離が spotMatchNearMeters 以内なら 1、spotMatchFarMeters 以上なら 0 で、その間は線形に下がります。
+func proximity(meters float64) float64 {
+	return math.Max(0, math.Min(1, (spotMatchFarMeters-meters)/(spotMatchFarMeters-spotMatchNearMeters)))
+}
@@ -0,0 +1,81 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func newSpot(id uint, name, city string, lat, lng *float64) *model.DateSpot {
+	return &model.DateSpot{ID: id, Name: name, CityName: city, Latitude: lat, Longitude: lng}
+}
+
+func TestSpotMatcher_Match(t *testing.T) {
+	matcher := service.NewSpotMatcher()
+	// スターバックス 渋谷店の付近
+	starbucks := newSpot(1, "スターバックス 渋谷店", "渋谷区", lo.ToPtr(35.6595), lo.ToPtr(139.7005))
+	doutor := newSpot(2, "ドトールコーヒー 渋谷店", "渋谷区", lo.ToPtr(35.6597), lo.ToPtr(139.7003))
+	spots := []*model.DateSpot{doutor, starbucks}
+
+	t.Run("merges_name_variant_at_same_place", func(t *testing.T) {
+		candidate := newSpot(0, "スターバックスコーヒー渋谷店", "東京都渋谷区道玄坂1-1", lo.ToPtr(35.6596), lo.ToPtr(139.7006))
+
+		got := matcher.Match(candidate, spots)
+
+		assert.Equal(t, service.SpotMatchMerge, got.Decision)
+		assert.Equal(t, starbucks, got.Spot)
+	})
+
+	t.Run("reviews_name_variant_without_coordinates", func(t *testing.T) {
+		candidate := newSpot(0, "スターバックスコーヒー渋谷店", "東京都渋谷区道玄坂1-1", nil, nil)
+
+		got := matcher.Match(candidate, spots)
+
+		assert.Equal(t, service.SpotMatchReview, got.Decision)
+		assert.Equal(t, starbucks, got.Spot)
+	})
+
+	t.Run("merges_same_name_without_coordinates", func(t *testing.T) {
+		candidate := newSpot(0, "スターバックス　渋谷店", "渋谷区", nil, nil)
+
+		got := matcher.Match(candidate, spots)
+
+		assert.Equal(t, service.SpotMatchMerge, got.Decision)
+		assert.InDelta(t, 1, got.Score, 1e-9)
+	})
+
+	t.Run("reviews_same_name_far_away", func(t *testing.T) {
+		candidate := newSpot(0, "スターバックス 渋谷店", "渋谷区", lo.ToPtr(35.6700), lo.ToPtr(139.7005))
+
+		got := matcher.Match(candidate, spots)
+
+		assert.Equal(t, service.SpotMatchReview, got.Decision)
+	})
+
+	t.Run("different_shop_next_door_is_new", func(t *testing.T) {
+		candidate := newSpot(0, "タリーズコーヒー 渋谷店", "渋谷区", lo.ToPtr(35.6596), lo.ToPtr(139.7004))
+
+		got := matcher.Match(candidate, []*model.DateSpot{starbucks})
+
+		assert.Equal(t, service.SpotMatchNew, got.Decision)
+		assert.Nil(t, got.Spot)
+	})
+
+	t.Run("other_branch_is_new", func(t *testing.T) {
+		candidate := newSpot(0, "スターバックス 新宿店", "新宿区", lo.ToPtr(35.6909), lo.ToPtr(139.7003))
+
+		got := matcher.Match(candidate, spots)
+
+		assert.Equal(t, service.SpotMatchNew, got.Decision)
+	})
+
+	t.Run("no_spots_is_new", func(t *testing.T) {
+		got := matcher.Match(starbucks, nil)
+
+		assert.Equal(t, service.SpotMatchNew, got.Decision)
+		assert.Nil(t, got.Spot)
+	})
+}
@@ -23,7 +23,8 @@ func (MySQLConnector) Open(ctx context.Context, cfg config.DBConfig) (*gorm.DB,
 		dsn += "&tls=true"
 	}
 
-	gdb, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})
+	// 一意キー違反などを gorm.ErrDuplicatedKey などの共通のエラーに変換し、ドライバーに依存せず判定できるようにする
+	gdb, err := gorm.Open(mysql.Open(dsn), &gorm.Config{TranslateError: true})
 	if err != nil {
 		return nil, err
 	}
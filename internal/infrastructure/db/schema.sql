@@ -5,8 +5,18 @@ CREATE TABLE users (
   email VARCHAR(255) NOT NULL,
   gender VARCHAR(255) NOT NULL,
   image VARCHAR(255),
+  image_thumbnail VARCHAR(255),
   admin TINYINT(1) NOT NULL DEFAULT 0,
   password_digest VARCHAR(255) NOT NULL,
+  -- アクセストークンの世代。ログアウト（全端末）やパスワード変更で上げ、古いトークンを無効にする
+  token_version INT UNSIGNED NOT NULL DEFAULT 0,
+  -- メールアドレスを確認した日時。未確認の間は NULL で、投稿などを制限する
+  email_verified_at DATETIME,
+  -- 最後にログインに成功してから続けて失敗した回数。上限に達したら locked_until までロックし、0 に戻す
+  failed_login_count INT UNSIGNED NOT NULL DEFAULT 0,
+  locked_until DATETIME,
+  last_login_at DATETIME,
+  last_login_ip VARCHAR(45),
   created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
   updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
   PRIMARY KEY (id),
@@ -22,11 +32,18 @@ CREATE TABLE date_spots (
   name VARCHAR(255) NOT NULL,
   city_name VARCHAR(255) NOT NULL,
   image VARCHAR(255),
+  image_thumbnail VARCHAR(255),
   latitude DOUBLE,
   longitude DOUBLE,
   source VARCHAR(20) NOT NULL DEFAULT 'manual',
   maps_url VARCHAR(1000),
   normalized_name VARCHAR(255),
+  -- 取得元で最後に掲載を確認した日時。手動登録のスポットは NULL
+  last_seen_at DATETIME,
+  -- 取り直しで取得元に見つからなかった連続回数。見つかれば 0 に戻す
+  missed_refreshes INT NOT NULL DEFAULT 0,
+  -- 閉店とみなした日時。NULL でなければ検索に出さない（コースから参照されるため削除はしない）
+  closed_at DATETIME,
   created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
   updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
   PRIMARY KEY (id)
@@ -36,6 +53,140 @@ CREATE TABLE date_spots (
 CREATE INDEX index_date_spots_on_genre_id_and_created_at ON date_spots (genre_id, created_at);
 CREATE INDEX index_date_spots_on_prefecture_id_and_created_at ON date_spots (prefecture_id, created_at);
 CREATE INDEX index_date_spots_on_normalized_name_and_prefecture_id ON date_spots (normalized_name, prefecture_id);
+-- 現在地からの検索用。本番の TiDB は空間インデックスを持たないため、緯度の範囲で引いて経度はインデックス上で絞る複合インデックスにする
+CREATE INDEX index_date_spots_on_latitude_and_longitude ON date_spots (latitude, longitude);
+
+-- テーブル: date_spot_opening_hours
+-- 曜日ごとの営業時間帯。weekday は 0=日曜〜6=土曜、分は曜日の0時からの経過分。
+-- 日をまたぐ営業は close_minute が 1440 を超える（例: 金曜 18:00〜翌 2:00 は weekday=5, 1080〜1560）
+CREATE TABLE date_spot_opening_hours (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  date_spot_id BIGINT UNSIGNED NOT NULL,
+  weekday TINYINT NOT NULL,
+  open_minute SMALLINT NOT NULL,
+  close_minute SMALLINT NOT NULL,
+  PRIMARY KEY (id),
+  CONSTRAINT fk_date_spot_opening_hours_date_spots FOREIGN KEY (date_spot_id) REFERENCES date_spots (id)
+);
+
+-- indexes (date_spot_opening_hours)
+CREATE INDEX index_date_spot_opening_hours_on_date_spot_id_and_weekday ON date_spot_opening_hours (date_spot_id, weekday);
+
+-- テーブル: date_spot_closures
+-- 祝日・年末年始などの臨時休業日。曜日の営業時間より優先する
+CREATE TABLE date_spot_closures (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  date_spot_id BIGINT UNSIGNED NOT NULL,
+  closed_on DATE NOT NULL,
+  PRIMARY KEY (id),
+  UNIQUE KEY uq_date_spot_closures_date_spot_closed_on (date_spot_id, closed_on),
+  CONSTRAINT fk_date_spot_closures_date_spots FOREIGN KEY (date_spot_id) REFERENCES date_spots (id)
+);
+
+-- テーブル: spot_sources
+-- 外部の取得元から取り込んだスポット1件分の記録。同じ場所を複数の取得元から取り込むと1つの date_spots に複数つながる。
+-- status が pending の行は既存スポットと同じ場所か判断がつかなかったもので、date_spot_id は一致しそうな既存スポットを指す。
+-- 管理者が統合すると linked になり、別の場所と判断すると新しい date_spots を作ってそちらにつなぎ直す
+CREATE TABLE spot_sources (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  date_spot_id BIGINT UNSIGNED NOT NULL,
+  genre_id INT NOT NULL,
+  prefecture_id INT NOT NULL,
+  source VARCHAR(20) NOT NULL,
+  external_id VARCHAR(255),
+  name VARCHAR(255) NOT NULL,
+  city_name VARCHAR(255) NOT NULL,
+  latitude DOUBLE,
+  longitude DOUBLE,
+  image VARCHAR(255),
+  page_url VARCHAR(1000),
+  match_score DOUBLE NOT NULL,
+  status VARCHAR(20) NOT NULL DEFAULT 'linked',
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  UNIQUE KEY uq_spot_sources_source_external_id (source, external_id),
+  CONSTRAINT fk_spot_sources_date_spots FOREIGN KEY (date_spot_id) REFERENCES date_spots (id)
+);
+
+-- indexes (spot_sources)
+CREATE INDEX index_spot_sources_on_date_spot_id ON spot_sources (date_spot_id);
+CREATE INDEX index_spot_sources_on_status_and_created_at ON spot_sources (status, created_at);
+
+-- テーブル: spot_refresh_runs
+-- 取り込み済みスポットを取得元から取り直した1回分の実行記録と件数
+CREATE TABLE spot_refresh_runs (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  source VARCHAR(20) NOT NULL,
+  started_at DATETIME NOT NULL,
+  finished_at DATETIME,
+  checked_count INT NOT NULL DEFAULT 0,
+  updated_count INT NOT NULL DEFAULT 0,
+  missing_count INT NOT NULL DEFAULT 0,
+  closed_count INT NOT NULL DEFAULT 0,
+  reopened_count INT NOT NULL DEFAULT 0,
+  failed_count INT NOT NULL DEFAULT 0,
+  PRIMARY KEY (id)
+);
+
+-- テーブル: spot_refresh_changes
+-- 取り直しで変わったスポットの属性1つ分の差分。閉店・再開も field = 'closed_at' の変更として残す
+CREATE TABLE spot_refresh_changes (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  run_id BIGINT UNSIGNED NOT NULL,
+  date_spot_id BIGINT UNSIGNED NOT NULL,
+  field VARCHAR(50) NOT NULL,
+  before_value VARCHAR(1000),
+  after_value VARCHAR(1000),
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  CONSTRAINT fk_spot_refresh_changes_spot_refresh_runs FOREIGN KEY (run_id) REFERENCES spot_refresh_runs (id),
+  CONSTRAINT fk_spot_refresh_changes_date_spots FOREIGN KEY (date_spot_id) REFERENCES date_spots (id)
+);
+
+-- indexes (spot_refresh_changes)
+CREATE INDEX index_spot_refresh_changes_on_run_id ON spot_refresh_changes (run_id);
+CREATE INDEX index_spot_refresh_changes_on_date_spot_id ON spot_refresh_changes (date_spot_id);
+
+-- テーブル: batch_runs
+-- スポット収集バッチの1回の実行と件数の合計。終了を記録する前に落ちた実行は status = 'running' のまま残る
+CREATE TABLE batch_runs (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  status VARCHAR(20) NOT NULL DEFAULT 'running',
+  started_at DATETIME NOT NULL,
+  finished_at DATETIME,
+  task_count INT NOT NULL DEFAULT 0,
+  succeeded_count INT NOT NULL DEFAULT 0,
+  skipped_count INT NOT NULL DEFAULT 0,
+  failed_count INT NOT NULL DEFAULT 0,
+  created_count INT NOT NULL DEFAULT 0,
+  merged_count INT NOT NULL DEFAULT 0,
+  pending_count INT NOT NULL DEFAULT 0,
+  PRIMARY KEY (id)
+);
+
+-- テーブル: batch_tasks
+-- 実行の中で都道府県 × ジャンルを1つ処理した結果。次の実行は成功・スキップした組み合わせを後回しにして続きから進める
+CREATE TABLE batch_tasks (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  run_id BIGINT UNSIGNED NOT NULL,
+  prefecture_id INT NOT NULL,
+  genre_id INT NOT NULL,
+  status VARCHAR(20) NOT NULL,
+  created_count INT NOT NULL DEFAULT 0,
+  merged_count INT NOT NULL DEFAULT 0,
+  pending_count INT NOT NULL DEFAULT 0,
+  error VARCHAR(1000),
+  started_at DATETIME NOT NULL,
+  finished_at DATETIME NOT NULL,
+  PRIMARY KEY (id),
+  CONSTRAINT fk_batch_tasks_batch_runs FOREIGN KEY (run_id) REFERENCES batch_runs (id)
+);
+
+-- indexes (batch_tasks)
+CREATE INDEX index_batch_tasks_on_run_id ON batch_tasks (run_id);
+-- 組み合わせごとに最後に実行し終えた日時を引く
+CREATE INDEX index_batch_tasks_on_status_and_combination ON batch_tasks (status, prefecture_id, genre_id, finished_at);
 
 -- テーブル: courses
 CREATE TABLE courses (
@@ -59,6 +210,8 @@ CREATE TABLE date_spot_reviews (
   content TEXT,
   user_id BIGINT UNSIGNED NOT NULL,
   date_spot_id BIGINT UNSIGNED NOT NULL,
+  -- 公開状態。published・approved だけを公開し、評価の平均や件数に数える
+  status VARCHAR(20) NOT NULL DEFAULT 'published',
   created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
   updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
   PRIMARY KEY (id),
@@ -70,12 +223,37 @@ CREATE TABLE date_spot_reviews (
 -- indexes (date_spot_reviews)
 CREATE INDEX index_date_spot_reviews_on_date_spot_id ON date_spot_reviews (date_spot_id);
 CREATE INDEX index_date_spot_reviews_on_user_id ON date_spot_reviews (user_id);
+-- 管理者の確認待ち一覧用
+CREATE INDEX index_date_spot_reviews_on_status_and_created_at ON date_spot_reviews (status, created_at);
+
+-- テーブル: date_spot_review_reports
+-- レビューの通報。管理者が承認・非公開にすると resolved_at が入る
+CREATE TABLE date_spot_review_reports (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  date_spot_review_id BIGINT UNSIGNED NOT NULL,
+  user_id BIGINT UNSIGNED NOT NULL,
+  reason VARCHAR(20) NOT NULL,
+  comment VARCHAR(500),
+  resolved_at DATETIME,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  UNIQUE KEY uq_date_spot_review_reports_review_user (date_spot_review_id, user_id),
+  CONSTRAINT fk_date_spot_review_reports_date_spot_reviews FOREIGN KEY (date_spot_review_id) REFERENCES date_spot_reviews (id),
+  CONSTRAINT fk_date_spot_review_reports_users FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+-- indexes (date_spot_review_reports)
+CREATE INDEX index_date_spot_review_reports_on_user_id ON date_spot_review_reports (user_id);
 
 -- テーブル: during_spots
 CREATE TABLE during_spots (
   id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
   course_id BIGINT UNSIGNED NOT NULL,
   date_spot_id BIGINT UNSIGNED NOT NULL,
+  position INT NOT NULL DEFAULT 0,
+  arrival_time VARCHAR(5),
+  stay_minutes INT,
+  memo VARCHAR(500),
   created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
   updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
   PRIMARY KEY (id),
@@ -84,7 +262,7 @@ CREATE TABLE during_spots (
 );
 
 -- indexes (during_spots)
-CREATE INDEX index_during_spots_on_course_id ON during_spots (course_id);
+CREATE INDEX index_during_spots_on_course_id_and_position ON during_spots (course_id, position);
 CREATE INDEX index_during_spots_on_date_spot_id ON during_spots (date_spot_id);
 
 -- テーブル: relationships
@@ -102,3 +280,174 @@ CREATE TABLE relationships (
 -- indexes (relationships)
 CREATE INDEX index_relationships_on_follow_id ON relationships (follow_id);
 CREATE INDEX index_relationships_on_user_id ON relationships (user_id);
+
+-- テーブル: course_favorites
+-- ユーザーがお気に入りに保存したコース。同じコースは1回しか保存できない
+CREATE TABLE course_favorites (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  user_id BIGINT UNSIGNED NOT NULL,
+  course_id BIGINT UNSIGNED NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  UNIQUE KEY uq_course_favorites_user_id_course_id (user_id, course_id),
+  CONSTRAINT fk_course_favorites_users FOREIGN KEY (user_id) REFERENCES users (id),
+  CONSTRAINT fk_course_favorites_courses FOREIGN KEY (course_id) REFERENCES courses (id)
+);
+
+-- indexes (course_favorites)
+CREATE INDEX index_course_favorites_on_course_id ON course_favorites (course_id);
+
+-- テーブル: notifications
+-- アプリ内通知。course_id・date_spot_id・date_spot_review_id は対象が削除されても通知を残すため外部キーにしない
+CREATE TABLE notifications (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  user_id BIGINT UNSIGNED NOT NULL,
+  actor_id BIGINT UNSIGNED NOT NULL,
+  -- followed / course_favorited / course_spot_reviewed
+  type VARCHAR(32) NOT NULL,
+  course_id BIGINT UNSIGNED,
+  date_spot_id BIGINT UNSIGNED,
+  date_spot_review_id BIGINT UNSIGNED,
+  -- 通知のもとになった outbox_events の ID。配り直しで同じ通知を2件作らないよう、受け取る人ごとに一意にする
+  outbox_event_id BIGINT UNSIGNED,
+  read_at DATETIME,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  UNIQUE KEY uq_notifications_outbox_event_id_user_id (outbox_event_id, user_id),
+  CONSTRAINT fk_notifications_users FOREIGN KEY (user_id) REFERENCES users (id),
+  CONSTRAINT fk_notifications_actors FOREIGN KEY (actor_id) REFERENCES users (id)
+);
+
+-- indexes (notifications)
+CREATE INDEX index_notifications_on_user_id_and_created_at ON notifications (user_id, created_at);
+CREATE INDEX index_notifications_on_user_id_and_read_at ON notifications (user_id, read_at);
+CREATE INDEX index_notifications_on_actor_id ON notifications (actor_id);
+
+-- テーブル: refresh_tokens
+-- リフレッシュトークン。平文は保存せず SHA-256 のハッシュだけを持つ。使うたびに失効させて新しいものと差し替える
+CREATE TABLE refresh_tokens (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  user_id BIGINT UNSIGNED NOT NULL,
+  token_hash CHAR(64) NOT NULL,
+  expires_at DATETIME NOT NULL,
+  revoked_at DATETIME,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  UNIQUE KEY uq_refresh_tokens_token_hash (token_hash),
+  CONSTRAINT fk_refresh_tokens_users FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+-- indexes (refresh_tokens)
+CREATE INDEX index_refresh_tokens_on_user_id ON refresh_tokens (user_id);
+
+-- テーブル: login_histories
+-- ログインの試行の記録。本人が身に覚えのないログインに気づけるよう、失敗も残す
+CREATE TABLE login_histories (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  user_id BIGINT UNSIGNED NOT NULL,
+  -- succeeded / failed（パスワード誤り）/ locked（ロック中のため拒否）
+  result VARCHAR(20) NOT NULL,
+  ip_address VARCHAR(45) NOT NULL,
+  user_agent VARCHAR(512) NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  CONSTRAINT fk_login_histories_users FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+-- indexes (login_histories)
+CREATE INDEX index_login_histories_on_user_id_and_created_at ON login_histories (user_id, created_at);
+
+-- テーブル: email_tokens
+-- メールアドレスの確認・パスワード再設定のリンクに載せる一度きりのトークン。平文は保存せず SHA-256 のハッシュだけを持つ
+CREATE TABLE email_tokens (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  user_id BIGINT UNSIGNED NOT NULL,
+  purpose VARCHAR(32) NOT NULL,
+  -- 送り先のメールアドレス。送った後にアドレスを変えていたら確認済みにしない
+  email VARCHAR(255) NOT NULL,
+  token_hash CHAR(64) NOT NULL,
+  expires_at DATETIME NOT NULL,
+  used_at DATETIME,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  UNIQUE KEY uq_email_tokens_token_hash (token_hash),
+  CONSTRAINT fk_email_tokens_users FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+-- indexes (email_tokens)
+CREATE INDEX index_email_tokens_on_user_id_and_purpose ON email_tokens (user_id, purpose);
+
+-- テーブル: rate_limit_buckets
+-- レート制限のトークンバケット。Lambda の実行環境をまたいで同じキーを数えるために DB に持つ。
+-- full_at を過ぎた行は満タンのバケットと同じなので、API が定期的に消す
+CREATE TABLE rate_limit_buckets (
+  bucket_key VARCHAR(191) NOT NULL,
+  tokens DOUBLE NOT NULL,
+  refilled_at DATETIME(6) NOT NULL,
+  full_at DATETIME(6) NOT NULL,
+  PRIMARY KEY (bucket_key)
+);
+
+-- indexes (rate_limit_buckets)
+CREATE INDEX index_rate_limit_buckets_on_full_at ON rate_limit_buckets (full_at);
+
+-- テーブル: outbox_events
+-- ドメインイベントの outbox。発行元の書き込みと同じトランザクションで追記し、cmd/relay が購読者へ配る。
+-- 外部キーは張らない（対象が消えた後に配るイベントもあるため）
+CREATE TABLE outbox_events (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  -- user.followed / course.created など
+  name VARCHAR(64) NOT NULL,
+  payload JSON NOT NULL,
+  -- pending（配る前・配り直し待ち）/ delivered（配り終えた）/ dead（配るのをあきらめた）
+  status VARCHAR(16) NOT NULL DEFAULT 'pending',
+  attempts INT UNSIGNED NOT NULL DEFAULT 0,
+  next_attempt_at DATETIME(6) NOT NULL,
+  last_error TEXT,
+  created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  delivered_at DATETIME(6),
+  PRIMARY KEY (id)
+);
+
+-- indexes (outbox_events)
+CREATE INDEX index_outbox_events_on_status_and_next_attempt_at ON outbox_events (status, next_attempt_at);
+
+-- テーブル: user_blocks
+-- ユーザーがブロックした相手。ブロックした側・された側のどちらからもフォローできず、互いのコース・レビューが一覧に出なくなる
+CREATE TABLE user_blocks (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  user_id BIGINT UNSIGNED NOT NULL,
+  blocked_user_id BIGINT UNSIGNED NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  UNIQUE KEY uq_user_blocks_user_id_blocked_user_id (user_id, blocked_user_id),
+  CONSTRAINT fk_user_blocks_users FOREIGN KEY (user_id) REFERENCES users (id),
+  CONSTRAINT fk_user_blocks_blocked_users FOREIGN KEY (blocked_user_id) REFERENCES users (id)
+);
+
+-- indexes (user_blocks)
+CREATE INDEX index_user_blocks_on_blocked_user_id ON user_blocks (blocked_user_id);
+
+-- テーブル: user_mutes
+-- ユーザーがミュートした相手。ミュートした本人の一覧からだけ相手のコンテンツが消え、相手には何も変わらない
+CREATE TABLE user_mutes (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  user_id BIGINT UNSIGNED NOT NULL,
+  muted_user_id BIGINT UNSIGNED NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  UNIQUE KEY uq_user_mutes_user_id_muted_user_id (user_id, muted_user_id),
+  CONSTRAINT fk_user_mutes_users FOREIGN KEY (user_id) REFERENCES users (id),
+  CONSTRAINT fk_user_mutes_muted_users FOREIGN KEY (muted_user_id) REFERENCES users (id)
+);
+
+-- indexes (user_mutes)
+CREATE INDEX index_user_mutes_on_muted_user_id ON user_mutes (muted_user_id);
+
+-- テーブル: data_migrations
+-- tools/datamigrate で流したデータ移行の記録。同じ移行を2回流さないために使う
+CREATE TABLE data_migrations (
+  name VARCHAR(255) NOT NULL,
+  applied_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (name)
+);
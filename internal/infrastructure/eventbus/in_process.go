@@ -0,0 +1,35 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
+)
+
+// InProcessDispatcher はイベントを同じプロセスのハンドラーへその場で配ります。
+// cmd/relay が outbox から読んだイベントを、起動時に登録したハンドラーへ振り分けるのに使います。
+// ハンドラーが失敗しても残りのハンドラーへは配り、失敗をまとめて返します。
+type InProcessDispatcher struct {
+	handlers map[string][]event.Handler
+}
+
+func NewInProcessDispatcher() *InProcessDispatcher {
+	return &InProcessDispatcher{handlers: map[string][]event.Handler{}}
+}
+
+// Subscribe は name のイベントを h に配るよう登録します。起動時にだけ呼びます。
+func (d *InProcessDispatcher) Subscribe(name string, h event.Handler) {
+	d.handlers[name] = append(d.handlers[name], h)
+}
+
+func (d *InProcessDispatcher) Dispatch(ctx context.Context, e event.Event) error {
+	var errs []error
+	for _, h := range d.handlers[e.Name()] {
+		if err := h.Handle(ctx, e); err != nil {
+			errs = append(errs, fmt.Errorf("%s handler %T: %w", e.Name(), h, err))
+		}
+	}
+	return errors.Join(errs...)
+}
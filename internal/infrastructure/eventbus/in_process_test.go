@@ -0,0 +1,61 @@
+package eventbus_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/eventbus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInProcessDispatcher_Dispatch(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("delivers_only_to_handlers_of_the_event_name", func(t *testing.T) {
+		d := eventbus.NewInProcessDispatcher()
+		var followed, favorited []event.Event
+		d.Subscribe(event.NameUserFollowed, event.HandlerFunc(func(_ context.Context, e event.Event) error {
+			followed = append(followed, e)
+			return nil
+		}))
+		d.Subscribe(event.NameCourseFavorited, event.HandlerFunc(func(_ context.Context, e event.Event) error {
+			favorited = append(favorited, e)
+			return nil
+		}))
+
+		err := d.Dispatch(ctx, event.UserFollowed{FollowerID: 1, FollowedUserID: 2})
+
+		require.NoError(t, err)
+		assert.Equal(t, []event.Event{event.UserFollowed{FollowerID: 1, FollowedUserID: 2}}, followed)
+		assert.Empty(t, favorited)
+	})
+
+	// 1つのハンドラーが失敗しても残りのハンドラーには配り、失敗は呼び出し側に返す
+	t.Run("returns_handler_error_after_delivering_to_the_rest", func(t *testing.T) {
+		d := eventbus.NewInProcessDispatcher()
+		boom := errors.New("boom")
+		calls := 0
+		d.Subscribe(event.NameUserFollowed, event.HandlerFunc(func(context.Context, event.Event) error {
+			calls++
+			return boom
+		}))
+		d.Subscribe(event.NameUserFollowed, event.HandlerFunc(func(context.Context, event.Event) error {
+			calls++
+			return nil
+		}))
+
+		err := d.Dispatch(ctx, event.UserFollowed{FollowerID: 1, FollowedUserID: 2})
+
+		require.ErrorIs(t, err, boom)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("ignores_events_without_handlers", func(t *testing.T) {
+		d := eventbus.NewInProcessDispatcher()
+
+		assert.NoError(t, d.Dispatch(ctx, event.DateSpotReviewed{ReviewID: 1}))
+	})
+}
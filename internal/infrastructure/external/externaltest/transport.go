@@ -0,0 +1,65 @@
+// Package externaltest は外部 API クライアントを、記録しておいたレスポンスで試すためのテスト用ヘルパーです。
+package externaltest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"testing"
+)
+
+// Transport は記録済みのレスポンスファイルを返す http.RoundTripper です。
+// リクエスト先の "host/path" でファイルを引き、届いたリクエストは Requests に残します。
+type Transport struct {
+	t        testing.TB
+	fixtures map[string]string
+
+	mu       sync.Mutex
+	requests []*http.Request
+}
+
+// NewTransport は fixtures（"host/path" → レスポンスボディのファイルパス）を返す Transport を作ります。
+func NewTransport(t testing.TB, fixtures map[string]string) *Transport {
+	t.Helper()
+	return &Transport{t: t, fixtures: fixtures}
+}
+
+// Client は Transport を使う http.Client を返します。各クライアントの WithHTTPClient に渡します。
+func (tr *Transport) Client() *http.Client {
+	return &http.Client{Transport: tr}
+}
+
+// Requests はこれまでに届いたリクエストを届いた順に返します。
+func (tr *Transport) Requests() []*http.Request {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	return append([]*http.Request(nil), tr.requests...)
+}
+
+func (tr *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	tr.mu.Lock()
+	tr.requests = append(tr.requests, req)
+	tr.mu.Unlock()
+
+	key := req.URL.Host + req.URL.Path
+	path, ok := tr.fixtures[key]
+	if !ok {
+		// 記録していない API を呼んだらテストの書き漏れなので失敗させる
+		tr.t.Errorf("externaltest: no fixture for %s", key)
+		return nil, fmt.Errorf("externaltest: no fixture for %s", key)
+	}
+	body, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("externaltest: read fixture %s: %w", path, err)
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(body)),
+		Request:    req,
+	}, nil
+}
@@ -9,6 +9,8 @@ import (
 	"log/slog"
 	"net/http"
 	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external/httpclient"
 )
 
 const baseURL = "https://generativelanguage.googleapis.com/v1beta/models"
@@ -19,13 +21,33 @@ type Client struct {
 	httpClient *http.Client
 }
 
-func NewClient(apiKey, model string) *Client {
-	return &Client{
-		apiKey: apiKey,
-		model:  model,
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
+func NewClient(apiKey, model string, opts ...Option) *Client {
+	c := &Client{
+		apiKey:     apiKey,
+		model:      model,
+		httpClient: httpclient.NewTransport(httpclient.DefaultConfig()).Client(30 * time.Second),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Option は Client の設定を変えます。
+type Option func(*Client)
+
+// WithHTTPClient は外部 API の呼び出しに使う HTTP クライアントを差し替えます。
+// 記録しておいたレスポンスを返すテスト用のクライアントを渡すときに使います。
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithTransport は Gemini API の呼び出しを通す http.RoundTripper を差し替えます。生成を待つためのタイムアウトの30秒はそのまま残します。
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *Client) {
+		c.httpClient = &http.Client{Transport: rt, Timeout: c.httpClient.Timeout}
 	}
 }
 
@@ -0,0 +1,70 @@
+package gemini
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external/nominatim"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+)
+
+// Provider は Gemini にスポットを挙げさせる external.SpotProvider です。
+// ジャンルを問わず候補を出せるため、HotPepper で件数が足りないときの補充や、HotPepper が扱えないジャンルに使います。
+// Gemini は座標を返さないので、候補ごとに Nominatim で緯度経度を引きます。
+type Provider struct {
+	client   *Client
+	geocoder *nominatim.Client
+}
+
+func NewProvider(client *Client, geocoder *nominatim.Client) *Provider {
+	return &Provider{
+		client:   client,
+		geocoder: geocoder,
+	}
+}
+
+func (p *Provider) Name() string {
+	return "gemini"
+}
+
+func (p *Provider) Source() model.DateSpotSource {
+	return model.DateSpotSourceGemini
+}
+
+// Covers はすべての都道府県・ジャンルを扱えます。
+func (p *Provider) Covers(_ string, _ int) bool {
+	return true
+}
+
+func (p *Provider) Search(ctx context.Context, query external.SpotQuery) ([]usecase.SpotCandidate, error) {
+	text, err := p.client.GenerateContent(ctx, BuildDateSpotsPrompt(query.PrefectureName, query.GenreName, query.Count))
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := ParseDateSpotCandidates(text)
+	if err != nil {
+		return nil, err
+	}
+
+	// ページ URL は無いので、登録時に Google Maps の検索 URL が入る
+	spots := make([]usecase.SpotCandidate, 0, len(candidates))
+	for _, c := range candidates {
+		spot := usecase.SpotCandidate{
+			Name:     c.Name,
+			CityName: c.CityName,
+			Source:   p.Source(),
+		}
+		// 座標が引けなくても登録はする（現在地からの検索に出ないだけ）
+		coord, gErr := p.geocoder.Search(ctx, c.Name, c.CityName)
+		if gErr != nil {
+			slog.InfoContext(ctx, "gemini provider: geocoding failed", "name", c.Name, "err", gErr)
+		} else if coord != nil {
+			spot.Latitude = &coord.Lat
+			spot.Longitude = &coord.Lon
+		}
+		spots = append(spots, spot)
+	}
+	return spots, nil
+}
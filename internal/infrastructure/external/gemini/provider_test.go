@@ -0,0 +1,60 @@
+package gemini_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external/externaltest"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external/gemini"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external/nominatim"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvider_Search(t *testing.T) {
+	tr := externaltest.NewTransport(t, map[string]string{
+		"generativelanguage.googleapis.com/v1beta/models/gemini-2.0-flash:generateContent": "testdata/generate_content.json",
+		"nominatim.openstreetmap.org/search":                                               "testdata/nominatim_search.json",
+	})
+	p := gemini.NewProvider(
+		gemini.NewClient("key", "gemini-2.0-flash", gemini.WithHTTPClient(tr.Client())),
+		nominatim.NewClient(nominatim.WithHTTPClient(tr.Client())),
+	)
+
+	assert.True(t, p.Covers("13", 1))
+
+	spots, err := p.Search(context.Background(), external.SpotQuery{
+		PrefCode:       "40",
+		PrefectureName: "福岡県",
+		GenreID:        3,
+		GenreName:      "カフェ・スイーツ",
+		Count:          3,
+	})
+	require.NoError(t, err)
+
+	t.Run("drops_candidates_without_name_and_geocodes_the_rest", func(t *testing.T) {
+		require.Len(t, spots, 2)
+		assert.Equal(t, "大濠公園", spots[0].Name)
+		assert.Equal(t, "福岡市中央区", spots[0].CityName)
+		assert.Equal(t, model.DateSpotSourceGemini, spots[0].Source)
+		assert.Empty(t, spots[0].PageURL)
+		require.NotNil(t, spots[0].Latitude)
+		require.NotNil(t, spots[0].Longitude)
+		assert.Equal(t, 33.5861, *spots[0].Latitude)
+		assert.Equal(t, 130.3763, *spots[0].Longitude)
+	})
+
+	t.Run("prompt_names_prefecture_and_genre", func(t *testing.T) {
+		reqs := tr.Requests()
+		// Gemini 1回 + 候補ごとの Nominatim 2回
+		require.Len(t, reqs, 3)
+		body, err := io.ReadAll(reqs[0].Body)
+		require.NoError(t, err)
+		assert.True(t, strings.Contains(string(body), "福岡県のカフェ・スイーツ"))
+		assert.Equal(t, "大濠公園 福岡市中央区", reqs[1].URL.Query().Get("q"))
+	})
+}
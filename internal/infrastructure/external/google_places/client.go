@@ -9,6 +9,9 @@ import (
 	"net/http"
 	"net/url"
 	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external/httpclient"
 )
 
 const searchURL = "https://maps.googleapis.com/maps/api/place/findplacefromtext/json"
@@ -20,20 +23,41 @@ type Client struct {
 	httpClient *http.Client
 }
 
-func NewClient(apiKey string) *Client {
-	return &Client{
-		apiKey: apiKey,
-		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+func NewClient(apiKey string, opts ...Option) *Client {
+	c := &Client{
+		apiKey:     apiKey,
+		httpClient: httpclient.NewTransport(httpclient.DefaultConfig()).Client(10 * time.Second),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Option は Client の設定を変えます。
+type Option func(*Client)
+
+// WithHTTPClient は Places API の呼び出しに使う HTTP クライアントを差し替えます。
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithTransport は Places API の呼び出しを通す http.RoundTripper を差し替えます。タイムアウトの10秒はそのまま残します。
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *Client) {
+		c.httpClient = &http.Client{Transport: rt, Timeout: c.httpClient.Timeout}
 	}
 }
 
 // PlaceDetail は取得したスポットの詳細情報です。
 type PlaceDetail struct {
-	PhotoURL    *string
-	OpeningTime *time.Time
-	ClosingTime *time.Time
+	PhotoURL *string
+	// OpeningHours は通常の週の営業時間帯です。
+	OpeningHours []*model.DateSpotOpeningHour
+	// Closures は直近の祝日などで、通常の週と違って終日休業する日です。
+	Closures []*model.DateSpotClosure
 }
 
 type findPlaceResponse struct {
@@ -49,17 +73,34 @@ type placeDetailResponse struct {
 			PhotoReference string `json:"photo_reference"`
 		} `json:"photos"`
 		OpeningHours *struct {
-			Periods []struct {
-				Open  *periodTime `json:"open"`
-				Close *periodTime `json:"close"`
-			} `json:"periods"`
+			Periods []period `json:"periods"`
 		} `json:"opening_hours"`
+		// CurrentOpeningHours は今日から7日間の実際の営業予定で、祝日などの特別営業日を含みます。
+		CurrentOpeningHours *struct {
+			Periods     []period `json:"periods"`
+			SpecialDays []struct {
+				Date             string `json:"date"` // "YYYY-MM-DD" 形式
+				ExceptionalHours bool   `json:"exceptional_hours"`
+			} `json:"special_days"`
+		} `json:"current_opening_hours"`
 	} `json:"result"`
 	Status string `json:"status"`
 }
 
+type period struct {
+	Open  *periodTime `json:"open"`
+	Close *periodTime `json:"close"`
+}
+
 type periodTime struct {
+	Day  int    `json:"day"`  // 0=日曜〜6=土曜
 	Time string `json:"time"` // "HHMM" 形式
+	Date string `json:"date"` // current_opening_hours のときだけ入る "YYYY-MM-DD" 形式
+}
+
+// Enabled は API キーが設定されているかを返します。未設定なら呼んでも失敗するだけなので、呼び出し側で飛ばします。
+func (c *Client) Enabled() bool {
+	return c.apiKey != ""
 }
 
 func (c *Client) FetchPlaceDetail(ctx context.Context, spotName, cityName string) (*PlaceDetail, error) {
@@ -98,6 +139,9 @@ func (c *Client) findPlaceID(ctx context.Context, query string) (string, error)
 	if err != nil {
 		return "", fmt.Errorf("google_places: read find body: %w", err)
 	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("google_places: find status %d", resp.StatusCode)
+	}
 
 	var result findPlaceResponse
 	if err := json.Unmarshal(body, &result); err != nil {
@@ -115,7 +159,7 @@ func (c *Client) findPlaceID(ctx context.Context, query string) (string, error)
 func (c *Client) fetchDetail(ctx context.Context, placeID string) (*PlaceDetail, error) {
 	params := url.Values{
 		"place_id": {placeID},
-		"fields":   {"photos,opening_hours"},
+		"fields":   {"photos,opening_hours,current_opening_hours"},
 		"language": {"ja"},
 		"key":      {c.apiKey},
 	}
@@ -135,6 +179,9 @@ func (c *Client) fetchDetail(ctx context.Context, placeID string) (*PlaceDetail,
 	if err != nil {
 		return nil, fmt.Errorf("google_places: read detail body: %w", err)
 	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("google_places: detail status %d", resp.StatusCode)
+	}
 
 	var result placeDetailResponse
 	if err := json.Unmarshal(body, &result); err != nil {
@@ -149,26 +196,83 @@ func (c *Client) fetchDetail(ctx context.Context, placeID string) (*PlaceDetail,
 		detail.PhotoURL = &photoURLStr
 	}
 
-	if result.Result.OpeningHours != nil && len(result.Result.OpeningHours.Periods) > 0 {
-		p := result.Result.OpeningHours.Periods[0]
-		if p.Open != nil {
-			t := parseHHMM(p.Open.Time)
-			detail.OpeningTime = &t
+	if result.Result.OpeningHours != nil {
+		detail.OpeningHours = openingHoursFromPeriods(result.Result.OpeningHours.Periods)
+	}
+	if current := result.Result.CurrentOpeningHours; current != nil {
+		opened := make(map[string]bool, len(current.Periods))
+		for _, p := range current.Periods {
+			if p.Open != nil {
+				opened[p.Open.Date] = true
+			}
 		}
-		if p.Close != nil {
-			t := parseHHMM(p.Close.Time)
-			detail.ClosingTime = &t
+		for _, d := range current.SpecialDays {
+			// 特別営業日のうち、営業時間帯が1つもない日を休業日とみなす
+			if !d.ExceptionalHours || opened[d.Date] {
+				continue
+			}
+			closedOn, err := time.ParseInLocation(time.DateOnly, d.Date, model.JST)
+			if err != nil {
+				continue
+			}
+			detail.Closures = append(detail.Closures, &model.DateSpotClosure{ClosedOn: closedOn})
 		}
 	}
 
 	return detail, nil
 }
 
-// parseHHMM は "0900" 形式の文字列を time.Time に変換します（日付は今日の UTC）。
-func parseHHMM(hhmm string) time.Time {
-	if len(hhmm) != 4 {
-		return time.Time{}
+// openingHoursFromPeriods は Places API の periods を曜日ごとの営業時間帯に変換します。
+// 日をまたぐ営業は翌日にはみ出したまま1つの時間帯にし、24時間を超えて続く営業は日ごとに分けます。
+func openingHoursFromPeriods(periods []period) []*model.DateSpotOpeningHour {
+	// 24時間営業は close のない 日曜 0000 の period 1つで表される
+	if len(periods) == 1 && periods[0].Open != nil && periods[0].Close == nil && periods[0].Open.Time == "0000" {
+		hours := make([]*model.DateSpotOpeningHour, 0, 7)
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			hours = append(hours, &model.DateSpotOpeningHour{Weekday: day, OpenMinute: 0, CloseMinute: model.MinutesPerDay})
+		}
+		return hours
+	}
+
+	var hours []*model.DateSpotOpeningHour
+	for _, p := range periods {
+		if p.Open == nil || p.Close == nil {
+			continue
+		}
+		openMinute, ok := parseHHMM(p.Open.Time)
+		if !ok {
+			continue
+		}
+		closeMinute, ok := parseHHMM(p.Close.Time)
+		if !ok {
+			continue
+		}
+		closeMinute += ((p.Close.Day - p.Open.Day + 7) % 7) * model.MinutesPerDay
+		if closeMinute <= openMinute {
+			// 同じ曜日の同じ時刻に閉まるのは、1週間続けて営業している場合
+			closeMinute += 7 * model.MinutesPerDay
+		}
+
+		day := time.Weekday(p.Open.Day)
+		if closeMinute-openMinute <= model.MinutesPerDay {
+			hours = append(hours, &model.DateSpotOpeningHour{Weekday: day, OpenMinute: openMinute, CloseMinute: closeMinute})
+			continue
+		}
+		for openMinute < closeMinute {
+			end := min(closeMinute, model.MinutesPerDay)
+			hours = append(hours, &model.DateSpotOpeningHour{Weekday: day, OpenMinute: openMinute, CloseMinute: end})
+			openMinute, closeMinute = 0, closeMinute-model.MinutesPerDay
+			day = (day + 1) % 7
+		}
+	}
+	return hours
+}
+
+// parseHHMM は "0930" 形式の文字列を0時からの経過分に変換します。
+func parseHHMM(hhmm string) (int, bool) {
+	t, err := time.Parse("1504", hhmm)
+	if err != nil {
+		return 0, false
 	}
-	t, _ := time.Parse("1504", hhmm)
-	return t
+	return t.Hour()*60 + t.Minute(), true
 }
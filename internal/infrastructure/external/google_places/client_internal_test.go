@@ -0,0 +1,76 @@
+package google_places
+
+import (
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpeningHoursFromPeriods(t *testing.T) {
+	at := func(day int, hhmm string) *periodTime { return &periodTime{Day: day, Time: hhmm} }
+
+	tests := []struct {
+		name    string
+		periods []period
+		want    []*model.DateSpotOpeningHour
+	}{
+		{
+			name: "multiple_intervals_on_same_day",
+			periods: []period{
+				{Open: at(1, "1130"), Close: at(1, "1400")},
+				{Open: at(1, "1700"), Close: at(1, "2200")},
+			},
+			want: []*model.DateSpotOpeningHour{
+				{Weekday: time.Monday, OpenMinute: 690, CloseMinute: 840},
+				{Weekday: time.Monday, OpenMinute: 1020, CloseMinute: 1320},
+			},
+		},
+		{
+			name:    "overnight_keeps_one_interval",
+			periods: []period{{Open: at(5, "1800"), Close: at(6, "0200")}},
+			want: []*model.DateSpotOpeningHour{
+				{Weekday: time.Friday, OpenMinute: 1080, CloseMinute: 1560},
+			},
+		},
+		{
+			name:    "overnight_from_saturday_wraps_to_sunday",
+			periods: []period{{Open: at(6, "2000"), Close: at(0, "0300")}},
+			want: []*model.DateSpotOpeningHour{
+				{Weekday: time.Saturday, OpenMinute: 1200, CloseMinute: 1620},
+			},
+		},
+		{
+			name:    "longer_than_a_day_is_split_per_day",
+			periods: []period{{Open: at(1, "1000"), Close: at(3, "0200")}},
+			want: []*model.DateSpotOpeningHour{
+				{Weekday: time.Monday, OpenMinute: 600, CloseMinute: 1440},
+				{Weekday: time.Tuesday, OpenMinute: 0, CloseMinute: 1440},
+				{Weekday: time.Wednesday, OpenMinute: 0, CloseMinute: 120},
+			},
+		},
+		{
+			name:    "invalid_time_is_skipped",
+			periods: []period{{Open: at(1, "25:00"), Close: at(1, "2200")}},
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, openingHoursFromPeriods(tt.periods))
+		})
+	}
+
+	t.Run("always_open_becomes_every_day", func(t *testing.T) {
+		got := openingHoursFromPeriods([]period{{Open: at(0, "0000")}})
+
+		assert.Len(t, got, 7)
+		for i, h := range got {
+			assert.Equal(t, time.Weekday(i), h.Weekday)
+			assert.Equal(t, 0, h.OpenMinute)
+			assert.Equal(t, model.MinutesPerDay, h.CloseMinute)
+		}
+	})
+}
@@ -7,7 +7,11 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external/httpclient"
+	"github.com/samber/lo"
 )
 
 const apiURL = "https://webservice.recruit.co.jp/hotpepper/gourmet/v1/"
@@ -44,14 +48,37 @@ type Client struct {
 	httpClient *http.Client
 }
 
-func NewClient(apiKey string) *Client {
-	return &Client{
+func NewClient(apiKey string, opts ...Option) *Client {
+	c := &Client{
 		apiKey:     apiKey,
-		httpClient: &http.Client{Timeout: 10 * time.Second},
+		httpClient: httpclient.NewTransport(httpclient.DefaultConfig()).Client(10 * time.Second),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Option は Client の設定を変えます。
+type Option func(*Client)
+
+// WithHTTPClient は外部 API の呼び出しに使う HTTP クライアントを差し替えます。
+// 記録しておいたレスポンスを返すテスト用のクライアントを渡すときに使います。
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithTransport はグルメサーチ API の呼び出しを通す http.RoundTripper を差し替えます。タイムアウトの10秒はそのまま残します。
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *Client) {
+		c.httpClient = &http.Client{Transport: rt, Timeout: c.httpClient.Timeout}
 	}
 }
 
 type Spot struct {
+	ID       string
 	Name     string
 	CityName string
 	Lat      float64
@@ -62,7 +89,13 @@ type Spot struct {
 
 type response struct {
 	Results struct {
+		// Error は API キーの誤りや上限超過のときだけ入ります。
+		Error []struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
 		Shop []struct {
+			ID      string  `json:"id"`
 			Name    string  `json:"name"`
 			Address string  `json:"address"`
 			Lat     float64 `json:"lat"`
@@ -91,7 +124,32 @@ func (c *Client) Search(ctx context.Context, prefectureName string, genreID int,
 	if gc, ok := AppGenreToHotPepper[genreID]; ok && gc != "" {
 		params.Set("genre", string(gc))
 	}
+	return c.get(ctx, params)
+}
+
+// lookupMaxIDs は1回の問い合わせで id パラメータに並べられる店舗 ID の上限です。
+const lookupMaxIDs = 20
 
+// Lookup は店舗 ID で HotPepper グルメ API を引きます。掲載が終わった店舗は結果に含まれません。
+// ID が多いときは lookupMaxIDs 件ずつに分けて問い合わせ、1回でも失敗すればエラーを返します。
+func (c *Client) Lookup(ctx context.Context, ids []string) ([]Spot, error) {
+	var spots []Spot
+	for _, chunk := range lo.Chunk(ids, lookupMaxIDs) {
+		found, err := c.get(ctx, url.Values{
+			"key":    {c.apiKey},
+			"id":     {strings.Join(chunk, ",")},
+			"count":  {fmt.Sprintf("%d", len(chunk))},
+			"format": {"json"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		spots = append(spots, found...)
+	}
+	return spots, nil
+}
+
+func (c *Client) get(ctx context.Context, params url.Values) ([]Spot, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL+"?"+params.Encode(), nil)
 	if err != nil {
 		return nil, fmt.Errorf("hotpepper: create request: %w", err)
@@ -107,15 +165,24 @@ func (c *Client) Search(ctx context.Context, prefectureName string, genreID int,
 	if err != nil {
 		return nil, fmt.Errorf("hotpepper: read body: %w", err)
 	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("hotpepper: status %d", resp.StatusCode)
+	}
 
 	var result response
 	if err := json.Unmarshal(body, &result); err != nil {
 		return nil, fmt.Errorf("hotpepper: unmarshal response: %w", err)
 	}
+	// エラーでも店舗が0件の正常なレスポンスと同じ形で返るため、見落とすと全店舗が掲載終了に見える
+	if len(result.Results.Error) > 0 {
+		e := result.Results.Error[0]
+		return nil, fmt.Errorf("hotpepper: api error code=%d: %s", e.Code, e.Message)
+	}
 
 	spots := make([]Spot, 0, len(result.Results.Shop))
 	for _, s := range result.Results.Shop {
 		spot := Spot{
+			ID:       s.ID,
 			Name:     s.Name,
 			CityName: s.Address,
 			Lat:      s.Lat,
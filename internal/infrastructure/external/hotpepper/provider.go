@@ -0,0 +1,73 @@
+package hotpepper
+
+import (
+	"context"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+)
+
+// Provider は HotPepper グルメ API をスポット取得元として使う external.SpotProvider です。
+// 飲食店のデータなので、扱えるのは AppGenreToHotPepper にあるジャンルだけです。
+type Provider struct {
+	client *Client
+}
+
+func NewProvider(client *Client) *Provider {
+	return &Provider{client: client}
+}
+
+func (p *Provider) Name() string {
+	return "hotpepper"
+}
+
+func (p *Provider) Source() model.DateSpotSource {
+	return model.DateSpotSourceHotPepper
+}
+
+// Covers は全都道府県の飲食ジャンルを扱えます。
+func (p *Provider) Covers(_ string, genreID int) bool {
+	return IsHotPepperGenre(genreID)
+}
+
+func (p *Provider) Search(ctx context.Context, query external.SpotQuery) ([]usecase.SpotCandidate, error) {
+	results, err := p.client.Search(ctx, query.PrefectureName, query.GenreID, query.Count)
+	if err != nil {
+		return nil, err
+	}
+	return p.candidates(results), nil
+}
+
+// Lookup は取り込み済みの店舗を ID で取り直します。掲載が終わった店舗は返しません。
+func (p *Provider) Lookup(ctx context.Context, externalIDs []string) ([]usecase.SpotCandidate, error) {
+	results, err := p.client.Lookup(ctx, externalIDs)
+	if err != nil {
+		return nil, err
+	}
+	return p.candidates(results), nil
+}
+
+func (p *Provider) candidates(results []Spot) []usecase.SpotCandidate {
+	spots := make([]usecase.SpotCandidate, 0, len(results))
+	for _, s := range results {
+		c := usecase.SpotCandidate{
+			ExternalID: s.ID,
+			Name:       s.Name,
+			CityName:   s.CityName,
+			PageURL:    s.PageURL,
+			ImageURL:   s.ImageURL,
+			Source:     p.Source(),
+		}
+		if s.Lat != 0 {
+			lat := s.Lat
+			c.Latitude = &lat
+		}
+		if s.Lng != 0 {
+			lng := s.Lng
+			c.Longitude = &lng
+		}
+		spots = append(spots, c)
+	}
+	return spots
+}
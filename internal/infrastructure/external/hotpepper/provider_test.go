@@ -0,0 +1,121 @@
+package hotpepper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external/externaltest"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external/hotpepper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvider_Covers(t *testing.T) {
+	p := hotpepper.NewProvider(hotpepper.NewClient("key"))
+
+	assert.True(t, p.Covers("40", 3))
+	assert.False(t, p.Covers("40", 13))
+}
+
+func TestProvider_Search(t *testing.T) {
+	tr := externaltest.NewTransport(t, map[string]string{
+		"webservice.recruit.co.jp/hotpepper/gourmet/v1/": "testdata/gourmet_search.json",
+	})
+	p := hotpepper.NewProvider(hotpepper.NewClient("key", hotpepper.WithHTTPClient(tr.Client())))
+
+	spots, err := p.Search(context.Background(), external.SpotQuery{
+		PrefCode:       "40",
+		PrefectureName: "福岡県",
+		GenreID:        3,
+		GenreName:      "カフェ・スイーツ",
+		Count:          2,
+	})
+	require.NoError(t, err)
+	require.Len(t, spots, 2)
+
+	t.Run("maps_shop_to_candidate", func(t *testing.T) {
+		s := spots[0]
+		assert.Equal(t, "J001000001", s.ExternalID)
+		assert.Equal(t, "カフェ ルミエール 天神店", s.Name)
+		assert.Equal(t, "福岡県福岡市中央区天神2-1-1", s.CityName)
+		assert.Equal(t, "https://www.hotpepper.jp/strJ001000001/", s.PageURL)
+		assert.Equal(t, model.DateSpotSourceHotPepper, s.Source)
+		require.NotNil(t, s.ImageURL)
+		require.NotNil(t, s.Latitude)
+		require.NotNil(t, s.Longitude)
+		assert.Equal(t, 33.5902, *s.Latitude)
+		assert.Equal(t, 130.3990, *s.Longitude)
+	})
+
+	t.Run("missing_photo_and_coordinates_stay_nil", func(t *testing.T) {
+		s := spots[1]
+		assert.Nil(t, s.ImageURL)
+		assert.Nil(t, s.Latitude)
+		assert.Nil(t, s.Longitude)
+	})
+
+	t.Run("sends_prefecture_genre_and_count", func(t *testing.T) {
+		reqs := tr.Requests()
+		require.Len(t, reqs, 1)
+		q := reqs[0].URL.Query()
+		assert.Equal(t, "福岡県", q.Get("keyword"))
+		assert.Equal(t, "G014", q.Get("genre"))
+		assert.Equal(t, "2", q.Get("count"))
+	})
+}
+
+func TestProvider_Lookup(t *testing.T) {
+	t.Run("returns_only_listed_shops", func(t *testing.T) {
+		tr := externaltest.NewTransport(t, map[string]string{
+			"webservice.recruit.co.jp/hotpepper/gourmet/v1/": "testdata/gourmet_lookup.json",
+		})
+		p := hotpepper.NewProvider(hotpepper.NewClient("key", hotpepper.WithHTTPClient(tr.Client())))
+
+		spots, err := p.Lookup(context.Background(), []string{"J001000001", "J001000003"})
+		require.NoError(t, err)
+		require.Len(t, spots, 1)
+		assert.Equal(t, "J001000001", spots[0].ExternalID)
+		assert.Equal(t, "カフェ ルミエール 天神本店", spots[0].Name)
+
+		reqs := tr.Requests()
+		require.Len(t, reqs, 1)
+		q := reqs[0].URL.Query()
+		assert.Equal(t, "J001000001,J001000003", q.Get("id"))
+		assert.Empty(t, q.Get("keyword"))
+	})
+
+	// 1回に引ける ID は20件までなので、それを超える分は分けて問い合わせる
+	t.Run("splits_ids_into_chunks", func(t *testing.T) {
+		tr := externaltest.NewTransport(t, map[string]string{
+			"webservice.recruit.co.jp/hotpepper/gourmet/v1/": "testdata/gourmet_lookup.json",
+		})
+		p := hotpepper.NewProvider(hotpepper.NewClient("key", hotpepper.WithHTTPClient(tr.Client())))
+		ids := make([]string, 25)
+		for n := range ids {
+			ids[n] = fmt.Sprintf("J%09d", n)
+		}
+
+		_, err := p.Lookup(context.Background(), ids)
+		require.NoError(t, err)
+
+		reqs := tr.Requests()
+		require.Len(t, reqs, 2)
+		assert.Equal(t, "20", reqs[0].URL.Query().Get("count"))
+		assert.Equal(t, "5", reqs[1].URL.Query().Get("count"))
+	})
+
+	// エラーのレスポンスも店舗0件と同じ形なので、掲載終了と取り違えないようエラーにする
+	t.Run("api_error_is_returned", func(t *testing.T) {
+		tr := externaltest.NewTransport(t, map[string]string{
+			"webservice.recruit.co.jp/hotpepper/gourmet/v1/": "testdata/gourmet_error.json",
+		})
+		p := hotpepper.NewProvider(hotpepper.NewClient("key", hotpepper.WithHTTPClient(tr.Client())))
+
+		_, err := p.Lookup(context.Background(), []string{"J001000001"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "code=2000")
+	})
+}
@@ -0,0 +1,326 @@
+// Package httpclient は外部 API の呼び出しをすべて通す、共通の HTTP レイヤーです。
+// ホストごとのレート制限・429/5xx のリトライ・サーキットブレーカー・リクエストログを1つの http.RoundTripper にまとめます。
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+)
+
+// NominatimHost は利用規約で毎秒1リクエストまでと決められている Nominatim のホストです。
+const NominatimHost = "nominatim.openstreetmap.org"
+
+// ErrCircuitOpen は失敗が続いたホストへの呼び出しを、送らずに止めたときのエラーです。
+var ErrCircuitOpen = errors.New("httpclient: circuit open")
+
+// Config は Transport の設定です。
+type Config struct {
+	// RequestsPerMinute はホストごとの毎分のリクエスト数の上限です。0 以下なら制限しません。
+	RequestsPerMinute int
+	// HostRequestsPerMinute はホストごとに上限を下げます。RequestsPerMinute より緩い値は無視します。
+	HostRequestsPerMinute map[string]int
+	// MaxRetries は 429・5xx・通信エラーのときに送り直す最大の回数です。
+	MaxRetries int
+	// BaseBackoff・MaxBackoff は送り直すまでの待ち時間の初期値と上限です。待ち時間は回数ごとに倍にし、揺らぎを加えます。
+	// Retry-After が MaxBackoff より長いときは送り直しません。
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// BreakerThreshold 回続けて失敗したホストへの呼び出しは、BreakerCooldown の間 ErrCircuitOpen で止めます。
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+	// Base は実際にリクエストを送る http.RoundTripper です。nil なら http.DefaultTransport を使います。
+	Base http.RoundTripper
+}
+
+// DefaultConfig は各クライアントが既定で使う設定です。Nominatim は利用規約に合わせて毎秒1リクエストに抑えます。
+func DefaultConfig() Config {
+	return Config{
+		RequestsPerMinute:     60,
+		HostRequestsPerMinute: map[string]int{NominatimHost: 60},
+		MaxRetries:            3,
+		BaseBackoff:           500 * time.Millisecond,
+		MaxBackoff:            8 * time.Second,
+		BreakerThreshold:      5,
+		BreakerCooldown:       30 * time.Second,
+	}
+}
+
+// Transport は外部 API の呼び出しを通す http.RoundTripper です。
+// レート制限とサーキットブレーカーはホストごとに持ち、同じ Transport を使うクライアントの間で共有します。
+// バッチでは1つの Transport を各クライアントの WithTransport に渡し、同じホストへの呼び出しをクライアントをまたいで数えます。
+// テストでは記録しておいたレスポンスを返す http.Client を WithHTTPClient に渡し、Transport を通しません。
+type Transport struct {
+	cfg  Config
+	base http.RoundTripper
+
+	mu    sync.Mutex
+	hosts map[string]*hostState
+}
+
+type hostState struct {
+	limiter *limiter
+	breaker *breaker
+}
+
+func NewTransport(cfg Config) *Transport {
+	base := cfg.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &Transport{cfg: cfg, base: base, hosts: map[string]*hostState{}}
+}
+
+// Client は Transport を使う http.Client を返します。timeout はレート制限・リトライの待ち時間も含めた1回の呼び出し全体の上限です。
+func (t *Transport) Client(timeout time.Duration) *http.Client {
+	return &http.Client{Transport: t, Timeout: timeout}
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	host := t.host(req.URL.Host)
+
+	if !host.breaker.allow(time.Now()) {
+		slog.WarnContext(ctx, "httpclient: circuit open, request not sent", "method", req.Method, "host", req.URL.Host, "path", req.URL.Path)
+		return nil, fmt.Errorf("%w: %s", ErrCircuitOpen, req.URL.Host)
+	}
+
+	resp, err := t.roundTripWithRetry(req, host)
+	switch {
+	case err != nil && ctx.Err() != nil:
+		// 呼び出し側が止めた・時間切れにしたものは、ホストの失敗に数えない
+		host.breaker.release()
+	case err != nil || retryable(resp.StatusCode):
+		host.breaker.failure(time.Now(), t.cfg.BreakerThreshold, t.cfg.BreakerCooldown)
+	default:
+		host.breaker.success()
+	}
+	return resp, err
+}
+
+func (t *Transport) roundTripWithRetry(req *http.Request, host *hostState) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		if err := host.limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+
+		attemptReq, err := rewind(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+		start := time.Now()
+		resp, err := t.base.RoundTrip(attemptReq)
+		logAttempt(ctx, req, attempt, resp, err, time.Since(start))
+
+		if ctx.Err() != nil || (err == nil && !retryable(resp.StatusCode)) {
+			return resp, err
+		}
+		if attempt >= t.cfg.MaxRetries || (req.Body != nil && req.GetBody == nil) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if wait > t.cfg.MaxBackoff {
+			// Retry-After が長すぎるときは、バッチを止めないよう送り直さずに今の結果を返す
+			return resp, err
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+			// 待っている間に時間切れになるなら、送り直さずに今の結果を返す
+			return resp, err
+		}
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+		slog.WarnContext(ctx, "httpclient: retrying", "host", req.URL.Host, "path", req.URL.Path, "attempt", attempt+1, "wait", wait)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// host は host のレート制限とサーキットブレーカーを、初めて呼ぶときに作って返します。
+func (t *Transport) host(host string) *hostState {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if s, ok := t.hosts[host]; ok {
+		return s
+	}
+
+	perMinute := t.cfg.RequestsPerMinute
+	if v, ok := t.cfg.HostRequestsPerMinute[host]; ok && v > 0 && (perMinute <= 0 || v < perMinute) {
+		perMinute = v
+	}
+	s := &hostState{limiter: newLimiter(perMinute), breaker: &breaker{}}
+	t.hosts[host] = s
+	return s
+}
+
+// backoff は attempt 回目の失敗のあと送り直すまでの待ち時間です。
+// Retry-After があればそれに従い、なければ BaseBackoff を倍々にした時間の半分から全部までの間で揺らします。
+func (t *Transport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			return wait
+		}
+	}
+	d := min(t.cfg.BaseBackoff<<attempt, t.cfg.MaxBackoff)
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + rand.N(d/2+1)
+}
+
+// retryable は送り直せば通る見込みのあるステータスかどうかを返します。
+func retryable(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// retryAfter は Retry-After の秒数か日時を、now からの待ち時間に変換します。
+func retryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if sec, err := strconv.Atoi(v); err == nil {
+		return max(time.Duration(sec)*time.Second, 0), true
+	}
+	if at, err := http.ParseTime(v); err == nil {
+		return max(at.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// rewind は2回目以降の送信のために、読み終えたボディを読み直せるリクエストを作ります。
+func rewind(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.Body == nil {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("httpclient: rewind body: %w", err)
+	}
+	r := req.Clone(req.Context())
+	r.Body = body
+	return r, nil
+}
+
+// logAttempt は1回の送信の結果を残します。API キーを含むことがあるため、クエリ文字列は残しません。
+func logAttempt(ctx context.Context, req *http.Request, attempt int, resp *http.Response, err error, elapsed time.Duration) {
+	attrs := []any{
+		"method", req.Method,
+		"host", req.URL.Host,
+		"path", req.URL.Path,
+		"attempt", attempt + 1,
+		"duration_ms", elapsed.Milliseconds(),
+	}
+	if err != nil {
+		slog.WarnContext(ctx, "httpclient: request failed", append(attrs, "err", err)...)
+		return
+	}
+	attrs = append(attrs, "status", resp.StatusCode)
+	if retryable(resp.StatusCode) {
+		slog.WarnContext(ctx, "httpclient: request failed", attrs...)
+		return
+	}
+	slog.InfoContext(ctx, "httpclient: request", attrs...)
+}
+
+// limiter はホストごとのレート制限です。
+// 毎分の上限をまとめて使い切らないよう、1リクエスト分だけ入るバケットで一定の間隔に均して送ります。
+type limiter struct {
+	mu     sync.Mutex
+	rule   model.RateLimitRule
+	bucket *model.RateLimitBucket
+}
+
+// newLimiter は毎分 perMinute 回までに抑える limiter を作ります。0 以下なら制限しない limiter を返します。
+func newLimiter(perMinute int) *limiter {
+	if perMinute <= 0 {
+		return &limiter{}
+	}
+	rule := model.RateLimitRule{Limit: 1, Window: time.Minute / time.Duration(perMinute)}
+	return &limiter{rule: rule, bucket: model.NewRateLimitBucket("", rule, time.Now())}
+}
+
+// wait は次の1回を送ってよくなるまで待ちます。ctx が先に終われば ctx のエラーを返します。
+func (l *limiter) wait(ctx context.Context) error {
+	if l.bucket == nil {
+		return nil
+	}
+	for {
+		l.mu.Lock()
+		result := l.bucket.Take(l.rule, time.Now())
+		l.mu.Unlock()
+		if result.Allowed {
+			return nil
+		}
+
+		timer := time.NewTimer(result.RetryAfter)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// breaker はホストごとのサーキットブレーカーです。
+// 続けて threshold 回失敗すると cooldown の間は呼び出しを止め、明けたら1件だけ試しに通して、成功すれば元に戻します。
+type breaker struct {
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+func (b *breaker) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.openUntil.IsZero() {
+		return true
+	}
+	if now.Before(b.openUntil) || b.probing {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+func (b *breaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+	b.openUntil = time.Time{}
+	b.probing = false
+}
+
+func (b *breaker) failure(now time.Time, threshold int, cooldown time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	b.probing = false
+	if threshold > 0 && b.failures >= threshold {
+		b.openUntil = now.Add(cooldown)
+	}
+}
+
+// release は成否の分からなかった試しの呼び出しを取り消し、次の呼び出しで試し直せるようにします。
+func (b *breaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
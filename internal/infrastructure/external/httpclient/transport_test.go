@@ -0,0 +1,170 @@
+package httpclient_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external/httpclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransport_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	cfg := httpclient.Config{
+		MaxRetries:       3,
+		BaseBackoff:      time.Millisecond,
+		MaxBackoff:       2 * time.Millisecond,
+		BreakerThreshold: 2,
+		BreakerCooldown:  time.Hour,
+	}
+	newTransport := func(base *scriptedTransport) *httpclient.Transport {
+		c := cfg
+		c.Base = base
+		return httpclient.NewTransport(c)
+	}
+	get := func(t *testing.T, client *http.Client, ctx context.Context, url string) (*http.Response, error) {
+		t.Helper()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		require.NoError(t, err)
+		return client.Do(req)
+	}
+
+	t.Run("success_retries_server_error", func(t *testing.T) {
+		base := &scriptedTransport{statuses: []int{503, 429, 200}}
+		resp, err := get(t, newTransport(base).Client(time.Second), ctx, "https://api.example.com/search")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 3, base.calls())
+	})
+
+	t.Run("success_returns_last_response_after_max_retries", func(t *testing.T) {
+		base := &scriptedTransport{statuses: []int{500, 500, 500, 500, 500}}
+		resp, err := get(t, newTransport(base).Client(time.Second), ctx, "https://api.example.com/search")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		assert.Equal(t, 4, base.calls())
+	})
+
+	t.Run("success_does_not_retry_client_error", func(t *testing.T) {
+		base := &scriptedTransport{statuses: []int{404}}
+		resp, err := get(t, newTransport(base).Client(time.Second), ctx, "https://api.example.com/search")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Equal(t, 1, base.calls())
+	})
+
+	// 送り直すボディは毎回読み直す
+	t.Run("success_resends_body", func(t *testing.T) {
+		base := &scriptedTransport{statuses: []int{503, 200}}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.example.com/generate", strings.NewReader(`{"q":"cafe"}`))
+		require.NoError(t, err)
+		_, err = newTransport(base).Client(time.Second).Do(req)
+		require.NoError(t, err)
+		assert.Equal(t, []string{`{"q":"cafe"}`, `{"q":"cafe"}`}, base.bodies)
+	})
+
+	// Retry-After まで待つと長すぎるなら、待たずに 429 を返す
+	t.Run("success_gives_up_when_retry_after_is_too_long", func(t *testing.T) {
+		base := &scriptedTransport{statuses: []int{429}, header: http.Header{"Retry-After": {"120"}}}
+		start := time.Now()
+		resp, err := get(t, newTransport(base).Client(time.Second), ctx, "https://api.example.com/search")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		assert.Equal(t, 1, base.calls())
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+	})
+
+	// 同じホストへの呼び出しだけを間隔を空けて送る
+	t.Run("success_rate_limits_per_host", func(t *testing.T) {
+		base := &scriptedTransport{statuses: []int{200, 200, 200}}
+		c := cfg
+		c.Base = base
+		c.RequestsPerMinute = 600
+		client := httpclient.NewTransport(c).Client(time.Second)
+
+		start := time.Now()
+		_, err := get(t, client, ctx, "https://api.example.com/search")
+		require.NoError(t, err)
+		_, err = get(t, client, ctx, "https://other.example.com/search")
+		require.NoError(t, err)
+		assert.Less(t, time.Since(start), 50*time.Millisecond)
+
+		_, err = get(t, client, ctx, "https://api.example.com/search")
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+	})
+
+	t.Run("error_circuit_opens_after_consecutive_failures", func(t *testing.T) {
+		base := &scriptedTransport{statuses: []int{500, 500, 500, 500, 500, 500, 500, 500}}
+		client := newTransport(base).Client(time.Second)
+
+		for range 2 {
+			resp, err := get(t, client, ctx, "https://api.example.com/search")
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		}
+		_, err := get(t, client, ctx, "https://api.example.com/search")
+		require.ErrorIs(t, err, httpclient.ErrCircuitOpen)
+		assert.Equal(t, 8, base.calls())
+
+		// 別のホストは止めない
+		base.statuses = []int{200}
+		resp, err := get(t, client, ctx, "https://other.example.com/search")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("error_network_failure", func(t *testing.T) {
+		base := &scriptedTransport{err: errors.New("connection refused")}
+		_, err := get(t, newTransport(base).Client(time.Second), ctx, "https://api.example.com/search")
+		require.Error(t, err)
+		assert.Equal(t, 4, base.calls())
+	})
+}
+
+// scriptedTransport は statuses を順に返す http.RoundTripper です。err があれば常にそれを返します。
+type scriptedTransport struct {
+	statuses []int
+	header   http.Header
+	err      error
+
+	mu     sync.Mutex
+	n      int
+	bodies []string
+}
+
+func (s *scriptedTransport) calls() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.n
+}
+
+func (s *scriptedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.n++
+	if req.Body != nil {
+		b, _ := io.ReadAll(req.Body)
+		s.bodies = append(s.bodies, string(b))
+	}
+	if s.err != nil {
+		return nil, s.err
+	}
+	status := s.statuses[0]
+	if len(s.statuses) > 1 {
+		s.statuses = s.statuses[1:]
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     s.header.Clone(),
+		Body:       io.NopCloser(strings.NewReader("{}")),
+		Request:    req,
+	}, nil
+}
@@ -10,6 +10,8 @@ import (
 	"net/url"
 	"strconv"
 	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external/httpclient"
 )
 
 const searchURL = "https://nominatim.openstreetmap.org/search"
@@ -18,11 +20,31 @@ type Client struct {
 	httpClient *http.Client
 }
 
-func NewClient() *Client {
-	return &Client{
-		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		httpClient: httpclient.NewTransport(httpclient.DefaultConfig()).Client(10 * time.Second),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Option は Client の設定を変えます。
+type Option func(*Client)
+
+// WithHTTPClient は外部 API の呼び出しに使う HTTP クライアントを差し替えます。
+// 記録しておいたレスポンスを返すテスト用のクライアントを渡すときに使います。
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithTransport はジオコーディングの呼び出しを通す http.RoundTripper を差し替えます。毎秒1リクエストの上限は Transport 側で守ります。
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *Client) {
+		c.httpClient = &http.Client{Transport: rt, Timeout: c.httpClient.Timeout}
 	}
 }
 
@@ -63,6 +85,9 @@ func (c *Client) Search(ctx context.Context, spotName, cityName string) (*Coordi
 	if err != nil {
 		return nil, fmt.Errorf("nominatim: read body: %w", err)
 	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("nominatim: status %d", resp.StatusCode)
+	}
 
 	var results []nominatimResult
 	if err := json.Unmarshal(body, &results); err != nil {
@@ -0,0 +1,58 @@
+package external
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+)
+
+// SpotQuery はスポット取得元に渡す検索条件です。
+type SpotQuery struct {
+	PrefCode       string
+	PrefectureName string
+	GenreID        int
+	GenreName      string
+	Count          int
+}
+
+// SpotProvider はスポット候補を返す外部の取得元1つです。
+// 取得元ごとに得意なジャンル・地域が違うため、どの組み合わせを扱えるかを Covers で宣言します。
+type SpotProvider interface {
+	// Name は BATCH_SPOT_PROVIDERS などの設定で取得元を指す名前です。
+	Name() string
+	// Source は取得したスポットの date_spots.source に入れる値です。
+	Source() model.DateSpotSource
+	// Covers は prefCode の都道府県・genreID のジャンルのスポットを返せるかを返します。
+	Covers(prefCode string, genreID int) bool
+	// Search は外部 API の結果を usecase.SpotCandidate に詰め替えて返します。Source も埋めます。
+	Search(ctx context.Context, query SpotQuery) ([]usecase.SpotCandidate, error)
+}
+
+// SpotProviderRegistry は名前で引けるスポット取得元の一覧です。
+type SpotProviderRegistry struct {
+	providers map[string]SpotProvider
+}
+
+func NewSpotProviderRegistry(providers ...SpotProvider) *SpotProviderRegistry {
+	r := &SpotProviderRegistry{providers: make(map[string]SpotProvider, len(providers))}
+	for _, p := range providers {
+		r.providers[p.Name()] = p
+	}
+	return r
+}
+
+// Resolve は names の取得元を names の順に返します。
+// 設定の書き間違いに起動時に気付けるよう、登録されていない名前があればエラーにします。
+func (r *SpotProviderRegistry) Resolve(names []string) ([]SpotProvider, error) {
+	providers := make([]SpotProvider, 0, len(names))
+	for _, name := range names {
+		p, ok := r.providers[name]
+		if !ok {
+			return nil, fmt.Errorf("spot provider %q is not registered", name)
+		}
+		providers = append(providers, p)
+	}
+	return providers, nil
+}
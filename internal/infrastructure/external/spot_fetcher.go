@@ -2,52 +2,56 @@ package external
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 
-	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external/hotpepper"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/master"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external/google_places"
 	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external/wikimedia"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 )
 
 // SpotFetcherImpl は usecase.SpotFetcher の実装です。
-// すべてのジャンルを HotPepper グルメ API から取得し、画像は Wikimedia でフォールバックします。
-// （HotPepper は飲食店データのため、観光系ジャンルもジャンル指定なしの実在店舗で埋めます）
+// ジャンルごとに設定された取得元（SpotProvider）を順に呼び、画像は Wikimedia でフォールバックします。
+// 営業時間と休業日は、取り込むと決まった候補についてだけ Google Places API から補います。
 type SpotFetcherImpl struct {
-	hotpepper *hotpepper.Client
-	wikimedia *wikimedia.Client
+	registry *SpotProviderRegistry
+	// providerNames はジャンル ID から、使う取得元の名前を使う順に返します。
+	providerNames func(genreID int) []string
+	wikimedia     *wikimedia.Client
+	googlePlaces  *google_places.Client
 }
 
-func NewSpotFetcher(hp *hotpepper.Client, wm *wikimedia.Client) *SpotFetcherImpl {
+func NewSpotFetcher(
+	registry *SpotProviderRegistry,
+	providerNames func(genreID int) []string,
+	wm *wikimedia.Client,
+	gp *google_places.Client,
+) *SpotFetcherImpl {
 	return &SpotFetcherImpl{
-		hotpepper: hp,
-		wikimedia: wm,
+		registry:      registry,
+		providerNames: providerNames,
+		wikimedia:     wm,
+		googlePlaces:  gp,
 	}
 }
 
 func (f *SpotFetcherImpl) FetchSpots(ctx context.Context, prefCode string, prefectureName string, genreID int, count int) ([]usecase.SpotCandidate, error) {
-	results, err := f.hotpepper.Search(ctx, prefectureName, genreID, count)
+	providers, err := f.registry.Resolve(f.providerNames(genreID))
 	if err != nil {
-		return nil, fmt.Errorf("spot_fetcher: hotpepper search: %w", err)
+		return nil, fmt.Errorf("spot_fetcher: %w", err)
 	}
 
-	spots := make([]usecase.SpotCandidate, 0, len(results))
-	for _, s := range results {
-		c := usecase.SpotCandidate{
-			Name:     s.Name,
-			CityName: s.CityName,
-			PageURL:  s.PageURL,
-			ImageURL: s.ImageURL,
-		}
-		if s.Lat != 0 {
-			lat := s.Lat
-			c.Latitude = &lat
-		}
-		if s.Lng != 0 {
-			lng := s.Lng
-			c.Longitude = &lng
-		}
-		spots = append(spots, c)
+	spots, err := collectSpots(ctx, providers, SpotQuery{
+		PrefCode:       prefCode,
+		PrefectureName: prefectureName,
+		GenreID:        genreID,
+		GenreName:      master.GenreNameByID(genreID),
+		Count:          count,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("spot_fetcher: %w", err)
 	}
 
 	// 画像がないスポットは Wikimedia でフォールバック
@@ -64,3 +68,53 @@ func (f *SpotFetcherImpl) FetchSpots(ctx context.Context, prefCode string, prefe
 
 	return spots, nil
 }
+
+// FetchOpeningHours は c の営業時間・休業日を Google Places API で埋めます。
+// API キーがなければ呼びません。取れなかったスポットも登録はする（来店日時での検索に出ないだけ）。
+func (f *SpotFetcherImpl) FetchOpeningHours(ctx context.Context, c *usecase.SpotCandidate) {
+	if f.googlePlaces == nil || !f.googlePlaces.Enabled() {
+		return
+	}
+	detail, err := f.googlePlaces.FetchPlaceDetail(ctx, c.Name, c.CityName)
+	if err != nil {
+		slog.InfoContext(ctx, "spot_fetcher: google places detail failed", "name", c.Name, "err", err)
+		return
+	}
+	c.OpeningHours = detail.OpeningHours
+	c.Closures = detail.Closures
+}
+
+// collectSpots は providers を順に呼び、query.Count 件に届いたところで止めます。
+// 都道府県・ジャンルを扱えない取得元は飛ばします。
+// 1つの取得元が失敗しても残りで埋められるよう、失敗はログに残して次へ進み、
+// 1件も取れずに失敗した取得元があったときだけエラーを返します。
+func collectSpots(ctx context.Context, providers []SpotProvider, query SpotQuery) ([]usecase.SpotCandidate, error) {
+	var spots []usecase.SpotCandidate
+	var errs []error
+	for _, p := range providers {
+		if len(spots) >= query.Count {
+			break
+		}
+		if !p.Covers(query.PrefCode, query.GenreID) {
+			continue
+		}
+
+		q := query
+		q.Count = query.Count - len(spots)
+		results, err := p.Search(ctx, q)
+		if err != nil {
+			slog.ErrorContext(ctx, "spot_fetcher: provider search failed", "provider", p.Name(), "err", err)
+			errs = append(errs, fmt.Errorf("%s search: %w", p.Name(), err))
+			continue
+		}
+		spots = append(spots, results...)
+	}
+
+	if len(spots) == 0 && len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	if len(spots) > query.Count {
+		spots = spots[:query.Count]
+	}
+	return spots, nil
+}
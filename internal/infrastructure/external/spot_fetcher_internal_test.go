@@ -0,0 +1,99 @@
+package external
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeProvider struct {
+	name    string
+	genreID int
+	spots   []usecase.SpotCandidate
+	err     error
+	queries []SpotQuery
+}
+
+func (p *fakeProvider) Name() string                 { return p.name }
+func (p *fakeProvider) Source() model.DateSpotSource { return model.DateSpotSource(p.name) }
+func (p *fakeProvider) Covers(_ string, genreID int) bool {
+	return p.genreID == 0 || p.genreID == genreID
+}
+
+func (p *fakeProvider) Search(_ context.Context, query SpotQuery) ([]usecase.SpotCandidate, error) {
+	p.queries = append(p.queries, query)
+	return p.spots, p.err
+}
+
+func candidates(names ...string) []usecase.SpotCandidate {
+	spots := make([]usecase.SpotCandidate, 0, len(names))
+	for _, n := range names {
+		spots = append(spots, usecase.SpotCandidate{Name: n})
+	}
+	return spots
+}
+
+func TestSpotProviderRegistry_Resolve(t *testing.T) {
+	hp := &fakeProvider{name: "hotpepper"}
+	gm := &fakeProvider{name: "gemini"}
+	r := NewSpotProviderRegistry(hp, gm)
+
+	t.Run("keeps_configured_order", func(t *testing.T) {
+		providers, err := r.Resolve([]string{"gemini", "hotpepper"})
+		require.NoError(t, err)
+		assert.Equal(t, []SpotProvider{gm, hp}, providers)
+	})
+
+	t.Run("unknown_name", func(t *testing.T) {
+		_, err := r.Resolve([]string{"hotpepper", "jalan"})
+		assert.ErrorContains(t, err, `"jalan"`)
+	})
+}
+
+func TestCollectSpots(t *testing.T) {
+	query := SpotQuery{PrefCode: "13", GenreID: 3, Count: 3}
+
+	t.Run("falls_through_until_count_is_filled", func(t *testing.T) {
+		first := &fakeProvider{name: "first", spots: candidates("a", "b")}
+		second := &fakeProvider{name: "second", spots: candidates("c", "d")}
+		third := &fakeProvider{name: "third", spots: candidates("e")}
+
+		spots, err := collectSpots(context.Background(), []SpotProvider{first, second, third}, query)
+		require.NoError(t, err)
+		assert.Equal(t, candidates("a", "b", "c"), spots)
+		require.Len(t, second.queries, 1)
+		assert.Equal(t, 1, second.queries[0].Count)
+		assert.Empty(t, third.queries)
+	})
+
+	t.Run("skips_providers_not_covering_genre", func(t *testing.T) {
+		other := &fakeProvider{name: "other", genreID: 5, spots: candidates("x")}
+		cafe := &fakeProvider{name: "cafe", genreID: 3, spots: candidates("a")}
+
+		spots, err := collectSpots(context.Background(), []SpotProvider{other, cafe}, query)
+		require.NoError(t, err)
+		assert.Equal(t, candidates("a"), spots)
+		assert.Empty(t, other.queries)
+	})
+
+	t.Run("failed_provider_is_skipped", func(t *testing.T) {
+		broken := &fakeProvider{name: "broken", err: errors.New("boom")}
+		ok := &fakeProvider{name: "ok", spots: candidates("a")}
+
+		spots, err := collectSpots(context.Background(), []SpotProvider{broken, ok}, query)
+		require.NoError(t, err)
+		assert.Equal(t, candidates("a"), spots)
+	})
+
+	t.Run("all_providers_failed", func(t *testing.T) {
+		broken := &fakeProvider{name: "broken", err: errors.New("boom")}
+
+		_, err := collectSpots(context.Background(), []SpotProvider{broken}, query)
+		assert.ErrorContains(t, err, "broken search: boom")
+	})
+}
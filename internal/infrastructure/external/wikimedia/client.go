@@ -8,6 +8,8 @@ import (
 	"net/http"
 	"net/url"
 	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/external/httpclient"
 )
 
 const apiURL = "https://ja.wikipedia.org/w/api.php"
@@ -16,9 +18,30 @@ type Client struct {
 	httpClient *http.Client
 }
 
-func NewClient() *Client {
-	return &Client{
-		httpClient: &http.Client{Timeout: 10 * time.Second},
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		httpClient: httpclient.NewTransport(httpclient.DefaultConfig()).Client(10 * time.Second),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Option は Client の設定を変えます。
+type Option func(*Client)
+
+// WithHTTPClient は Wikipedia API の呼び出しに使う HTTP クライアントを差し替えます。
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithTransport は Wikipedia API の呼び出しを通す http.RoundTripper を差し替えます。タイムアウトの10秒はそのまま残します。
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *Client) {
+		c.httpClient = &http.Client{Transport: rt, Timeout: c.httpClient.Timeout}
 	}
 }
 
@@ -58,6 +81,9 @@ func (c *Client) FetchImage(ctx context.Context, spotName string) (*string, erro
 	if err != nil {
 		return nil, fmt.Errorf("wikimedia: read body: %w", err)
 	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("wikimedia: status %d", resp.StatusCode)
+	}
 
 	var result queryResponse
 	if err := json.Unmarshal(body, &result); err != nil {
@@ -0,0 +1,49 @@
+package mail
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+)
+
+// fileMailer は送信せずに、メッセージを .eml ファイルとしてディレクトリに書き出す Mailer です。
+// ローカル開発で確認メールのリンクなどを開くために使います。
+type fileMailer struct {
+	dir  string
+	from string
+}
+
+func NewFileMailer(dir, from string) repository.Mailer {
+	return &fileMailer{dir: dir, from: from}
+}
+
+func (m *fileMailer) Send(ctx context.Context, msg repository.MailMessage) error {
+	now := time.Now()
+	data, err := buildMessage(m.from, msg, now)
+	if err != nil {
+		return err
+	}
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return err
+	}
+	// 送った順に並ぶよう、ファイル名は時刻から始める
+	path := filepath.Join(m.dir, now.Format("20060102-150405.000000")+"-"+hex.EncodeToString(suffix)+".eml")
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		slog.ErrorContext(ctx, "fileMailer.Send failed", "err", err)
+		return err
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		slog.ErrorContext(ctx, "fileMailer.Send failed", "err", err)
+		return err
+	}
+	// 本文には確認・再設定のトークンが入るため、ログには出さない。リンクは path のファイルで確かめる
+	slog.InfoContext(ctx, "fileMailer.Send succeeded", "path", path, "to", msg.To, "subject", msg.Subject)
+	return nil
+}
@@ -0,0 +1,78 @@
+package mail_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"log/slog"
+	"mime"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	mailinfra "github.com/daisuke-harada/date-courses-go/internal/infrastructure/mail"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileMailer(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success_writes_eml", func(t *testing.T) {
+		dir := t.TempDir()
+		mailer := mailinfra.NewFileMailer(dir, "DateCourses <no-reply@example.com>")
+		body := "以下のリンクを開いてください\nhttps://example.com/verify-email?token=abc"
+
+		err := mailer.Send(ctx, repository.MailMessage{To: "alice@example.com", Subject: "メールアドレスの確認", Body: body})
+		require.NoError(t, err)
+
+		files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		f, err := os.Open(files[0])
+		require.NoError(t, err)
+		defer f.Close()
+
+		msg, err := mail.ReadMessage(f)
+		require.NoError(t, err)
+		assert.Equal(t, "alice@example.com", msg.Header.Get("To"))
+		subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+		require.NoError(t, err)
+		assert.Equal(t, "メールアドレスの確認", subject)
+		decoded, err := io.ReadAll(base64.NewDecoder(base64.StdEncoding, msg.Body))
+		require.NoError(t, err)
+		assert.Equal(t, body, string(decoded))
+	})
+
+	// 本文のリンクにはトークンが入るため、ログに残さない
+	t.Run("success_does_not_log_body", func(t *testing.T) {
+		var logs bytes.Buffer
+		prev := slog.Default()
+		slog.SetDefault(slog.New(slog.NewJSONHandler(&logs, nil)))
+		t.Cleanup(func() { slog.SetDefault(prev) })
+
+		mailer := mailinfra.NewFileMailer(t.TempDir(), "DateCourses <no-reply@example.com>")
+		err := mailer.Send(ctx, repository.MailMessage{To: "alice@example.com", Subject: "パスワードの再設定", Body: "https://example.com/reset-password?token=secret-token"})
+
+		require.NoError(t, err)
+		assert.Contains(t, logs.String(), "alice@example.com")
+		assert.NotContains(t, logs.String(), "secret-token")
+	})
+
+	t.Run("error_invalid_headers", func(t *testing.T) {
+		dir := t.TempDir()
+		mailer := mailinfra.NewFileMailer(dir, "DateCourses <no-reply@example.com>")
+
+		// 改行を含む件名でヘッダーを書き足せないこと
+		err := mailer.Send(ctx, repository.MailMessage{To: "alice@example.com", Subject: "hi\r\nBcc: evil@example.com", Body: "x"})
+		assert.Error(t, err)
+		err = mailer.Send(ctx, repository.MailMessage{To: "not an address", Subject: "hi", Body: "x"})
+		assert.Error(t, err)
+
+		files, _ := filepath.Glob(filepath.Join(dir, "*.eml"))
+		assert.Empty(t, files)
+	})
+}
@@ -0,0 +1,66 @@
+// Package mail は repository.Mailer の実装です。
+//
+// 本番では SMTP サーバー経由で送信し、ローカル開発では送信せずにファイルへ書き出します。
+// どちらも同じ形式（RFC 5322 のメッセージ）を組み立てるため、書き出したファイルはそのままメールソフトで開けます。
+package mail
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+)
+
+// bodyLineLength は base64 にした本文を折り返す長さです（RFC 2045 の上限 76 文字）。
+const bodyLineLength = 76
+
+// buildMessage は msg を送信できる形式のメッセージにします。
+// 件名と本文は日本語を含むため、件名は MIME エンコードし、本文は base64 で送ります。
+func buildMessage(from string, msg repository.MailMessage, now time.Time) ([]byte, error) {
+	if _, err := mail.ParseAddress(from); err != nil {
+		return nil, fmt.Errorf("mail: invalid from address %q: %w", from, err)
+	}
+	if _, err := mail.ParseAddress(msg.To); err != nil {
+		return nil, fmt.Errorf("mail: invalid to address %q: %w", msg.To, err)
+	}
+	// 改行を含む値はヘッダーを書き足せてしまうため受け付けない
+	for _, v := range []string{from, msg.To, msg.Subject} {
+		if strings.ContainsAny(v, "\r\n") {
+			return nil, fmt.Errorf("mail: header contains newline")
+		}
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("UTF-8", msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: base64\r\n")
+	b.WriteString("\r\n")
+
+	encoded := base64.StdEncoding.EncodeToString([]byte(msg.Body))
+	for len(encoded) > bodyLineLength {
+		b.WriteString(encoded[:bodyLineLength])
+		b.WriteString("\r\n")
+		encoded = encoded[bodyLineLength:]
+	}
+	b.WriteString(encoded)
+	b.WriteString("\r\n")
+	return b.Bytes(), nil
+}
+
+// envelopeAddress は "名前 <addr>" 形式の address から SMTP のエンベロープに使うアドレスだけを取り出します。
+func envelopeAddress(address string) (string, error) {
+	a, err := mail.ParseAddress(address)
+	if err != nil {
+		return "", err
+	}
+	return a.Address, nil
+}
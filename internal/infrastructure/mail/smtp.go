@@ -0,0 +1,59 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+)
+
+// SMTPConfig は SMTP サーバーの接続情報です。
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+// smtpMailer は SMTP サーバー経由で送信する Mailer です。
+// サーバーが STARTTLS に対応していれば暗号化してから認証します。
+type smtpMailer struct {
+	cfg SMTPConfig
+}
+
+func NewSMTPMailer(cfg SMTPConfig) repository.Mailer {
+	return &smtpMailer{cfg: cfg}
+}
+
+func (m *smtpMailer) Send(ctx context.Context, msg repository.MailMessage) error {
+	data, err := buildMessage(m.cfg.From, msg, time.Now())
+	if err != nil {
+		return err
+	}
+	from, err := envelopeAddress(m.cfg.From)
+	if err != nil {
+		return err
+	}
+	to, err := envelopeAddress(msg.To)
+	if err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if m.cfg.Username != "" {
+		auth = smtp.PlainAuth("", m.cfg.Username, m.cfg.Password, m.cfg.Host)
+	}
+	addr := net.JoinHostPort(m.cfg.Host, strconv.Itoa(m.cfg.Port))
+	if err := smtp.SendMail(addr, auth, from, []string{to}, data); err != nil {
+		slog.ErrorContext(ctx, "smtpMailer.Send failed", "err", err, "subject", msg.Subject)
+		return fmt.Errorf("mail: send via %s: %w", addr, err)
+	}
+	slog.InfoContext(ctx, "smtpMailer.Send succeeded", "subject", msg.Subject)
+	return nil
+}
@@ -0,0 +1,75 @@
+package persistence
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type batchRunRepository struct {
+	db *gorm.DB
+}
+
+func NewBatchRunRepository(db *gorm.DB) repository.BatchRunRepository {
+	return &batchRunRepository{db: db}
+}
+
+func (r *batchRunRepository) CreateRun(ctx context.Context, run *model.BatchRun) error {
+	if err := dbFromContext(ctx, r.db).Create(run).Error; err != nil {
+		slog.ErrorContext(ctx, "batchRunRepository.CreateRun failed", "err", err)
+		return apperror.InternalServerError(err)
+	}
+	return nil
+}
+
+func (r *batchRunRepository) FinishRun(ctx context.Context, run *model.BatchRun) error {
+	if err := dbFromContext(ctx, r.db).
+		Model(run).
+		Select("status", "finished_at", "task_count", "succeeded_count", "skipped_count", "failed_count",
+			"created_count", "merged_count", "pending_count").
+		Updates(run).Error; err != nil {
+		slog.ErrorContext(ctx, "batchRunRepository.FinishRun failed", "err", err, "id", run.ID)
+		return apperror.InternalServerError(err)
+	}
+	return nil
+}
+
+func (r *batchRunRepository) CreateTask(ctx context.Context, task *model.BatchTask) error {
+	if err := dbFromContext(ctx, r.db).Create(task).Error; err != nil {
+		slog.ErrorContext(ctx, "batchRunRepository.CreateTask failed", "err", err, "run_id", task.RunID)
+		return apperror.InternalServerError(err)
+	}
+	return nil
+}
+
+func (r *batchRunRepository) FindLastCompletedTasks(ctx context.Context) ([]*model.BatchTask, error) {
+	var tasks []*model.BatchTask
+	if err := dbFromContext(ctx, r.db).
+		Model(&model.BatchTask{}).
+		Select("prefecture_id, genre_id, MAX(finished_at) AS finished_at").
+		Where("status IN ?", model.CompletedBatchTaskStatuses).
+		Group("prefecture_id, genre_id").
+		Find(&tasks).Error; err != nil {
+		slog.ErrorContext(ctx, "batchRunRepository.FindLastCompletedTasks failed", "err", err)
+		return nil, apperror.InternalServerError(err)
+	}
+	return tasks, nil
+}
+
+func (r *batchRunRepository) FindLastFailedTasks(ctx context.Context) ([]*model.BatchTask, error) {
+	var tasks []*model.BatchTask
+	if err := dbFromContext(ctx, r.db).
+		Model(&model.BatchTask{}).
+		Select("prefecture_id, genre_id, MAX(finished_at) AS finished_at").
+		Where("status = ?", model.BatchTaskStatusFailed).
+		Group("prefecture_id, genre_id").
+		Find(&tasks).Error; err != nil {
+		slog.ErrorContext(ctx, "batchRunRepository.FindLastFailedTasks failed", "err", err)
+		return nil, apperror.InternalServerError(err)
+	}
+	return tasks, nil
+}
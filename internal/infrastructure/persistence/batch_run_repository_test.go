@@ -0,0 +1,38 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/persistence"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchRunRepository_FindLastCompletedTasks(t *testing.T) {
+	// 失敗した組み合わせは数えず、成功・スキップの最後の終了日時を組み合わせごとに引く
+	t.Run("groups_completed_tasks_by_combination", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewBatchRunRepository(db)
+
+		_, _ = repo.FindLastCompletedTasks(context.Background())
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "MAX(finished_at) AS finished_at")
+		assert.Contains(t, sql, "WHERE status IN (?,?)")
+		assert.Contains(t, sql, "GROUP BY prefecture_id, genre_id")
+	})
+}
+
+func TestBatchRunRepository_FindLastFailedTasks(t *testing.T) {
+	t.Run("groups_failed_tasks_by_combination", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewBatchRunRepository(db)
+
+		_, _ = repo.FindLastFailedTasks(context.Background())
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "MAX(finished_at) AS finished_at")
+		assert.Contains(t, sql, "WHERE status = ?")
+		assert.Contains(t, sql, "GROUP BY prefecture_id, genre_id")
+	})
+}
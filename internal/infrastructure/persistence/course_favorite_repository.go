@@ -0,0 +1,116 @@
+package persistence
+
+import (
+	"context"
+	"log/slog"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type courseFavoriteRepository struct {
+	db *gorm.DB
+}
+
+func NewCourseFavoriteRepository(db *gorm.DB) repository.CourseFavoriteRepository {
+	return &courseFavoriteRepository{db: db}
+}
+
+// Create はお気に入りを保存します。
+// ボタンの連打や再送で同じ組み合わせが来ても、一意制約に当たった行は無視してエラーにしません。
+// そのときは書き込んだ行がないため false を返します。
+func (r *courseFavoriteRepository) Create(ctx context.Context, favorite *model.CourseFavorite) (bool, error) {
+	result := dbFromContext(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(favorite)
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "courseFavoriteRepository.Create failed", "err", result.Error)
+		return false, result.Error
+	}
+	slog.InfoContext(ctx, "courseFavoriteRepository.Create succeeded", "user_id", favorite.UserID, "course_id", favorite.CourseID)
+	return result.RowsAffected > 0, nil
+}
+
+func (r *courseFavoriteRepository) DeleteByUserIDAndCourseID(ctx context.Context, userID, courseID uint) error {
+	if err := dbFromContext(ctx, r.db).
+		Where("user_id = ? AND course_id = ?", userID, courseID).
+		Delete(&model.CourseFavorite{}).Error; err != nil {
+		slog.ErrorContext(ctx, "courseFavoriteRepository.DeleteByUserIDAndCourseID failed", "err", err)
+		return err
+	}
+	return nil
+}
+
+// courseFavoriteOrder はお気に入り一覧の並び順です。お気に入りに入れた日時の新しい順だけに対応します。
+var courseFavoriteOrder = keysetOrder{key: "course_favorites.created_at", id: "course_favorites.id", desc: true}
+
+// SearchCoursesByUserID はお気に入りの行を1ページ分読み、コースを立ち寄り先ごと読み込んで返します。
+// カーソルはコースではなくお気に入りの日時と ID を指します。
+func (r *courseFavoriteRepository) SearchCoursesByUserID(ctx context.Context, params repository.CourseFavoriteSearchParams) (pagination.Page[*model.Course], error) {
+	db := dbFromContext(ctx, r.db).
+		Model(&model.CourseFavorite{}).
+		Joins("JOIN courses ON courses.id = course_favorites.course_id").
+		Where("course_favorites.user_id = ?", params.UserID).
+		Where("courses.authority = ?", model.CourseAuthorityPublic).
+		Preload("Course.User").
+		Preload("Course.DuringSpots", orderDuringSpots).
+		Preload("Course.DuringSpots.DateSpot")
+	db = courseFavoriteOrder.paginate(db, params.Page)
+
+	var favorites []*model.CourseFavorite
+	if err := db.Find(&favorites).Error; err != nil {
+		slog.ErrorContext(ctx, "courseFavoriteRepository.SearchCoursesByUserID failed", "err", err)
+		return pagination.Page[*model.Course]{}, err
+	}
+
+	page := pagination.NewPage(favorites, params.Page, func(f *model.CourseFavorite) pagination.Cursor {
+		return pagination.NewTimeCursor(pagination.SortNewest, f.CreatedAt, f.ID)
+	})
+	return pagination.Page[*model.Course]{
+		Items: lo.Map(page.Items, func(f *model.CourseFavorite, _ int) *model.Course {
+			return f.Course
+		}),
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+// courseFavoriteCount は SummarizeByCourseIDs の集計結果の1行です。
+type courseFavoriteCount struct {
+	CourseID          uint
+	Count             int
+	FavoritedByViewer bool
+}
+
+// SummarizeByCourseIDs は件数と閲覧者のお気に入り有無を、コース数によらず1回のクエリで集計します。
+func (r *courseFavoriteRepository) SummarizeByCourseIDs(ctx context.Context, courseIDs []uint, viewerID uint) (map[uint]*model.CourseFavoriteSummary, error) {
+	result := make(map[uint]*model.CourseFavoriteSummary, len(courseIDs))
+	for _, id := range courseIDs {
+		result[id] = &model.CourseFavoriteSummary{}
+	}
+	if len(courseIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []courseFavoriteCount
+	if err := dbFromContext(ctx, r.db).
+		Model(&model.CourseFavorite{}).
+		Select("course_id, COUNT(*) AS count, MAX(CASE WHEN user_id = ? THEN 1 ELSE 0 END) = 1 AS favorited_by_viewer", viewerID).
+		Where("course_id IN ?", courseIDs).
+		Group("course_id").
+		Find(&rows).Error; err != nil {
+		slog.ErrorContext(ctx, "courseFavoriteRepository.SummarizeByCourseIDs failed", "err", err)
+		return nil, err
+	}
+
+	for _, row := range rows {
+		result[row.CourseID] = &model.CourseFavoriteSummary{
+			Count:             row.Count,
+			FavoritedByViewer: row.FavoritedByViewer,
+		}
+	}
+	return result, nil
+}
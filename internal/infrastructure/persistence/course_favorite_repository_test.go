@@ -0,0 +1,91 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/persistence"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCourseFavoriteRepository_SearchCoursesByUserID(t *testing.T) {
+	ctx := context.Background()
+
+	// お気に入りに入れた後で非公開になったコースは出さない
+	t.Run("returns_only_public_courses_in_favorited_order", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewCourseFavoriteRepository(db)
+
+		_, _ = repo.SearchCoursesByUserID(ctx, repository.CourseFavoriteSearchParams{
+			UserID: 1,
+			Page:   pagination.Params{Limit: 20},
+		})
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "JOIN courses ON courses.id = course_favorites.course_id")
+		assert.Contains(t, sql, "course_favorites.user_id = ?")
+		assert.Contains(t, sql, "courses.authority = ?")
+		assert.Contains(t, sql, "ORDER BY course_favorites.created_at DESC,course_favorites.id DESC")
+	})
+
+	// カーソルはコースの作成日時ではなく、お気に入りに入れた日時を指す
+	t.Run("cursor_pages_by_favorited_time", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewCourseFavoriteRepository(db)
+		cursor := pagination.NewTimeCursor(pagination.SortNewest, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), 3)
+
+		_, _ = repo.SearchCoursesByUserID(ctx, repository.CourseFavoriteSearchParams{
+			UserID: 1,
+			Page:   pagination.Params{Limit: 20, Cursor: &cursor},
+		})
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "(course_favorites.created_at < ? OR (course_favorites.created_at = ? AND course_favorites.id < ?))")
+	})
+}
+
+func TestCourseFavoriteRepository_SummarizeByCourseIDs(t *testing.T) {
+	ctx := context.Background()
+
+	// コース数によらず1回の集計クエリで済ませる
+	t.Run("aggregates_in_single_query", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewCourseFavoriteRepository(db)
+
+		_, _ = repo.SummarizeByCourseIDs(ctx, []uint{1, 2, 3}, 9)
+
+		require.Len(t, *captured, 1)
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "COUNT(*) AS count")
+		assert.Contains(t, sql, "course_id IN (?,?,?)")
+		assert.Contains(t, sql, "GROUP BY `course_id`")
+	})
+
+	// お気に入りが無いコースも件数 0 として返し、呼び出し側で nil を扱わなくてよいようにする
+	t.Run("fills_zero_for_courses_without_favorites", func(t *testing.T) {
+		db, _ := newDryRunDB(t)
+		repo := persistence.NewCourseFavoriteRepository(db)
+
+		got, err := repo.SummarizeByCourseIDs(ctx, []uint{1, 2}, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, &model.CourseFavoriteSummary{}, got[1])
+		assert.Equal(t, &model.CourseFavoriteSummary{}, got[2])
+	})
+
+	t.Run("skips_query_for_no_courses", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewCourseFavoriteRepository(db)
+
+		got, err := repo.SummarizeByCourseIDs(ctx, nil, 1)
+
+		require.NoError(t, err)
+		assert.Empty(t, got)
+		assert.Empty(t, *captured)
+	})
+}
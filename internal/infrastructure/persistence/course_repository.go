@@ -2,11 +2,13 @@ package persistence
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
 	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 	"gorm.io/gorm"
 )
 
@@ -27,8 +29,22 @@ func visibleToViewer(viewerID uint) func(*gorm.DB) *gorm.DB {
 	}
 }
 
+// preloadDuringSpots はコースの立ち寄り先を訪問順に並べて読み込む GORM スコープです。
+// 順番を指定しないと DB の返す行順になり、タイムライン表示で立ち寄り先が入れ替わってしまう。
+// 同じ position が並んだ場合（position 導入前のデータなど）は登録順で並べる。
+func preloadDuringSpots(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("DuringSpots", orderDuringSpots).
+		Preload("DuringSpots.DateSpot")
+}
+
+// orderDuringSpots は立ち寄り先を訪問順に並べます。コースを別のモデル経由で読み込むときも同じ順にするため分けています。
+func orderDuringSpots(db *gorm.DB) *gorm.DB {
+	return db.Order("during_spots.position ASC, during_spots.id ASC")
+}
+
 func (r *courseRepository) Create(ctx context.Context, course *model.Course) error {
-	if err := r.db.WithContext(ctx).Create(course).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Create(course).Error; err != nil {
 		slog.ErrorContext(ctx, "courseRepository.Create failed", "err", err)
 		return err
 	}
@@ -45,11 +61,11 @@ func (r *courseRepository) FindPublicByUserIDs(ctx context.Context, userIDs []ui
 	}
 
 	var courses []*model.Course
-	if err := r.db.WithContext(ctx).
+	if err := dbFromContext(ctx, r.db).
 		Where("courses.user_id IN ?", userIDs).
 		Where("courses.authority = ?", model.CourseAuthorityPublic).
 		Preload("User").
-		Preload("DuringSpots.DateSpot").
+		Scopes(preloadDuringSpots).
 		Find(&courses).Error; err != nil {
 		slog.ErrorContext(ctx, "courseRepository.FindPublicByUserIDs failed", "err", err)
 		return nil, err
@@ -65,10 +81,10 @@ func (r *courseRepository) FindPublicByUserIDs(ctx context.Context, userIDs []ui
 // 本人がマイページを開いたときだけ使います。
 func (r *courseRepository) FindAllByUserID(ctx context.Context, userID uint) ([]*model.Course, error) {
 	var courses []*model.Course
-	if err := r.db.WithContext(ctx).
+	if err := dbFromContext(ctx, r.db).
 		Where("courses.user_id = ?", userID).
 		Preload("User").
-		Preload("DuringSpots.DateSpot").
+		Scopes(preloadDuringSpots).
 		Find(&courses).Error; err != nil {
 		slog.ErrorContext(ctx, "courseRepository.FindAllByUserID failed", "err", err)
 		return nil, err
@@ -76,38 +92,105 @@ func (r *courseRepository) FindAllByUserID(ctx context.Context, userID uint) ([]
 	return courses, nil
 }
 
+// FindOwnerIDsByDateSpotID は dateSpotID を立ち寄り先に含むコースの作成者を、重複なく返します。
+func (r *courseRepository) FindOwnerIDsByDateSpotID(ctx context.Context, dateSpotID uint) ([]uint, error) {
+	var userIDs []uint
+	if err := dbFromContext(ctx, r.db).
+		Model(&model.Course{}).
+		Distinct("courses.user_id").
+		Joins("JOIN during_spots ON during_spots.course_id = courses.id").
+		Where("during_spots.date_spot_id = ?", dateSpotID).
+		Pluck("courses.user_id", &userIDs).Error; err != nil {
+		slog.ErrorContext(ctx, "courseRepository.FindOwnerIDsByDateSpotID failed", "err", err)
+		return nil, err
+	}
+	return userIDs, nil
+}
+
+// courseOrders はコース一覧の並び順ごとの並び替えキーです。
+// 評価・レビュー数は SELECT で求めた値なので HAVING で比較します。名前は作成者の名前で並べます。
+var courseOrders = map[pagination.Sort]keysetOrder{
+	pagination.SortNewest:      {key: "courses.created_at", id: "courses.id", desc: true},
+	pagination.SortRating:      {key: "average_rate", id: "courses.id", desc: true, aggregate: true},
+	pagination.SortReviewCount: {key: "review_total_number", id: "courses.id", desc: true, aggregate: true},
+	pagination.SortName:        {key: "users.name", id: "courses.id"},
+}
+
+// courseReviewsSQL は立ち寄り先の公開中レビューを集計する相関サブクエリです。%s に集計式が入ります。
+const courseReviewsSQL = `(SELECT %s FROM date_spot_reviews
+	WHERE date_spot_reviews.status IN ?
+	AND date_spot_reviews.date_spot_id IN (SELECT during_spots.date_spot_id FROM during_spots WHERE during_spots.course_id = courses.id))`
+
+// courseCursor は c の位置を指すカーソルを作ります。
+func courseCursor(sort pagination.Sort) func(*model.Course) pagination.Cursor {
+	return func(c *model.Course) pagination.Cursor {
+		switch sort {
+		case pagination.SortRating:
+			return pagination.NewFloatCursor(sort, c.AverageRate, c.ID)
+		case pagination.SortReviewCount:
+			return pagination.NewIntCursor(sort, c.ReviewTotalNumber, c.ID)
+		case pagination.SortName:
+			var name string
+			if c.User != nil {
+				name = c.User.Name
+			}
+			return pagination.NewStringCursor(sort, name, c.ID)
+		default:
+			return pagination.NewTimeCursor(sort, c.CreatedAt, c.ID)
+		}
+	}
+}
+
 // Search はフィルタ条件に基づいてコース一覧を返します。
 // デートコース一覧には公開コースだけを載せます。作成者本人であっても
 // 自分の非公開コースはここには出さず、マイページからのみ辿れるようにしています。
-func (r *courseRepository) Search(ctx context.Context, params repository.CourseSearchParams) ([]*model.Course, error) {
+func (r *courseRepository) Search(ctx context.Context, params repository.CourseSearchParams) (pagination.Page[*model.Course], error) {
 	var courses []*model.Course
-	db := r.db.WithContext(ctx).
+	db := dbFromContext(ctx, r.db).
+		Model(&model.Course{}).
 		Where("courses.authority = ?", model.CourseAuthorityPublic).
+		Scopes(excludeHiddenUsers("courses.user_id", params.ViewerID)).
 		Preload("User").
-		Preload("DuringSpots.DateSpot")
+		Scopes(preloadDuringSpots)
 
 	if params.PrefectureID != nil {
-		db = db.Joins("JOIN during_spots ON during_spots.course_id = courses.id").
-			Joins("JOIN date_spots ON date_spots.id = during_spots.date_spot_id").
-			Where("date_spots.prefecture_id = ?", *params.PrefectureID).
-			Distinct("courses.*")
+		db = db.Where(`EXISTS (SELECT 1 FROM during_spots
+			JOIN date_spots ON date_spots.id = during_spots.date_spot_id
+			WHERE during_spots.course_id = courses.id AND date_spots.prefecture_id = ?)`, *params.PrefectureID)
+	}
+
+	order, ok := courseOrders[params.Sort]
+	if !ok {
+		params.Sort = pagination.SortNewest
+		order = courseOrders[params.Sort]
+	}
+	switch params.Sort {
+	case pagination.SortRating, pagination.SortReviewCount:
+		db = db.Select("courses.*, "+
+			fmt.Sprintf(courseReviewsSQL, "COALESCE(AVG(date_spot_reviews.rate), 0)")+" AS average_rate, "+
+			fmt.Sprintf(courseReviewsSQL, "COUNT(*)")+" AS review_total_number",
+			model.VisibleReviewStatuses, model.VisibleReviewStatuses).
+			Group("courses.id")
+	case pagination.SortName:
+		db = db.Select("courses.*").Joins("JOIN users ON users.id = courses.user_id")
 	}
+	db = order.paginate(db, params.Page)
 
 	if err := db.Find(&courses).Error; err != nil {
 		slog.ErrorContext(ctx, "courseRepository.Search failed", "err", err)
-		return nil, apperror.InternalServerError(err)
+		return pagination.Page[*model.Course]{}, apperror.InternalServerError(err)
 	}
-	return courses, nil
+	return pagination.NewPage(courses, params.Page, courseCursor(params.Sort)), nil
 }
 
 // FindByID は指定IDのコースを返します。
 // 他人の非公開コースは存在を隠すため、見つからなかった場合と同じ扱いになります。
 func (r *courseRepository) FindByID(ctx context.Context, id, viewerID uint) (*model.Course, error) {
 	var course model.Course
-	if err := r.db.WithContext(ctx).
+	if err := dbFromContext(ctx, r.db).
 		Scopes(visibleToViewer(viewerID)).
 		Preload("User").
-		Preload("DuringSpots.DateSpot").
+		Scopes(preloadDuringSpots).
 		First(&course, id).Error; err != nil {
 		slog.ErrorContext(ctx, "courseRepository.FindByID failed", "err", err)
 		return nil, err
@@ -115,13 +198,47 @@ func (r *courseRepository) FindByID(ctx context.Context, id, viewerID uint) (*mo
 	return &course, nil
 }
 
-// DeleteByID は指定IDのコースを、紐づく during_spots ごと削除します。
-// during_spots はコースに従属するレコードで、外部キー制約があるため
+// Update はコースの移動手段・公開設定を更新し、立ち寄り先を duringSpots で置き換えます。
+// 既存の during_spots を個別に突き合わせるより、全件消して入れ直すほうが
+// 並び替え・追加・削除を一様に扱える。途中で失敗して立ち寄り先が消えたままに
+// ならないよう、更新・削除・登録はトランザクションにまとめる。
+func (r *courseRepository) Update(ctx context.Context, course *model.Course, duringSpots []*model.DuringSpot) error {
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		return updateCourse(tx, course, duringSpots)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "courseRepository.Update failed", "err", err)
+		return err
+	}
+	slog.InfoContext(ctx, "courseRepository.Update succeeded", "course_id", course.ID)
+	return nil
+}
+
+// updateCourse はコースの更新、during_spots の削除・再登録をこの順で行います。
+// 呼び出し側がトランザクションを張る前提のため、db にはその tx を渡します。
+func updateCourse(db *gorm.DB, course *model.Course, duringSpots []*model.DuringSpot) error {
+	if err := db.Model(&model.Course{}).Where("id = ?", course.ID).Updates(map[string]any{
+		"travel_mode": course.TravelMode,
+		"authority":   course.Authority,
+	}).Error; err != nil {
+		return err
+	}
+	if err := db.Where("course_id = ?", course.ID).Delete(&model.DuringSpot{}).Error; err != nil {
+		return err
+	}
+	if len(duringSpots) == 0 {
+		return nil
+	}
+	return db.Create(&duringSpots).Error
+}
+
+// DeleteByID は指定IDのコースを、紐づく during_spots・お気に入りごと削除します。
+// どちらもコースに従属するレコードで、外部キー制約があるため
 // 先に消さないと親のコースを削除できない。
-// 途中で失敗して during_spots だけが消えた状態にならないよう、
-// 2つの削除はトランザクションにまとめる。
+// 途中で失敗して子レコードだけが消えた状態にならないよう、
+// 削除はトランザクションにまとめる。
 func (r *courseRepository) DeleteByID(ctx context.Context, id uint) error {
-	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		return deleteCourse(tx, id)
 	})
 	if err != nil {
@@ -132,11 +249,14 @@ func (r *courseRepository) DeleteByID(ctx context.Context, id uint) error {
 	return nil
 }
 
-// deleteCourse は during_spots とコースをこの順で削除します。
+// deleteCourse は during_spots・お気に入り・コースをこの順で削除します。
 // 呼び出し側がトランザクションを張る前提のため、db にはその tx を渡します。
 func deleteCourse(db *gorm.DB, id uint) error {
 	if err := db.Where("course_id = ?", id).Delete(&model.DuringSpot{}).Error; err != nil {
 		return err
 	}
+	if err := db.Where("course_id = ?", id).Delete(&model.CourseFavorite{}).Error; err != nil {
+		return err
+	}
 	return db.Delete(&model.Course{}, id).Error
 }
@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gorm.io/driver/mysql"
@@ -39,19 +40,59 @@ func newDryRunDBForDelete(t *testing.T) (*gorm.DB, *[]string) {
 }
 
 func TestDeleteCourse(t *testing.T) {
-	// during_spots を先に消さないと外部キー制約で親を削除できない
-	t.Run("deletes_during_spots_before_course", func(t *testing.T) {
+	// during_spots・お気に入りを先に消さないと外部キー制約で親を削除できない
+	t.Run("deletes_children_before_course", func(t *testing.T) {
 		db, captured := newDryRunDBForDelete(t)
 
 		_ = deleteCourse(db, 1)
 
-		require.Equal(t, 2, len(*captured), "during_spots とコースの2回の DELETE が必要")
+		require.Equal(t, 3, len(*captured), "during_spots・お気に入り・コースの3回の DELETE が必要")
 		assert.Contains(t, (*captured)[0], "DELETE FROM `during_spots`")
 		assert.Contains(t, (*captured)[0], "course_id = ?")
-		assert.Contains(t, (*captured)[1], "DELETE FROM `courses`")
+		assert.Contains(t, (*captured)[1], "DELETE FROM `course_favorites`")
+		assert.Contains(t, (*captured)[1], "course_id = ?")
+		assert.Contains(t, (*captured)[2], "DELETE FROM `courses`")
 		assert.True(t,
 			strings.Index(issued(captured), "during_spots") < strings.Index(issued(captured), "FROM `courses`"),
 			"during_spots の削除が先であること")
+		assert.True(t,
+			strings.Index(issued(captured), "course_favorites") < strings.Index(issued(captured), "FROM `courses`"),
+			"お気に入りの削除が先であること")
+	})
+}
+
+// newDryRunDBForUpdate は updateCourse が発行する UPDATE / DELETE / INSERT を順に記録します。
+func newDryRunDBForUpdate(t *testing.T) (*gorm.DB, *[]string) {
+	t.Helper()
+
+	db, captured := newDryRunDBForDelete(t)
+	require.NoError(t, db.Callback().Update().After("gorm:update").Register("test:capture_update", func(d *gorm.DB) {
+		*captured = append(*captured, d.Statement.SQL.String())
+	}))
+	require.NoError(t, db.Callback().Create().After("gorm:create").Register("test:capture_create", func(d *gorm.DB) {
+		*captured = append(*captured, d.Statement.SQL.String())
+	}))
+	return db, captured
+}
+
+func TestUpdateCourse(t *testing.T) {
+	// 立ち寄り先は全件消して入れ直すため、UPDATE → DELETE → INSERT の順になる
+	t.Run("replaces_during_spots_after_updating_course", func(t *testing.T) {
+		db, captured := newDryRunDBForUpdate(t)
+
+		_ = updateCourse(db, &model.Course{ID: 1, TravelMode: "WALKING", Authority: model.CourseAuthorityPrivate}, []*model.DuringSpot{
+			{CourseID: 1, DateSpotID: 3, Position: 1},
+			{CourseID: 1, DateSpotID: 2, Position: 2},
+		})
+
+		require.Equal(t, 3, len(*captured))
+		assert.Contains(t, (*captured)[0], "UPDATE `courses`")
+		assert.Contains(t, (*captured)[0], "`travel_mode`")
+		assert.Contains(t, (*captured)[0], "`authority`")
+		assert.Contains(t, (*captured)[1], "DELETE FROM `during_spots`")
+		assert.Contains(t, (*captured)[1], "course_id = ?")
+		assert.Contains(t, (*captured)[2], "INSERT INTO `during_spots`")
+		assert.Contains(t, (*captured)[2], "`position`")
 	})
 }
 
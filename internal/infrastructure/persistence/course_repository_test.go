@@ -4,9 +4,11 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
 	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/persistence"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gorm.io/driver/mysql"
@@ -36,6 +38,7 @@ func newDryRunDB(t *testing.T) (*gorm.DB, *[]string) {
 	}
 	require.NoError(t, db.Callback().Query().After("gorm:query").Register("test:capture_query", capture))
 	require.NoError(t, db.Callback().Delete().After("gorm:delete").Register("test:capture_delete", capture))
+	require.NoError(t, db.Callback().Raw().After("gorm:raw").Register("test:capture_raw", capture))
 
 	return db, &captured
 }
@@ -59,6 +62,18 @@ func TestCourseRepository_Search(t *testing.T) {
 		assert.NotContains(t, issuedSQL(captured), "user_id")
 	})
 
+	// ブロックした・された相手とミュートした相手のコースは出さない
+	t.Run("excludes_courses_of_users_hidden_from_viewer", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewCourseRepository(db)
+
+		_, _ = repo.Search(ctx, repository.CourseSearchParams{ViewerID: 9})
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "courses.user_id NOT IN (SELECT blocked_user_id FROM user_blocks WHERE user_id = ?")
+		assert.Contains(t, sql, "SELECT muted_user_id FROM user_mutes WHERE user_id = ?")
+	})
+
 	t.Run("keeps_public_filter_with_prefecture_id", func(t *testing.T) {
 		db, captured := newDryRunDB(t)
 		repo := persistence.NewCourseRepository(db)
@@ -69,6 +84,56 @@ func TestCourseRepository_Search(t *testing.T) {
 		assert.Contains(t, issuedSQL(captured), "courses.authority = ?")
 		assert.Contains(t, issuedSQL(captured), "date_spots.prefecture_id = ?")
 	})
+
+	t.Run("pages_by_newest_with_cursor", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewCourseRepository(db)
+		cursor := pagination.NewTimeCursor(pagination.SortNewest, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), 7)
+
+		_, _ = repo.Search(ctx, repository.CourseSearchParams{
+			Sort: pagination.SortNewest,
+			Page: pagination.Params{Limit: 10, Cursor: &cursor},
+		})
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "(courses.created_at < ? OR (courses.created_at = ? AND courses.id < ?))")
+		assert.Contains(t, sql, "ORDER BY courses.created_at DESC,courses.id DESC")
+		assert.Contains(t, sql, "LIMIT ?")
+	})
+
+	// 評価は立ち寄り先の公開中レビューから求めるため、カーソル条件は HAVING に置く
+	t.Run("pages_by_rating_with_cursor", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewCourseRepository(db)
+		cursor := pagination.NewFloatCursor(pagination.SortRating, 4.5, 7)
+
+		_, _ = repo.Search(ctx, repository.CourseSearchParams{
+			Sort: pagination.SortRating,
+			Page: pagination.Params{Limit: 10, Cursor: &cursor},
+		})
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "COALESCE(AVG(date_spot_reviews.rate), 0)")
+		assert.Contains(t, sql, "during_spots.course_id = courses.id")
+		assert.Contains(t, sql, "HAVING (average_rate < ? OR (average_rate = ? AND courses.id < ?))")
+		assert.Contains(t, sql, "ORDER BY average_rate DESC,courses.id DESC")
+	})
+
+	t.Run("pages_by_author_name_with_cursor", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewCourseRepository(db)
+		cursor := pagination.NewStringCursor(pagination.SortName, "hanako", 7)
+
+		_, _ = repo.Search(ctx, repository.CourseSearchParams{
+			Sort: pagination.SortName,
+			Page: pagination.Params{Limit: 10, Cursor: &cursor},
+		})
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "JOIN users ON users.id = courses.user_id")
+		assert.Contains(t, sql, "(users.name > ? OR (users.name = ? AND courses.id > ?))")
+		assert.Contains(t, sql, "ORDER BY users.name ASC,courses.id ASC")
+	})
 }
 
 func TestCourseRepository_FindByID(t *testing.T) {
@@ -122,3 +187,19 @@ func TestCourseRepository_FindByUserID(t *testing.T) {
 		assert.NotContains(t, issuedSQL(captured), "authority")
 	})
 }
+
+func TestCourseRepository_FindOwnerIDsByDateSpotID(t *testing.T) {
+	// 同じユーザーがそのスポットを含むコースを複数持っていても、通知は1人1件にする
+	t.Run("returns_distinct_owners_including_private_courses", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewCourseRepository(db)
+
+		_, _ = repo.FindOwnerIDsByDateSpotID(context.Background(), 3)
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "SELECT DISTINCT courses.user_id")
+		assert.Contains(t, sql, "JOIN during_spots ON during_spots.course_id = courses.id")
+		assert.Contains(t, sql, "during_spots.date_spot_id = ?")
+		assert.NotContains(t, sql, "authority")
+	})
+}
@@ -2,12 +2,18 @@ package persistence
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/geo"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/samber/lo"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type dateSpotRepository struct {
@@ -19,7 +25,7 @@ func NewDateSpotRepository(db *gorm.DB) repository.DateSpotRepository {
 }
 
 func (r *dateSpotRepository) Create(ctx context.Context, dateSpot *model.DateSpot) error {
-	if err := r.db.WithContext(ctx).Create(dateSpot).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Create(dateSpot).Error; err != nil {
 		slog.ErrorContext(ctx, "dateSpotRepository.Create failed", "err", err)
 		return err
 	}
@@ -28,12 +34,12 @@ func (r *dateSpotRepository) Create(ctx context.Context, dateSpot *model.DateSpo
 }
 
 func (r *dateSpotRepository) FindByID(ctx context.Context, id uint) (*model.DateSpot, error) {
-	db := r.db.WithContext(ctx).
+	db := dbFromContext(ctx, r.db).
 		Model(&model.DateSpot{}).
 		Select(`date_spots.*,
 			COALESCE(AVG(date_spot_reviews.rate), 0)  AS average_rate,
 			COUNT(date_spot_reviews.id)               AS review_total_number`).
-		Joins("LEFT JOIN date_spot_reviews ON date_spot_reviews.date_spot_id = date_spots.id").
+		Joins(visibleReviewsJoinSQL, model.VisibleReviewStatuses).
 		Group("date_spots.id")
 
 	var dateSpot model.DateSpot
@@ -47,17 +53,100 @@ func (r *dateSpotRepository) FindByID(ctx context.Context, id uint) (*model.Date
 	return &dateSpot, nil
 }
 
-func (r *dateSpotRepository) Search(ctx context.Context, params repository.DateSpotSearchParams) ([]*model.DateSpot, error) {
-	db := r.db.WithContext(ctx).
+func (r *dateSpotRepository) FindExistingIDs(ctx context.Context, ids []uint) ([]uint, error) {
+	existing := []uint{}
+	if len(ids) == 0 {
+		return existing, nil
+	}
+	if err := dbFromContext(ctx, r.db).
 		Model(&model.DateSpot{}).
-		Select(`date_spots.*,
+		Where("id IN ?", ids).
+		Pluck("id", &existing).Error; err != nil {
+		slog.ErrorContext(ctx, "dateSpotRepository.FindExistingIDs failed", "err", err)
+		return nil, apperror.InternalServerError(err)
+	}
+	return existing, nil
+}
+
+// visibleReviewsJoinSQL は公開中のレビューだけを結合します。
+// 非公開・保留中のレビューは average_rate・review_total_number に数えません。
+// プレースホルダには model.VisibleReviewStatuses を渡します。
+const visibleReviewsJoinSQL = "LEFT JOIN date_spot_reviews ON date_spot_reviews.date_spot_id = date_spots.id AND date_spot_reviews.status IN ?"
+
+// haversineDistanceSQL は date_spots の緯度経度と中心との大圏距離（メートル）を求める式です。
+// プレースホルダには中心の緯度・緯度・経度の順で値を渡します。
+// 浮動小数点の誤差で ASIN の引数が 1 を超えないよう LEAST で丸めます。
+var haversineDistanceSQL = fmt.Sprintf(`2 * %f * ASIN(LEAST(1, SQRT(
+	POW(SIN(RADIANS(date_spots.latitude - ?) / 2), 2) +
+	COS(RADIANS(?)) * COS(RADIANS(date_spots.latitude)) * POW(SIN(RADIANS(date_spots.longitude - ?) / 2), 2))))`, geo.EarthRadiusMeters)
+
+// openAtSQL は指定日時に営業しているかの条件です。
+// 当日の時間帯に入っているか、前日から日をまたいで続く時間帯に入っているかを見ます。
+// どちらもその営業日が臨時休業日なら営業していないものとして扱います。
+const openAtSQL = `EXISTS (
+	SELECT 1 FROM date_spot_opening_hours
+	WHERE date_spot_opening_hours.date_spot_id = date_spots.id
+	AND (
+		(date_spot_opening_hours.weekday = ? AND date_spot_opening_hours.open_minute <= ? AND date_spot_opening_hours.close_minute > ?
+			AND NOT EXISTS (SELECT 1 FROM date_spot_closures WHERE date_spot_closures.date_spot_id = date_spots.id AND date_spot_closures.closed_on = ?))
+		OR
+		(date_spot_opening_hours.weekday = ? AND date_spot_opening_hours.close_minute > ?
+			AND NOT EXISTS (SELECT 1 FROM date_spot_closures WHERE date_spot_closures.date_spot_id = date_spots.id AND date_spot_closures.closed_on = ?))
+	))`
+
+// dateSpotOrders は一覧の並び順ごとの並び替えキーです。
+// 評価・レビュー数・距離は SELECT で求めた値なので HAVING で比較します。
+var dateSpotOrders = map[pagination.Sort]keysetOrder{
+	pagination.SortNewest:      {key: "date_spots.created_at", id: "date_spots.id", desc: true},
+	pagination.SortRating:      {key: "average_rate", id: "date_spots.id", desc: true, aggregate: true},
+	pagination.SortReviewCount: {key: "review_total_number", id: "date_spots.id", desc: true, aggregate: true},
+	pagination.SortName:        {key: "date_spots.name", id: "date_spots.id"},
+	pagination.SortDistance:    {key: "distance_meters", id: "date_spots.id", aggregate: true},
+}
+
+// dateSpotCursor は ds の位置を指すカーソルを作ります。
+func dateSpotCursor(sort pagination.Sort) func(*model.DateSpot) pagination.Cursor {
+	return func(ds *model.DateSpot) pagination.Cursor {
+		switch sort {
+		case pagination.SortRating:
+			return pagination.NewFloatCursor(sort, ds.AverageRate, ds.ID)
+		case pagination.SortReviewCount:
+			return pagination.NewIntCursor(sort, ds.ReviewTotalNumber, ds.ID)
+		case pagination.SortName:
+			return pagination.NewStringCursor(sort, ds.Name, ds.ID)
+		case pagination.SortDistance:
+			return pagination.NewFloatCursor(sort, lo.FromPtr(ds.DistanceMeters), ds.ID)
+		case pagination.SortRelevance:
+			return pagination.NewFloatCursor(sort, lo.FromPtr(ds.Relevance), ds.ID)
+		default:
+			return pagination.NewTimeCursor(sort, ds.CreatedAt, ds.ID)
+		}
+	}
+}
+
+func (r *dateSpotRepository) Search(ctx context.Context, params repository.DateSpotSearchParams) (pagination.Page[*model.DateSpot], error) {
+	selectSQL := `date_spots.*,
 			COALESCE(AVG(date_spot_reviews.rate), 0)  AS average_rate,
-			COUNT(date_spot_reviews.id)               AS review_total_number`).
-		Joins("LEFT JOIN date_spot_reviews ON date_spot_reviews.date_spot_id = date_spots.id").
+			COUNT(date_spot_reviews.id)               AS review_total_number`
+	var selectArgs []any
+	if params.HasNear() {
+		selectSQL += `,
+			` + haversineDistanceSQL + ` AS distance_meters`
+		selectArgs = append(selectArgs, *params.Latitude, *params.Latitude, *params.Longitude)
+	}
+
+	db := dbFromContext(ctx, r.db).
+		Model(&model.DateSpot{}).
+		Select(selectSQL, selectArgs...).
+		Joins(visibleReviewsJoinSQL, model.VisibleReviewStatuses).
+		Where("date_spots.closed_at IS NULL").
 		Group("date_spots.id")
 
-	if params.Name != nil && *params.Name != "" {
-		db = db.Where("date_spots.name LIKE ?", "%"+*params.Name+"%")
+	if params.HasMatches() {
+		if len(params.Matches) == 0 {
+			return pagination.Page[*model.DateSpot]{Items: []*model.DateSpot{}}, nil
+		}
+		db = db.Where("date_spots.id IN ?", matchIDs(params.Matches))
 	}
 	if params.PrefectureID != nil {
 		db = db.Where("date_spots.prefecture_id = ?", *params.PrefectureID)
@@ -65,23 +154,96 @@ func (r *dateSpotRepository) Search(ctx context.Context, params repository.DateS
 	if params.GenreID != nil {
 		db = db.Where("date_spots.genre_id = ?", *params.GenreID)
 	}
-	if params.ComeTime != nil && *params.ComeTime != "" {
-		db = db.Where("date_spots.opening_time <= ?", *params.ComeTime).
-			Where("date_spots.closing_time >= ?", *params.ComeTime)
+	if params.OpenAt != nil {
+		// DATE 列との比較は接続のタイムゾーン設定に左右されないよう、日付文字列で渡す
+		at := params.OpenAt
+		db = db.Where(openAtSQL,
+			at.Weekday, at.Minute, at.Minute, at.Date.Format(time.DateOnly),
+			at.PrevWeekday, at.Minute+model.MinutesPerDay, at.PrevDate.Format(time.DateOnly))
+	}
+	if params.HasNear() {
+		// 矩形の範囲条件で (latitude, longitude) インデックスを使って候補を絞り、
+		// 残った行だけ正確な距離を計算して円の外を除く
+		box := geo.NewBoundingBox(*params.Latitude, *params.Longitude, float64(*params.RadiusMeters))
+		db = db.Where("date_spots.latitude BETWEEN ? AND ?", box.MinLatitude, box.MaxLatitude).
+			Where("date_spots.longitude BETWEEN ? AND ?", box.MinLongitude, box.MaxLongitude).
+			Having("distance_meters <= ?", *params.RadiusMeters)
+	}
+
+	if params.Sort == pagination.SortRelevance && params.HasMatches() {
+		return r.searchByRelevance(ctx, db, params)
+	}
+
+	order, ok := dateSpotOrders[params.Sort]
+	if !ok || (params.Sort == pagination.SortDistance && !params.HasNear()) {
+		params.Sort = pagination.SortNewest
+		order = dateSpotOrders[params.Sort]
+	}
+	db = order.paginate(db, params.Page)
+
+	var dateSpots []*model.DateSpot
+	if err := db.Find(&dateSpots).Error; err != nil {
+		slog.ErrorContext(ctx, "dateSpotRepository.Search failed", "err", err)
+		return pagination.Page[*model.DateSpot]{}, err
+	}
+	setRelevance(dateSpots, params.Matches)
+	return pagination.NewPage(dateSpots, params.Page, dateSpotCursor(params.Sort)), nil
+}
+
+// searchByRelevance は関連度の順に並べます。関連度は DB の外で求めた値のため、
+// カーソルより後ろのヒットを先に選び、その並びのまま FIELD で並べ替えます。
+func (r *dateSpotRepository) searchByRelevance(ctx context.Context, db *gorm.DB, params repository.DateSpotSearchParams) (pagination.Page[*model.DateSpot], error) {
+	matches := matchesAfter(params.Matches, params.Page.Cursor)
+	if len(matches) == 0 {
+		return pagination.Page[*model.DateSpot]{Items: []*model.DateSpot{}}, nil
+	}
+	ids := matchIDs(matches)
+	db = db.Where("date_spots.id IN ?", ids).
+		Clauses(clause.OrderBy{Expression: clause.Expr{SQL: "FIELD(date_spots.id, ?)", Vars: []any{ids}, WithoutParentheses: true}})
+	if params.Page.Limit > 0 {
+		db = db.Limit(params.Page.FetchLimit())
 	}
 
 	var dateSpots []*model.DateSpot
 	if err := db.Find(&dateSpots).Error; err != nil {
 		slog.ErrorContext(ctx, "dateSpotRepository.Search failed", "err", err)
-		return nil, err
+		return pagination.Page[*model.DateSpot]{}, err
+	}
+	setRelevance(dateSpots, matches)
+	return pagination.NewPage(dateSpots, params.Page, dateSpotCursor(params.Sort)), nil
+}
+
+func matchIDs(matches []repository.DateSpotSearchHit) []uint {
+	return lo.Map(matches, func(m repository.DateSpotSearchHit, _ int) uint { return m.DateSpotID })
+}
+
+// matchesAfter は関連度の高い順（同じなら ID の小さい順）に並んだ matches から、カーソルより後ろを返します。
+func matchesAfter(matches []repository.DateSpotSearchHit, cursor *pagination.Cursor) []repository.DateSpotSearchHit {
+	if cursor == nil {
+		return matches
+	}
+	score := cursor.FloatValue()
+	return lo.Filter(matches, func(m repository.DateSpotSearchHit, _ int) bool {
+		return m.Score < score || (m.Score == score && m.DateSpotID > cursor.ID)
+	})
+}
+
+func setRelevance(dateSpots []*model.DateSpot, matches []repository.DateSpotSearchHit) {
+	if len(matches) == 0 {
+		return
+	}
+	scores := lo.SliceToMap(matches, func(m repository.DateSpotSearchHit) (uint, float64) { return m.DateSpotID, m.Score })
+	for _, ds := range dateSpots {
+		if score, ok := scores[ds.ID]; ok {
+			ds.Relevance = lo.ToPtr(score)
+		}
 	}
-	return dateSpots, nil
 }
 
 func (r *dateSpotRepository) Update(ctx context.Context, id uint, dateSpot *model.DateSpot) error {
 	// Ensure the ID is set on the struct so GORM treats this as an update
 	dateSpot.ID = id
-	if err := r.db.WithContext(ctx).Model(&model.DateSpot{}).Where("id = ?", id).Updates(dateSpot).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Model(&model.DateSpot{}).Where("id = ?", id).Updates(dateSpot).Error; err != nil {
 		slog.ErrorContext(ctx, "dateSpotRepository.Update failed", "err", err, "id", id)
 		return err
 	}
@@ -98,7 +260,7 @@ func (r *dateSpotRepository) Update(ctx context.Context, id uint, dateSpot *mode
 // スポットが1件減る。実在しなくなったスポットを管理者が消す運用のため、
 // コース側を残したうえで中間レコードだけを取り除く方針とする。
 func (r *dateSpotRepository) Delete(ctx context.Context, id uint) error {
-	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		return deleteDateSpot(tx, id)
 	})
 	if err != nil {
@@ -112,32 +274,112 @@ func (r *dateSpotRepository) Delete(ctx context.Context, id uint) error {
 // deleteDateSpot は依存関係の深い順に削除します。
 // 呼び出し側がトランザクションを張る前提のため、db にはその tx を渡します。
 func deleteDateSpot(db *gorm.DB, id uint) error {
+	if err := db.Where("date_spot_id = ?", id).Delete(&model.DateSpotOpeningHour{}).Error; err != nil {
+		return err
+	}
+	if err := db.Where("date_spot_id = ?", id).Delete(&model.DateSpotClosure{}).Error; err != nil {
+		return err
+	}
+	// 通報はレビュー経由の孫レコード。レビューより先に消す
+	if err := db.Where("date_spot_review_id IN (?)", db.Raw("SELECT id FROM date_spot_reviews WHERE date_spot_id = ?", id)).
+		Delete(&model.DateSpotReviewReport{}).Error; err != nil {
+		return err
+	}
 	if err := db.Where("date_spot_id = ?", id).Delete(&model.DateSpotReview{}).Error; err != nil {
 		return err
 	}
 	if err := db.Where("date_spot_id = ?", id).Delete(&model.DuringSpot{}).Error; err != nil {
 		return err
 	}
+	// 確認待ちの取り込み結果も、照合相手のスポットが無くなれば判断できないので一緒に消す
+	if err := db.Where("date_spot_id = ?", id).Delete(&model.SpotSource{}).Error; err != nil {
+		return err
+	}
+	if err := db.Where("date_spot_id = ?", id).Delete(&model.SpotRefreshChange{}).Error; err != nil {
+		return err
+	}
 	return db.Delete(&model.DateSpot{}, id).Error
 }
 
-func (r *dateSpotRepository) ExistsByNormalizedNameAndPrefecture(ctx context.Context, normalizedName string, prefectureID int) (bool, error) {
+func (r *dateSpotRepository) FindByPrefecture(ctx context.Context, prefectureID int) ([]*model.DateSpot, error) {
+	var spots []*model.DateSpot
+	if err := dbFromContext(ctx, r.db).
+		Select("id", "name", "city_name", "latitude", "longitude").
+		Where("prefecture_id = ? AND closed_at IS NULL", prefectureID).
+		Find(&spots).Error; err != nil {
+		slog.ErrorContext(ctx, "dateSpotRepository.FindByPrefecture failed", "err", err)
+		return nil, apperror.InternalServerError(err)
+	}
+	return spots, nil
+}
+
+// FillMissing は列ごとに COALESCE で埋めるので、読み込んでから書き戻すあいだに利用者が編集した値を消しません。
+// 営業時間・休業日は1件も登録されていないときだけ from の分を登録します。
+func (r *dateSpotRepository) FillMissing(ctx context.Context, id uint, from *model.DateSpot) error {
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.DateSpot{}).Where("id = ?", id).Updates(map[string]any{
+			"image":     gorm.Expr("COALESCE(image, ?)", from.Image),
+			"latitude":  gorm.Expr("COALESCE(latitude, ?)", from.Latitude),
+			"longitude": gorm.Expr("COALESCE(longitude, ?)", from.Longitude),
+			"maps_url":  gorm.Expr("COALESCE(maps_url, ?)", from.MapsURL),
+		}).Error; err != nil {
+			return err
+		}
+		if len(from.OpeningHours) > 0 {
+			if err := createIfNone(tx, id, lo.Map(from.OpeningHours, func(h *model.DateSpotOpeningHour, _ int) *model.DateSpotOpeningHour {
+				return &model.DateSpotOpeningHour{DateSpotID: id, Weekday: h.Weekday, OpenMinute: h.OpenMinute, CloseMinute: h.CloseMinute}
+			})); err != nil {
+				return err
+			}
+		}
+		if len(from.Closures) > 0 {
+			if err := createIfNone(tx, id, lo.Map(from.Closures, func(c *model.DateSpotClosure, _ int) *model.DateSpotClosure {
+				return &model.DateSpotClosure{DateSpotID: id, ClosedOn: c.ClosedOn}
+			})); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "dateSpotRepository.FillMissing failed", "err", err, "id", id)
+		return apperror.InternalServerError(err)
+	}
+	slog.InfoContext(ctx, "dateSpotRepository.FillMissing succeeded", "id", id)
+	return nil
+}
+
+// createIfNone は dateSpotID のスポットに T の行が1件も無いときだけ rows を登録します。
+func createIfNone[T any](tx *gorm.DB, dateSpotID uint, rows []*T) error {
 	var count int64
-	if err := r.db.WithContext(ctx).
-		Model(&model.DateSpot{}).
-		Where("normalized_name = ? AND prefecture_id = ?", normalizedName, prefectureID).
-		Count(&count).Error; err != nil {
-		slog.ErrorContext(ctx, "dateSpotRepository.ExistsByNormalizedNameAndPrefecture failed", "err", err)
-		return false, apperror.InternalServerError(err)
+	if err := tx.Model(new(T)).Where("date_spot_id = ?", dateSpotID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
 	}
-	return count > 0, nil
+	return tx.Create(&rows).Error
+}
+
+// UpdateRefreshed は列を指定して書き込むので、nil や 0 に戻した値もそのまま反映します。
+func (r *dateSpotRepository) UpdateRefreshed(ctx context.Context, dateSpot *model.DateSpot) error {
+	if err := dbFromContext(ctx, r.db).
+		Model(dateSpot).
+		Select("name", "normalized_name", "city_name", "image", "latitude", "longitude", "maps_url",
+			"last_seen_at", "missed_refreshes", "closed_at").
+		Updates(dateSpot).Error; err != nil {
+		slog.ErrorContext(ctx, "dateSpotRepository.UpdateRefreshed failed", "err", err, "id", dateSpot.ID)
+		return apperror.InternalServerError(err)
+	}
+	return nil
 }
 
+// CountByPrefectureAndGenre が閉店とみなしたスポットを数えないので、閉店が続いた組み合わせは次の実行で補充されます。
 func (r *dateSpotRepository) CountByPrefectureAndGenre(ctx context.Context, prefectureID, genreID int) (int64, error) {
 	var count int64
-	if err := r.db.WithContext(ctx).
+	if err := dbFromContext(ctx, r.db).
 		Model(&model.DateSpot{}).
-		Where("prefecture_id = ? AND genre_id = ?", prefectureID, genreID).
+		Where("prefecture_id = ? AND genre_id = ? AND closed_at IS NULL", prefectureID, genreID).
 		Count(&count).Error; err != nil {
 		slog.ErrorContext(ctx, "dateSpotRepository.CountByPrefectureAndGenre failed", "err", err)
 		return 0, apperror.InternalServerError(err)
@@ -149,7 +391,7 @@ func (r *dateSpotRepository) CreateBatch(ctx context.Context, dateSpots []*model
 	if len(dateSpots) == 0 {
 		return nil
 	}
-	if err := r.db.WithContext(ctx).Create(&dateSpots).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Create(&dateSpots).Error; err != nil {
 		slog.ErrorContext(ctx, "dateSpotRepository.CreateBatch failed", "err", err)
 		return apperror.InternalServerError(err)
 	}
@@ -15,12 +15,18 @@ func TestDeleteDateSpot(t *testing.T) {
 
 		_ = deleteDateSpot(db, 3)
 
-		require.Equal(t, 3, len(*captured), "レビュー・コース中間テーブル・本体で3回の DELETE が必要")
+		require.Equal(t, 8, len(*captured), "営業時間・休業日・通報・レビュー・コース中間テーブル・取り込み記録・取り直しの差分・本体で8回の DELETE が必要")
 
 		sqls := *captured
-		assert.Contains(t, sqls[0], "DELETE FROM `date_spot_reviews`")
-		assert.Contains(t, sqls[1], "DELETE FROM `during_spots`")
-		assert.Contains(t, sqls[2], "DELETE FROM `date_spots`")
+		assert.Contains(t, sqls[0], "DELETE FROM `date_spot_opening_hours`")
+		assert.Contains(t, sqls[1], "DELETE FROM `date_spot_closures`")
+		assert.Contains(t, sqls[2], "DELETE FROM `date_spot_review_reports`")
+		assert.Contains(t, sqls[2], "SELECT id FROM date_spot_reviews WHERE date_spot_id = ?", "レビュー経由で孫を特定する")
+		assert.Contains(t, sqls[3], "DELETE FROM `date_spot_reviews`")
+		assert.Contains(t, sqls[4], "DELETE FROM `during_spots`")
+		assert.Contains(t, sqls[5], "DELETE FROM `spot_sources`")
+		assert.Contains(t, sqls[6], "DELETE FROM `spot_refresh_changes`")
+		assert.Contains(t, sqls[7], "DELETE FROM `date_spots`")
 	})
 
 	t.Run("deletes_in_dependency_order", func(t *testing.T) {
@@ -29,10 +35,12 @@ func TestDeleteDateSpot(t *testing.T) {
 		_ = deleteDateSpot(db, 3)
 
 		all := strings.Join(*captured, "\n")
+		reports := strings.Index(all, "DELETE FROM `date_spot_review_reports`")
 		reviews := strings.Index(all, "DELETE FROM `date_spot_reviews`")
 		during := strings.Index(all, "DELETE FROM `during_spots`")
 		spot := strings.Index(all, "DELETE FROM `date_spots`")
 
+		assert.Less(t, reports, reviews, "通報はレビューより先")
 		assert.Less(t, reviews, spot, "レビューはスポットより先")
 		assert.Less(t, during, spot, "コース中間テーブルはスポットより先")
 	})
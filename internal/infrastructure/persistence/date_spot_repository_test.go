@@ -0,0 +1,230 @@
+package persistence_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/persistence"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateSpotRepository_Search(t *testing.T) {
+	ctx := context.Background()
+
+	// 非公開・保留中のレビューは評価の平均や件数に数えない
+	t.Run("joins_only_visible_reviews", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewDateSpotRepository(db)
+
+		_, _ = repo.Search(ctx, repository.DateSpotSearchParams{})
+
+		assert.Contains(t, issuedSQL(captured), "LEFT JOIN date_spot_reviews ON date_spot_reviews.date_spot_id = date_spots.id AND date_spot_reviews.status IN (?,?)")
+	})
+
+	// 閉店とみなしたスポットは削除せず、検索にだけ出さない
+	t.Run("excludes_closed_spots", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewDateSpotRepository(db)
+
+		_, _ = repo.Search(ctx, repository.DateSpotSearchParams{})
+
+		assert.Contains(t, issuedSQL(captured), "date_spots.closed_at IS NULL")
+	})
+
+	t.Run("without_near_keeps_default_query", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewDateSpotRepository(db)
+
+		_, _ = repo.Search(ctx, repository.DateSpotSearchParams{})
+
+		sql := issuedSQL(captured)
+		assert.NotContains(t, sql, "distance_meters")
+		assert.NotContains(t, sql, "date_spots.latitude BETWEEN")
+	})
+
+	// 矩形でインデックスを使って絞り込んでから、正確な距離で円の外を除き近い順に並べる
+	t.Run("near_filters_by_bounding_box_and_orders_by_distance", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewDateSpotRepository(db)
+		lat, lng, radius := 35.681236, 139.767125, 1000
+
+		_, _ = repo.Search(ctx, repository.DateSpotSearchParams{
+			Latitude:     &lat,
+			Longitude:    &lng,
+			RadiusMeters: &radius,
+			Sort:         pagination.SortDistance,
+		})
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "AS distance_meters")
+		assert.Contains(t, sql, "date_spots.latitude BETWEEN ? AND ?")
+		assert.Contains(t, sql, "date_spots.longitude BETWEEN ? AND ?")
+		assert.Contains(t, sql, "HAVING distance_meters <= ?")
+		assert.Contains(t, sql, "ORDER BY distance_meters ASC,date_spots.id ASC")
+		assert.Less(t, strings.Index(sql, "GROUP BY"), strings.Index(sql, "HAVING"))
+	})
+
+	t.Run("near_requires_all_of_lat_lng_radius", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewDateSpotRepository(db)
+		lat, lng := 35.681236, 139.767125
+
+		_, _ = repo.Search(ctx, repository.DateSpotSearchParams{Latitude: &lat, Longitude: &lng})
+
+		assert.NotContains(t, issuedSQL(captured), "distance_meters")
+	})
+
+	// 当日の時間帯と、前日から日をまたいで続く時間帯の両方を見て、臨時休業日は除く
+	t.Run("open_at_checks_opening_hours_and_closures", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewDateSpotRepository(db)
+		at := model.NewOpenAt(time.Date(2026, 10, 17, 1, 30, 0, 0, model.JST))
+
+		_, _ = repo.Search(ctx, repository.DateSpotSearchParams{OpenAt: &at})
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "EXISTS (")
+		assert.Contains(t, sql, "FROM date_spot_opening_hours")
+		assert.Contains(t, sql, "date_spot_opening_hours.open_minute <= ? AND date_spot_opening_hours.close_minute > ?")
+		assert.Equal(t, 2, strings.Count(sql, "NOT EXISTS (SELECT 1 FROM date_spot_closures"))
+		assert.NotContains(t, sql, "opening_time")
+	})
+
+	// 関連度は DB の外で求めた値なので、ヒットの並びのまま FIELD で並べる
+	t.Run("relevance_orders_by_matches", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewDateSpotRepository(db)
+
+		_, _ = repo.Search(ctx, repository.DateSpotSearchParams{
+			Matches: []repository.DateSpotSearchHit{{DateSpotID: 3, Score: 6}, {DateSpotID: 1, Score: 3}},
+			Sort:    pagination.SortRelevance,
+			Page:    pagination.Params{Limit: 20},
+		})
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "date_spots.id IN (?,?)")
+		assert.Contains(t, sql, "ORDER BY FIELD(date_spots.id, ?,?)")
+		assert.NotContains(t, sql, "LIKE")
+	})
+
+	t.Run("matches_with_other_sort_only_filters", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewDateSpotRepository(db)
+
+		_, _ = repo.Search(ctx, repository.DateSpotSearchParams{
+			Matches: []repository.DateSpotSearchHit{{DateSpotID: 3, Score: 6}},
+			Sort:    pagination.SortName,
+		})
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "date_spots.id IN (?)")
+		assert.Contains(t, sql, "ORDER BY date_spots.name ASC,date_spots.id ASC")
+	})
+
+	t.Run("empty_matches_skips_query", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewDateSpotRepository(db)
+
+		page, err := repo.Search(ctx, repository.DateSpotSearchParams{Matches: []repository.DateSpotSearchHit{}})
+
+		assert.NoError(t, err)
+		assert.Empty(t, page.Items)
+		assert.Empty(t, *captured)
+	})
+
+	// カーソルより後ろのヒットだけを対象にする
+	t.Run("relevance_cursor_drops_earlier_matches", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewDateSpotRepository(db)
+		cursor := pagination.NewFloatCursor(pagination.SortRelevance, 3, 1)
+
+		_, _ = repo.Search(ctx, repository.DateSpotSearchParams{
+			Matches: []repository.DateSpotSearchHit{{DateSpotID: 3, Score: 6}, {DateSpotID: 1, Score: 3}, {DateSpotID: 2, Score: 3}, {DateSpotID: 5, Score: 1}},
+			Sort:    pagination.SortRelevance,
+			Page:    pagination.Params{Limit: 20, Cursor: &cursor},
+		})
+
+		assert.Contains(t, issuedSQL(captured), "date_spots.id IN (?,?)")
+	})
+
+	t.Run("default_sort_is_newest_with_limit", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewDateSpotRepository(db)
+
+		_, _ = repo.Search(ctx, repository.DateSpotSearchParams{Page: pagination.Params{Limit: 20}})
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "ORDER BY date_spots.created_at DESC,date_spots.id DESC")
+		assert.Contains(t, sql, "LIMIT ?")
+	})
+
+	// 評価・レビュー数は集計値なので、続きの条件は WHERE ではなく HAVING に置く
+	t.Run("rating_cursor_uses_having", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewDateSpotRepository(db)
+		cursor := pagination.NewFloatCursor(pagination.SortRating, 4.5, 10)
+
+		_, _ = repo.Search(ctx, repository.DateSpotSearchParams{
+			Sort: pagination.SortRating,
+			Page: pagination.Params{Limit: 20, Cursor: &cursor},
+		})
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "HAVING (average_rate < ? OR (average_rate = ? AND date_spots.id < ?))")
+		assert.Contains(t, sql, "ORDER BY average_rate DESC,date_spots.id DESC")
+	})
+
+	t.Run("name_cursor_uses_where_in_ascending_order", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewDateSpotRepository(db)
+		cursor := pagination.NewStringCursor(pagination.SortName, "東京タワー", 10)
+
+		_, _ = repo.Search(ctx, repository.DateSpotSearchParams{
+			Sort: pagination.SortName,
+			Page: pagination.Params{Limit: 20, Cursor: &cursor},
+		})
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "WHERE date_spots.closed_at IS NULL AND ((date_spots.name > ? OR (date_spots.name = ? AND date_spots.id > ?)))")
+		assert.Contains(t, sql, "ORDER BY date_spots.name ASC,date_spots.id ASC")
+	})
+
+	t.Run("distance_sort_without_near_falls_back_to_newest", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewDateSpotRepository(db)
+
+		_, _ = repo.Search(ctx, repository.DateSpotSearchParams{Sort: pagination.SortDistance})
+
+		sql := issuedSQL(captured)
+		assert.NotContains(t, sql, "distance_meters")
+		assert.Contains(t, sql, "ORDER BY date_spots.created_at DESC")
+	})
+}
+
+func TestDateSpotRepository_FindByID(t *testing.T) {
+	t.Run("joins_only_visible_reviews", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewDateSpotRepository(db)
+
+		_, _ = repo.FindByID(context.Background(), 1)
+
+		assert.Contains(t, issuedSQL(captured), "AND date_spot_reviews.status IN (?,?)")
+	})
+}
+
+func TestDateSpotRepository_FindByPrefecture(t *testing.T) {
+	// 閉店とみなしたスポットは照合相手にしない
+	t.Run("excludes_closed_spots", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewDateSpotRepository(db)
+
+		_, _ = repo.FindByPrefecture(context.Background(), 13)
+
+		assert.Contains(t, issuedSQL(captured), "prefecture_id = ? AND closed_at IS NULL")
+	})
+}
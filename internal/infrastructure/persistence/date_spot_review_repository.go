@@ -3,9 +3,11 @@ package persistence
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 	"gorm.io/gorm"
 )
 
@@ -18,7 +20,7 @@ func NewDateSpotReviewRepository(db *gorm.DB) repository.DateSpotReviewRepositor
 }
 
 func (r *dateSpotReviewRepository) Create(ctx context.Context, review *model.DateSpotReview) error {
-	if err := r.db.WithContext(ctx).Create(review).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Create(review).Error; err != nil {
 		slog.ErrorContext(ctx, "dateSpotReviewRepository.Create failed", "err", err)
 		return err
 	}
@@ -26,10 +28,10 @@ func (r *dateSpotReviewRepository) Create(ctx context.Context, review *model.Dat
 	return nil
 }
 
-// FindByID は指定 ID のレビューを返します。
+// FindByID は指定 ID のレビューを返します。非公開・保留中のレビューも返します。
 func (r *dateSpotReviewRepository) FindByID(ctx context.Context, id uint) (*model.DateSpotReview, error) {
 	var review model.DateSpotReview
-	if err := r.db.WithContext(ctx).First(&review, id).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).First(&review, id).Error; err != nil {
 		slog.ErrorContext(ctx, "dateSpotReviewRepository.FindByID failed", "err", err)
 		return nil, err
 	}
@@ -37,19 +39,28 @@ func (r *dateSpotReviewRepository) FindByID(ctx context.Context, id uint) (*mode
 }
 
 // DeleteByID は指定 ID のレビューを削除します。
+// 通報がレビューを参照しているため先に消し、一部だけ消えた状態にならないようトランザクションにまとめます。
 func (r *dateSpotReviewRepository) DeleteByID(ctx context.Context, id uint) error {
-	if err := r.db.WithContext(ctx).Delete(&model.DateSpotReview{}, id).Error; err != nil {
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("date_spot_review_id = ?", id).Delete(&model.DateSpotReviewReport{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&model.DateSpotReview{}, id).Error
+	})
+	if err != nil {
 		slog.ErrorContext(ctx, "dateSpotReviewRepository.DeleteByID failed", "err", err)
 		return err
 	}
 	return nil
 }
 
-// FindByDateSpotID は指定 DateSpot のレビュー一覧を User 込みで返します。
-func (r *dateSpotReviewRepository) FindByDateSpotID(ctx context.Context, dateSpotID uint) ([]*model.DateSpotReview, error) {
+// FindByDateSpotID は指定 DateSpot の公開中のレビュー一覧を User 込みで返します。
+// viewerID がブロック・ミュートしている相手のレビューは除きます。
+func (r *dateSpotReviewRepository) FindByDateSpotID(ctx context.Context, dateSpotID, viewerID uint) ([]*model.DateSpotReview, error) {
 	var reviews []*model.DateSpotReview
-	if err := r.db.WithContext(ctx).
-		Where("date_spot_id = ?", dateSpotID).
+	if err := dbFromContext(ctx, r.db).
+		Where("date_spot_id = ? AND status IN ?", dateSpotID, model.VisibleReviewStatuses).
+		Scopes(excludeHiddenUsers("date_spot_reviews.user_id", viewerID)).
 		Preload("User").
 		Find(&reviews).Error; err != nil {
 		slog.ErrorContext(ctx, "dateSpotReviewRepository.FindByDateSpotID failed", "err", err)
@@ -58,7 +69,7 @@ func (r *dateSpotReviewRepository) FindByDateSpotID(ctx context.Context, dateSpo
 	return reviews, nil
 }
 
-// FindByUserIDs は指定ユーザーたちのレビューを userID ごとにまとめて返します。
+// FindByUserIDs は指定ユーザーたちの公開中のレビューを userID ごとにまとめて返します。
 // ユーザー一覧では人数分のクエリになるため、IN 句で1回にまとめています。
 func (r *dateSpotReviewRepository) FindByUserIDs(ctx context.Context, userIDs []uint) (map[uint][]*model.DateSpotReview, error) {
 	result := make(map[uint][]*model.DateSpotReview, len(userIDs))
@@ -67,8 +78,8 @@ func (r *dateSpotReviewRepository) FindByUserIDs(ctx context.Context, userIDs []
 	}
 
 	var reviews []*model.DateSpotReview
-	if err := r.db.WithContext(ctx).
-		Where("user_id IN ?", userIDs).
+	if err := dbFromContext(ctx, r.db).
+		Where("user_id IN ? AND status IN ?", userIDs, model.VisibleReviewStatuses).
 		Preload("DateSpot").
 		Find(&reviews).Error; err != nil {
 		slog.ErrorContext(ctx, "dateSpotReviewRepository.FindByUserIDs failed", "err", err)
@@ -81,7 +92,7 @@ func (r *dateSpotReviewRepository) FindByUserIDs(ctx context.Context, userIDs []
 	return result, nil
 }
 
-// UpdateByID は指定 ID のレビューを更新します。nil フィールドは更新しません。
+// UpdateByID は指定 ID のレビューを更新します。nil フィールドと空の Status は更新しません。
 func (r *dateSpotReviewRepository) UpdateByID(ctx context.Context, id uint, review *model.DateSpotReview) error {
 	updates := map[string]interface{}{}
 	if review.Rate != nil {
@@ -90,9 +101,108 @@ func (r *dateSpotReviewRepository) UpdateByID(ctx context.Context, id uint, revi
 	if review.Content != nil {
 		updates["content"] = review.Content
 	}
-	if err := r.db.WithContext(ctx).Model(&model.DateSpotReview{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+	if review.Status != "" {
+		updates["status"] = review.Status
+	}
+	if err := dbFromContext(ctx, r.db).Model(&model.DateSpotReview{}).Where("id = ?", id).Updates(updates).Error; err != nil {
 		slog.ErrorContext(ctx, "dateSpotReviewRepository.UpdateByID failed", "err", err)
 		return err
 	}
 	return nil
 }
+
+func (r *dateSpotReviewRepository) UpdateStatus(ctx context.Context, id uint, status model.ReviewStatus) error {
+	if err := dbFromContext(ctx, r.db).Model(&model.DateSpotReview{}).Where("id = ?", id).Update("status", status).Error; err != nil {
+		slog.ErrorContext(ctx, "dateSpotReviewRepository.UpdateStatus failed", "err", err)
+		return err
+	}
+	slog.InfoContext(ctx, "dateSpotReviewRepository.UpdateStatus succeeded", "review_id", id, "status", status)
+	return nil
+}
+
+func (r *dateSpotReviewRepository) CreateReport(ctx context.Context, report *model.DateSpotReviewReport) error {
+	if err := dbFromContext(ctx, r.db).Create(report).Error; err != nil {
+		slog.ErrorContext(ctx, "dateSpotReviewRepository.CreateReport failed", "err", err)
+		return err
+	}
+	slog.InfoContext(ctx, "dateSpotReviewRepository.CreateReport succeeded", "report_id", report.ID, "review_id", report.DateSpotReviewID)
+	return nil
+}
+
+// ExistsReport は同じ利用者が同じレビューをすでに通報しているかを返します。対応済みの通報も数えます。
+func (r *dateSpotReviewRepository) ExistsReport(ctx context.Context, reviewID, userID uint) (bool, error) {
+	var count int64
+	if err := dbFromContext(ctx, r.db).
+		Model(&model.DateSpotReviewReport{}).
+		Where("date_spot_review_id = ? AND user_id = ?", reviewID, userID).
+		Count(&count).Error; err != nil {
+		slog.ErrorContext(ctx, "dateSpotReviewRepository.ExistsReport failed", "err", err)
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *dateSpotReviewRepository) CountOpenReports(ctx context.Context, reviewID uint) (int64, error) {
+	var count int64
+	if err := dbFromContext(ctx, r.db).
+		Model(&model.DateSpotReviewReport{}).
+		Where("date_spot_review_id = ? AND resolved_at IS NULL", reviewID).
+		Count(&count).Error; err != nil {
+		slog.ErrorContext(ctx, "dateSpotReviewRepository.CountOpenReports failed", "err", err)
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *dateSpotReviewRepository) ResolveReports(ctx context.Context, reviewID uint, at time.Time) error {
+	if err := dbFromContext(ctx, r.db).
+		Model(&model.DateSpotReviewReport{}).
+		Where("date_spot_review_id = ? AND resolved_at IS NULL", reviewID).
+		Update("resolved_at", at).Error; err != nil {
+		slog.ErrorContext(ctx, "dateSpotReviewRepository.ResolveReports failed", "err", err)
+		return err
+	}
+	return nil
+}
+
+// openReportExistsSQL は未対応の通報があるレビューに絞る条件です。
+const openReportExistsSQL = `EXISTS (
+	SELECT 1 FROM date_spot_review_reports
+	WHERE date_spot_review_reports.date_spot_review_id = date_spot_reviews.id
+	AND date_spot_review_reports.resolved_at IS NULL)`
+
+// reviewModerationOrders はモデレーション一覧の並び順ごとの並び替えキーです。
+var reviewModerationOrders = map[pagination.Sort]keysetOrder{
+	pagination.SortOldest: {key: "date_spot_reviews.created_at", id: "date_spot_reviews.id"},
+	pagination.SortNewest: {key: "date_spot_reviews.created_at", id: "date_spot_reviews.id", desc: true},
+}
+
+func (r *dateSpotReviewRepository) SearchForModeration(ctx context.Context, params repository.ReviewModerationParams) (pagination.Page[*model.DateSpotReview], error) {
+	db := dbFromContext(ctx, r.db).
+		Model(&model.DateSpotReview{}).
+		Preload("User").
+		Preload("DateSpot").
+		Preload("Reports", "resolved_at IS NULL")
+
+	if params.Status != nil {
+		db = db.Where("date_spot_reviews.status = ?", *params.Status)
+	} else {
+		db = db.Where("(date_spot_reviews.status = ? OR "+openReportExistsSQL+")", model.ReviewStatusPending)
+	}
+
+	order, ok := reviewModerationOrders[params.Sort]
+	if !ok {
+		params.Sort = pagination.SortOldest
+		order = reviewModerationOrders[params.Sort]
+	}
+	db = order.paginate(db, params.Page)
+
+	var reviews []*model.DateSpotReview
+	if err := db.Find(&reviews).Error; err != nil {
+		slog.ErrorContext(ctx, "dateSpotReviewRepository.SearchForModeration failed", "err", err)
+		return pagination.Page[*model.DateSpotReview]{}, err
+	}
+	return pagination.NewPage(reviews, params.Page, func(rv *model.DateSpotReview) pagination.Cursor {
+		return pagination.NewTimeCursor(params.Sort, rv.CreatedAt, rv.ID)
+	}), nil
+}
@@ -0,0 +1,76 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/persistence"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateSpotReviewRepository_FindByDateSpotID(t *testing.T) {
+	t.Run("filters_to_visible_reviews", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewDateSpotReviewRepository(db)
+
+		_, _ = repo.FindByDateSpotID(context.Background(), 3, 0)
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "date_spot_id = ? AND status IN (?,?)")
+		assert.NotContains(t, sql, "user_blocks", "未ログインならブロック・ミュートで絞らない")
+	})
+
+	// ブロックは双方向、ミュートは閲覧者がした分だけ除く
+	t.Run("excludes_users_hidden_from_viewer", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewDateSpotReviewRepository(db)
+
+		_, _ = repo.FindByDateSpotID(context.Background(), 3, 9)
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "date_spot_reviews.user_id NOT IN (SELECT blocked_user_id FROM user_blocks WHERE user_id = ?")
+		assert.Contains(t, sql, "SELECT user_id FROM user_blocks WHERE blocked_user_id = ?")
+		assert.Contains(t, sql, "SELECT muted_user_id FROM user_mutes WHERE user_id = ?")
+	})
+}
+
+func TestDateSpotReviewRepository_SearchForModeration(t *testing.T) {
+	ctx := context.Background()
+
+	// 確認待ちは保留中のレビューと、未対応の通報があるレビューを古い順に並べる
+	t.Run("queue_without_status", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewDateSpotReviewRepository(db)
+
+		_, _ = repo.SearchForModeration(ctx, repository.ReviewModerationParams{})
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "date_spot_reviews.status = ? OR EXISTS")
+		assert.Contains(t, sql, "date_spot_review_reports.resolved_at IS NULL")
+		assert.Contains(t, sql, "ORDER BY date_spot_reviews.created_at ASC,date_spot_reviews.id ASC")
+	})
+
+	t.Run("filters_by_status_with_cursor", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewDateSpotReviewRepository(db)
+		status := model.ReviewStatusHidden
+		cursor := pagination.NewTimeCursor(pagination.SortNewest, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), 7)
+
+		_, _ = repo.SearchForModeration(ctx, repository.ReviewModerationParams{
+			Status: &status,
+			Sort:   pagination.SortNewest,
+			Page:   pagination.Params{Limit: 10, Cursor: &cursor},
+		})
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "date_spot_reviews.status = ?")
+		assert.NotContains(t, sql, "EXISTS")
+		assert.Contains(t, sql, "date_spot_reviews.created_at < ?")
+		assert.Contains(t, sql, "ORDER BY date_spot_reviews.created_at DESC,date_spot_reviews.id DESC")
+		assert.Contains(t, sql, "LIMIT ?")
+	})
+}
@@ -0,0 +1,119 @@
+package persistence
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/master"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/textsearch"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// dateSpotSearchIndexTTL は DB から索引を作り直す間隔です。
+// 同じプロセスでの登録・更新・削除はすぐ反映しますが、バッチや別インスタンスで
+// 追加されたスポットはこの間隔で取り込みます。
+const dateSpotSearchIndexTTL = 5 * time.Minute
+
+// dateSpotSearchBatchSize は索引を作るときに1回で読み込むスポット数です。
+const dateSpotSearchBatchSize = 1000
+
+// 名前の一致を最も重くし、ジャンル名・市区町村の順に軽くする
+var dateSpotSearchWeights = []float64{3, 2, 1}
+
+// dateSpotSearchIndex はプロセス内の n-gram インデックスで全文検索する DateSpotSearchIndex です。
+//
+// 本番の TiDB は MySQL の FULLTEXT（ngram パーサ）に対応していないため、DB の全文検索は使いません。
+// スポットは数千件規模で、名前・市区町村・ジャンル名だけなら全件をメモリに載せても小さく、
+// 起動後に初めて検索したときに DB から読み込んで索引を作ります。
+type dateSpotSearchIndex struct {
+	db *gorm.DB
+
+	mu       sync.Mutex
+	index    *textsearch.Index
+	loadedAt time.Time
+	now      func() time.Time
+}
+
+func NewDateSpotSearchIndex(db *gorm.DB) repository.DateSpotSearchIndex {
+	return &dateSpotSearchIndex{db: db, now: time.Now}
+}
+
+func (r *dateSpotSearchIndex) Search(ctx context.Context, query string, limit int) ([]repository.DateSpotSearchHit, error) {
+	index, err := r.current(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hits := index.Search(query, limit)
+	return lo.Map(hits, func(h textsearch.Hit, _ int) repository.DateSpotSearchHit {
+		return repository.DateSpotSearchHit{DateSpotID: h.ID, Score: h.Score}
+	}), nil
+}
+
+func (r *dateSpotSearchIndex) Put(ctx context.Context, dateSpot *model.DateSpot) error {
+	index, err := r.current(ctx)
+	if err != nil {
+		return err
+	}
+	index.Put(dateSpot.ID, dateSpotSearchFields(dateSpot)...)
+	return nil
+}
+
+func (r *dateSpotSearchIndex) Remove(ctx context.Context, id uint) error {
+	index, err := r.current(ctx)
+	if err != nil {
+		return err
+	}
+	index.Remove(id)
+	return nil
+}
+
+// current は索引を返します。まだ作っていないか古くなっていれば DB から作り直します。
+// 作り直しに失敗したときは、古い索引があればそれを使い続けます。
+func (r *dateSpotSearchIndex) current(ctx context.Context) (*textsearch.Index, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.index != nil && r.now().Sub(r.loadedAt) < dateSpotSearchIndexTTL {
+		return r.index, nil
+	}
+	index, err := r.load(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "dateSpotSearchIndex.load failed", "err", err)
+		if r.index != nil {
+			return r.index, nil
+		}
+		return nil, err
+	}
+	r.index, r.loadedAt = index, r.now()
+	slog.InfoContext(ctx, "dateSpotSearchIndex.load succeeded", "count", index.Len())
+	return r.index, nil
+}
+
+func (r *dateSpotSearchIndex) load(ctx context.Context) (*textsearch.Index, error) {
+	index := textsearch.NewIndex(dateSpotSearchWeights...)
+	var batch []*model.DateSpot
+	err := r.db.WithContext(ctx).
+		Model(&model.DateSpot{}).
+		Select("id", "name", "city_name", "genre_id").
+		Where("closed_at IS NULL").
+		FindInBatches(&batch, dateSpotSearchBatchSize, func(_ *gorm.DB, _ int) error {
+			for _, ds := range batch {
+				index.Put(ds.ID, dateSpotSearchFields(ds)...)
+			}
+			return nil
+		}).Error
+	if err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+// dateSpotSearchFields は dateSpotSearchWeights と同じ順に索引するフィールドを並べます。
+func dateSpotSearchFields(ds *model.DateSpot) []string {
+	return []string{ds.Name, master.GenreNameByID(lo.FromPtr(ds.GenreID)), ds.CityName}
+}
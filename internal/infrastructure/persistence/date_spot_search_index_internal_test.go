@@ -0,0 +1,74 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestDateSpotSearchIndex(t *testing.T) {
+	ctx := context.Background()
+
+	// newIndex は DB からの読み込み回数を数えられる索引と、時計を進める関数を返します。
+	newIndex := func(t *testing.T) (*dateSpotSearchIndex, *int, func(time.Duration)) {
+		db, _ := newDryRunDBForDelete(t)
+		loads := 0
+		require.NoError(t, db.Callback().Query().After("gorm:query").Register("test:count_query", func(_ *gorm.DB) {
+			loads++
+		}))
+		clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		index := NewDateSpotSearchIndex(db).(*dateSpotSearchIndex)
+		index.now = func() time.Time { return clock }
+		return index, &loads, func(d time.Duration) { clock = clock.Add(d) }
+	}
+
+	t.Run("loads_once_within_ttl", func(t *testing.T) {
+		index, loads, advance := newIndex(t)
+
+		_, _ = index.Search(ctx, "東京", 10)
+		advance(dateSpotSearchIndexTTL - time.Second)
+		_, _ = index.Search(ctx, "東京", 10)
+
+		assert.Equal(t, 1, *loads)
+	})
+
+	t.Run("reloads_after_ttl", func(t *testing.T) {
+		index, loads, advance := newIndex(t)
+
+		_, _ = index.Search(ctx, "東京", 10)
+		advance(dateSpotSearchIndexTTL)
+		_, _ = index.Search(ctx, "東京", 10)
+
+		assert.Equal(t, 2, *loads)
+	})
+
+	// 同じプロセスでの登録・削除は作り直しを待たずに検索へ反映する
+	t.Run("put_and_remove_apply_immediately", func(t *testing.T) {
+		index, _, _ := newIndex(t)
+
+		require.NoError(t, index.Put(ctx, &model.DateSpot{ID: 7, Name: "東京タワー", CityName: "東京都港区", GenreID: lo.ToPtr(1)}))
+		hits, err := index.Search(ctx, "とうきょう たわー", 10)
+		require.NoError(t, err)
+		assert.Empty(t, hits, "漢字の読みまでは同一視しない")
+
+		hits, err = index.Search(ctx, "ﾀﾜｰ", 10)
+		require.NoError(t, err)
+		require.Len(t, hits, 1)
+		assert.Equal(t, uint(7), hits[0].DateSpotID)
+
+		hits, err = index.Search(ctx, "居酒屋", 10)
+		require.NoError(t, err)
+		assert.Len(t, hits, 1, "ジャンル名でも引ける")
+
+		require.NoError(t, index.Remove(ctx, 7))
+		hits, err = index.Search(ctx, "ﾀﾜｰ", 10)
+		require.NoError(t, err)
+		assert.Empty(t, hits)
+	})
+}
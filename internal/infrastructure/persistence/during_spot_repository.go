@@ -18,7 +18,7 @@ func NewDuringSpotRepository(db *gorm.DB) repository.DuringSpotRepository {
 }
 
 func (r *duringSpotRepository) Create(ctx context.Context, duringSpot *model.DuringSpot) error {
-	if err := r.db.WithContext(ctx).Create(duringSpot).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Create(duringSpot).Error; err != nil {
 		slog.ErrorContext(ctx, "duringSpotRepository.Create failed", "err", err)
 		return err
 	}
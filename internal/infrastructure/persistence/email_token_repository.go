@@ -0,0 +1,61 @@
+package persistence
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type emailTokenRepository struct {
+	db *gorm.DB
+}
+
+func NewEmailTokenRepository(db *gorm.DB) repository.EmailTokenRepository {
+	return &emailTokenRepository{db: db}
+}
+
+func (r *emailTokenRepository) Create(ctx context.Context, token *model.EmailToken) error {
+	if err := dbFromContext(ctx, r.db).Create(token).Error; err != nil {
+		slog.ErrorContext(ctx, "emailTokenRepository.Create failed", "err", err)
+		return err
+	}
+	slog.InfoContext(ctx, "emailTokenRepository.Create succeeded", "email_token_id", token.ID, "user_id", token.UserID, "purpose", token.Purpose)
+	return nil
+}
+
+func (r *emailTokenRepository) FindByHash(ctx context.Context, purpose model.EmailTokenPurpose, tokenHash string) (*model.EmailToken, error) {
+	var token model.EmailToken
+	if err := dbFromContext(ctx, r.db).Where("purpose = ? AND token_hash = ?", purpose, tokenHash).First(&token).Error; err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *emailTokenRepository) MarkUsed(ctx context.Context, id uint, at time.Time) (bool, error) {
+	result := dbFromContext(ctx, r.db).
+		Model(&model.EmailToken{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", at)
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "emailTokenRepository.MarkUsed failed", "err", result.Error)
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *emailTokenRepository) InvalidateByUserID(ctx context.Context, userID uint, purpose model.EmailTokenPurpose, at time.Time) error {
+	result := dbFromContext(ctx, r.db).
+		Model(&model.EmailToken{}).
+		Where("user_id = ? AND purpose = ? AND used_at IS NULL", userID, purpose).
+		Update("used_at", at)
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "emailTokenRepository.InvalidateByUserID failed", "err", result.Error)
+		return result.Error
+	}
+	slog.InfoContext(ctx, "emailTokenRepository.InvalidateByUserID succeeded", "user_id", userID, "purpose", purpose, "count", result.RowsAffected)
+	return nil
+}
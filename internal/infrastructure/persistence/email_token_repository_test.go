@@ -0,0 +1,36 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/persistence"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmailTokenRepository_MarkUsed(t *testing.T) {
+	// 同じリンクが同時に開かれても1回だけ成功させるため、未使用のものだけを更新する
+	t.Run("updates_only_unused_token", func(t *testing.T) {
+		db, captured := newDryRunDBForUpdate(t)
+		repo := persistence.NewEmailTokenRepository(db)
+
+		_, _ = repo.MarkUsed(context.Background(), 3, time.Now())
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "UPDATE `email_tokens` SET `used_at`=?")
+		assert.Contains(t, sql, "id = ? AND used_at IS NULL")
+	})
+}
+
+func TestEmailTokenRepository_InvalidateByUserID(t *testing.T) {
+	t.Run("updates_unused_tokens_of_same_purpose", func(t *testing.T) {
+		db, captured := newDryRunDBForUpdate(t)
+		repo := persistence.NewEmailTokenRepository(db)
+
+		_ = repo.InvalidateByUserID(context.Background(), 5, model.EmailTokenPurposeResetPassword, time.Now())
+
+		assert.Contains(t, issuedSQL(captured), "user_id = ? AND purpose = ? AND used_at IS NULL")
+	})
+}
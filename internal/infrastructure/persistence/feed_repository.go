@@ -0,0 +1,158 @@
+package persistence
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+type feedRepository struct {
+	db *gorm.DB
+}
+
+func NewFeedRepository(db *gorm.DB) repository.FeedRepository {
+	return &feedRepository{db: db}
+}
+
+// feedRow は3つのテーブルを UNION ALL した activities の1行です。
+// SubjectID はコース・レビュー・フォローされたユーザーの ID で、ActorID は出来事を起こしたユーザーの ID です。
+// ActivityKey はテーブルをまたいで一意になるよう、元の行の ID を種類ごとにずらした値で、
+// 作成日時が同じ行の順序とカーソルの ID に使います。
+type feedRow struct {
+	ActivityType model.ActivityType
+	SubjectID    uint
+	ActorID      uint
+	CreatedAt    time.Time
+	ActivityKey  uint
+}
+
+// feedOrder はフィードの並び順です。新しい順だけに対応します。
+var feedOrder = keysetOrder{key: "activities.created_at", id: "activities.activity_key", desc: true}
+
+// Search はフォロー中のユーザーの出来事を1ページ分読み、種類ごとにまとめて本体を読み込みます。
+// 1ページ目を読んでから本体を読むまでに削除・非公開になった出来事はページから除くため、
+// ページの件数が limit より少なくなることがあります。
+func (r *feedRepository) Search(ctx context.Context, params repository.FeedSearchParams) (pagination.Page[*model.Activity], error) {
+	db := dbFromContext(ctx, r.db)
+
+	// ミュートした相手はフォローしたままでもフィードに出さない
+	followees := db.Model(&model.Relationship{}).
+		Select("follow_id").
+		Where("user_id = ?", params.UserID).
+		Scopes(excludeHiddenUsers("follow_id", params.UserID))
+	courses := db.Model(&model.Course{}).
+		Select("? AS activity_type, courses.id AS subject_id, courses.user_id AS actor_id, courses.created_at, courses.id * 3 AS activity_key", model.ActivityTypeCourseCreated).
+		Where("courses.user_id IN (?)", followees).
+		Where("courses.authority = ?", model.CourseAuthorityPublic)
+	reviews := db.Model(&model.DateSpotReview{}).
+		Select("? AS activity_type, date_spot_reviews.id AS subject_id, date_spot_reviews.user_id AS actor_id, date_spot_reviews.created_at, date_spot_reviews.id * 3 + 1 AS activity_key", model.ActivityTypeReviewCreated).
+		Where("date_spot_reviews.user_id IN (?)", followees).
+		Where("date_spot_reviews.status IN ?", model.VisibleReviewStatuses)
+	// フォロー・フォロワー一覧と同じく、管理者をフォローした出来事は出さない
+	follows := db.Model(&model.Relationship{}).
+		Select("? AS activity_type, relationships.follow_id AS subject_id, relationships.user_id AS actor_id, relationships.created_at, relationships.id * 3 + 2 AS activity_key", model.ActivityTypeUserFollowed).
+		Joins("JOIN users ON users.id = relationships.follow_id").
+		Where("relationships.user_id IN (?)", followees).
+		Where("users.admin = false").
+		Scopes(excludeHiddenUsers("relationships.follow_id", params.UserID))
+
+	query := db.Table("(? UNION ALL ? UNION ALL ?) AS activities", courses, reviews, follows)
+	query = feedOrder.paginate(query, params.Page)
+
+	var rows []*feedRow
+	if err := query.Find(&rows).Error; err != nil {
+		slog.ErrorContext(ctx, "feedRepository.Search failed", "err", err)
+		return pagination.Page[*model.Activity]{}, err
+	}
+
+	page := pagination.NewPage(rows, params.Page, func(row *feedRow) pagination.Cursor {
+		return pagination.NewTimeCursor(pagination.SortNewest, row.CreatedAt, row.ActivityKey)
+	})
+	activities, err := r.loadActivities(ctx, page.Items)
+	if err != nil {
+		return pagination.Page[*model.Activity]{}, err
+	}
+	return pagination.Page[*model.Activity]{Items: activities, NextCursor: page.NextCursor}, nil
+}
+
+// loadActivities は rows の本体を種類ごとに1回ずつ読み込み、rows の順に Activity を組み立てます。
+func (r *feedRepository) loadActivities(ctx context.Context, rows []*feedRow) ([]*model.Activity, error) {
+	db := dbFromContext(ctx, r.db)
+
+	subjectIDs := func(t model.ActivityType) []uint {
+		return lo.FilterMap(rows, func(row *feedRow, _ int) (uint, bool) {
+			return row.SubjectID, row.ActivityType == t
+		})
+	}
+	courseIDs := subjectIDs(model.ActivityTypeCourseCreated)
+	reviewIDs := subjectIDs(model.ActivityTypeReviewCreated)
+	userIDs := lo.Uniq(append(
+		lo.Map(rows, func(row *feedRow, _ int) uint { return row.ActorID }),
+		subjectIDs(model.ActivityTypeUserFollowed)...,
+	))
+
+	var courses []*model.Course
+	if len(courseIDs) > 0 {
+		// 読み込むまでの間に非公開になったコースも出さない
+		if err := db.
+			Where("id IN ? AND authority = ?", courseIDs, model.CourseAuthorityPublic).
+			Preload("User").
+			Preload("DuringSpots", orderDuringSpots).
+			Preload("DuringSpots.DateSpot").
+			Find(&courses).Error; err != nil {
+			slog.ErrorContext(ctx, "feedRepository.loadActivities failed", "err", err)
+			return nil, err
+		}
+	}
+
+	var reviews []*model.DateSpotReview
+	if len(reviewIDs) > 0 {
+		if err := db.
+			Where("id IN ? AND status IN ?", reviewIDs, model.VisibleReviewStatuses).
+			Preload("DateSpot").
+			Find(&reviews).Error; err != nil {
+			slog.ErrorContext(ctx, "feedRepository.loadActivities failed", "err", err)
+			return nil, err
+		}
+	}
+
+	var users []*model.User
+	if len(userIDs) > 0 {
+		if err := db.Where("id IN ?", userIDs).Find(&users).Error; err != nil {
+			slog.ErrorContext(ctx, "feedRepository.loadActivities failed", "err", err)
+			return nil, err
+		}
+	}
+
+	courseByID := lo.KeyBy(courses, func(c *model.Course) uint { return c.ID })
+	reviewByID := lo.KeyBy(reviews, func(r *model.DateSpotReview) uint { return r.ID })
+	userByID := lo.KeyBy(users, func(u *model.User) uint { return u.ID })
+
+	activities := make([]*model.Activity, 0, len(rows))
+	for _, row := range rows {
+		actor, ok := userByID[row.ActorID]
+		if !ok {
+			continue
+		}
+		activity := &model.Activity{Type: row.ActivityType, CreatedAt: row.CreatedAt, Actor: actor}
+		switch row.ActivityType {
+		case model.ActivityTypeCourseCreated:
+			activity.Course, ok = courseByID[row.SubjectID]
+		case model.ActivityTypeReviewCreated:
+			activity.DateSpotReview, ok = reviewByID[row.SubjectID]
+		case model.ActivityTypeUserFollowed:
+			activity.FollowedUser, ok = userByID[row.SubjectID]
+		}
+		if !ok {
+			continue
+		}
+		activities = append(activities, activity)
+	}
+	return activities, nil
+}
@@ -0,0 +1,70 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/persistence"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedRepository_Search(t *testing.T) {
+	ctx := context.Background()
+
+	// 3種類の出来事を1回のクエリで新しい順に並べ、非公開のコースは含めない
+	t.Run("merges_public_courses_reviews_and_follows_of_followees", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewFeedRepository(db)
+
+		_, err := repo.Search(ctx, repository.FeedSearchParams{
+			UserID: 1,
+			Page:   pagination.Params{Limit: 20},
+		})
+
+		require.NoError(t, err)
+		// 部分クエリも組み立て時に記録されるため、最後に発行した本体のクエリを見る
+		require.NotEmpty(t, *captured)
+		sql := (*captured)[len(*captured)-1]
+		assert.Contains(t, sql, "UNION ALL")
+		assert.Contains(t, sql, "courses.user_id IN (SELECT `follow_id` FROM `relationships` WHERE user_id = ?")
+		assert.Contains(t, sql, "courses.authority = ?")
+		assert.Contains(t, sql, "date_spot_reviews.status IN (?,?)")
+		assert.Contains(t, sql, "users.admin = false")
+		assert.Contains(t, sql, "ORDER BY activities.created_at DESC,activities.activity_key DESC")
+		assert.Contains(t, sql, "LIMIT ?")
+	})
+
+	// ミュートした相手はフォロー中でも出さず、ブロック・ミュートした相手がフォローされた出来事も出さない
+	t.Run("excludes_hidden_users", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewFeedRepository(db)
+
+		_, _ = repo.Search(ctx, repository.FeedSearchParams{
+			UserID: 1,
+			Page:   pagination.Params{Limit: 20},
+		})
+
+		require.NotEmpty(t, *captured)
+		sql := (*captured)[len(*captured)-1]
+		assert.Contains(t, sql, "WHERE user_id = ? AND follow_id NOT IN (SELECT blocked_user_id FROM user_blocks")
+		assert.Contains(t, sql, "relationships.follow_id NOT IN (SELECT blocked_user_id FROM user_blocks")
+	})
+
+	t.Run("cursor_pages_by_created_at_and_activity_key", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewFeedRepository(db)
+		cursor := pagination.NewTimeCursor(pagination.SortNewest, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), 31)
+
+		_, _ = repo.Search(ctx, repository.FeedSearchParams{
+			UserID: 1,
+			Page:   pagination.Params{Limit: 20, Cursor: &cursor},
+		})
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "(activities.created_at < ? OR (activities.created_at = ? AND activities.activity_key < ?))")
+	})
+}
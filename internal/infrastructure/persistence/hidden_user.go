@@ -0,0 +1,23 @@
+package persistence
+
+import "gorm.io/gorm"
+
+// hiddenUserIDsSQL は viewer から見えないユーザーの ID を返すサブクエリです。
+// ブロックは双方向に効くため、viewer がブロックした相手と viewer をブロックした相手の両方を含めます。
+// ミュートは viewer が相手に気付かれずに一覧から外すためのものなので、viewer がミュートした相手だけです。
+const hiddenUserIDsSQL = "SELECT blocked_user_id FROM user_blocks WHERE user_id = ?" +
+	" UNION SELECT user_id FROM user_blocks WHERE blocked_user_id = ?" +
+	" UNION SELECT muted_user_id FROM user_mutes WHERE user_id = ?"
+
+// excludeHiddenUsers は column が viewerID から見えないユーザーを指す行を除く GORM スコープです。
+// viewerID が 0（未ログイン）のときはブロック・ミュートがないため何もしません。
+func excludeHiddenUsers(column string, viewerID uint) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if viewerID == 0 {
+			return db
+		}
+		// 絞り込み中の条件をサブクエリに持ち込まないよう、同じ接続（トランザクション）の新しい文から作る
+		hidden := db.Session(&gorm.Session{NewDB: true}).Raw(hiddenUserIDsSQL, viewerID, viewerID, viewerID)
+		return db.Where(column+" NOT IN (?)", hidden)
+	}
+}
@@ -0,0 +1,46 @@
+package persistence
+
+import (
+	"context"
+	"log/slog"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"gorm.io/gorm"
+)
+
+type loginHistoryRepository struct {
+	db *gorm.DB
+}
+
+func NewLoginHistoryRepository(db *gorm.DB) repository.LoginHistoryRepository {
+	return &loginHistoryRepository{db: db}
+}
+
+func (r *loginHistoryRepository) Create(ctx context.Context, history *model.LoginHistory) error {
+	if err := dbFromContext(ctx, r.db).Create(history).Error; err != nil {
+		slog.ErrorContext(ctx, "loginHistoryRepository.Create failed", "err", err)
+		return err
+	}
+	return nil
+}
+
+// loginHistoryOrder はログイン履歴の並び順です。新しい順だけに対応します。
+var loginHistoryOrder = keysetOrder{key: "login_histories.created_at", id: "login_histories.id", desc: true}
+
+func (r *loginHistoryRepository) SearchByUserID(ctx context.Context, params repository.LoginHistorySearchParams) (pagination.Page[*model.LoginHistory], error) {
+	db := dbFromContext(ctx, r.db).
+		Model(&model.LoginHistory{}).
+		Where("login_histories.user_id = ?", params.UserID)
+	db = loginHistoryOrder.paginate(db, params.Page)
+
+	var histories []*model.LoginHistory
+	if err := db.Find(&histories).Error; err != nil {
+		slog.ErrorContext(ctx, "loginHistoryRepository.SearchByUserID failed", "err", err)
+		return pagination.Page[*model.LoginHistory]{}, err
+	}
+	return pagination.NewPage(histories, params.Page, func(h *model.LoginHistory) pagination.Cursor {
+		return pagination.NewTimeCursor(pagination.SortNewest, h.CreatedAt, h.ID)
+	}), nil
+}
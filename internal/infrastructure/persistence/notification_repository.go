@@ -0,0 +1,79 @@
+package persistence
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) repository.NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+func (r *notificationRepository) CreateBatch(ctx context.Context, notifications []*model.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	// outbox のイベントは配り直すことがあるため、同じイベントから作った通知は一意キーで弾いて2件目を作らない
+	if err := dbFromContext(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(&notifications).Error; err != nil {
+		slog.ErrorContext(ctx, "notificationRepository.CreateBatch failed", "err", err)
+		return err
+	}
+	return nil
+}
+
+// notificationOrder は通知一覧の並び順です。新しい順だけに対応します。
+var notificationOrder = keysetOrder{key: "notifications.created_at", id: "notifications.id", desc: true}
+
+func (r *notificationRepository) Search(ctx context.Context, params repository.NotificationSearchParams) (pagination.Page[*model.Notification], error) {
+	db := dbFromContext(ctx, r.db).
+		Model(&model.Notification{}).
+		Where("notifications.user_id = ?", params.UserID).
+		Preload("Actor")
+	db = notificationOrder.paginate(db, params.Page)
+
+	var notifications []*model.Notification
+	if err := db.Find(&notifications).Error; err != nil {
+		slog.ErrorContext(ctx, "notificationRepository.Search failed", "err", err)
+		return pagination.Page[*model.Notification]{}, err
+	}
+	return pagination.NewPage(notifications, params.Page, func(n *model.Notification) pagination.Cursor {
+		return pagination.NewTimeCursor(pagination.SortNewest, n.CreatedAt, n.ID)
+	}), nil
+}
+
+func (r *notificationRepository) CountUnread(ctx context.Context, userID uint) (int, error) {
+	var count int64
+	if err := dbFromContext(ctx, r.db).
+		Model(&model.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Count(&count).Error; err != nil {
+		slog.ErrorContext(ctx, "notificationRepository.CountUnread failed", "err", err)
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (r *notificationRepository) MarkRead(ctx context.Context, userID uint, ids []uint, readAt time.Time) error {
+	db := dbFromContext(ctx, r.db).
+		Model(&model.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID)
+	if len(ids) > 0 {
+		db = db.Where("id IN ?", ids)
+	}
+	if err := db.Update("read_at", readAt).Error; err != nil {
+		slog.ErrorContext(ctx, "notificationRepository.MarkRead failed", "err", err)
+		return err
+	}
+	return nil
+}
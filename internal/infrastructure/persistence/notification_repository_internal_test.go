@@ -0,0 +1,39 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationRepository_MarkRead(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// ID を指定しても本人宛ての通知に絞り、他のユーザーの通知は既読にしない
+	t.Run("marks_given_ids_of_the_user", func(t *testing.T) {
+		db, captured := newDryRunDBForUpdate(t)
+		repo := NewNotificationRepository(db)
+
+		require.NoError(t, repo.MarkRead(ctx, 1, []uint{3, 4}, now))
+
+		require.Len(t, *captured, 1)
+		sql := (*captured)[0]
+		assert.Contains(t, sql, "UPDATE `notifications` SET `read_at`=?")
+		assert.Contains(t, sql, "user_id = ? AND read_at IS NULL")
+		assert.Contains(t, sql, "id IN (?,?)")
+	})
+
+	t.Run("marks_all_unread_without_ids", func(t *testing.T) {
+		db, captured := newDryRunDBForUpdate(t)
+		repo := NewNotificationRepository(db)
+
+		require.NoError(t, repo.MarkRead(ctx, 1, nil, now))
+
+		require.Len(t, *captured, 1)
+		assert.NotContains(t, (*captured)[0], "id IN")
+	})
+}
@@ -0,0 +1,70 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/persistence"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestNotificationRepository_CreateBatch(t *testing.T) {
+	// 配り直しで同じイベントの通知が来ても、一意キーにぶつかった行は作らずに進める
+	t.Run("ignores_duplicated_outbox_event", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		require.NoError(t, db.Callback().Create().After("gorm:create").Register("test:capture_create", func(d *gorm.DB) {
+			*captured = append(*captured, d.Statement.SQL.String())
+		}))
+		// 実際には接続しないため、INSERT を囲むトランザクションを張らない
+		repo := persistence.NewNotificationRepository(db.Session(&gorm.Session{SkipDefaultTransaction: true}))
+
+		_ = repo.CreateBatch(context.Background(), []*model.Notification{
+			{UserID: 2, ActorID: 1, Type: model.NotificationTypeFollowed, OutboxEventID: lo.ToPtr(uint(10))},
+		})
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "INSERT INTO `notifications`")
+		assert.Contains(t, sql, "`outbox_event_id`")
+		assert.Contains(t, sql, "ON DUPLICATE KEY UPDATE")
+	})
+}
+
+func TestNotificationRepository_Search(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns_own_notifications_newest_first", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewNotificationRepository(db)
+		cursor := pagination.NewTimeCursor(pagination.SortNewest, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), 5)
+
+		_, _ = repo.Search(ctx, repository.NotificationSearchParams{
+			UserID: 1,
+			Page:   pagination.Params{Limit: 20, Cursor: &cursor},
+		})
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "notifications.user_id = ?")
+		assert.Contains(t, sql, "(notifications.created_at < ? OR (notifications.created_at = ? AND notifications.id < ?))")
+		assert.Contains(t, sql, "ORDER BY notifications.created_at DESC,notifications.id DESC")
+	})
+}
+
+func TestNotificationRepository_CountUnread(t *testing.T) {
+	t.Run("counts_only_unread", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewNotificationRepository(db)
+
+		_, _ = repo.CountUnread(context.Background(), 1)
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "SELECT count(*) FROM `notifications`")
+		assert.Contains(t, sql, "user_id = ? AND read_at IS NULL")
+	})
+}
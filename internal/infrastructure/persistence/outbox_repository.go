@@ -0,0 +1,96 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type outboxRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) repository.OutboxRepository {
+	return &outboxRepository{db: db}
+}
+
+// Publish は events を JSON にして outbox_events に追記します。
+// ctx にトランザクションが載っていればそこに参加するため、発行元の書き込みと一緒にコミット・ロールバックされます。
+func (r *outboxRepository) Publish(ctx context.Context, events ...event.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	now := time.Now()
+	rows := make([]*model.OutboxEvent, 0, len(events))
+	for _, e := range events {
+		payload, err := json.Marshal(e)
+		if err != nil {
+			slog.ErrorContext(ctx, "outboxRepository.Publish failed", "event", e.Name(), "err", err)
+			return err
+		}
+		rows = append(rows, &model.OutboxEvent{
+			Name:          e.Name(),
+			Payload:       payload,
+			Status:        model.OutboxEventStatusPending,
+			NextAttemptAt: now,
+		})
+	}
+	if err := dbFromContext(ctx, r.db).Create(&rows).Error; err != nil {
+		slog.ErrorContext(ctx, "outboxRepository.Publish failed", "err", err)
+		return err
+	}
+	return nil
+}
+
+func (r *outboxRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*model.OutboxEvent, error) {
+	var rows []*model.OutboxEvent
+	if err := dbFromContext(ctx, r.db).
+		Where("status = ? AND next_attempt_at <= ?", model.OutboxEventStatusPending, now).
+		Order("id").
+		Limit(limit).
+		Find(&rows).Error; err != nil {
+		slog.ErrorContext(ctx, "outboxRepository.FindDue failed", "err", err)
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (r *outboxRepository) MarkDelivered(ctx context.Context, id uint, deliveredAt time.Time) error {
+	return r.update(ctx, "outboxRepository.MarkDelivered", id, map[string]any{
+		"status":       model.OutboxEventStatusDelivered,
+		"delivered_at": deliveredAt,
+	})
+}
+
+func (r *outboxRepository) MarkRetry(ctx context.Context, id uint, attempts uint, nextAttemptAt time.Time, lastError string) error {
+	return r.update(ctx, "outboxRepository.MarkRetry", id, map[string]any{
+		"attempts":        attempts,
+		"next_attempt_at": nextAttemptAt,
+		"last_error":      lastError,
+	})
+}
+
+func (r *outboxRepository) MarkDead(ctx context.Context, id uint, attempts uint, lastError string) error {
+	return r.update(ctx, "outboxRepository.MarkDead", id, map[string]any{
+		"status":     model.OutboxEventStatusDead,
+		"attempts":   attempts,
+		"last_error": lastError,
+	})
+}
+
+func (r *outboxRepository) update(ctx context.Context, op string, id uint, values map[string]any) error {
+	if err := dbFromContext(ctx, r.db).
+		Model(&model.OutboxEvent{}).
+		Where("id = ?", id).
+		Updates(values).Error; err != nil {
+		slog.ErrorContext(ctx, op+" failed", "id", id, "err", err)
+		return err
+	}
+	return nil
+}
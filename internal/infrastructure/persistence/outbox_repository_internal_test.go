@@ -0,0 +1,76 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxRepository_Publish(t *testing.T) {
+	ctx := context.Background()
+
+	// 複数のイベントも1回の INSERT で書き込む
+	t.Run("inserts_events_as_pending_rows", func(t *testing.T) {
+		db, captured := newDryRunDBForUpdate(t)
+		repo := NewOutboxRepository(db)
+
+		require.NoError(t, repo.Publish(ctx,
+			event.CourseCreated{CourseID: 1, UserID: 2},
+			event.UserFollowed{FollowerID: 2, FollowedUserID: 3},
+		))
+
+		require.Len(t, *captured, 1)
+		assert.Contains(t, (*captured)[0], "INSERT INTO `outbox_events`")
+	})
+
+	t.Run("does_nothing_without_events", func(t *testing.T) {
+		db, captured := newDryRunDBForUpdate(t)
+		repo := NewOutboxRepository(db)
+
+		require.NoError(t, repo.Publish(ctx))
+
+		assert.Empty(t, *captured)
+	})
+}
+
+func TestOutboxRepository_Mark(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("mark_delivered_updates_status", func(t *testing.T) {
+		db, captured := newDryRunDBForUpdate(t)
+		repo := NewOutboxRepository(db)
+
+		require.NoError(t, repo.MarkDelivered(ctx, 1, now))
+
+		require.Len(t, *captured, 1)
+		assert.Contains(t, (*captured)[0], "UPDATE `outbox_events` SET `delivered_at`=?,`status`=?")
+		assert.Contains(t, (*captured)[0], "id = ?")
+	})
+
+	// 配り直すイベントは pending のまま残すため、status は変えない
+	t.Run("mark_retry_keeps_status", func(t *testing.T) {
+		db, captured := newDryRunDBForUpdate(t)
+		repo := NewOutboxRepository(db)
+
+		require.NoError(t, repo.MarkRetry(ctx, 1, 2, now, "boom"))
+
+		require.Len(t, *captured, 1)
+		assert.Contains(t, (*captured)[0], "SET `attempts`=?,`last_error`=?,`next_attempt_at`=?")
+		assert.NotContains(t, (*captured)[0], "`status`")
+	})
+
+	t.Run("mark_dead_updates_status", func(t *testing.T) {
+		db, captured := newDryRunDBForUpdate(t)
+		repo := NewOutboxRepository(db)
+
+		require.NoError(t, repo.MarkDead(ctx, 1, 10, "boom"))
+
+		require.Len(t, *captured, 1)
+		assert.Contains(t, (*captured)[0], "SET `attempts`=?,`last_error`=?,`status`=?")
+	})
+}
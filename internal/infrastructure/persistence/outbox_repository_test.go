@@ -0,0 +1,28 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxRepository_FindDue(t *testing.T) {
+	// 配り直しを待っているイベントは next_attempt_at を過ぎるまで読まない
+	t.Run("reads_pending_events_due_by_now_in_id_order", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewOutboxRepository(db)
+
+		_, err := repo.FindDue(context.Background(), time.Now(), 50)
+		require.NoError(t, err)
+
+		require.Len(t, *captured, 1)
+		sql := (*captured)[0]
+		assert.Contains(t, sql, "FROM `outbox_events`")
+		assert.Contains(t, sql, "status = ? AND next_attempt_at <= ?")
+		assert.Contains(t, sql, "ORDER BY id LIMIT ?")
+	})
+}
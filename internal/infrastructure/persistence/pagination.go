@@ -0,0 +1,59 @@
+package persistence
+
+import (
+	"fmt"
+
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"gorm.io/gorm"
+)
+
+// keysetOrder は一覧の並び順を「並び替えキー + ID」の組で表します。
+// ID を第2キーにすることで、キーが同じ行でも順序が一意に決まり、カーソルで続きを引けます。
+type keysetOrder struct {
+	key  string
+	id   string
+	desc bool
+	// aggregate はキーが集計値や SELECT の別名のときに true にします。
+	// WHERE では参照できないため、カーソル条件を HAVING に置きます。
+	aggregate bool
+}
+
+// paginate は並び順・カーソル条件・取得件数を db に付けます。
+func (o keysetOrder) paginate(db *gorm.DB, page pagination.Params) *gorm.DB {
+	dir := "ASC"
+	op := ">"
+	if o.desc {
+		dir = "DESC"
+		op = "<"
+	}
+
+	if page.Cursor != nil {
+		value := cursorValue(page.Cursor)
+		cond := fmt.Sprintf("(%s %s ? OR (%s = ? AND %s %s ?))", o.key, op, o.key, o.id, op)
+		if o.aggregate {
+			db = db.Having(cond, value, value, page.Cursor.ID)
+		} else {
+			db = db.Where(cond, value, value, page.Cursor.ID)
+		}
+	}
+
+	db = db.Order(o.key + " " + dir).Order(o.id + " " + dir)
+	if limit := page.FetchLimit(); limit > 0 {
+		db = db.Limit(limit)
+	}
+	return db
+}
+
+// cursorValue はカーソルの並び替えキーを SQL に渡せる型で返します。
+func cursorValue(c *pagination.Cursor) any {
+	switch c.Sort {
+	case pagination.SortNewest, pagination.SortOldest:
+		return c.TimeValue()
+	case pagination.SortRating, pagination.SortDistance:
+		return c.FloatValue()
+	case pagination.SortReviewCount:
+		return c.IntValue()
+	default:
+		return c.Value
+	}
+}
@@ -0,0 +1,61 @@
+package persistence
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type refreshTokenRepository struct {
+	db *gorm.DB
+}
+
+func NewRefreshTokenRepository(db *gorm.DB) repository.RefreshTokenRepository {
+	return &refreshTokenRepository{db: db}
+}
+
+func (r *refreshTokenRepository) Create(ctx context.Context, token *model.RefreshToken) error {
+	if err := dbFromContext(ctx, r.db).Create(token).Error; err != nil {
+		slog.ErrorContext(ctx, "refreshTokenRepository.Create failed", "err", err)
+		return err
+	}
+	slog.InfoContext(ctx, "refreshTokenRepository.Create succeeded", "refresh_token_id", token.ID, "user_id", token.UserID)
+	return nil
+}
+
+func (r *refreshTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*model.RefreshToken, error) {
+	var token model.RefreshToken
+	if err := dbFromContext(ctx, r.db).Where("token_hash = ?", tokenHash).First(&token).Error; err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *refreshTokenRepository) Revoke(ctx context.Context, id uint, at time.Time) (bool, error) {
+	result := dbFromContext(ctx, r.db).
+		Model(&model.RefreshToken{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", at)
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "refreshTokenRepository.Revoke failed", "err", result.Error)
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *refreshTokenRepository) RevokeAllByUserID(ctx context.Context, userID uint, at time.Time) error {
+	result := dbFromContext(ctx, r.db).
+		Model(&model.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", at)
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "refreshTokenRepository.RevokeAllByUserID failed", "err", result.Error)
+		return result.Error
+	}
+	slog.InfoContext(ctx, "refreshTokenRepository.RevokeAllByUserID succeeded", "user_id", userID, "count", result.RowsAffected)
+	return nil
+}
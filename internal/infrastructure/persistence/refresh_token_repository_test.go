@@ -0,0 +1,49 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestRefreshTokenRepository_Revoke(t *testing.T) {
+	// 同じトークンで同時に再発行されても1回だけ成功させるため、未失効のものだけを更新する
+	t.Run("updates_only_unrevoked_token", func(t *testing.T) {
+		db, captured := newDryRunDBForUpdate(t)
+		repo := persistence.NewRefreshTokenRepository(db)
+
+		_, _ = repo.Revoke(context.Background(), 3, time.Now())
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "UPDATE `refresh_tokens` SET `revoked_at`=?")
+		assert.Contains(t, sql, "id = ? AND revoked_at IS NULL")
+	})
+}
+
+func TestRefreshTokenRepository_RevokeAllByUserID(t *testing.T) {
+	t.Run("updates_unrevoked_tokens_of_user", func(t *testing.T) {
+		db, captured := newDryRunDBForUpdate(t)
+		repo := persistence.NewRefreshTokenRepository(db)
+
+		_ = repo.RevokeAllByUserID(context.Background(), 5, time.Now())
+
+		assert.Contains(t, issuedSQL(captured), "user_id = ? AND revoked_at IS NULL")
+	})
+}
+
+// newDryRunDBForUpdate は newDryRunDB に UPDATE の記録を加えます。
+// GORM は UPDATE ごとに既定のトランザクションを張るため、実接続のない DryRun では無効にしておく。
+func newDryRunDBForUpdate(t *testing.T) (*gorm.DB, *[]string) {
+	t.Helper()
+
+	db, captured := newDryRunDB(t)
+	require.NoError(t, db.Callback().Update().After("gorm:update").Register("test:capture_update", func(d *gorm.DB) {
+		*captured = append(*captured, d.Statement.SQL.String())
+	}))
+	return db.Session(&gorm.Session{SkipDefaultTransaction: true}), captured
+}
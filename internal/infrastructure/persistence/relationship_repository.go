@@ -3,9 +3,12 @@ package persistence
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/samber/lo"
 	"gorm.io/gorm"
 )
 
@@ -17,18 +20,36 @@ func NewRelationshipRepository(db *gorm.DB) repository.RelationshipRepository {
 	return &relationshipRepository{db: db}
 }
 
+// blockBetweenSQL は2人のどちらかがもう一方をブロックしている行を探すサブクエリです。
+const blockBetweenSQL = "SELECT 1 FROM user_blocks" +
+	" WHERE (user_id = ? AND blocked_user_id = ?) OR (user_id = ? AND blocked_user_id = ?)"
+
+// Create はブロックがないことを確かめてから保存します。確認と保存は INSERT ... SELECT の1文で行うため relationship.ID は埋まりません。
+// 1文にしても並行して作られるブロックとは競合するため、呼び出し側は UserRepository.LockForUpdate で2人の行をロックしておきます。
 func (r *relationshipRepository) Create(ctx context.Context, relationship *model.Relationship) error {
-	if err := r.db.WithContext(ctx).Create(relationship).Error; err != nil {
-		slog.ErrorContext(ctx, "relationshipRepository.Create failed", "err", err)
-		return err
+	now := time.Now()
+	result := dbFromContext(ctx, r.db).Exec(
+		"INSERT INTO relationships (user_id, follow_id, created_at, updated_at)"+
+			" SELECT ?, ?, ?, ? FROM DUAL WHERE NOT EXISTS ("+blockBetweenSQL+")",
+		relationship.UserID, relationship.FollowID, now, now,
+		relationship.UserID, relationship.FollowID, relationship.FollowID, relationship.UserID,
+	)
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "relationshipRepository.Create failed", "err", result.Error)
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrBlocked
 	}
-	slog.InfoContext(ctx, "relationshipRepository.Create succeeded", "relationship_id", relationship.ID)
+	relationship.CreatedAt = now
+	relationship.UpdatedAt = now
+	slog.InfoContext(ctx, "relationshipRepository.Create succeeded", "user_id", relationship.UserID, "follow_id", relationship.FollowID)
 	return nil
 }
 
 // DeleteByUserIDs は user_id と follow_id の組み合わせに一致するレコードを削除します。
 func (r *relationshipRepository) DeleteByUserIDs(ctx context.Context, userID uint, followID uint) error {
-	if err := r.db.WithContext(ctx).
+	if err := dbFromContext(ctx, r.db).
 		Where("user_id = ? AND follow_id = ?", userID, followID).
 		Delete(&model.Relationship{}).Error; err != nil {
 		slog.ErrorContext(ctx, "relationshipRepository.DeleteByUserIDs failed", "err", err)
@@ -37,30 +58,81 @@ func (r *relationshipRepository) DeleteByUserIDs(ctx context.Context, userID uin
 	return nil
 }
 
+// followUser はフォロー・フォロワー一覧の1行です。
+// フォローした日時の順に並べるため、relationships の値も一緒に読みます。
+type followUser struct {
+	model.User
+	RelationshipID uint
+	FollowedAt     time.Time
+}
+
+// followOrders はフォロー・フォロワー一覧の並び順ごとの並び替えキーです。
+// newest はユーザーの登録日時ではなく、フォローした日時の新しい順です。
+var followOrders = map[pagination.Sort]keysetOrder{
+	pagination.SortNewest: {key: "relationships.created_at", id: "relationships.id", desc: true},
+	pagination.SortName:   {key: "users.name", id: "users.id"},
+}
+
+// followUserCursor は f の位置を指すカーソルを作ります。
+func followUserCursor(sort pagination.Sort) func(*followUser) pagination.Cursor {
+	return func(f *followUser) pagination.Cursor {
+		if sort == pagination.SortName {
+			return pagination.NewStringCursor(sort, f.Name, f.ID)
+		}
+		return pagination.NewTimeCursor(sort, f.FollowedAt, f.RelationshipID)
+	}
+}
+
+// findFollowUsers は relationships を joinOn で users と結合し、where に合うユーザーを1ページ分返します。
+func (r *relationshipRepository) findFollowUsers(ctx context.Context, joinOn, where string, userID uint, params repository.FollowSearchParams) (pagination.Page[*model.User], error) {
+	order, ok := followOrders[params.Sort]
+	if !ok {
+		params.Sort = pagination.SortNewest
+		order = followOrders[params.Sort]
+	}
+
+	db := dbFromContext(ctx, r.db).
+		Model(&model.User{}).
+		Select("users.*, relationships.id AS relationship_id, relationships.created_at AS followed_at").
+		Joins("JOIN relationships ON "+joinOn).
+		Where(where+" AND users.admin = false", userID).
+		Scopes(excludeHiddenUsers("users.id", params.ViewerID))
+	db = order.paginate(db, params.Page)
+
+	var rows []*followUser
+	if err := db.Find(&rows).Error; err != nil {
+		return pagination.Page[*model.User]{}, err
+	}
+
+	page := pagination.NewPage(rows, params.Page, followUserCursor(params.Sort))
+	return pagination.Page[*model.User]{
+		Items: lo.Map(page.Items, func(f *followUser, _ int) *model.User {
+			return &f.User
+		}),
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
 // FindFollowingsByUserID は指定ユーザーがフォローしているユーザー一覧（管理者除く）を返します。
+// 閲覧しているユーザーがブロック・ミュートしている相手は一覧に出しません。
 // Rails: user.followings.includes(...).non_admins に相当します。
-func (r *relationshipRepository) FindFollowingsByUserID(ctx context.Context, userID uint) ([]*model.User, error) {
-	var users []*model.User
-	if err := r.db.WithContext(ctx).
-		Joins("JOIN relationships ON relationships.follow_id = users.id").
-		Where("relationships.user_id = ? AND users.admin = false", userID).
-		Find(&users).Error; err != nil {
+func (r *relationshipRepository) FindFollowingsByUserID(ctx context.Context, userID uint, params repository.FollowSearchParams) (pagination.Page[*model.User], error) {
+	page, err := r.findFollowUsers(ctx, "relationships.follow_id = users.id", "relationships.user_id = ?", userID, params)
+	if err != nil {
 		slog.ErrorContext(ctx, "relationshipRepository.FindFollowingsByUserID failed", "err", err)
-		return nil, err
+		return pagination.Page[*model.User]{}, err
 	}
-	return users, nil
+	return page, nil
 }
 
 // FindFollowersByUserID は指定ユーザーをフォローしているユーザー一覧（管理者除く）を返します。
+// 閲覧しているユーザーがブロック・ミュートしている相手は一覧に出しません。
 // Rails: user.followers.includes(...).non_admins に相当します。
-func (r *relationshipRepository) FindFollowersByUserID(ctx context.Context, userID uint) ([]*model.User, error) {
-	var users []*model.User
-	if err := r.db.WithContext(ctx).
-		Joins("JOIN relationships ON relationships.user_id = users.id").
-		Where("relationships.follow_id = ? AND users.admin = false", userID).
-		Find(&users).Error; err != nil {
+func (r *relationshipRepository) FindFollowersByUserID(ctx context.Context, userID uint, params repository.FollowSearchParams) (pagination.Page[*model.User], error) {
+	page, err := r.findFollowUsers(ctx, "relationships.user_id = users.id", "relationships.follow_id = ?", userID, params)
+	if err != nil {
 		slog.ErrorContext(ctx, "relationshipRepository.FindFollowersByUserID failed", "err", err)
-		return nil, err
+		return pagination.Page[*model.User]{}, err
 	}
-	return users, nil
+	return page, nil
 }
@@ -0,0 +1,87 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/persistence"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelationshipRepository_Create(t *testing.T) {
+	ctx := context.Background()
+
+	// ブロックの確認と保存の間に隙間ができないよう、1文の INSERT ... SELECT で保存する
+	t.Run("inserts_only_without_block_in_either_direction", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewRelationshipRepository(db)
+
+		_ = repo.Create(ctx, &model.Relationship{UserID: 1, FollowID: 2})
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "INSERT INTO relationships (user_id, follow_id, created_at, updated_at) SELECT ?, ?, ?, ? FROM DUAL WHERE NOT EXISTS")
+		assert.Contains(t, sql, "(user_id = ? AND blocked_user_id = ?) OR (user_id = ? AND blocked_user_id = ?)")
+	})
+
+	// 行が書き込まれなかったときはブロックされている
+	t.Run("returns_err_blocked_when_no_row_inserted", func(t *testing.T) {
+		db, _ := newDryRunDB(t)
+		repo := persistence.NewRelationshipRepository(db)
+
+		err := repo.Create(ctx, &model.Relationship{UserID: 1, FollowID: 2})
+
+		assert.ErrorIs(t, err, repository.ErrBlocked)
+	})
+}
+
+func TestRelationshipRepository_FindFollowersByUserID(t *testing.T) {
+	ctx := context.Background()
+
+	// newest はユーザーの登録日時ではなく、フォローした日時の新しい順
+	t.Run("newest_orders_by_follow_time", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewRelationshipRepository(db)
+
+		_, _ = repo.FindFollowersByUserID(ctx, 1, repository.FollowSearchParams{
+			Sort: pagination.SortNewest,
+			Page: pagination.Params{Limit: 20},
+		})
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "JOIN relationships ON relationships.user_id = users.id")
+		assert.Contains(t, sql, "relationships.follow_id = ? AND users.admin = false")
+		assert.Contains(t, sql, "ORDER BY relationships.created_at DESC,relationships.id DESC")
+		assert.Contains(t, sql, "LIMIT ?")
+	})
+
+	t.Run("name_cursor_pages_by_user_name", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewRelationshipRepository(db)
+		cursor := pagination.NewStringCursor(pagination.SortName, "たろう", 3)
+
+		_, _ = repo.FindFollowingsByUserID(ctx, 1, repository.FollowSearchParams{
+			Sort: pagination.SortName,
+			Page: pagination.Params{Limit: 20, Cursor: &cursor},
+		})
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "JOIN relationships ON relationships.follow_id = users.id")
+		assert.Contains(t, sql, "(users.name > ? OR (users.name = ? AND users.id > ?))")
+		assert.Contains(t, sql, "ORDER BY users.name ASC,users.id ASC")
+	})
+
+	t.Run("excludes_users_hidden_from_viewer", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewRelationshipRepository(db)
+
+		_, _ = repo.FindFollowersByUserID(ctx, 1, repository.FollowSearchParams{
+			Page:     pagination.Params{Limit: 20},
+			ViewerID: 9,
+		})
+
+		assert.Contains(t, issuedSQL(captured), "users.id NOT IN (SELECT blocked_user_id FROM user_blocks WHERE user_id = ?")
+	})
+}
@@ -0,0 +1,49 @@
+package persistence
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type spotRefreshRepository struct {
+	db *gorm.DB
+}
+
+func NewSpotRefreshRepository(db *gorm.DB) repository.SpotRefreshRepository {
+	return &spotRefreshRepository{db: db}
+}
+
+func (r *spotRefreshRepository) CreateRun(ctx context.Context, run *model.SpotRefreshRun) error {
+	if err := dbFromContext(ctx, r.db).Create(run).Error; err != nil {
+		slog.ErrorContext(ctx, "spotRefreshRepository.CreateRun failed", "err", err)
+		return apperror.InternalServerError(err)
+	}
+	return nil
+}
+
+func (r *spotRefreshRepository) FinishRun(ctx context.Context, run *model.SpotRefreshRun) error {
+	if err := dbFromContext(ctx, r.db).
+		Model(run).
+		Select("finished_at", "checked_count", "updated_count", "missing_count", "closed_count", "reopened_count", "failed_count").
+		Updates(run).Error; err != nil {
+		slog.ErrorContext(ctx, "spotRefreshRepository.FinishRun failed", "err", err, "id", run.ID)
+		return apperror.InternalServerError(err)
+	}
+	return nil
+}
+
+func (r *spotRefreshRepository) CreateChanges(ctx context.Context, changes []*model.SpotRefreshChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	if err := dbFromContext(ctx, r.db).Create(&changes).Error; err != nil {
+		slog.ErrorContext(ctx, "spotRefreshRepository.CreateChanges failed", "err", err)
+		return apperror.InternalServerError(err)
+	}
+	return nil
+}
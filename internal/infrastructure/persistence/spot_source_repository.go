@@ -0,0 +1,159 @@
+package persistence
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"gorm.io/gorm"
+)
+
+type spotSourceRepository struct {
+	db *gorm.DB
+}
+
+func NewSpotSourceRepository(db *gorm.DB) repository.SpotSourceRepository {
+	return &spotSourceRepository{db: db}
+}
+
+func (r *spotSourceRepository) CreateBatch(ctx context.Context, sources []*model.SpotSource) error {
+	if len(sources) == 0 {
+		return nil
+	}
+	if err := dbFromContext(ctx, r.db).Omit("DateSpot").Create(&sources).Error; err != nil {
+		slog.ErrorContext(ctx, "spotSourceRepository.CreateBatch failed", "err", err)
+		return apperror.InternalServerError(err)
+	}
+	slog.InfoContext(ctx, "spotSourceRepository.CreateBatch succeeded", "count", len(sources))
+	return nil
+}
+
+func (r *spotSourceRepository) FindByID(ctx context.Context, id uint) (*model.SpotSource, error) {
+	var source model.SpotSource
+	if err := dbFromContext(ctx, r.db).Preload("DateSpot").First(&source, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, apperror.NotFound()
+		}
+		slog.ErrorContext(ctx, "spotSourceRepository.FindByID failed", "err", err, "id", id)
+		return nil, apperror.InternalServerError(err)
+	}
+	return &source, nil
+}
+
+func (r *spotSourceRepository) FindImportedExternalIDs(ctx context.Context, source model.DateSpotSource, externalIDs []string) ([]string, error) {
+	imported := []string{}
+	if len(externalIDs) == 0 {
+		return imported, nil
+	}
+	if err := dbFromContext(ctx, r.db).
+		Model(&model.SpotSource{}).
+		Where("source = ? AND external_id IN ?", source, externalIDs).
+		Pluck("external_id", &imported).Error; err != nil {
+		slog.ErrorContext(ctx, "spotSourceRepository.FindImportedExternalIDs failed", "err", err)
+		return nil, apperror.InternalServerError(err)
+	}
+	return imported, nil
+}
+
+func (r *spotSourceRepository) FindImportedNames(ctx context.Context, source model.DateSpotSource, prefectureID int) ([]string, error) {
+	names := []string{}
+	if err := dbFromContext(ctx, r.db).
+		Model(&model.SpotSource{}).
+		Where("source = ? AND prefecture_id = ?", source, prefectureID).
+		Distinct().
+		Pluck("name", &names).Error; err != nil {
+		slog.ErrorContext(ctx, "spotSourceRepository.FindImportedNames failed", "err", err)
+		return nil, apperror.InternalServerError(err)
+	}
+	return names, nil
+}
+
+// spotSourceOrders は取り込み記録の一覧の並び順ごとの並び替えキーです。
+var spotSourceOrders = map[pagination.Sort]keysetOrder{
+	pagination.SortOldest: {key: "spot_sources.created_at", id: "spot_sources.id"},
+	pagination.SortNewest: {key: "spot_sources.created_at", id: "spot_sources.id", desc: true},
+}
+
+func (r *spotSourceRepository) Search(ctx context.Context, params repository.SpotSourceSearchParams) (pagination.Page[*model.SpotSource], error) {
+	db := dbFromContext(ctx, r.db).
+		Model(&model.SpotSource{}).
+		Preload("DateSpot").
+		Where("spot_sources.status = ?", params.Status)
+
+	order, ok := spotSourceOrders[params.Sort]
+	if !ok {
+		params.Sort = pagination.SortOldest
+		order = spotSourceOrders[params.Sort]
+	}
+	db = order.paginate(db, params.Page)
+
+	var sources []*model.SpotSource
+	if err := db.Find(&sources).Error; err != nil {
+		slog.ErrorContext(ctx, "spotSourceRepository.Search failed", "err", err)
+		return pagination.Page[*model.SpotSource]{}, err
+	}
+	return pagination.NewPage(sources, params.Page, func(s *model.SpotSource) pagination.Cursor {
+		return pagination.NewTimeCursor(params.Sort, s.CreatedAt, s.ID)
+	}), nil
+}
+
+// Link は確認待ちの行だけを書き換えるので、2人の管理者が同時に判断しても統合先を決めるのは先に書いた1人だけです。
+func (r *spotSourceRepository) Link(ctx context.Context, id, dateSpotID uint) error {
+	result := dbFromContext(ctx, r.db).
+		Model(&model.SpotSource{}).
+		Where("id = ? AND status = ?", id, model.SpotSourceStatusPending).
+		Updates(map[string]any{"date_spot_id": dateSpotID, "status": model.SpotSourceStatusLinked})
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "spotSourceRepository.Link failed", "err", result.Error, "id", id)
+		return apperror.InternalServerError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrSpotSourceNotPending
+	}
+	slog.InfoContext(ctx, "spotSourceRepository.Link succeeded", "id", id, "date_spot_id", dateSpotID)
+	return nil
+}
+
+// refreshableSourcesSQL は取り直しに使える取り込み記録（統合済みで取得元の ID を持つもの）の条件です。
+const refreshableSourcesSQL = "spot_sources.source = ? AND spot_sources.status = ? AND spot_sources.external_id IS NOT NULL"
+
+// FindRefreshTargets は先にスポットの ID で1ページ分を決めてから、そのスポットの記録をすべて読みます。
+// 記録の ID で区切ると1つのスポットの記録がページをまたぎ、一部の ID だけで見つからないと判断してしまうためです。
+func (r *spotSourceRepository) FindRefreshTargets(ctx context.Context, source model.DateSpotSource, afterDateSpotID uint, limit int) ([]*model.SpotSource, error) {
+	db := dbFromContext(ctx, r.db)
+
+	var spotIDs []uint
+	refreshable := db.Session(&gorm.Session{NewDB: true}).
+		Model(&model.SpotSource{}).
+		Select("1").
+		Where("spot_sources.date_spot_id = date_spots.id").
+		Where(refreshableSourcesSQL, source, model.SpotSourceStatusLinked)
+	if err := db.
+		Model(&model.DateSpot{}).
+		Where("date_spots.source = ? AND date_spots.id > ?", source, afterDateSpotID).
+		Where("EXISTS (?)", refreshable).
+		Order("date_spots.id").
+		Limit(limit).
+		Pluck("date_spots.id", &spotIDs).Error; err != nil {
+		slog.ErrorContext(ctx, "spotSourceRepository.FindRefreshTargets failed", "err", err)
+		return nil, apperror.InternalServerError(err)
+	}
+	if len(spotIDs) == 0 {
+		return nil, nil
+	}
+
+	var sources []*model.SpotSource
+	if err := db.
+		Preload("DateSpot").
+		Where("spot_sources.date_spot_id IN ?", spotIDs).
+		Where(refreshableSourcesSQL, source, model.SpotSourceStatusLinked).
+		Order("spot_sources.date_spot_id, spot_sources.id").
+		Find(&sources).Error; err != nil {
+		slog.ErrorContext(ctx, "spotSourceRepository.FindRefreshTargets failed", "err", err)
+		return nil, apperror.InternalServerError(err)
+	}
+	return sources, nil
+}
@@ -0,0 +1,101 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/persistence"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestSpotSourceRepository_Search(t *testing.T) {
+	// 確認待ちの取り込み結果を古い順に並べ、統合候補のスポットも読み込む
+	t.Run("pending_oldest_first", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewSpotSourceRepository(db)
+
+		_, _ = repo.Search(context.Background(), repository.SpotSourceSearchParams{
+			Status: model.SpotSourceStatusPending,
+			Page:   pagination.Params{Limit: 10},
+		})
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "spot_sources.status = ?")
+		assert.Contains(t, sql, "ORDER BY spot_sources.created_at ASC,spot_sources.id ASC")
+		assert.Contains(t, sql, "LIMIT ?")
+	})
+}
+
+func TestSpotSourceRepository_FindImportedExternalIDs(t *testing.T) {
+	// 取得元の ID が空なら問い合わせない
+	t.Run("skips_query_without_ids", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewSpotSourceRepository(db)
+
+		ids, err := repo.FindImportedExternalIDs(context.Background(), model.DateSpotSourceHotPepper, nil)
+
+		assert.NoError(t, err)
+		assert.Empty(t, ids)
+		assert.Empty(t, *captured)
+	})
+
+	t.Run("filters_by_source_and_ids", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewSpotSourceRepository(db)
+
+		_, _ = repo.FindImportedExternalIDs(context.Background(), model.DateSpotSourceHotPepper, []string{"J001"})
+
+		assert.Contains(t, issuedSQL(captured), "source = ? AND external_id IN (?)")
+	})
+}
+
+func TestSpotSourceRepository_FindImportedNames(t *testing.T) {
+	t.Run("filters_by_source_and_prefecture", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewSpotSourceRepository(db)
+
+		_, _ = repo.FindImportedNames(context.Background(), model.DateSpotSourceGemini, 13)
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "SELECT DISTINCT `name`")
+		assert.Contains(t, sql, "source = ? AND prefecture_id = ?")
+	})
+}
+
+func TestSpotSourceRepository_Link(t *testing.T) {
+	// 確認待ちの行だけを書き換え、先に判断されていて1行も変わらなければ ErrSpotSourceNotPending を返す
+	t.Run("updates_only_pending_source", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		require.NoError(t, db.Callback().Update().After("gorm:update").Register("test:capture_update", func(d *gorm.DB) {
+			*captured = append(*captured, d.Statement.SQL.String())
+		}))
+		repo := persistence.NewSpotSourceRepository(db.Session(&gorm.Session{SkipDefaultTransaction: true}))
+
+		err := repo.Link(context.Background(), 3, 7)
+
+		assert.ErrorIs(t, err, repository.ErrSpotSourceNotPending)
+		assert.Contains(t, issuedSQL(captured), "id = ? AND status = ?")
+	})
+}
+
+func TestSpotSourceRepository_FindRefreshTargets(t *testing.T) {
+	// 1ページ分は記録ではなくスポットの ID で区切り、取得元が同じで取得元の ID を持つ記録がつながったスポットだけを読む
+	t.Run("pages_by_date_spot_of_same_origin", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewSpotSourceRepository(db)
+
+		_, _ = repo.FindRefreshTargets(context.Background(), model.DateSpotSourceHotPepper, 10, 100)
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "SELECT `date_spots`.`id` FROM `date_spots`")
+		assert.Contains(t, sql, "date_spots.source = ? AND date_spots.id > ?")
+		assert.Contains(t, sql, "EXISTS (SELECT 1 FROM `spot_sources` WHERE spot_sources.date_spot_id = date_spots.id")
+		assert.Contains(t, sql, "spot_sources.external_id IS NOT NULL")
+		assert.Contains(t, sql, "ORDER BY date_spots.id LIMIT ?")
+	})
+}
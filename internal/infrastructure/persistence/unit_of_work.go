@@ -0,0 +1,54 @@
+package persistence
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// txKey は context にトランザクション中の *gorm.DB を載せるためのキーです。
+type txKey struct{}
+
+type unitOfWork struct {
+	db *gorm.DB
+}
+
+func NewUnitOfWork(db *gorm.DB) repository.UnitOfWork {
+	return &unitOfWork{db: db}
+}
+
+// Do は fn をトランザクション内で実行します。
+// すでにトランザクション内で呼ばれた場合は入れ子にせず、外側のトランザクションに参加します。
+// fn のエラーは usecase が組み立てた apperror なのでそのまま返し、
+// BEGIN / COMMIT 自体の失敗だけを 500 として包みます。
+func (u *unitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	var fnErr error
+	err := u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		fnErr = fn(context.WithValue(ctx, txKey{}, tx))
+		return fnErr
+	})
+	if fnErr != nil {
+		return fnErr
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "unitOfWork.Do failed", "err", err)
+		return apperror.InternalServerError(err)
+	}
+	return nil
+}
+
+// dbFromContext は ctx にトランザクションが載っていればそれを、なければ db を返します。
+// リポジトリは常にこの関数経由で DB を触ることで、UnitOfWork の中でも外でも同じ実装で動きます。
+func dbFromContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
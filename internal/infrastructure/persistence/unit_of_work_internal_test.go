@@ -0,0 +1,45 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDBFromContext(t *testing.T) {
+	t.Run("returns_transaction_in_context", func(t *testing.T) {
+		db, _ := newDryRunDBForDelete(t)
+		tx, _ := newDryRunDBForDelete(t)
+
+		ctx := context.WithValue(context.Background(), txKey{}, tx)
+
+		assert.Same(t, tx.Statement.ConnPool, dbFromContext(ctx, db).Statement.ConnPool)
+	})
+
+	t.Run("falls_back_to_db_outside_transaction", func(t *testing.T) {
+		db, _ := newDryRunDBForDelete(t)
+
+		assert.Same(t, db.Statement.ConnPool, dbFromContext(context.Background(), db).Statement.ConnPool)
+	})
+}
+
+func TestUnitOfWork_Do(t *testing.T) {
+	// 入れ子の Do は新しいトランザクションを張らず、外側の tx をそのまま使う
+	t.Run("joins_outer_transaction", func(t *testing.T) {
+		db, _ := newDryRunDBForDelete(t)
+		tx, _ := newDryRunDBForDelete(t)
+		outer := context.WithValue(context.Background(), txKey{}, tx)
+
+		called := false
+		err := NewUnitOfWork(db).Do(outer, func(ctx context.Context) error {
+			called = true
+			assert.Same(t, tx.Statement.ConnPool, dbFromContext(ctx, db).Statement.ConnPool)
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.True(t, called)
+	})
+}
@@ -0,0 +1,53 @@
+package persistence
+
+import (
+	"context"
+	"log/slog"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type userBlockRepository struct {
+	db *gorm.DB
+}
+
+func NewUserBlockRepository(db *gorm.DB) repository.UserBlockRepository {
+	return &userBlockRepository{db: db}
+}
+
+// Create はブロックを保存します。再送で同じ組み合わせが来ても、一意制約に当たった行は無視してエラーにしません。
+func (r *userBlockRepository) Create(ctx context.Context, block *model.UserBlock) error {
+	if err := dbFromContext(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(block).Error; err != nil {
+		slog.ErrorContext(ctx, "userBlockRepository.Create failed", "err", err)
+		return err
+	}
+	slog.InfoContext(ctx, "userBlockRepository.Create succeeded", "user_id", block.UserID, "blocked_user_id", block.BlockedUserID)
+	return nil
+}
+
+func (r *userBlockRepository) DeleteByUserIDs(ctx context.Context, userID, blockedUserID uint) error {
+	if err := dbFromContext(ctx, r.db).
+		Where("user_id = ? AND blocked_user_id = ?", userID, blockedUserID).
+		Delete(&model.UserBlock{}).Error; err != nil {
+		slog.ErrorContext(ctx, "userBlockRepository.DeleteByUserIDs failed", "err", err)
+		return err
+	}
+	return nil
+}
+
+// ExistsBetween はブロックが双方向に効くため、どちら向きのブロックも探します。
+func (r *userBlockRepository) ExistsBetween(ctx context.Context, userID, otherUserID uint) (bool, error) {
+	var exists bool
+	if err := dbFromContext(ctx, r.db).
+		Raw("SELECT EXISTS ("+blockBetweenSQL+")", userID, otherUserID, otherUserID, userID).
+		Scan(&exists).Error; err != nil {
+		slog.ErrorContext(ctx, "userBlockRepository.ExistsBetween failed", "err", err)
+		return false, err
+	}
+	return exists, nil
+}
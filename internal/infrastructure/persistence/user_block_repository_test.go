@@ -0,0 +1,28 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestUserBlockRepository_ExistsBetween(t *testing.T) {
+	// ブロックは双方向に効くため、どちら向きのブロックも探す
+	t.Run("checks_both_directions", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		require.NoError(t, db.Callback().Row().After("gorm:row").Register("test:capture_row", func(d *gorm.DB) {
+			*captured = append(*captured, d.Statement.SQL.String())
+		}))
+		repo := persistence.NewUserBlockRepository(db)
+
+		_, _ = repo.ExistsBetween(context.Background(), 1, 2)
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "SELECT EXISTS (SELECT 1 FROM user_blocks")
+		assert.Contains(t, sql, "(user_id = ? AND blocked_user_id = ?) OR (user_id = ? AND blocked_user_id = ?)")
+	})
+}
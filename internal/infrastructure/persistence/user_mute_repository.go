@@ -0,0 +1,41 @@
+package persistence
+
+import (
+	"context"
+	"log/slog"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type userMuteRepository struct {
+	db *gorm.DB
+}
+
+func NewUserMuteRepository(db *gorm.DB) repository.UserMuteRepository {
+	return &userMuteRepository{db: db}
+}
+
+// Create はミュートを保存します。再送で同じ組み合わせが来ても、一意制約に当たった行は無視してエラーにしません。
+func (r *userMuteRepository) Create(ctx context.Context, mute *model.UserMute) error {
+	if err := dbFromContext(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(mute).Error; err != nil {
+		slog.ErrorContext(ctx, "userMuteRepository.Create failed", "err", err)
+		return err
+	}
+	slog.InfoContext(ctx, "userMuteRepository.Create succeeded", "user_id", mute.UserID, "muted_user_id", mute.MutedUserID)
+	return nil
+}
+
+func (r *userMuteRepository) DeleteByUserIDs(ctx context.Context, userID, mutedUserID uint) error {
+	if err := dbFromContext(ctx, r.db).
+		Where("user_id = ? AND muted_user_id = ?", userID, mutedUserID).
+		Delete(&model.UserMute{}).Error; err != nil {
+		slog.ErrorContext(ctx, "userMuteRepository.DeleteByUserIDs failed", "err", err)
+		return err
+	}
+	return nil
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"time"
 
 	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type userRepository struct {
@@ -19,7 +22,7 @@ func NewUserRepository(db *gorm.DB) repository.UserRepository {
 }
 
 func (r *userRepository) Create(ctx context.Context, user *model.User) error {
-	if err := r.db.WithContext(ctx).Create(user).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Create(user).Error; err != nil {
 		slog.ErrorContext(ctx, "userRepository.Create failed", "err", err)
 		return err
 	}
@@ -30,7 +33,7 @@ func (r *userRepository) Create(ctx context.Context, user *model.User) error {
 // FindByID は id でユーザーを検索します。
 func (r *userRepository) FindByID(ctx context.Context, id uint) (*model.User, error) {
 	var user model.User
-	if err := r.db.WithContext(ctx).First(&user, id).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).First(&user, id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, gorm.ErrRecordNotFound
 		}
@@ -43,7 +46,7 @@ func (r *userRepository) FindByID(ctx context.Context, id uint) (*model.User, er
 // FindByName は name でユーザーを検索します。
 func (r *userRepository) FindByName(ctx context.Context, name string) (*model.User, error) {
 	var user model.User
-	if err := r.db.WithContext(ctx).Where("name = ?", name).First(&user).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Where("name = ?", name).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, gorm.ErrRecordNotFound
 		}
@@ -54,23 +57,62 @@ func (r *userRepository) FindByName(ctx context.Context, name string) (*model.Us
 	return &user, nil
 }
 
+func (r *userRepository) FindByEmail(ctx context.Context, email string) (*model.User, error) {
+	var user model.User
+	if err := dbFromContext(ctx, r.db).Where("email = ?", email).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, gorm.ErrRecordNotFound
+		}
+		slog.ErrorContext(ctx, "userRepository.FindByEmail failed", "err", err)
+		return nil, err
+	}
+	slog.InfoContext(ctx, "userRepository.FindByEmail succeeded", "user_id", user.ID)
+	return &user, nil
+}
+
+// userOrders はユーザー一覧の並び順ごとの並び替えキーです。
+var userOrders = map[pagination.Sort]keysetOrder{
+	pagination.SortNewest: {key: "users.created_at", id: "users.id", desc: true},
+	pagination.SortName:   {key: "users.name", id: "users.id"},
+}
+
+// userCursor は u の位置を指すカーソルを作ります。
+func userCursor(sort pagination.Sort) func(*model.User) pagination.Cursor {
+	return func(u *model.User) pagination.Cursor {
+		if sort == pagination.SortName {
+			return pagination.NewStringCursor(sort, u.Name, u.ID)
+		}
+		return pagination.NewTimeCursor(sort, u.CreatedAt, u.ID)
+	}
+}
+
 // Search は管理者を除くユーザーを名前で部分一致検索します。
-func (r *userRepository) Search(ctx context.Context, name *string) ([]*model.User, error) {
+func (r *userRepository) Search(ctx context.Context, params repository.UserSearchParams) (pagination.Page[*model.User], error) {
 	var users []*model.User
-	db := r.db.WithContext(ctx).Where("admin = false")
-	if name != nil && *name != "" {
-		db = db.Where("name LIKE ?", "%"+*name+"%")
+	db := dbFromContext(ctx, r.db).
+		Where("admin = false").
+		Scopes(excludeHiddenUsers("users.id", params.ViewerID))
+	if params.Name != nil && *params.Name != "" {
+		db = db.Where("name LIKE ?", "%"+*params.Name+"%")
+	}
+
+	order, ok := userOrders[params.Sort]
+	if !ok {
+		params.Sort = pagination.SortNewest
+		order = userOrders[params.Sort]
 	}
+	db = order.paginate(db, params.Page)
+
 	if err := db.Find(&users).Error; err != nil {
 		slog.ErrorContext(ctx, "userRepository.Search failed", "err", err)
-		return nil, err
+		return pagination.Page[*model.User]{}, err
 	}
-	return users, nil
+	return pagination.NewPage(users, params.Page, userCursor(params.Sort)), nil
 }
 
 func (r *userRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
 	var count int64
-	if err := r.db.WithContext(ctx).Model(&model.User{}).Where("email = ?", email).Count(&count).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Model(&model.User{}).Where("email = ?", email).Count(&count).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return false, nil
 		}
@@ -110,7 +152,7 @@ func (r *userRepository) findRelationshipIDs(ctx context.Context, userIDs []uint
 	}
 
 	var pairs []relationshipPair
-	if err := r.db.WithContext(ctx).
+	if err := dbFromContext(ctx, r.db).
 		Table("relationships").
 		Select(targetColumn+" AS target_id, "+otherColumn+" AS other_id").
 		Where(targetColumn+" IN ?", userIDs).
@@ -126,8 +168,13 @@ func (r *userRepository) findRelationshipIDs(ctx context.Context, userIDs []uint
 }
 
 // Update はユーザー情報を更新します。
+// トークンバージョンとログインの記録（失敗回数・ロック・最終ログイン）は保存しません。
+// 読んだ時点の値で上書きすると、その間に IncrementTokenVersion・RecordLoginFailure・RecordLoginSuccess で DB 上で変えた分が消え、
+// ログインのロックが外れることもあるためです。
 func (r *userRepository) Update(ctx context.Context, user *model.User) error {
-	if err := r.db.WithContext(ctx).Save(user).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).
+		Omit("token_version", "failed_login_count", "locked_until", "last_login_at", "last_login_ip").
+		Save(user).Error; err != nil {
 		slog.ErrorContext(ctx, "userRepository.Update failed", "err", err)
 		return err
 	}
@@ -135,12 +182,104 @@ func (r *userRepository) Update(ctx context.Context, user *model.User) error {
 	return nil
 }
 
+// IncrementTokenVersion はトークンバージョンを1つ上げ、発行済みのアクセストークンを無効にします。
+// 読んでから保存すると同時に上げたときに片方が消えるため、DB 上で加算します。
+func (r *userRepository) IncrementTokenVersion(ctx context.Context, id uint) error {
+	if err := dbFromContext(ctx, r.db).
+		Model(&model.User{}).
+		Where("id = ?", id).
+		Update("token_version", gorm.Expr("token_version + 1")).Error; err != nil {
+		slog.ErrorContext(ctx, "userRepository.IncrementTokenVersion failed", "err", err)
+		return err
+	}
+	slog.InfoContext(ctx, "userRepository.IncrementTokenVersion succeeded", "user_id", id)
+	return nil
+}
+
+// LockForUpdate は id の小さい順に行ロックを取ります。
+// 取る順番を揃えておくと、同じ2人を逆向きにロックする書き込みが来てもデッドロックになりません。
+func (r *userRepository) LockForUpdate(ctx context.Context, ids ...uint) error {
+	var locked []uint
+	if err := dbFromContext(ctx, r.db).
+		Model(&model.User{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ?", ids).
+		Order("id").
+		Pluck("id", &locked).Error; err != nil {
+		slog.ErrorContext(ctx, "userRepository.LockForUpdate failed", "err", err)
+		return err
+	}
+	return nil
+}
+
+// RecordLoginFailure はログインの失敗を1回数え、maxAttempts 回続いたら lockedUntil までロックします。
+// ロックしたら失敗回数を 0 に戻し、ロックが明けたら改めて maxAttempts 回まで試せるようにします。
+func (r *userRepository) RecordLoginFailure(ctx context.Context, id uint, maxAttempts uint, lockedUntil time.Time) (bool, error) {
+	var locked bool
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var err error
+		locked, err = recordLoginFailure(tx, id, maxAttempts, lockedUntil)
+		return err
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "userRepository.RecordLoginFailure failed", "err", err)
+		return false, err
+	}
+	if locked {
+		slog.WarnContext(ctx, "userRepository.RecordLoginFailure locked user", "user_id", id, "locked_until", lockedUntil)
+	}
+	return locked, nil
+}
+
+// recordLoginFailure は失敗回数を DB 上で加算してから読み直します。
+// 複数のインスタンスで同時に失敗しても、UPDATE で行ロックを取るため回数を取りこぼしません。
+// ログインの記録はプロフィールの変更ではないため、updated_at は変えません。
+func recordLoginFailure(db *gorm.DB, id uint, maxAttempts uint, lockedUntil time.Time) (bool, error) {
+	if err := db.Model(&model.User{}).
+		Where("id = ?", id).
+		UpdateColumn("failed_login_count", gorm.Expr("failed_login_count + 1")).Error; err != nil {
+		return false, err
+	}
+
+	var user model.User
+	if err := db.Select("id", "failed_login_count").Where("id = ?", id).Take(&user).Error; err != nil {
+		return false, err
+	}
+	if user.FailedLoginCount < maxAttempts {
+		return false, nil
+	}
+
+	if err := db.Model(&model.User{}).
+		Where("id = ?", id).
+		UpdateColumns(map[string]any{"failed_login_count": 0, "locked_until": lockedUntil}).Error; err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RecordLoginSuccess は失敗回数とロックを解除し、最終ログイン日時と IP アドレスを記録します。
+func (r *userRepository) RecordLoginSuccess(ctx context.Context, id uint, at time.Time, ipAddress string) error {
+	if err := dbFromContext(ctx, r.db).
+		Model(&model.User{}).
+		Where("id = ?", id).
+		UpdateColumns(map[string]any{
+			"failed_login_count": 0,
+			"locked_until":       nil,
+			"last_login_at":      at,
+			"last_login_ip":      ipAddress,
+		}).Error; err != nil {
+		slog.ErrorContext(ctx, "userRepository.RecordLoginSuccess failed", "err", err)
+		return err
+	}
+	return nil
+}
+
 // Delete は指定IDのユーザーを、紐づく子レコードごと削除します。
 // users を参照する外部キーがあるため、コース・レビュー・フォロー関係を
 // 先に消さないとユーザー本体を削除できない。
 // 途中で失敗して一部だけが消えた状態にならないよう、トランザクションにまとめる。
 func (r *userRepository) Delete(ctx context.Context, id uint) error {
-	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		return deleteUser(tx, id)
 	})
 	if err != nil {
@@ -159,15 +298,45 @@ func deleteUser(db *gorm.DB, id uint) error {
 		Delete(&model.DuringSpot{}).Error; err != nil {
 		return err
 	}
+	// 本人のお気に入りと、本人のコースへの他人のお気に入りを消す。後者はコースより先に消す
+	if err := db.Where("user_id = ? OR course_id IN (?)", id, db.Raw("SELECT id FROM courses WHERE user_id = ?", id)).
+		Delete(&model.CourseFavorite{}).Error; err != nil {
+		return err
+	}
 	if err := db.Where("user_id = ?", id).Delete(&model.Course{}).Error; err != nil {
 		return err
 	}
+	// 本人がした通報と、本人のレビューへの通報を消す。後者はレビューより先に消す
+	if err := db.Where("user_id = ? OR date_spot_review_id IN (?)", id, db.Raw("SELECT id FROM date_spot_reviews WHERE user_id = ?", id)).
+		Delete(&model.DateSpotReviewReport{}).Error; err != nil {
+		return err
+	}
 	if err := db.Where("user_id = ?", id).Delete(&model.DateSpotReview{}).Error; err != nil {
 		return err
 	}
+	if err := db.Where("user_id = ?", id).Delete(&model.RefreshToken{}).Error; err != nil {
+		return err
+	}
+	if err := db.Where("user_id = ?", id).Delete(&model.EmailToken{}).Error; err != nil {
+		return err
+	}
+	if err := db.Where("user_id = ?", id).Delete(&model.LoginHistory{}).Error; err != nil {
+		return err
+	}
+	// 本人宛ての通知と、本人の操作で他のユーザーに届いた通知を消す
+	if err := db.Where("user_id = ? OR actor_id = ?", id, id).Delete(&model.Notification{}).Error; err != nil {
+		return err
+	}
 	// フォローしている側・されている側の両方を消す
 	if err := db.Where("user_id = ? OR follow_id = ?", id, id).Delete(&model.Relationship{}).Error; err != nil {
 		return err
 	}
+	// ブロック・ミュートは、した側・された側の両方を消す
+	if err := db.Where("user_id = ? OR blocked_user_id = ?", id, id).Delete(&model.UserBlock{}).Error; err != nil {
+		return err
+	}
+	if err := db.Where("user_id = ? OR muted_user_id = ?", id, id).Delete(&model.UserMute{}).Error; err != nil {
+		return err
+	}
 	return db.Delete(&model.User{}, id).Error
 }
@@ -3,6 +3,7 @@ package persistence
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -16,16 +17,29 @@ func TestDeleteUser(t *testing.T) {
 
 		_ = deleteUser(db, 7)
 
-		require.Equal(t, 5, len(*captured), "孫・子・本体で5回の DELETE が必要")
+		require.Equal(t, 13, len(*captured), "孫・子・本体で13回の DELETE が必要")
 
 		sqls := *captured
 		assert.Contains(t, sqls[0], "DELETE FROM `during_spots`")
 		assert.Contains(t, sqls[0], "SELECT id FROM courses WHERE user_id = ?", "コース経由で孫を特定する")
-		assert.Contains(t, sqls[1], "DELETE FROM `courses`")
-		assert.Contains(t, sqls[2], "DELETE FROM `date_spot_reviews`")
-		assert.Contains(t, sqls[3], "DELETE FROM `relationships`")
-		assert.Contains(t, sqls[3], "follow_id = ?", "フォロー・フォロワーの両方を消す")
-		assert.Contains(t, sqls[4], "DELETE FROM `users`")
+		assert.Contains(t, sqls[1], "DELETE FROM `course_favorites`")
+		assert.Contains(t, sqls[1], "SELECT id FROM courses WHERE user_id = ?", "本人のコースへのお気に入りも消す")
+		assert.Contains(t, sqls[2], "DELETE FROM `courses`")
+		assert.Contains(t, sqls[3], "DELETE FROM `date_spot_review_reports`")
+		assert.Contains(t, sqls[3], "SELECT id FROM date_spot_reviews WHERE user_id = ?", "本人のレビューへの通報も消す")
+		assert.Contains(t, sqls[4], "DELETE FROM `date_spot_reviews`")
+		assert.Contains(t, sqls[5], "DELETE FROM `refresh_tokens`")
+		assert.Contains(t, sqls[6], "DELETE FROM `email_tokens`")
+		assert.Contains(t, sqls[7], "DELETE FROM `login_histories`")
+		assert.Contains(t, sqls[8], "DELETE FROM `notifications`")
+		assert.Contains(t, sqls[8], "actor_id = ?", "本人が起こした他人宛ての通知も消す")
+		assert.Contains(t, sqls[9], "DELETE FROM `relationships`")
+		assert.Contains(t, sqls[9], "follow_id = ?", "フォロー・フォロワーの両方を消す")
+		assert.Contains(t, sqls[10], "DELETE FROM `user_blocks`")
+		assert.Contains(t, sqls[10], "blocked_user_id = ?", "ブロックした側・された側の両方を消す")
+		assert.Contains(t, sqls[11], "DELETE FROM `user_mutes`")
+		assert.Contains(t, sqls[11], "muted_user_id = ?", "ミュートした側・された側の両方を消す")
+		assert.Contains(t, sqls[12], "DELETE FROM `users`")
 	})
 
 	// 順序が崩れると外部キー制約で失敗するため、並び自体を検証する
@@ -36,10 +50,29 @@ func TestDeleteUser(t *testing.T) {
 
 		all := strings.Join(*captured, "\n")
 		during := strings.Index(all, "DELETE FROM `during_spots`")
+		favorites := strings.Index(all, "DELETE FROM `course_favorites`")
 		courses := strings.Index(all, "DELETE FROM `courses`")
 		users := strings.Index(all, "DELETE FROM `users`")
+		reports := strings.Index(all, "DELETE FROM `date_spot_review_reports`")
+		reviews := strings.Index(all, "DELETE FROM `date_spot_reviews`")
 
 		assert.Less(t, during, courses, "during_spots はコースより先")
+		assert.Less(t, favorites, courses, "お気に入りはコースより先")
 		assert.Less(t, courses, users, "コースはユーザーより先")
+		assert.Less(t, reports, reviews, "通報はレビューより先")
+	})
+}
+
+func TestRecordLoginFailure(t *testing.T) {
+	// 読んでから書くと同時に失敗したときに数え漏れるため、DB 上で加算する
+	t.Run("increments_in_db_without_touching_updated_at", func(t *testing.T) {
+		db, captured := newDryRunDBForUpdate(t)
+
+		_, _ = recordLoginFailure(db, 7, 5, time.Now())
+
+		require.NotEmpty(t, *captured)
+		sql := (*captured)[0]
+		assert.Contains(t, sql, "UPDATE `users` SET `failed_login_count`=failed_login_count + 1")
+		assert.NotContains(t, sql, "updated_at", "ログインの記録ではプロフィールの更新日時を変えない")
 	})
 }
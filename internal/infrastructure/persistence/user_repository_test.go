@@ -0,0 +1,48 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserRepository_LockForUpdate(t *testing.T) {
+	// 渡した順に関係なく id の小さい順にロックし、逆向きの書き込み同士でデッドロックにならないようにする
+	t.Run("locks_rows_in_id_order", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+		repo := persistence.NewUserRepository(db)
+
+		_ = repo.LockForUpdate(context.Background(), 2, 1)
+
+		sql := issuedSQL(captured)
+		assert.Contains(t, sql, "id IN (?,?)")
+		assert.Contains(t, sql, "ORDER BY id FOR UPDATE")
+	})
+}
+
+func TestUserRepository_Update(t *testing.T) {
+	// プロフィールの更新は読んでから保存するため、その間に RecordLoginFailure で掛けたロックを古い値で外さない
+	t.Run("keeps_login_state_set_by_record_login_failure", func(t *testing.T) {
+		db, captured := newDryRunDBForUpdate(t)
+		repo := persistence.NewUserRepository(db)
+
+		// ロックされる前に読んだユーザー
+		user := &model.User{ID: 7, Name: "alice", Email: "alice@example.com"}
+		_, _ = repo.RecordLoginFailure(context.Background(), 7, 1, time.Now().Add(time.Hour))
+		*captured = nil
+		_ = repo.Update(context.Background(), user)
+
+		require.Len(t, *captured, 1)
+		sql := (*captured)[0]
+		assert.Contains(t, sql, "UPDATE `users` SET")
+		assert.Contains(t, sql, "`name`=?")
+		for _, column := range []string{"token_version", "failed_login_count", "locked_until", "last_login_at", "last_login_ip"} {
+			assert.NotContains(t, sql, column, "DB 上で更新する列は上書きしない")
+		}
+	})
+}
@@ -0,0 +1,55 @@
+// Package ratelimit は repository.RateLimitStore の実装です。
+//
+// メモリ上の実装は1プロセスの中だけで数えるため、ローカル開発や単一サーバー向けです。
+// Lambda のように実行環境が増減する本番では、すべての実行環境で同じバケットを共有する MySQL の実装を使います。
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+)
+
+// sweepInterval は上限まで回復したバケットをまとめて消す間隔です。
+const sweepInterval = time.Minute
+
+// memoryStore はプロセスのメモリ上にバケットを持つ RateLimitStore です。
+type memoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*model.RateLimitBucket
+	nextSweep time.Time
+}
+
+func NewMemoryStore() repository.RateLimitStore {
+	return &memoryStore{buckets: make(map[string]*model.RateLimitBucket)}
+}
+
+func (s *memoryStore) Take(_ context.Context, key string, rule model.RateLimitRule, now time.Time) (model.RateLimitResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+	bucket, ok := s.buckets[key]
+	if !ok {
+		bucket = model.NewRateLimitBucket(key, rule, now)
+		s.buckets[key] = bucket
+	}
+	return bucket.Take(rule, now), nil
+}
+
+// sweep は上限まで回復したバケットを消します。IP ごとにエントリを増やし続けないための措置です。
+// 消したキーは次に来たときに満タンのバケットから数え直すため、数え方は変わりません。
+func (s *memoryStore) sweep(now time.Time) {
+	if now.Before(s.nextSweep) {
+		return
+	}
+	for key, bucket := range s.buckets {
+		if !now.Before(bucket.FullAt) {
+			delete(s.buckets, key)
+		}
+	}
+	s.nextSweep = now.Add(sweepInterval)
+}
@@ -0,0 +1,90 @@
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/ratelimit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	rule := model.RateLimitRule{Limit: 3, Window: 3 * time.Second}
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	take := func(t *testing.T, store repository.RateLimitStore, key string, now time.Time) model.RateLimitResult {
+		t.Helper()
+		result, err := store.Take(ctx, key, rule, now)
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("counts_down_remaining_until_rejected", func(t *testing.T) {
+		store := ratelimit.NewMemoryStore()
+
+		for want := 2; want >= 0; want-- {
+			result := take(t, store, "k", start)
+			assert.True(t, result.Allowed)
+			assert.Equal(t, 3, result.Limit)
+			assert.Equal(t, want, result.Remaining)
+		}
+
+		result := take(t, store, "k", start)
+		assert.False(t, result.Allowed)
+		assert.Equal(t, 0, result.Remaining)
+		assert.Equal(t, time.Second, result.RetryAfter, "1秒に1つ回復する")
+		assert.Equal(t, start.Add(3*time.Second), result.ResetAt, "3つ回復するまで3秒")
+	})
+
+	// 固定窓と違い、窓の終わりを待たずに経過時間の分だけ回復する
+	t.Run("refills_gradually", func(t *testing.T) {
+		store := ratelimit.NewMemoryStore()
+		for i := 0; i < 3; i++ {
+			take(t, store, "k", start)
+		}
+
+		assert.False(t, take(t, store, "k", start.Add(500*time.Millisecond)).Allowed)
+		assert.True(t, take(t, store, "k", start.Add(time.Second)).Allowed, "1秒で1つ回復する")
+		assert.False(t, take(t, store, "k", start.Add(time.Second)).Allowed)
+	})
+
+	// 長く空いても上限を超えて貯まらない
+	t.Run("does_not_refill_beyond_limit", func(t *testing.T) {
+		store := ratelimit.NewMemoryStore()
+		take(t, store, "k", start)
+
+		result := take(t, store, "k", start.Add(time.Hour))
+		assert.True(t, result.Allowed)
+		assert.Equal(t, 2, result.Remaining)
+	})
+
+	t.Run("counts_keys_separately", func(t *testing.T) {
+		store := ratelimit.NewMemoryStore()
+		for i := 0; i < 3; i++ {
+			take(t, store, "a", start)
+		}
+
+		assert.False(t, take(t, store, "a", start).Allowed)
+		assert.True(t, take(t, store, "b", start).Allowed)
+	})
+
+	// 掃除で消えたバケットは満タンから数え直すため、消す前と結果が変わらない
+	t.Run("sweep_keeps_counting_consistent", func(t *testing.T) {
+		store := ratelimit.NewMemoryStore()
+		for i := 0; i < 3; i++ {
+			take(t, store, "k", start)
+		}
+
+		// 掃除の間隔を過ぎ、"k" は満タンまで回復している
+		later := start.Add(2 * time.Minute)
+		take(t, store, "other", later)
+		result := take(t, store, "k", later)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, 2, result.Remaining)
+	})
+}
@@ -0,0 +1,92 @@
+package ratelimit
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// sweepBatchSize は1回の掃除で消す行数の上限です。大量の行を一度に消してロックを長く持たないようにします。
+const sweepBatchSize = 1000
+
+// mysqlStore は rate_limit_buckets テーブルにバケットを持つ RateLimitStore です。
+// Lambda の実行環境が増えても同じ行を数えるため、スケールアウトしても制限が緩みません。
+type mysqlStore struct {
+	db *gorm.DB
+
+	mu        sync.Mutex
+	nextSweep time.Time
+}
+
+func NewMySQLStore(db *gorm.DB) repository.RateLimitStore {
+	return &mysqlStore{db: db}
+}
+
+func (s *mysqlStore) Take(ctx context.Context, key string, rule model.RateLimitRule, now time.Time) (model.RateLimitResult, error) {
+	var result model.RateLimitResult
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		result, err = take(tx, key, rule, now)
+		return err
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "ratelimit.mysqlStore.Take failed", "err", err, "key", key)
+		return model.RateLimitResult{}, err
+	}
+	s.sweep(ctx, now)
+	return result, nil
+}
+
+// take は行が無ければ満タンのバケットとして作ってから、行ロックを取って数えます。
+// 複数の実行環境から同じキーに同時に来ても、行ロックで1つずつ順に数えるため取りこぼしません。
+func take(tx *gorm.DB, key string, rule model.RateLimitRule, now time.Time) (model.RateLimitResult, error) {
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(model.NewRateLimitBucket(key, rule, now)).Error; err != nil {
+		return model.RateLimitResult{}, err
+	}
+
+	var bucket model.RateLimitBucket
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("bucket_key = ?", key).
+		Take(&bucket).Error; err != nil {
+		return model.RateLimitResult{}, err
+	}
+
+	result := bucket.Take(rule, now)
+	if err := tx.Model(&model.RateLimitBucket{}).
+		Where("bucket_key = ?", key).
+		Updates(map[string]any{
+			"tokens":      bucket.Tokens,
+			"refilled_at": bucket.RefilledAt,
+			"full_at":     bucket.FullAt,
+		}).Error; err != nil {
+		return model.RateLimitResult{}, err
+	}
+	return result, nil
+}
+
+// sweep は上限まで回復したバケットの行を消します。
+// 消した行は次に来たときに満タンで作り直すため、数え方は変わりません。
+// 掃除は実行環境ごとに sweepInterval に1回だけ行い、失敗してもリクエストは止めません。
+func (s *mysqlStore) sweep(ctx context.Context, now time.Time) {
+	s.mu.Lock()
+	if now.Before(s.nextSweep) {
+		s.mu.Unlock()
+		return
+	}
+	s.nextSweep = now.Add(sweepInterval)
+	s.mu.Unlock()
+
+	if err := s.db.WithContext(ctx).
+		Where("full_at < ?", now).
+		Limit(sweepBatchSize).
+		Delete(&model.RateLimitBucket{}).Error; err != nil {
+		slog.WarnContext(ctx, "ratelimit.mysqlStore.sweep failed", "err", err)
+	}
+}
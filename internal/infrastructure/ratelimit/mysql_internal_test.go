@@ -0,0 +1,54 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newDryRunDB(t *testing.T) (*gorm.DB, *[]string) {
+	t.Helper()
+
+	db, err := gorm.Open(mysql.New(mysql.Config{
+		DSN:                       "user:password@tcp(127.0.0.1:3306)/dummy?charset=utf8mb4&parseTime=True",
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+		Logger:                 logger.Discard,
+	})
+	require.NoError(t, err)
+
+	captured := []string{}
+	capture := func(d *gorm.DB) {
+		captured = append(captured, d.Statement.SQL.String())
+	}
+	require.NoError(t, db.Callback().Create().After("gorm:create").Register("test:capture_create", capture))
+	require.NoError(t, db.Callback().Query().After("gorm:query").Register("test:capture_query", capture))
+	require.NoError(t, db.Callback().Update().After("gorm:update").Register("test:capture_update", capture))
+	return db, &captured
+}
+
+func TestTake(t *testing.T) {
+	// 同じキーに複数の実行環境から同時に来ても数え漏れないよう、行を作ってから行ロックを取って更新する
+	t.Run("locks_row_before_updating", func(t *testing.T) {
+		db, captured := newDryRunDB(t)
+
+		_, err := take(db, "auth:ip:10.0.0.1", model.RateLimitRule{Limit: 10, Window: time.Minute}, time.Now())
+		require.NoError(t, err)
+
+		require.Equal(t, 3, len(*captured), "INSERT・SELECT・UPDATE の3回")
+		assert.Contains(t, (*captured)[0], "INSERT INTO `rate_limit_buckets`")
+		assert.Contains(t, (*captured)[0], "ON DUPLICATE KEY UPDATE", "既にある行は上書きしない")
+		assert.Contains(t, (*captured)[1], "FOR UPDATE")
+		assert.Contains(t, (*captured)[2], "UPDATE `rate_limit_buckets`")
+		assert.Contains(t, (*captured)[2], "bucket_key = ?")
+	})
+}
@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+)
+
+// localBlobStore はローカルのディレクトリに保存する BlobStore です。
+// 保存したファイルは API サーバーが baseURL で静的配信する前提です。
+type localBlobStore struct {
+	dir     string
+	baseURL string
+}
+
+func NewLocalBlobStore(dir, baseURL string) repository.BlobStore {
+	return &localBlobStore{dir: dir, baseURL: baseURL}
+}
+
+// Put は一時ファイルに書いてから rename するため、配信中のファイルが書きかけで見えることはありません。
+func (s *localBlobStore) Put(ctx context.Context, key string, _ string, data []byte) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+	path := filepath.Join(s.dir, filepath.FromSlash(key))
+	if err := s.write(path, data); err != nil {
+		slog.ErrorContext(ctx, "localBlobStore.Put failed", "err", err, "key", key)
+		return "", err
+	}
+	slog.InfoContext(ctx, "localBlobStore.Put succeeded", "key", key, "bytes", len(data))
+	return joinURL(s.baseURL, key), nil
+}
+
+func (s *localBlobStore) write(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *localBlobStore) Delete(ctx context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	err := os.Remove(filepath.Join(s.dir, filepath.FromSlash(key)))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		slog.ErrorContext(ctx, "localBlobStore.Delete failed", "err", err, "key", key)
+		return err
+	}
+	return nil
+}
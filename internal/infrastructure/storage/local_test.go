@@ -0,0 +1,48 @@
+package storage_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalBlobStore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success_put_and_delete", func(t *testing.T) {
+		dir := t.TempDir()
+		store := storage.NewLocalBlobStore(dir, "http://localhost:1099/uploads/")
+
+		url, err := store.Put(ctx, "users/abc.jpg", "image/jpeg", []byte("jpeg"))
+		require.NoError(t, err)
+		assert.Equal(t, "http://localhost:1099/uploads/users/abc.jpg", url)
+
+		data, err := os.ReadFile(filepath.Join(dir, "users", "abc.jpg"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("jpeg"), data)
+
+		require.NoError(t, store.Delete(ctx, "users/abc.jpg"))
+		_, err = os.Stat(filepath.Join(dir, "users", "abc.jpg"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("success_delete_missing_key", func(t *testing.T) {
+		store := storage.NewLocalBlobStore(t.TempDir(), "http://localhost:1099/uploads")
+
+		assert.NoError(t, store.Delete(ctx, "users/missing.jpg"))
+	})
+
+	t.Run("error_key_escapes_dir", func(t *testing.T) {
+		store := storage.NewLocalBlobStore(t.TempDir(), "http://localhost:1099/uploads")
+
+		for _, key := range []string{"../secret.jpg", "users/../../secret.jpg", "/etc/passwd", "", "users//a.jpg"} {
+			_, err := store.Put(ctx, key, "image/jpeg", []byte("x"))
+			assert.Error(t, err, key)
+		}
+	})
+}
@@ -0,0 +1,217 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+)
+
+// S3Config は S3 互換ストレージへの接続設定です。
+type S3Config struct {
+	// Endpoint は "https://s3.ap-northeast-1.amazonaws.com" のような API のエンドポイントです。
+	// Cloudflare R2 や MinIO など S3 互換のサービスもエンドポイントを変えるだけで使えます。
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// SessionToken は一時的な認証情報（Lambda の実行ロールなど）のセッショントークンです。長期のキーでは空にします。
+	SessionToken string
+	// UsePathStyle は "endpoint/bucket/key" 形式の URL で呼び出します。
+	// false のときは "bucket.endpoint/key" 形式（仮想ホスト形式）です。MinIO などは true が必要です。
+	UsePathStyle bool
+	// PublicBaseURL は返す公開 URL の基点です。CDN を前に置くときに指定します。
+	// 空のときはオブジェクトの URL をそのまま返します。
+	PublicBaseURL string
+}
+
+// s3BlobStore は S3 の REST API を署名付きで直接呼び出す BlobStore です。
+// 使う操作は PutObject と DeleteObject だけのため、SDK には依存せず署名（SigV4）を自前で行います。
+type s3BlobStore struct {
+	cfg        S3Config
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+func NewS3BlobStore(cfg S3Config) repository.BlobStore {
+	return &s3BlobStore{
+		cfg:        cfg,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		now:        time.Now,
+	}
+}
+
+func (s *s3BlobStore) Put(ctx context.Context, key string, contentType string, data []byte) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key), bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", contentType)
+	// キーは内容のハッシュから決まり同じキーの中身は変わらないため、長期間キャッシュさせる
+	req.Header.Set("Cache-Control", "public, max-age=31536000, immutable")
+	if err := s.do(req, data); err != nil {
+		slog.ErrorContext(ctx, "s3BlobStore.Put failed", "err", err, "key", key)
+		return "", err
+	}
+	slog.InfoContext(ctx, "s3BlobStore.Put succeeded", "key", key, "bytes", len(data))
+	if s.cfg.PublicBaseURL != "" {
+		return joinURL(s.cfg.PublicBaseURL, key), nil
+	}
+	return s.objectURL(key), nil
+}
+
+func (s *s3BlobStore) Delete(ctx context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key), nil)
+	if err != nil {
+		return err
+	}
+	// 存在しないキーの削除も S3 は 204 を返すため、エラーにはならない
+	if err := s.do(req, nil); err != nil {
+		slog.ErrorContext(ctx, "s3BlobStore.Delete failed", "err", err, "key", key)
+		return err
+	}
+	return nil
+}
+
+func (s *s3BlobStore) do(req *http.Request, payload []byte) error {
+	signV4(req, payload, s.cfg.AccessKeyID, s.cfg.SecretAccessKey, s.cfg.SessionToken, s.cfg.Region, s.now())
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("s3: %s %s: status %d: %s", req.Method, req.URL.Path, resp.StatusCode, body)
+	}
+	return nil
+}
+
+func (s *s3BlobStore) objectURL(key string) string {
+	u, err := url.Parse(s.cfg.Endpoint)
+	if err != nil {
+		return ""
+	}
+	if s.cfg.UsePathStyle {
+		u.Path = "/" + s.cfg.Bucket + "/" + key
+	} else {
+		u.Host = s.cfg.Bucket + "." + u.Host
+		u.Path = "/" + key
+	}
+	return u.String()
+}
+
+// signV4 はリクエストに AWS Signature Version 4 の Authorization ヘッダーを付けます。
+// 署名対象は Host と、その時点で req.Header にあるすべてのヘッダーです。
+// sessionToken があれば X-Amz-Security-Token ヘッダーで送り、署名にも含めます。
+func signV4(req *http.Request, payload []byte, accessKeyID, secretAccessKey, sessionToken, region string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	sum := sha256.Sum256(payload)
+	payloadHash := hex.EncodeToString(sum[:])
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", sessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		uriEncode(req.URL.Path, false),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + region + "/s3/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKeyID, scope, signedHeaders, signature,
+	))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func canonicalQuery(q url.Values) string {
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, k := range keys {
+		values := append([]string(nil), q[k]...)
+		sort.Strings(values)
+		for _, v := range values {
+			parts = append(parts, uriEncode(k, true)+"="+uriEncode(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// uriEncode は SigV4 の規則で文字列をエンコードします。
+// 英数字と "-_.~" 以外を %XX にし、encodeSlash が false のときは "/" を残します。
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for _, c := range []byte(s) {
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,28 @@
+// Package storage は repository.BlobStore の実装です。
+//
+// 開発環境ではローカルのファイルシステムに、本番では S3 互換のオブジェクトストレージに保存します。
+// どちらも保存したファイルの公開 URL を返し、URL はキーだけで決まるため保存後に変わりません。
+package storage
+
+import (
+	"fmt"
+	"strings"
+)
+
+// validateKey はキーが保存先の外を指さないことを確かめます。
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return fmt.Errorf("storage: invalid key %q", key)
+	}
+	for _, seg := range strings.Split(key, "/") {
+		if seg == "" || seg == "." || seg == ".." {
+			return fmt.Errorf("storage: invalid key %q", key)
+		}
+	}
+	return nil
+}
+
+// joinURL は公開 URL の基点とキーをつなぎます。
+func joinURL(baseURL, key string) string {
+	return strings.TrimRight(baseURL, "/") + "/" + key
+}
@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+type DeleteApiV1CoursesIdFavoriteHandler struct {
+	InputPort usecase.DeleteCourseFavoriteInputPort
+}
+
+func (h *DeleteApiV1CoursesIdFavoriteHandler) DeleteApiV1CoursesIdFavorite(ctx echo.Context, id int) error {
+	currentUser, err := middleware.RequireCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.InputPort.Execute(ctx.Request().Context(), usecase.DeleteCourseFavoriteInput{
+		CourseID: uint(id),
+		UserID:   currentUser.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.NewCourseFavoriteResponse(output.CourseID, output.Favorites))
+}
@@ -0,0 +1,62 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeleteApiV1CoursesIdFavoriteHandler(t *testing.T) {
+	t.Run("success_returns_200_with_count", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockDeleteCourseFavoriteInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.DeleteCourseFavoriteInput{CourseID: 10, UserID: 2}).
+			Return(&usecase.DeleteCourseFavoriteOutput{
+				CourseID:  10,
+				Favorites: &model.CourseFavoriteSummary{Count: 2},
+			}, nil)
+
+		ctx, rec := setupJSONRequest(t, http.MethodDelete, "/api/v1/courses/10/favorite", nil)
+		middleware.SetCurrentUser(ctx, &model.User{ID: 2, Name: "bob"})
+
+		h := handler.DeleteApiV1CoursesIdFavoriteHandler{InputPort: mockPort}
+		err := h.DeleteApiV1CoursesIdFavorite(ctx, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp openapi.CourseFavoriteResponseData
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, openapi.CourseFavoriteResponseData{CourseId: 10, FavoriteCount: 2, FavoritedByMe: false}, resp)
+	})
+
+	t.Run("error_unauthorized_without_current_user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockDeleteCourseFavoriteInputPort(ctrl)
+
+		ctx, _ := setupJSONRequest(t, http.MethodDelete, "/api/v1/courses/10/favorite", nil)
+
+		h := handler.DeleteApiV1CoursesIdFavoriteHandler{InputPort: mockPort}
+		err := h.DeleteApiV1CoursesIdFavorite(ctx, 10)
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, statusCode)
+	})
+}
@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+type DeleteApiV1UsersUserIdBlockHandler struct {
+	InputPort usecase.DeleteUserBlockInputPort
+}
+
+func (h *DeleteApiV1UsersUserIdBlockHandler) DeleteApiV1UsersUserIdBlock(ctx echo.Context, userId int) error {
+	currentUser, err := middleware.RequireCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := h.InputPort.Execute(ctx.Request().Context(), usecase.DeleteUserBlockInput{
+		UserID:        currentUser.ID,
+		BlockedUserID: uint(userId),
+	}); err != nil {
+		return err
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
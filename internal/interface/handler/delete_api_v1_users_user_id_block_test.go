@@ -0,0 +1,53 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeleteApiV1UsersUserIdBlockHandler(t *testing.T) {
+	t.Run("success_returns_204", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockDeleteUserBlockInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.DeleteUserBlockInput{UserID: 10, BlockedUserID: 2}).
+			Return(nil)
+
+		ctx, rec := setupJSONRequest(t, http.MethodDelete, "/api/v1/users/2/block", nil)
+		middleware.SetCurrentUser(ctx, &model.User{ID: 10, Name: "alice"})
+
+		h := handler.DeleteApiV1UsersUserIdBlockHandler{InputPort: mockPort}
+		err := h.DeleteApiV1UsersUserIdBlock(ctx, 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("error_unauthorized_without_current_user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockDeleteUserBlockInputPort(ctrl)
+
+		ctx, _ := setupJSONRequest(t, http.MethodDelete, "/api/v1/users/2/block", nil)
+
+		h := handler.DeleteApiV1UsersUserIdBlockHandler{InputPort: mockPort}
+		err := h.DeleteApiV1UsersUserIdBlock(ctx, 2)
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, statusCode)
+	})
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+type GetApiV1AdminDateSpotReviewsHandler struct {
+	InputPort usecase.GetModerationReviewsInputPort
+}
+
+func (h *GetApiV1AdminDateSpotReviewsHandler) GetApiV1AdminDateSpotReviews(ctx echo.Context, params openapi.GetApiV1AdminDateSpotReviewsParams) error {
+	// 非公開・保留中のレビューと通報者を含むため、見られるのは管理者だけ
+	if _, err := middleware.RequireAdmin(ctx); err != nil {
+		return err
+	}
+
+	input := usecase.GetModerationReviewsInput{
+		Page: newPageInput(params.Sort, params.Limit, params.Cursor),
+	}
+	if params.Status != nil {
+		s := string(*params.Status)
+		input.Status = &s
+	}
+	output, err := h.InputPort.Execute(ctx.Request().Context(), input)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.NewModerationReviewListResponse(output.Reviews, output.NextCursor))
+}
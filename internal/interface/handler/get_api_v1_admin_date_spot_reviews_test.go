@@ -0,0 +1,98 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetApiV1AdminDateSpotReviewsHandler(t *testing.T) {
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/date_spot_reviews", nil)
+		rec := httptest.NewRecorder()
+		return e.NewContext(req, rec), rec
+	}
+
+	t.Run("success_returns_200_with_reviews_and_reports", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		content := "ひどい"
+		reviews := []*model.DateSpotReview{{
+			ID:         7,
+			Content:    &content,
+			Status:     model.ReviewStatusPending,
+			UserID:     3,
+			DateSpotID: 4,
+			User:       &model.User{ID: 3, Name: "carol"},
+			DateSpot:   &model.DateSpot{ID: 4, Name: "東京タワー"},
+			Reports: []*model.DateSpotReviewReport{
+				{ID: 1, DateSpotReviewID: 7, UserID: 2, Reason: model.ReportReasonAbuse},
+			},
+		}}
+
+		mockPort := usecasemock.NewMockGetModerationReviewsInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.GetModerationReviewsInput{
+				Status: lo.ToPtr("pending"),
+				Page:   usecase.PageInput{Sort: lo.ToPtr("newest")},
+			}).
+			Return(&usecase.GetModerationReviewsOutput{Reviews: reviews}, nil)
+
+		ctx, rec := newContext()
+		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "admin", Admin: true})
+
+		h := handler.GetApiV1AdminDateSpotReviewsHandler{InputPort: mockPort}
+		err := h.GetApiV1AdminDateSpotReviews(ctx, openapi.GetApiV1AdminDateSpotReviewsParams{
+			Status: lo.ToPtr(openapi.ReviewStatusPending),
+			Sort:   lo.ToPtr(openapi.GetApiV1AdminDateSpotReviewsParamsSortNewest),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp openapi.ModerationReviewListResponseData
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Len(t, resp.DateSpotReviews, 1)
+		got := resp.DateSpotReviews[0]
+		assert.Equal(t, openapi.ReviewStatusPending, got.Status)
+		assert.Equal(t, "carol", got.UserName)
+		assert.Equal(t, "東京タワー", got.DateSpotName)
+		require.Len(t, got.Reports, 1)
+		assert.Equal(t, "abuse", got.Reports[0].Reason)
+		assert.Nil(t, resp.Pagination.NextCursor)
+	})
+
+	// 非公開のレビューや通報者を含むため、一般ユーザーには見せない
+	t.Run("error_forbidden_for_non_admin", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockGetModerationReviewsInputPort(ctrl)
+
+		ctx, _ := newContext()
+		middleware.SetCurrentUser(ctx, &model.User{ID: 2, Name: "alice"})
+
+		h := handler.GetApiV1AdminDateSpotReviewsHandler{InputPort: mockPort}
+		err := h.GetApiV1AdminDateSpotReviews(ctx, openapi.GetApiV1AdminDateSpotReviewsParams{})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusForbidden, statusCode)
+	})
+}
@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+type GetApiV1AdminSpotMatchesHandler struct {
+	InputPort usecase.GetSpotMatchesInputPort
+}
+
+func (h *GetApiV1AdminSpotMatchesHandler) GetApiV1AdminSpotMatches(ctx echo.Context, params openapi.GetApiV1AdminSpotMatchesParams) error {
+	// スポットの統合を判断するのは管理者だけ
+	if _, err := middleware.RequireAdmin(ctx); err != nil {
+		return err
+	}
+
+	output, err := h.InputPort.Execute(ctx.Request().Context(), usecase.GetSpotMatchesInput{
+		Page: newPageInput(params.Sort, params.Limit, params.Cursor),
+	})
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.NewSpotMatchListResponse(output.SpotSources, output.NextCursor))
+}
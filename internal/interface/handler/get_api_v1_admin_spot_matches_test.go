@@ -0,0 +1,94 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetApiV1AdminSpotMatchesHandler(t *testing.T) {
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/spot_matches", nil)
+		rec := httptest.NewRecorder()
+		return e.NewContext(req, rec), rec
+	}
+
+	t.Run("success_returns_200_with_pending_matches", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sources := []*model.SpotSource{{
+			ID:         3,
+			DateSpotID: 7,
+			Source:     model.DateSpotSourceHotPepper,
+			ExternalID: lo.ToPtr("J001"),
+			Name:       "スターバックスコーヒー渋谷店",
+			CityName:   "東京都渋谷区道玄坂1-1",
+			MatchScore: 0.72,
+			Status:     model.SpotSourceStatusPending,
+			DateSpot:   &model.DateSpot{ID: 7, Name: "スターバックス 渋谷店", Source: model.DateSpotSourceManual},
+		}}
+
+		mockPort := usecasemock.NewMockGetSpotMatchesInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.GetSpotMatchesInput{
+				Page: usecase.PageInput{Sort: lo.ToPtr("newest")},
+			}).
+			Return(&usecase.GetSpotMatchesOutput{SpotSources: sources}, nil)
+
+		ctx, rec := newContext()
+		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "admin", Admin: true})
+
+		h := handler.GetApiV1AdminSpotMatchesHandler{InputPort: mockPort}
+		err := h.GetApiV1AdminSpotMatches(ctx, openapi.GetApiV1AdminSpotMatchesParams{
+			Sort: lo.ToPtr(openapi.GetApiV1AdminSpotMatchesParamsSortNewest),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp openapi.SpotMatchListResponseData
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Len(t, resp.SpotMatches, 1)
+		got := resp.SpotMatches[0]
+		assert.Equal(t, "スターバックスコーヒー渋谷店", got.Name)
+		assert.Equal(t, "J001", *got.ExternalId)
+		assert.Equal(t, openapi.SpotMatchDataStatusPending, got.Status)
+		assert.InDelta(t, 0.72, got.MatchScore, 1e-6)
+		assert.Equal(t, 7, got.DateSpot.Id)
+		assert.Equal(t, "スターバックス 渋谷店", got.DateSpot.DateSpot.Name)
+		assert.Nil(t, resp.Pagination.NextCursor)
+	})
+
+	t.Run("error_forbidden_for_non_admin", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockGetSpotMatchesInputPort(ctrl)
+
+		ctx, _ := newContext()
+		middleware.SetCurrentUser(ctx, &model.User{ID: 2, Name: "alice"})
+
+		h := handler.GetApiV1AdminSpotMatchesHandler{InputPort: mockPort}
+		err := h.GetApiV1AdminSpotMatches(ctx, openapi.GetApiV1AdminSpotMatchesParams{})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusForbidden, statusCode)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
 	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	"github.com/labstack/echo/v4"
@@ -16,13 +17,15 @@ type GetApiV1CoursesHandler struct {
 func (h *GetApiV1CoursesHandler) GetApiV1Courses(ctx echo.Context, params openapi.GetApiV1CoursesParams) error {
 	input := usecase.GetCoursesInput{
 		PrefectureID: params.PrefectureId,
+		Page:         newPageInput(params.Sort, params.Limit, params.Cursor),
+		ViewerID:     middleware.CurrentUserID(ctx),
 	}
 	output, err := h.InputPort.Execute(ctx.Request().Context(), input)
 	if err != nil {
 		return err
 	}
 
-	resp, err := openapi.NewCoursesResponse(output.Courses)
+	resp, err := openapi.NewCourseListResponse(output.Courses, output.NextCursor)
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
@@ -55,6 +55,49 @@ func TestGetApiV1CoursesIdHandler(t *testing.T) {
 		assert.Equal(t, float64(2), user["id"])
 	})
 
+	t.Run("success_includes_route_totals_and_legs", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		distance, duration := 1250, 938
+		mockPort := usecasemock.NewMockGetCourseInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.GetCourseInput{CourseID: 1}).
+			Return(&usecase.GetCourseOutput{
+				Course: &model.Course{
+					ID:         1,
+					TravelMode: "WALKING",
+					Authority:  "公開",
+					User:       newTestUser(2, "user@example.com"),
+					Route: &model.CourseRoute{
+						TotalDistanceMeters:  distance,
+						TotalDurationSeconds: duration,
+						Legs: []*model.CourseRouteLeg{
+							{FromDateSpotID: 3, ToDateSpotID: 4, DistanceMeters: &distance, DurationSeconds: &duration},
+							{FromDateSpotID: 4, ToDateSpotID: 5},
+						},
+					},
+				},
+			}, nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/courses/1", nil)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+
+		h := handler.GetApiV1CoursesIdHandler{InputPort: mockPort}
+		require.NoError(t, h.GetApiV1CoursesId(ctx, 1))
+
+		var resp map[string]interface{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, float64(1250), resp["total_distance_meters"])
+		assert.Equal(t, float64(938), resp["total_duration_seconds"])
+		legs := resp["legs"].([]interface{})
+		require.Len(t, legs, 2)
+		assert.Equal(t, float64(3), legs[0].(map[string]interface{})["from_date_spot_id"])
+		assert.Nil(t, legs[1].(map[string]interface{})["distance_meters"])
+	})
+
 	t.Run("error_not_found", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
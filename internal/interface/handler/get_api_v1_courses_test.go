@@ -53,9 +53,9 @@ func TestGetApiV1CoursesHandler(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		var resp []interface{}
+		var resp openapi.CourseListResponseData
 		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
-		assert.Equal(t, 2, len(resp))
+		assert.Equal(t, 2, len(resp.Courses))
 	})
 
 	t.Run("success_with_prefecture_id_filter", func(t *testing.T) {
@@ -83,9 +83,9 @@ func TestGetApiV1CoursesHandler(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		var resp []interface{}
+		var resp openapi.CourseListResponseData
 		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
-		assert.Equal(t, 1, len(resp))
+		assert.Equal(t, 1, len(resp.Courses))
 	})
 
 	t.Run("success_returns_empty_list", func(t *testing.T) {
@@ -108,9 +108,9 @@ func TestGetApiV1CoursesHandler(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		var resp []interface{}
+		var resp openapi.CourseListResponseData
 		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
-		assert.Equal(t, 0, len(resp))
+		assert.Equal(t, 0, len(resp.Courses))
 	})
 
 	t.Run("error_usecase_failure_returns_error", func(t *testing.T) {
@@ -19,11 +19,15 @@ func (h *GetApiV1DateSpotsHandler) GetApiV1DateSpots(ctx echo.Context, params op
 		PrefectureID: params.PrefectureId,
 		GenreID:      params.GenreId,
 		ComeTime:     params.ComeTime,
+		Latitude:     params.Lat,
+		Longitude:    params.Lng,
+		RadiusMeters: params.RadiusM,
+		Page:         newPageInput(params.Sort, params.Limit, params.Cursor),
 	}
 	output, err := h.InputPort.Execute(ctx.Request().Context(), input)
 	if err != nil {
 		return err
 	}
 
-	return ctx.JSON(http.StatusOK, openapi.NewDateSpotsResponse(output.DateSpots))
+	return ctx.JSON(http.StatusOK, openapi.NewDateSpotListResponse(output.DateSpots, output.NextCursor))
 }
@@ -3,6 +3,7 @@ package handler
 import (
 	"net/http"
 
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
 	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	"github.com/labstack/echo/v4"
@@ -14,7 +15,8 @@ type GetApiV1DateSpotsIdHandler struct {
 
 func (h *GetApiV1DateSpotsIdHandler) GetApiV1DateSpotsId(ctx echo.Context, id int) error {
 	output, err := h.InputPort.Execute(ctx.Request().Context(), usecase.GetDateSpotInput{
-		ID: uint(id),
+		ID:       uint(id),
+		ViewerID: middleware.CurrentUserID(ctx),
 	})
 	if err != nil {
 		return err
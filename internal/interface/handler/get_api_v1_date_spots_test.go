@@ -14,6 +14,7 @@ import (
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
 	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -49,9 +50,9 @@ func TestGetApiV1DateSpotsHandler(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		var resp []map[string]interface{}
+		var resp openapi.DateSpotListResponseData
 		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
-		assert.Len(t, resp, 1)
+		assert.Len(t, resp.DateSpots, 1)
 	})
 
 	t.Run("success_returns_200_empty_list", func(t *testing.T) {
@@ -74,9 +75,44 @@ func TestGetApiV1DateSpotsHandler(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		var resp []interface{}
+		var resp openapi.DateSpotListResponseData
 		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
-		assert.Len(t, resp, 0)
+		assert.Len(t, resp.DateSpots, 0)
+	})
+
+	t.Run("success_passes_near_params_and_returns_distance", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		lat, lng, radius := 35.681236, 139.767125, 1000
+		distance := 120.6
+		dateSpot := dummyDateSpot(1, "東京タワー")
+		dateSpot.DistanceMeters = &distance
+
+		mockPort := usecasemock.NewMockGetDateSpotsInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.GetDateSpotsInput{
+				Latitude:     &lat,
+				Longitude:    &lng,
+				RadiusMeters: &radius,
+			}).
+			Return(&usecase.GetDateSpotsOutput{DateSpots: []*model.DateSpot{dateSpot}}, nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/date_spots?lat=35.681236&lng=139.767125&radius_m=1000", nil)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+
+		h := handler.GetApiV1DateSpotsHandler{InputPort: mockPort}
+		err := h.GetApiV1DateSpots(ctx, openapi.GetApiV1DateSpotsParams{Lat: &lat, Lng: &lng, RadiusM: &radius})
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp openapi.DateSpotListResponseData
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Len(t, resp.DateSpots, 1)
+		assert.Equal(t, lo.ToPtr(121), resp.DateSpots[0].DistanceMeters)
 	})
 
 	t.Run("error_usecase_returns_internal_server_error", func(t *testing.T) {
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+type GetApiV1FeedHandler struct {
+	InputPort usecase.GetFeedInputPort
+}
+
+func (h *GetApiV1FeedHandler) GetApiV1Feed(ctx echo.Context, params openapi.GetApiV1FeedParams) error {
+	currentUser, err := middleware.RequireCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.InputPort.Execute(ctx.Request().Context(), usecase.GetFeedInput{
+		UserID: currentUser.ID,
+		Page:   usecase.PageInput{Limit: params.Limit, Cursor: params.Cursor},
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := openapi.NewFeedResponse(output.Activities, output.NextCursor)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
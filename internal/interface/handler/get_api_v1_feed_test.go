@@ -0,0 +1,112 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetApiV1FeedHandler(t *testing.T) {
+	// 種類ごとに course・date_spot_review・followed_user のいずれか1つだけを返す
+	t.Run("success_returns_activities_of_each_type", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		createdAt := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+		actor := &model.User{ID: 2, Name: "bob", Gender: model.GenderMale}
+		next := pagination.NewTimeCursor(pagination.SortNewest, createdAt, 62)
+		mockPort := usecasemock.NewMockGetFeedInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.GetFeedInput{
+				UserID: 1,
+				Page:   usecase.PageInput{Limit: lo.ToPtr(3)},
+			}).
+			Return(&usecase.GetFeedOutput{
+				Activities: []*model.Activity{
+					{
+						Type:      model.ActivityTypeCourseCreated,
+						CreatedAt: createdAt,
+						Actor:     actor,
+						Course: &model.Course{
+							ID: 20, UserID: 2, Authority: model.CourseAuthorityPublic, User: actor,
+							Favorites: &model.CourseFavoriteSummary{Count: 1},
+						},
+					},
+					{
+						Type:           model.ActivityTypeReviewCreated,
+						CreatedAt:      createdAt,
+						Actor:          actor,
+						DateSpotReview: &model.DateSpotReview{ID: 30, Rate: lo.ToPtr(4.0), Content: lo.ToPtr("よかった")},
+					},
+					{
+						Type:         model.ActivityTypeUserFollowed,
+						CreatedAt:    createdAt,
+						Actor:        actor,
+						FollowedUser: &model.User{ID: 4, Name: "carol", Gender: model.GenderFemale},
+					},
+				},
+				NextCursor: &next,
+			}, nil)
+
+		ctx, rec := setupJSONRequest(t, http.MethodGet, "/api/v1/feed?limit=3", nil)
+		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+
+		h := handler.GetApiV1FeedHandler{InputPort: mockPort}
+		err := h.GetApiV1Feed(ctx, openapi.GetApiV1FeedParams{Limit: lo.ToPtr(3)})
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp openapi.FeedResponseData
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Len(t, resp.Activities, 3)
+
+		assert.Equal(t, openapi.CourseCreated, resp.Activities[0].Type)
+		assert.Equal(t, 2, resp.Activities[0].Actor.Id)
+		require.NotNil(t, resp.Activities[0].Course)
+		assert.Equal(t, 20, resp.Activities[0].Course.Id)
+		assert.Equal(t, lo.ToPtr(1), resp.Activities[0].Course.FavoriteCount)
+		assert.Nil(t, resp.Activities[0].DateSpotReview)
+		assert.Nil(t, resp.Activities[0].FollowedUser)
+
+		assert.Equal(t, openapi.ReviewCreated, resp.Activities[1].Type)
+		require.NotNil(t, resp.Activities[1].DateSpotReview)
+		assert.Equal(t, 30, resp.Activities[1].DateSpotReview.Id)
+		assert.Nil(t, resp.Activities[1].Course)
+
+		assert.Equal(t, openapi.UserFollowed, resp.Activities[2].Type)
+		require.NotNil(t, resp.Activities[2].FollowedUser)
+		assert.Equal(t, "carol", resp.Activities[2].FollowedUser.Name)
+
+		assert.Equal(t, lo.ToPtr(next.Encode()), resp.Pagination.NextCursor)
+	})
+
+	t.Run("error_unauthorized_without_current_user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx, _ := setupJSONRequest(t, http.MethodGet, "/api/v1/feed", nil)
+
+		h := handler.GetApiV1FeedHandler{InputPort: usecasemock.NewMockGetFeedInputPort(ctrl)}
+		err := h.GetApiV1Feed(ctx, openapi.GetApiV1FeedParams{})
+
+		require.Error(t, err)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, statusCode)
+	})
+}
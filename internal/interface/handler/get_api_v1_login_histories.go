@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+type GetApiV1LoginHistoriesHandler struct {
+	InputPort usecase.GetLoginHistoriesInputPort
+}
+
+func (h *GetApiV1LoginHistoriesHandler) GetApiV1LoginHistories(ctx echo.Context, params openapi.GetApiV1LoginHistoriesParams) error {
+	// IP アドレスを含むため、見られるのは本人の履歴だけ
+	currentUser, err := middleware.RequireCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.InputPort.Execute(ctx.Request().Context(), usecase.GetLoginHistoriesInput{
+		UserID: currentUser.ID,
+		Page:   usecase.PageInput{Limit: params.Limit, Cursor: params.Cursor},
+	})
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.NewLoginHistoryListResponse(output.Histories, output.NextCursor))
+}
@@ -0,0 +1,71 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetApiV1LoginHistoriesHandler(t *testing.T) {
+	t.Run("success_returns_current_user_histories", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		createdAt := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+		mockPort := usecasemock.NewMockGetLoginHistoriesInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.GetLoginHistoriesInput{
+				UserID: 1,
+				Page:   usecase.PageInput{Limit: lo.ToPtr(10)},
+			}).
+			Return(&usecase.GetLoginHistoriesOutput{
+				Histories: []*model.LoginHistory{
+					{ID: 3, UserID: 1, Result: model.LoginResultFailed, IPAddress: "192.0.2.1", UserAgent: "test-agent", CreatedAt: createdAt},
+				},
+			}, nil)
+
+		ctx, rec := setupJSONRequest(t, http.MethodGet, "/api/v1/login_histories?limit=10", nil)
+		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+
+		h := handler.GetApiV1LoginHistoriesHandler{InputPort: mockPort}
+		err := h.GetApiV1LoginHistories(ctx, openapi.GetApiV1LoginHistoriesParams{Limit: lo.ToPtr(10)})
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp openapi.LoginHistoryListResponseData
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Len(t, resp.LoginHistories, 1)
+		assert.Equal(t, openapi.LoginHistoryDataResult("failed"), resp.LoginHistories[0].Result)
+		assert.Equal(t, "192.0.2.1", resp.LoginHistories[0].IpAddress)
+		assert.Nil(t, resp.Pagination.NextCursor)
+	})
+
+	t.Run("error_unauthorized_without_current_user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx, _ := setupJSONRequest(t, http.MethodGet, "/api/v1/login_histories", nil)
+
+		h := handler.GetApiV1LoginHistoriesHandler{InputPort: usecasemock.NewMockGetLoginHistoriesInputPort(ctrl)}
+		err := h.GetApiV1LoginHistories(ctx, openapi.GetApiV1LoginHistoriesParams{})
+
+		require.Error(t, err)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, statusCode)
+	})
+}
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+type GetApiV1NotificationsHandler struct {
+	InputPort usecase.GetNotificationsInputPort
+}
+
+func (h *GetApiV1NotificationsHandler) GetApiV1Notifications(ctx echo.Context, params openapi.GetApiV1NotificationsParams) error {
+	currentUser, err := middleware.RequireCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.InputPort.Execute(ctx.Request().Context(), usecase.GetNotificationsInput{
+		UserID: currentUser.ID,
+		Page:   usecase.PageInput{Limit: params.Limit, Cursor: params.Cursor},
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := openapi.NewNotificationListResponse(output.Notifications, output.NextCursor)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
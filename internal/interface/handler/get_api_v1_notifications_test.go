@@ -0,0 +1,93 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetApiV1NotificationsHandler(t *testing.T) {
+	// 種類に応じた ID だけを返し、read_at の有無を read にする
+	t.Run("success_returns_notifications", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		createdAt := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+		actor := &model.User{ID: 2, Name: "bob", Gender: model.GenderMale}
+		next := pagination.NewTimeCursor(pagination.SortNewest, createdAt, 10)
+		mockPort := usecasemock.NewMockGetNotificationsInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.GetNotificationsInput{
+				UserID: 1,
+				Page:   usecase.PageInput{Limit: lo.ToPtr(2)},
+			}).
+			Return(&usecase.GetNotificationsOutput{
+				Notifications: []*model.Notification{
+					{
+						ID: 11, UserID: 1, ActorID: 2, Type: model.NotificationTypeCourseFavorited,
+						CourseID: lo.ToPtr(uint(20)), CreatedAt: createdAt, Actor: actor,
+					},
+					{
+						ID: 10, UserID: 1, ActorID: 2, Type: model.NotificationTypeFollowed,
+						ReadAt: lo.ToPtr(createdAt), CreatedAt: createdAt, Actor: actor,
+					},
+				},
+				NextCursor: &next,
+			}, nil)
+
+		ctx, rec := setupJSONRequest(t, http.MethodGet, "/api/v1/notifications?limit=2", nil)
+		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+
+		h := handler.GetApiV1NotificationsHandler{InputPort: mockPort}
+		err := h.GetApiV1Notifications(ctx, openapi.GetApiV1NotificationsParams{Limit: lo.ToPtr(2)})
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp openapi.NotificationListResponseData
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Len(t, resp.Notifications, 2)
+
+		assert.Equal(t, 11, resp.Notifications[0].Id)
+		assert.Equal(t, openapi.CourseFavorited, resp.Notifications[0].Type)
+		assert.Equal(t, "bob", resp.Notifications[0].Actor.Name)
+		assert.Equal(t, lo.ToPtr(20), resp.Notifications[0].CourseId)
+		assert.Nil(t, resp.Notifications[0].DateSpotId)
+		assert.False(t, resp.Notifications[0].Read)
+
+		assert.Equal(t, openapi.Followed, resp.Notifications[1].Type)
+		assert.Nil(t, resp.Notifications[1].CourseId)
+		assert.True(t, resp.Notifications[1].Read)
+
+		assert.Equal(t, lo.ToPtr(next.Encode()), resp.Pagination.NextCursor)
+	})
+
+	t.Run("error_unauthorized_without_current_user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx, _ := setupJSONRequest(t, http.MethodGet, "/api/v1/notifications", nil)
+
+		h := handler.GetApiV1NotificationsHandler{InputPort: usecasemock.NewMockGetNotificationsInputPort(ctrl)}
+		err := h.GetApiV1Notifications(ctx, openapi.GetApiV1NotificationsParams{})
+
+		require.Error(t, err)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, statusCode)
+	})
+}
@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+type GetApiV1NotificationsUnreadCountHandler struct {
+	InputPort usecase.GetUnreadNotificationCountInputPort
+}
+
+func (h *GetApiV1NotificationsUnreadCountHandler) GetApiV1NotificationsUnreadCount(ctx echo.Context) error {
+	currentUser, err := middleware.RequireCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.InputPort.Execute(ctx.Request().Context(), usecase.GetUnreadNotificationCountInput{
+		UserID: currentUser.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.UnreadNotificationCountResponseData{UnreadCount: output.UnreadCount})
+}
@@ -0,0 +1,56 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetApiV1NotificationsUnreadCountHandler(t *testing.T) {
+	t.Run("success_returns_unread_count", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockGetUnreadNotificationCountInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.GetUnreadNotificationCountInput{UserID: 1}).
+			Return(&usecase.GetUnreadNotificationCountOutput{UnreadCount: 3}, nil)
+
+		ctx, rec := setupJSONRequest(t, http.MethodGet, "/api/v1/notifications/unread_count", nil)
+		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+
+		h := handler.GetApiV1NotificationsUnreadCountHandler{InputPort: mockPort}
+		require.NoError(t, h.GetApiV1NotificationsUnreadCount(ctx))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp openapi.UnreadNotificationCountResponseData
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, 3, resp.UnreadCount)
+	})
+
+	t.Run("error_unauthorized_without_current_user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx, _ := setupJSONRequest(t, http.MethodGet, "/api/v1/notifications/unread_count", nil)
+
+		h := handler.GetApiV1NotificationsUnreadCountHandler{InputPort: usecasemock.NewMockGetUnreadNotificationCountInputPort(ctrl)}
+		err := h.GetApiV1NotificationsUnreadCount(ctx)
+
+		require.Error(t, err)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, statusCode)
+	})
+}
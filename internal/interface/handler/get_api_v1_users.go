@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
 	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	"github.com/labstack/echo/v4"
@@ -15,13 +16,15 @@ type GetApiV1UsersHandler struct {
 
 func (h *GetApiV1UsersHandler) GetApiV1Users(ctx echo.Context, params openapi.GetApiV1UsersParams) error {
 	output, err := h.InputPort.Execute(ctx.Request().Context(), usecase.GetUsersInput{
-		Name: params.Name,
+		Name:     params.Name,
+		Page:     newPageInput(params.Sort, params.Limit, params.Cursor),
+		ViewerID: middleware.CurrentUserID(ctx),
 	})
 	if err != nil {
 		return err
 	}
 
-	response, err := openapi.NewGetUsersResponse(output.Users)
+	response, err := openapi.NewUserListResponse(output.Users, output.NextCursor)
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
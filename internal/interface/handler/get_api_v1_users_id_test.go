@@ -8,7 +8,9 @@ import (
 	"testing"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
 	"github.com/labstack/echo/v4"
@@ -45,6 +47,42 @@ func TestGetApiV1UsersIdHandler(t *testing.T) {
 		assert.Equal(t, "ターゲットユーザー", resp["name"])
 	})
 
+	// お気に入りタブのコースには件数と閲覧者のお気に入り有無を載せる
+	t.Run("success_returns_favorite_courses", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		user := dummyUserWithRelations(1, "ターゲットユーザー")
+		user.FavoriteCourses = []*model.Course{{
+			ID:        20,
+			UserID:    2,
+			Authority: model.CourseAuthorityPublic,
+			Favorites: &model.CourseFavoriteSummary{Count: 3, FavoritedByViewer: true},
+		}}
+		mockPort := usecasemock.NewMockGetUserInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.GetUserInput{ID: 1}).
+			Return(&usecase.GetUserOutput{UserWithRelations: user}, nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users/1", nil)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+
+		h := handler.GetApiV1UsersIdHandler{InputPort: mockPort}
+		err := h.GetApiV1UsersId(ctx, 1)
+
+		require.NoError(t, err)
+
+		var resp openapi.UserResponseData
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.NotNil(t, resp.FavoriteCourses)
+		require.Len(t, *resp.FavoriteCourses, 1)
+		assert.Equal(t, 20, (*resp.FavoriteCourses)[0].Id)
+		assert.Equal(t, 3, *(*resp.FavoriteCourses)[0].FavoriteCount)
+		assert.True(t, *(*resp.FavoriteCourses)[0].FavoritedByMe)
+	})
+
 	t.Run("error_user_not_found", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -11,9 +11,11 @@ import (
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
 	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
 	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -41,10 +43,10 @@ func TestGetApiV1UsersHandler(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		var resp []map[string]interface{}
+		var resp openapi.UserListResponseData
 		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
-		assert.Len(t, resp, 1)
-		assert.Equal(t, float64(1), resp[0]["id"])
+		assert.Len(t, resp.Users, 1)
+		assert.Equal(t, 1, resp.Users[0].Id)
 	})
 
 	t.Run("success_returns_200_empty_list", func(t *testing.T) {
@@ -67,9 +69,64 @@ func TestGetApiV1UsersHandler(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		var resp []interface{}
+		var resp openapi.UserListResponseData
 		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
-		assert.Len(t, resp, 0)
+		assert.Len(t, resp.Users, 0)
+	})
+
+	t.Run("success_passes_page_params_and_returns_next_cursor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sort := openapi.GetApiV1UsersParamsSortName
+		limit := 1
+		cursor := "prev"
+		next := pagination.NewStringCursor(pagination.SortName, "ユーザー1", 1)
+		user := dummyUserWithRelations(1, "ユーザー1")
+
+		mockPort := usecasemock.NewMockGetUsersInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.GetUsersInput{
+				Page: usecase.PageInput{Sort: lo.ToPtr("name"), Limit: &limit, Cursor: &cursor},
+			}).
+			Return(&usecase.GetUsersOutput{Users: []*model.UserWithRelations{user}, NextCursor: &next}, nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users?sort=name&limit=1&cursor=prev", nil)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+
+		h := handler.GetApiV1UsersHandler{InputPort: mockPort}
+		err := h.GetApiV1Users(ctx, openapi.GetApiV1UsersParams{Sort: &sort, Limit: &limit, Cursor: &cursor})
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp openapi.UserListResponseData
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Len(t, resp.Users, 1)
+		assert.Equal(t, lo.ToPtr(next.Encode()), resp.Pagination.NextCursor)
+	})
+
+	t.Run("success_last_page_returns_null_next_cursor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockGetUsersInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			Return(&usecase.GetUsersOutput{Users: []*model.UserWithRelations{}}, nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users", nil)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+
+		h := handler.GetApiV1UsersHandler{InputPort: mockPort}
+		err := h.GetApiV1Users(ctx, openapi.GetApiV1UsersParams{})
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"users":[],"pagination":{"next_cursor":null}}`, rec.Body.String())
 	})
 
 	t.Run("error_usecase_returns_internal_server_error", func(t *testing.T) {
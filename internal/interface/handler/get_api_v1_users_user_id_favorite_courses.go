@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+type GetApiV1UsersUserIdFavoriteCoursesHandler struct {
+	InputPort usecase.GetUserFavoriteCoursesInputPort
+}
+
+func (h *GetApiV1UsersUserIdFavoriteCoursesHandler) GetApiV1UsersUserIdFavoriteCourses(ctx echo.Context, userId int, params openapi.GetApiV1UsersUserIdFavoriteCoursesParams) error {
+	output, err := h.InputPort.Execute(ctx.Request().Context(), usecase.GetUserFavoriteCoursesInput{
+		UserID:   uint(userId),
+		ViewerID: middleware.CurrentUserID(ctx),
+		Page:     usecase.PageInput{Limit: params.Limit, Cursor: params.Cursor},
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := openapi.NewCourseListResponse(output.Courses, output.NextCursor)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,98 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetApiV1UsersUserIdFavoriteCoursesHandler(t *testing.T) {
+	// ログイン中なら閲覧者の ID を渡し、favorited_by_me を閲覧者から見た値にする
+	t.Run("success_passes_viewer_id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockGetUserFavoriteCoursesInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.GetUserFavoriteCoursesInput{
+				UserID:   1,
+				ViewerID: 7,
+				Page:     usecase.PageInput{Limit: lo.ToPtr(10)},
+			}).
+			Return(&usecase.GetUserFavoriteCoursesOutput{
+				Courses: []*model.Course{{
+					ID:        20,
+					UserID:    2,
+					Authority: model.CourseAuthorityPublic,
+					User:      &model.User{ID: 2, Name: "bob", Gender: "男性"},
+					Favorites: &model.CourseFavoriteSummary{Count: 4, FavoritedByViewer: true},
+				}},
+			}, nil)
+
+		ctx, rec := setupJSONRequest(t, http.MethodGet, "/api/v1/users/1/favorite_courses?limit=10", nil)
+		middleware.SetCurrentUser(ctx, &model.User{ID: 7, Name: "viewer"})
+
+		h := handler.GetApiV1UsersUserIdFavoriteCoursesHandler{InputPort: mockPort}
+		err := h.GetApiV1UsersUserIdFavoriteCourses(ctx, 1, openapi.GetApiV1UsersUserIdFavoriteCoursesParams{Limit: lo.ToPtr(10)})
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp openapi.CourseListResponseData
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Len(t, resp.Courses, 1)
+		assert.Equal(t, 20, resp.Courses[0].Id)
+		assert.Equal(t, lo.ToPtr(4), resp.Courses[0].FavoriteCount)
+		assert.Equal(t, lo.ToPtr(true), resp.Courses[0].FavoritedByMe)
+	})
+
+	// 未ログインでも見られる。閲覧者は 0 として渡す
+	t.Run("success_without_login", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockGetUserFavoriteCoursesInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.GetUserFavoriteCoursesInput{UserID: 1}).
+			Return(&usecase.GetUserFavoriteCoursesOutput{Courses: []*model.Course{}}, nil)
+
+		ctx, rec := setupJSONRequest(t, http.MethodGet, "/api/v1/users/1/favorite_courses", nil)
+
+		h := handler.GetApiV1UsersUserIdFavoriteCoursesHandler{InputPort: mockPort}
+		err := h.GetApiV1UsersUserIdFavoriteCourses(ctx, 1, openapi.GetApiV1UsersUserIdFavoriteCoursesParams{})
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("error_user_not_found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockGetUserFavoriteCoursesInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			Return(nil, apperror.NotFound())
+
+		ctx, _ := setupJSONRequest(t, http.MethodGet, "/api/v1/users/999/favorite_courses", nil)
+
+		h := handler.GetApiV1UsersUserIdFavoriteCoursesHandler{InputPort: mockPort}
+		err := h.GetApiV1UsersUserIdFavoriteCourses(ctx, 999, openapi.GetApiV1UsersUserIdFavoriteCoursesParams{})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, statusCode)
+	})
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"net/http"
 
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
 	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	"github.com/labstack/echo/v4"
@@ -12,15 +13,17 @@ type GetApiV1UsersUserIdFollowersHandler struct {
 	InputPort usecase.GetUserFollowersInputPort
 }
 
-func (h *GetApiV1UsersUserIdFollowersHandler) GetApiV1UsersUserIdFollowers(ctx echo.Context, userId int) error {
+func (h *GetApiV1UsersUserIdFollowersHandler) GetApiV1UsersUserIdFollowers(ctx echo.Context, userId int, params openapi.GetApiV1UsersUserIdFollowersParams) error {
 	output, err := h.InputPort.Execute(ctx.Request().Context(), usecase.GetUserFollowersInput{
-		UserID: uint(userId),
+		UserID:   uint(userId),
+		Page:     newPageInput(params.Sort, params.Limit, params.Cursor),
+		ViewerID: middleware.CurrentUserID(ctx),
 	})
 	if err != nil {
 		return err
 	}
 
-	response, err := openapi.NewGetUsersResponse(output.Users)
+	response, err := openapi.NewRelationshipResponse(output.UserName, output.Users, output.NextCursor)
 	if err != nil {
 		return err
 	}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
 	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	"github.com/labstack/echo/v4"
@@ -13,15 +14,17 @@ type GetApiV1UsersUserIdFollowingsHandler struct {
 	InputPort usecase.GetUserFollowingsInputPort
 }
 
-func (h *GetApiV1UsersUserIdFollowingsHandler) GetApiV1UsersUserIdFollowings(ctx echo.Context, userId int) error {
+func (h *GetApiV1UsersUserIdFollowingsHandler) GetApiV1UsersUserIdFollowings(ctx echo.Context, userId int, params openapi.GetApiV1UsersUserIdFollowingsParams) error {
 	output, err := h.InputPort.Execute(ctx.Request().Context(), usecase.GetUserFollowingsInput{
-		UserID: uint(userId),
+		UserID:   uint(userId),
+		Page:     newPageInput(params.Sort, params.Limit, params.Cursor),
+		ViewerID: middleware.CurrentUserID(ctx),
 	})
 	if err != nil {
 		return err
 	}
 
-	response, err := openapi.NewGetUsersResponse(output.Users)
+	response, err := openapi.NewRelationshipResponse(output.UserName, output.Users, output.NextCursor)
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
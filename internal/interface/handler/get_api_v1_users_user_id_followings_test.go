@@ -10,6 +10,7 @@ import (
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
 	"github.com/labstack/echo/v4"
@@ -27,7 +28,7 @@ func TestGetApiV1UsersUserIdFollowingsHandler(t *testing.T) {
 		mockPort := usecasemock.NewMockGetUserFollowingsInputPort(ctrl)
 		mockPort.EXPECT().
 			Execute(gomock.Any(), usecase.GetUserFollowingsInput{UserID: 1}).
-			Return(&usecase.GetUserFollowingsOutput{Users: []*model.UserWithRelations{following}}, nil)
+			Return(&usecase.GetUserFollowingsOutput{UserName: "テストユーザー", Users: []*model.UserWithRelations{following}}, nil)
 
 		e := echo.New()
 		req := httptest.NewRequest(http.MethodGet, "/api/v1/users/1/followings", nil)
@@ -35,15 +36,16 @@ func TestGetApiV1UsersUserIdFollowingsHandler(t *testing.T) {
 		ctx := e.NewContext(req, rec)
 
 		h := handler.GetApiV1UsersUserIdFollowingsHandler{InputPort: mockPort}
-		err := h.GetApiV1UsersUserIdFollowings(ctx, 1)
+		err := h.GetApiV1UsersUserIdFollowings(ctx, 1, openapi.GetApiV1UsersUserIdFollowingsParams{})
 
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		var resp []map[string]interface{}
+		var resp openapi.RelationShipResponsData
 		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
-		assert.Len(t, resp, 1)
-		assert.Equal(t, float64(2), resp[0]["id"])
+		assert.Equal(t, "テストユーザー", resp.UserName)
+		assert.Len(t, resp.Users, 1)
+		assert.Equal(t, 2, resp.Users[0].Id)
 	})
 
 	t.Run("success_returns_200_empty_list", func(t *testing.T) {
@@ -61,14 +63,14 @@ func TestGetApiV1UsersUserIdFollowingsHandler(t *testing.T) {
 		ctx := e.NewContext(req, rec)
 
 		h := handler.GetApiV1UsersUserIdFollowingsHandler{InputPort: mockPort}
-		err := h.GetApiV1UsersUserIdFollowings(ctx, 1)
+		err := h.GetApiV1UsersUserIdFollowings(ctx, 1, openapi.GetApiV1UsersUserIdFollowingsParams{})
 
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		var resp []interface{}
+		var resp openapi.RelationShipResponsData
 		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
-		assert.Len(t, resp, 0)
+		assert.Len(t, resp.Users, 0)
 	})
 
 	t.Run("error_user_not_found", func(t *testing.T) {
@@ -86,7 +88,7 @@ func TestGetApiV1UsersUserIdFollowingsHandler(t *testing.T) {
 		ctx := e.NewContext(req, rec)
 
 		h := handler.GetApiV1UsersUserIdFollowingsHandler{InputPort: mockPort}
-		err := h.GetApiV1UsersUserIdFollowings(ctx, 999)
+		err := h.GetApiV1UsersUserIdFollowings(ctx, 999, openapi.GetApiV1UsersUserIdFollowingsParams{})
 
 		assert.Error(t, err)
 		statusCode, _, _, ok := apperror.HTTPStatus(err)
@@ -109,7 +111,7 @@ func TestGetApiV1UsersUserIdFollowingsHandler(t *testing.T) {
 		ctx := e.NewContext(req, rec)
 
 		h := handler.GetApiV1UsersUserIdFollowingsHandler{InputPort: mockPort}
-		err := h.GetApiV1UsersUserIdFollowings(ctx, 1)
+		err := h.GetApiV1UsersUserIdFollowings(ctx, 1, openapi.GetApiV1UsersUserIdFollowingsParams{})
 
 		assert.Error(t, err)
 		statusCode, _, _, ok := apperror.HTTPStatus(err)
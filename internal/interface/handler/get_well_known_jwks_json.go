@@ -0,0 +1,22 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	jwtpkg "github.com/daisuke-harada/date-courses-go/internal/pkg/jwt"
+	"github.com/labstack/echo/v4"
+)
+
+// jwksCacheControl は JWKS を取得する側にキャッシュさせる期間です。
+// 鍵を入れ替えるときは、新しい公開鍵を載せてからこの期間以上待って署名鍵を切り替えます。
+const jwksCacheControl = "public, max-age=300"
+
+type GetWellKnownJwksJsonHandler struct {
+	Keyring *jwtpkg.Keyring
+}
+
+func (h *GetWellKnownJwksJsonHandler) GetWellKnownJwksJson(ctx echo.Context) error {
+	ctx.Response().Header().Set("Cache-Control", jwksCacheControl)
+	return ctx.JSON(http.StatusOK, openapi.NewJWKSResponse(h.Keyring.JWKS()))
+}
@@ -0,0 +1,70 @@
+package handler_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	jwtpkg "github.com/daisuke-harada/date-courses-go/internal/pkg/jwt"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetWellKnownJwksJsonHandler(t *testing.T) {
+	t.Run("success_returns_public_keys", func(t *testing.T) {
+		private, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		der, err := x509.MarshalECPrivateKey(private)
+		require.NoError(t, err)
+		signing, err := jwtpkg.ParsePrivateKeyPEM("ec-1", pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}))
+		require.NoError(t, err)
+		keyring, err := jwtpkg.NewKeyring("https://api.example.com", "date-courses", signing)
+		require.NoError(t, err)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+
+		h := &handler.GetWellKnownJwksJsonHandler{Keyring: keyring}
+		err = h.GetWellKnownJwksJson(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "public, max-age=300", rec.Header().Get("Cache-Control"))
+		var res openapi.JWKSResponseData
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Len(t, res.Keys, 1)
+		assert.Equal(t, "ec-1", res.Keys[0].Kid)
+		assert.Equal(t, "ES256", res.Keys[0].Alg)
+		assert.Equal(t, "P-256", *res.Keys[0].Crv)
+		assert.Nil(t, res.Keys[0].N)
+	})
+
+	t.Run("success_hides_hmac_key", func(t *testing.T) {
+		key, err := jwtpkg.NewHMACKey("local-hs256", []byte("secret"))
+		require.NoError(t, err)
+		keyring, err := jwtpkg.NewKeyring("https://api.example.com", "date-courses", key)
+		require.NoError(t, err)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+
+		h := &handler.GetWellKnownJwksJsonHandler{Keyring: keyring}
+		err = h.GetWellKnownJwksJson(ctx)
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"keys":[]}`, rec.Body.String())
+	})
+}
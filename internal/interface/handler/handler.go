@@ -2,6 +2,7 @@ package handler
 
 import (
 	"github.com/daisuke-harada/date-courses-go/internal/di"
+	jwtpkg "github.com/daisuke-harada/date-courses-go/internal/pkg/jwt"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 )
 
@@ -10,6 +11,9 @@ func NewHandler(container *di.Container) *Handler {
 		DeleteApiV1CoursesIdHandler: DeleteApiV1CoursesIdHandler{
 			InputPort: di.MustInvoke[usecase.DeleteCourseInputPort](container),
 		},
+		DeleteApiV1CoursesIdFavoriteHandler: DeleteApiV1CoursesIdFavoriteHandler{
+			InputPort: di.MustInvoke[usecase.DeleteCourseFavoriteInputPort](container),
+		},
 		DeleteApiV1DateSpotReviewsIdHandler: DeleteApiV1DateSpotReviewsIdHandler{
 			InputPort: di.MustInvoke[usecase.DeleteDateSpotReviewInputPort](container),
 		},
@@ -22,7 +26,19 @@ func NewHandler(container *di.Container) *Handler {
 		DeleteApiV1UsersIdHandler: DeleteApiV1UsersIdHandler{
 			InputPort: di.MustInvoke[usecase.DeleteUserInputPort](container),
 		},
+		DeleteApiV1UsersUserIdBlockHandler: DeleteApiV1UsersUserIdBlockHandler{
+			InputPort: di.MustInvoke[usecase.DeleteUserBlockInputPort](container),
+		},
+		DeleteApiV1UsersUserIdMuteHandler: DeleteApiV1UsersUserIdMuteHandler{
+			InputPort: di.MustInvoke[usecase.DeleteUserMuteInputPort](container),
+		},
 		GetHandler: GetHandler{},
+		GetApiV1AdminDateSpotReviewsHandler: GetApiV1AdminDateSpotReviewsHandler{
+			InputPort: di.MustInvoke[usecase.GetModerationReviewsInputPort](container),
+		},
+		GetApiV1AdminSpotMatchesHandler: GetApiV1AdminSpotMatchesHandler{
+			InputPort: di.MustInvoke[usecase.GetSpotMatchesInputPort](container),
+		},
 		GetApiV1CoursesHandler: GetApiV1CoursesHandler{
 			InputPort: di.MustInvoke[usecase.GetCoursesInputPort](container),
 		},
@@ -35,9 +51,21 @@ func NewHandler(container *di.Container) *Handler {
 		GetApiV1DateSpotsIdHandler: GetApiV1DateSpotsIdHandler{
 			InputPort: di.MustInvoke[usecase.GetDateSpotInputPort](container),
 		},
+		GetApiV1FeedHandler: GetApiV1FeedHandler{
+			InputPort: di.MustInvoke[usecase.GetFeedInputPort](container),
+		},
 		GetApiV1GenresIdHandler: GetApiV1GenresIdHandler{
 			InputPort: di.MustInvoke[usecase.GetDateSpotsInputPort](container),
 		},
+		GetApiV1LoginHistoriesHandler: GetApiV1LoginHistoriesHandler{
+			InputPort: di.MustInvoke[usecase.GetLoginHistoriesInputPort](container),
+		},
+		GetApiV1NotificationsHandler: GetApiV1NotificationsHandler{
+			InputPort: di.MustInvoke[usecase.GetNotificationsInputPort](container),
+		},
+		GetApiV1NotificationsUnreadCountHandler: GetApiV1NotificationsUnreadCountHandler{
+			InputPort: di.MustInvoke[usecase.GetUnreadNotificationCountInputPort](container),
+		},
 		GetApiV1PrefecturesIdHandler: GetApiV1PrefecturesIdHandler{
 			InputPort: di.MustInvoke[usecase.GetDateSpotsInputPort](container),
 		},
@@ -50,30 +78,78 @@ func NewHandler(container *di.Container) *Handler {
 		GetApiV1UsersIdHandler: GetApiV1UsersIdHandler{
 			InputPort: di.MustInvoke[usecase.GetUserInputPort](container),
 		},
+		GetApiV1UsersUserIdFavoriteCoursesHandler: GetApiV1UsersUserIdFavoriteCoursesHandler{
+			InputPort: di.MustInvoke[usecase.GetUserFavoriteCoursesInputPort](container),
+		},
 		GetApiV1UsersUserIdFollowersHandler: GetApiV1UsersUserIdFollowersHandler{
 			InputPort: di.MustInvoke[usecase.GetUserFollowersInputPort](container),
 		},
 		GetApiV1UsersUserIdFollowingsHandler: GetApiV1UsersUserIdFollowingsHandler{
 			InputPort: di.MustInvoke[usecase.GetUserFollowingsInputPort](container),
 		},
+		GetWellKnownJwksJsonHandler: GetWellKnownJwksJsonHandler{
+			Keyring: di.MustInvoke[*jwtpkg.Keyring](container),
+		},
 		PostApiV1CoursesHandler: PostApiV1CoursesHandler{
 			InputPort: di.MustInvoke[usecase.CreateCourseInputPort](container),
 		},
+		PostApiV1CoursesIdFavoriteHandler: PostApiV1CoursesIdFavoriteHandler{
+			InputPort: di.MustInvoke[usecase.CreateCourseFavoriteInputPort](container),
+		},
 		PostApiV1DateSpotReviewsHandler: PostApiV1DateSpotReviewsHandler{
 			InputPort: di.MustInvoke[usecase.CreateDateSpotReviewInputPort](container),
 		},
+		PostApiV1DateSpotReviewsIdReportsHandler: PostApiV1DateSpotReviewsIdReportsHandler{
+			InputPort: di.MustInvoke[usecase.ReportDateSpotReviewInputPort](container),
+		},
 		PostApiV1DateSpotsHandler: PostApiV1DateSpotsHandler{
 			InputPort: di.MustInvoke[usecase.CreateDateSpotInputPort](container),
 		},
+		PostApiV1EmailVerificationHandler: PostApiV1EmailVerificationHandler{
+			InputPort: di.MustInvoke[usecase.RequestEmailVerificationInputPort](container),
+		},
+		PostApiV1EmailVerificationConfirmHandler: PostApiV1EmailVerificationConfirmHandler{
+			InputPort: di.MustInvoke[usecase.VerifyEmailInputPort](container),
+		},
 		PostApiV1LoginHandler: PostApiV1LoginHandler{
 			InputPort: di.MustInvoke[usecase.LoginInputPort](container),
 		},
+		PostApiV1LogoutHandler: PostApiV1LogoutHandler{
+			InputPort: di.MustInvoke[usecase.LogoutInputPort](container),
+		},
+		PostApiV1NotificationsReadHandler: PostApiV1NotificationsReadHandler{
+			InputPort: di.MustInvoke[usecase.MarkNotificationsReadInputPort](container),
+		},
+		PostApiV1PasswordResetHandler: PostApiV1PasswordResetHandler{
+			InputPort: di.MustInvoke[usecase.RequestPasswordResetInputPort](container),
+		},
+		PostApiV1PasswordResetConfirmHandler: PostApiV1PasswordResetConfirmHandler{
+			InputPort: di.MustInvoke[usecase.ResetPasswordInputPort](container),
+		},
 		PostApiV1RelationshipsHandler: PostApiV1RelationshipsHandler{
 			InputPort: di.MustInvoke[usecase.CreateRelationshipInputPort](container),
 		},
 		PostApiV1SignupHandler: PostApiV1SignupHandler{
 			InputPort: di.MustInvoke[usecase.SignupInputPort](container),
 		},
+		PostApiV1TokenRefreshHandler: PostApiV1TokenRefreshHandler{
+			InputPort: di.MustInvoke[usecase.RefreshTokenInputPort](container),
+		},
+		PostApiV1UsersUserIdBlockHandler: PostApiV1UsersUserIdBlockHandler{
+			InputPort: di.MustInvoke[usecase.CreateUserBlockInputPort](container),
+		},
+		PostApiV1UsersUserIdMuteHandler: PostApiV1UsersUserIdMuteHandler{
+			InputPort: di.MustInvoke[usecase.CreateUserMuteInputPort](container),
+		},
+		PutApiV1AdminDateSpotReviewsIdHandler: PutApiV1AdminDateSpotReviewsIdHandler{
+			InputPort: di.MustInvoke[usecase.ModerateDateSpotReviewInputPort](container),
+		},
+		PutApiV1AdminSpotMatchesIdHandler: PutApiV1AdminSpotMatchesIdHandler{
+			InputPort: di.MustInvoke[usecase.ResolveSpotMatchInputPort](container),
+		},
+		PutApiV1CoursesIdHandler: PutApiV1CoursesIdHandler{
+			InputPort: di.MustInvoke[usecase.UpdateCourseInputPort](container),
+		},
 		PutApiV1DateSpotReviewsIdHandler: PutApiV1DateSpotReviewsIdHandler{
 			InputPort: di.MustInvoke[usecase.UpdateDateSpotReviewInputPort](container),
 		},
@@ -3,28 +3,53 @@ package handler
 
 type Handler struct {
 	DeleteApiV1CoursesIdHandler
+	DeleteApiV1CoursesIdFavoriteHandler
 	DeleteApiV1DateSpotReviewsIdHandler
 	DeleteApiV1DateSpotsIdHandler
 	DeleteApiV1RelationshipsCurrentUserIdOtherUserIdHandler
 	DeleteApiV1UsersIdHandler
+	DeleteApiV1UsersUserIdBlockHandler
+	DeleteApiV1UsersUserIdMuteHandler
 	GetHandler
+	GetApiV1AdminDateSpotReviewsHandler
+	GetApiV1AdminSpotMatchesHandler
 	GetApiV1CoursesHandler
 	GetApiV1CoursesIdHandler
 	GetApiV1DateSpotsHandler
 	GetApiV1DateSpotsIdHandler
+	GetApiV1FeedHandler
 	GetApiV1GenresIdHandler
+	GetApiV1LoginHistoriesHandler
+	GetApiV1NotificationsHandler
+	GetApiV1NotificationsUnreadCountHandler
 	GetApiV1PrefecturesIdHandler
 	GetApiV1TopHandler
 	GetApiV1UsersHandler
 	GetApiV1UsersIdHandler
+	GetApiV1UsersUserIdFavoriteCoursesHandler
 	GetApiV1UsersUserIdFollowersHandler
 	GetApiV1UsersUserIdFollowingsHandler
+	GetWellKnownJwksJsonHandler
 	PostApiV1CoursesHandler
+	PostApiV1CoursesIdFavoriteHandler
 	PostApiV1DateSpotReviewsHandler
+	PostApiV1DateSpotReviewsIdReportsHandler
 	PostApiV1DateSpotsHandler
+	PostApiV1EmailVerificationHandler
+	PostApiV1EmailVerificationConfirmHandler
 	PostApiV1LoginHandler
+	PostApiV1LogoutHandler
+	PostApiV1NotificationsReadHandler
+	PostApiV1PasswordResetHandler
+	PostApiV1PasswordResetConfirmHandler
 	PostApiV1RelationshipsHandler
 	PostApiV1SignupHandler
+	PostApiV1TokenRefreshHandler
+	PostApiV1UsersUserIdBlockHandler
+	PostApiV1UsersUserIdMuteHandler
+	PutApiV1AdminDateSpotReviewsIdHandler
+	PutApiV1AdminSpotMatchesIdHandler
+	PutApiV1CoursesIdHandler
 	PutApiV1DateSpotReviewsIdHandler
 	PutApiV1DateSpotsIdHandler
 	PutApiV1UsersIdHandler
@@ -0,0 +1,14 @@
+package handler
+
+import "github.com/daisuke-harada/date-courses-go/internal/usecase"
+
+// newPageInput は一覧 API 共通の sort・limit・cursor クエリを usecase の入力にします。
+// sort の型は API ごとに生成されるため、型パラメータで受けます。
+func newPageInput[S ~string](sort *S, limit *int, cursor *string) usecase.PageInput {
+	input := usecase.PageInput{Limit: limit, Cursor: cursor}
+	if sort != nil {
+		s := string(*sort)
+		input.Sort = &s
+	}
+	return input
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"net/http"
+	"net/url"
 	"strconv"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
@@ -18,7 +19,7 @@ type PostApiV1CoursesHandler struct {
 func (h *PostApiV1CoursesHandler) PostApiV1Courses(ctx echo.Context) error {
 	// 作成者はリクエストではなくトークンから決める。
 	// リクエストの user_id を信用すると他人名義のコースを登録できてしまう。
-	currentUser, err := middleware.RequireCurrentUser(ctx)
+	currentUser, err := middleware.RequireVerifiedUser(ctx)
 	if err != nil {
 		return err
 	}
@@ -28,24 +29,19 @@ func (h *PostApiV1CoursesHandler) PostApiV1Courses(ctx echo.Context) error {
 		return apperror.BadRequestWithCause(err)
 	}
 
-	dateSpotIDStrs := form["date_spots[]"]
-	var dateSpotIDs []uint
-	for _, s := range dateSpotIDStrs {
-		id, err := strconv.Atoi(s)
-		if err != nil {
-			return apperror.BadRequest("date_spots[] は整数で指定してください")
-		}
-		dateSpotIDs = append(dateSpotIDs, uint(id))
+	stops, err := parseCourseStops(form)
+	if err != nil {
+		return err
 	}
 
 	travelMode := form.Get("travel_mode")
 	authority := form.Get("authority")
 
 	output, err := h.InputPort.Execute(ctx.Request().Context(), usecase.CreateCourseInput{
-		UserID:      currentUser.ID,
-		DateSpotIDs: dateSpotIDs,
-		TravelMode:  travelMode,
-		Authority:   authority,
+		UserID:     currentUser.ID,
+		Stops:      stops,
+		TravelMode: travelMode,
+		Authority:  authority,
 	})
 	if err != nil {
 		return err
@@ -53,3 +49,44 @@ func (h *PostApiV1CoursesHandler) PostApiV1Courses(ctx echo.Context) error {
 
 	return ctx.JSON(http.StatusCreated, openapi.NewCreateCourseResponse(output.CourseID))
 }
+
+// parseCourseStops はフォームの立ち寄り先を usecase の入力に変換します。
+// date_spots[] の並びが訪問順で、arrival_times[] / stay_minutes[] / memos[] は
+// 同じ添字の立ち寄り先に対応します。任意項目のため、空文字や省略は未設定として扱います。
+func parseCourseStops(form url.Values) ([]usecase.CourseStopInput, error) {
+	dateSpotIDStrs := form["date_spots[]"]
+	arrivalTimes := form["arrival_times[]"]
+	stayMinutes := form["stay_minutes[]"]
+	memos := form["memos[]"]
+
+	if len(arrivalTimes) > len(dateSpotIDStrs) || len(stayMinutes) > len(dateSpotIDStrs) || len(memos) > len(dateSpotIDStrs) {
+		return nil, apperror.BadRequest("arrival_times[] / stay_minutes[] / memos[] は date_spots[] と同じ件数以内で指定してください")
+	}
+
+	stops := make([]usecase.CourseStopInput, 0, len(dateSpotIDStrs))
+	for idx, s := range dateSpotIDStrs {
+		id, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, apperror.BadRequest("date_spots[] は整数で指定してください")
+		}
+		stop := usecase.CourseStopInput{DateSpotID: uint(id)}
+
+		if idx < len(arrivalTimes) && arrivalTimes[idx] != "" {
+			arrivalTime := arrivalTimes[idx]
+			stop.ArrivalTime = &arrivalTime
+		}
+		if idx < len(stayMinutes) && stayMinutes[idx] != "" {
+			minutes, err := strconv.Atoi(stayMinutes[idx])
+			if err != nil {
+				return nil, apperror.BadRequest("stay_minutes[] は整数で指定してください")
+			}
+			stop.StayMinutes = &minutes
+		}
+		if idx < len(memos) && memos[idx] != "" {
+			memo := memos[idx]
+			stop.Memo = &memo
+		}
+		stops = append(stops, stop)
+	}
+	return stops, nil
+}
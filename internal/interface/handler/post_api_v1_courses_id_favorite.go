@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+type PostApiV1CoursesIdFavoriteHandler struct {
+	InputPort usecase.CreateCourseFavoriteInputPort
+}
+
+func (h *PostApiV1CoursesIdFavoriteHandler) PostApiV1CoursesIdFavorite(ctx echo.Context, id int) error {
+	currentUser, err := middleware.RequireCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.InputPort.Execute(ctx.Request().Context(), usecase.CreateCourseFavoriteInput{
+		CourseID: uint(id),
+		UserID:   currentUser.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, openapi.NewCourseFavoriteResponse(output.CourseID, output.Favorites))
+}
@@ -0,0 +1,83 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostApiV1CoursesIdFavoriteHandler(t *testing.T) {
+	// お気に入りに入れるユーザーはトークンの currentUser から決める
+	t.Run("success_returns_201_with_count", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockCreateCourseFavoriteInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.CreateCourseFavoriteInput{CourseID: 10, UserID: 2}).
+			Return(&usecase.CreateCourseFavoriteOutput{
+				CourseID:  10,
+				Favorites: &model.CourseFavoriteSummary{Count: 3, FavoritedByViewer: true},
+			}, nil)
+
+		ctx, rec := setupJSONRequest(t, http.MethodPost, "/api/v1/courses/10/favorite", nil)
+		middleware.SetCurrentUser(ctx, &model.User{ID: 2, Name: "bob"})
+
+		h := handler.PostApiV1CoursesIdFavoriteHandler{InputPort: mockPort}
+		err := h.PostApiV1CoursesIdFavorite(ctx, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		var resp openapi.CourseFavoriteResponseData
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, openapi.CourseFavoriteResponseData{CourseId: 10, FavoriteCount: 3, FavoritedByMe: true}, resp)
+	})
+
+	t.Run("error_unauthorized_without_current_user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockCreateCourseFavoriteInputPort(ctrl)
+
+		ctx, _ := setupJSONRequest(t, http.MethodPost, "/api/v1/courses/10/favorite", nil)
+
+		h := handler.PostApiV1CoursesIdFavoriteHandler{InputPort: mockPort}
+		err := h.PostApiV1CoursesIdFavorite(ctx, 10)
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, statusCode)
+	})
+
+	t.Run("error_usecase_returns_unprocessable_entity", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockCreateCourseFavoriteInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			Return(nil, apperror.UnprocessableEntity("自分のコースはお気に入りにできません"))
+
+		ctx, _ := setupJSONRequest(t, http.MethodPost, "/api/v1/courses/10/favorite", nil)
+		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+
+		h := handler.PostApiV1CoursesIdFavoriteHandler{InputPort: mockPort}
+		err := h.PostApiV1CoursesIdFavorite(ctx, 10)
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+}
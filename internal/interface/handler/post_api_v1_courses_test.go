@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
@@ -12,11 +13,17 @@ import (
 	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
+// newVerifiedUser はメールアドレスを確認済みのユーザーを返します。投稿系の API は確認済みのユーザーにだけ許します。
+func newVerifiedUser(id uint, name string) *model.User {
+	return &model.User{ID: id, Name: name, EmailVerifiedAt: lo.ToPtr(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))}
+}
+
 func TestPostApiV1CoursesHandler(t *testing.T) {
 	t.Run("success_returns_201_with_course_id", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
@@ -25,10 +32,10 @@ func TestPostApiV1CoursesHandler(t *testing.T) {
 		mockPort := usecasemock.NewMockCreateCourseInputPort(ctrl)
 		mockPort.EXPECT().
 			Execute(gomock.Any(), usecase.CreateCourseInput{
-				UserID:      1,
-				DateSpotIDs: []uint{10, 20},
-				TravelMode:  "DRIVING",
-				Authority:   "公開",
+				UserID:     1,
+				Stops:      []usecase.CourseStopInput{{DateSpotID: 10}, {DateSpotID: 20}},
+				TravelMode: "DRIVING",
+				Authority:  "公開",
 			}).
 			Return(&usecase.CreateCourseOutput{CourseID: 5}, nil)
 
@@ -38,7 +45,7 @@ func TestPostApiV1CoursesHandler(t *testing.T) {
 		form.Set("travel_mode", "DRIVING")
 		form.Set("authority", "公開")
 		ctx, rec := setupFormRequest(http.MethodPost, "/api/v1/courses", form)
-		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+		middleware.SetCurrentUser(ctx, newVerifiedUser(1, "alice"))
 
 		h := handler.PostApiV1CoursesHandler{InputPort: mockPort}
 		err := h.PostApiV1Courses(ctx)
@@ -51,6 +58,42 @@ func TestPostApiV1CoursesHandler(t *testing.T) {
 		assert.Equal(t, float64(5), resp["course_id"])
 	})
 
+	t.Run("success_parses_stop_details_by_index", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockCreateCourseInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.CreateCourseInput{
+				UserID: 1,
+				Stops: []usecase.CourseStopInput{
+					{DateSpotID: 10, ArrivalTime: lo.ToPtr("11:00"), StayMinutes: lo.ToPtr(60), Memo: lo.ToPtr("ランチ")},
+					{DateSpotID: 20},
+				},
+				TravelMode: "DRIVING",
+				Authority:  "公開",
+			}).
+			Return(&usecase.CreateCourseOutput{CourseID: 5}, nil)
+
+		form := url.Values{}
+		form.Add("date_spots[]", "10")
+		form.Add("date_spots[]", "20")
+		form.Add("arrival_times[]", "11:00")
+		form.Add("arrival_times[]", "")
+		form.Add("stay_minutes[]", "60")
+		form.Add("memos[]", "ランチ")
+		form.Set("travel_mode", "DRIVING")
+		form.Set("authority", "公開")
+		ctx, rec := setupFormRequest(http.MethodPost, "/api/v1/courses", form)
+		middleware.SetCurrentUser(ctx, newVerifiedUser(1, "alice"))
+
+		h := handler.PostApiV1CoursesHandler{InputPort: mockPort}
+		err := h.PostApiV1Courses(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+	})
+
 	// リクエストに user_id を混ぜても他人名義で登録できないことを保証する
 	t.Run("ignores_user_id_in_request_body", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
@@ -59,10 +102,10 @@ func TestPostApiV1CoursesHandler(t *testing.T) {
 		mockPort := usecasemock.NewMockCreateCourseInputPort(ctrl)
 		mockPort.EXPECT().
 			Execute(gomock.Any(), usecase.CreateCourseInput{
-				UserID:      1,
-				DateSpotIDs: []uint{10},
-				TravelMode:  "DRIVING",
-				Authority:   "公開",
+				UserID:     1,
+				Stops:      []usecase.CourseStopInput{{DateSpotID: 10}},
+				TravelMode: "DRIVING",
+				Authority:  "公開",
 			}).
 			Return(&usecase.CreateCourseOutput{CourseID: 5}, nil)
 
@@ -72,7 +115,7 @@ func TestPostApiV1CoursesHandler(t *testing.T) {
 		form.Set("travel_mode", "DRIVING")
 		form.Set("authority", "公開")
 		ctx, rec := setupFormRequest(http.MethodPost, "/api/v1/courses", form)
-		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+		middleware.SetCurrentUser(ctx, newVerifiedUser(1, "alice"))
 
 		h := handler.PostApiV1CoursesHandler{InputPort: mockPort}
 		err := h.PostApiV1Courses(ctx)
@@ -81,6 +124,29 @@ func TestPostApiV1CoursesHandler(t *testing.T) {
 		assert.Equal(t, http.StatusCreated, rec.Code)
 	})
 
+	// 捨てアドレスで量産したアカウントから投稿できないよう、メールアドレスの確認を求める
+	t.Run("error_forbidden_when_email_unverified", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockCreateCourseInputPort(ctrl)
+
+		form := url.Values{}
+		form.Add("date_spots[]", "10")
+		form.Set("travel_mode", "DRIVING")
+		form.Set("authority", "公開")
+		ctx, _ := setupFormRequest(http.MethodPost, "/api/v1/courses", form)
+		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+
+		h := handler.PostApiV1CoursesHandler{InputPort: mockPort}
+		err := h.PostApiV1Courses(ctx)
+
+		require.Error(t, err)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusForbidden, statusCode)
+	})
+
 	t.Run("error_unauthorized_without_current_user", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -113,7 +179,54 @@ func TestPostApiV1CoursesHandler(t *testing.T) {
 		form.Set("travel_mode", "DRIVING")
 		form.Set("authority", "公開")
 		ctx, _ := setupFormRequest(http.MethodPost, "/api/v1/courses", form)
-		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+		middleware.SetCurrentUser(ctx, newVerifiedUser(1, "alice"))
+
+		h := handler.PostApiV1CoursesHandler{InputPort: mockPort}
+		err := h.PostApiV1Courses(ctx)
+
+		require.Error(t, err)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, statusCode)
+	})
+
+	t.Run("error_bad_request_invalid_stay_minutes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockCreateCourseInputPort(ctrl)
+
+		form := url.Values{}
+		form.Add("date_spots[]", "10")
+		form.Add("stay_minutes[]", "abc")
+		form.Set("travel_mode", "DRIVING")
+		form.Set("authority", "公開")
+		ctx, _ := setupFormRequest(http.MethodPost, "/api/v1/courses", form)
+		middleware.SetCurrentUser(ctx, newVerifiedUser(1, "alice"))
+
+		h := handler.PostApiV1CoursesHandler{InputPort: mockPort}
+		err := h.PostApiV1Courses(ctx)
+
+		require.Error(t, err)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, statusCode)
+	})
+
+	t.Run("error_bad_request_more_stop_details_than_date_spots", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockCreateCourseInputPort(ctrl)
+
+		form := url.Values{}
+		form.Add("date_spots[]", "10")
+		form.Add("memos[]", "a")
+		form.Add("memos[]", "b")
+		form.Set("travel_mode", "DRIVING")
+		form.Set("authority", "公開")
+		ctx, _ := setupFormRequest(http.MethodPost, "/api/v1/courses", form)
+		middleware.SetCurrentUser(ctx, newVerifiedUser(1, "alice"))
 
 		h := handler.PostApiV1CoursesHandler{InputPort: mockPort}
 		err := h.PostApiV1Courses(ctx)
@@ -137,7 +250,7 @@ func TestPostApiV1CoursesHandler(t *testing.T) {
 		form.Set("travel_mode", "DRIVING")
 		form.Set("authority", "公開")
 		ctx, _ := setupFormRequest(http.MethodPost, "/api/v1/courses", form)
-		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+		middleware.SetCurrentUser(ctx, newVerifiedUser(1, "alice"))
 
 		h := handler.PostApiV1CoursesHandler{InputPort: mockPort}
 		err := h.PostApiV1Courses(ctx)
@@ -162,7 +275,7 @@ func TestPostApiV1CoursesHandler(t *testing.T) {
 		form.Set("travel_mode", "DRIVING")
 		form.Set("authority", "公開")
 		ctx, _ := setupFormRequest(http.MethodPost, "/api/v1/courses", form)
-		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+		middleware.SetCurrentUser(ctx, newVerifiedUser(1, "alice"))
 
 		h := handler.PostApiV1CoursesHandler{InputPort: mockPort}
 		err := h.PostApiV1Courses(ctx)
@@ -7,6 +7,7 @@ import (
 	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
 )
 
 type PostApiV1DateSpotReviewsHandler struct {
@@ -16,7 +17,7 @@ type PostApiV1DateSpotReviewsHandler struct {
 func (h *PostApiV1DateSpotReviewsHandler) PostApiV1DateSpotReviews(ctx echo.Context) error {
 	// 投稿者はリクエストではなくトークンから決める。
 	// リクエストの user_id を信用すると他人名義でレビューを投稿できてしまう。
-	currentUser, err := middleware.RequireCurrentUser(ctx)
+	currentUser, err := middleware.RequireVerifiedUser(ctx)
 	if err != nil {
 		return err
 	}
@@ -35,7 +36,10 @@ func (h *PostApiV1DateSpotReviewsHandler) PostApiV1DateSpotReviews(ctx echo.Cont
 		return err
 	}
 
-	return ctx.JSON(http.StatusCreated, openapi.NewDateSpotReviewResponse(output.DateSpotReviews))
+	// 保留になったレビューは一覧に含まれないため、公開状態を返してクライアントが案内できるようにする
+	resp := openapi.NewDateSpotReviewResponse(output.DateSpotReviews)
+	resp.Status = lo.ToPtr(openapi.ReviewStatus(output.Status))
+	return ctx.JSON(http.StatusCreated, resp)
 }
 
 // handler defers parsing to usecase.NewCreateDateSpotReviewInputFromStrings
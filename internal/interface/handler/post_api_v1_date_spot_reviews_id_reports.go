@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+type PostApiV1DateSpotReviewsIdReportsHandler struct {
+	InputPort usecase.ReportDateSpotReviewInputPort
+}
+
+func (h *PostApiV1DateSpotReviewsIdReportsHandler) PostApiV1DateSpotReviewsIdReports(ctx echo.Context, id int) error {
+	// 通報者はトークンから決める。同じ利用者が何度も通報して件数を水増しできないようにするため
+	currentUser, err := middleware.RequireVerifiedUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	input := usecase.NewReportDateSpotReviewInput(id, currentUser.ID, ctx.FormValue("reason"), ctx.FormValue("comment"))
+	output, err := h.InputPort.Execute(ctx.Request().Context(), input)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, openapi.NewDateSpotReviewReportResponse(output.Report))
+}
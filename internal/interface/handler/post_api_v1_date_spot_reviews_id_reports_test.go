@@ -0,0 +1,98 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostApiV1DateSpotReviewsIdReportsHandler(t *testing.T) {
+	// 通報者はトークンの currentUser から決める
+	t.Run("success_returns_201_with_report", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockReportDateSpotReviewInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.ReportDateSpotReviewInput{
+				ReviewID: 5,
+				UserID:   2,
+				Reason:   model.ReportReasonAbuse,
+				Comment:  lo.ToPtr("暴言です"),
+			}).
+			Return(&usecase.ReportDateSpotReviewOutput{Report: &model.DateSpotReviewReport{
+				ID:               9,
+				DateSpotReviewID: 5,
+				UserID:           2,
+				Reason:           model.ReportReasonAbuse,
+				CreatedAt:        time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+			}}, nil)
+
+		form := url.Values{}
+		form.Set("reason", "abuse")
+		form.Set("comment", " 暴言です ")
+		ctx, rec := setupFormRequest(http.MethodPost, "/api/v1/date_spot_reviews/5/reports", form)
+		middleware.SetCurrentUser(ctx, newVerifiedUser(2, "bob"))
+
+		h := handler.PostApiV1DateSpotReviewsIdReportsHandler{InputPort: mockPort}
+		err := h.PostApiV1DateSpotReviewsIdReports(ctx, 5)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		var resp map[string]interface{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, float64(9), resp["id"])
+		assert.Equal(t, float64(5), resp["date_spot_review_id"])
+		assert.Equal(t, "abuse", resp["reason"])
+	})
+
+	t.Run("error_unauthorized_without_current_user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockReportDateSpotReviewInputPort(ctrl)
+
+		ctx, _ := setupFormRequest(http.MethodPost, "/api/v1/date_spot_reviews/5/reports", url.Values{"reason": {"spam"}})
+
+		h := handler.PostApiV1DateSpotReviewsIdReportsHandler{InputPort: mockPort}
+		err := h.PostApiV1DateSpotReviewsIdReports(ctx, 5)
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, statusCode)
+	})
+
+	t.Run("error_usecase_returns_unprocessable_entity", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockReportDateSpotReviewInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			Return(nil, apperror.UnprocessableEntity("このレビューはすでに通報しています"))
+
+		ctx, _ := setupFormRequest(http.MethodPost, "/api/v1/date_spot_reviews/5/reports", url.Values{"reason": {"spam"}})
+		middleware.SetCurrentUser(ctx, newVerifiedUser(2, "bob"))
+
+		h := handler.PostApiV1DateSpotReviewsIdReportsHandler{InputPort: mockPort}
+		err := h.PostApiV1DateSpotReviewsIdReports(ctx, 5)
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+}
@@ -42,7 +42,7 @@ func TestPostApiV1DateSpotReviewsHandler(t *testing.T) {
 
 		ctx, rec := setupFormRequest(http.MethodPost, "/api/v1/date_spot_reviews", validDateSpotReviewForm())
 
-		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+		middleware.SetCurrentUser(ctx, newVerifiedUser(1, "alice"))
 		h := handler.PostApiV1DateSpotReviewsHandler{InputPort: mockPort}
 		err := h.PostApiV1DateSpotReviews(ctx)
 
@@ -73,7 +73,7 @@ func TestPostApiV1DateSpotReviewsHandler(t *testing.T) {
 		form.Set("rate", "4.5")
 		form.Set("content", "とても良かったです")
 		ctx, _ := setupFormRequest(http.MethodPost, "/api/v1/date_spot_reviews", form)
-		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+		middleware.SetCurrentUser(ctx, newVerifiedUser(1, "alice"))
 
 		h := handler.PostApiV1DateSpotReviewsHandler{InputPort: mockPort}
 		require.NoError(t, h.PostApiV1DateSpotReviews(ctx))
@@ -110,7 +110,7 @@ func TestPostApiV1DateSpotReviewsHandler(t *testing.T) {
 		form.Set("date_spot_id", "xyz")
 		ctx, _ := setupFormRequest(http.MethodPost, "/api/v1/date_spot_reviews", form)
 
-		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+		middleware.SetCurrentUser(ctx, newVerifiedUser(1, "alice"))
 		h := handler.PostApiV1DateSpotReviewsHandler{InputPort: mockPort}
 		err := h.PostApiV1DateSpotReviews(ctx)
 
@@ -133,7 +133,7 @@ func TestPostApiV1DateSpotReviewsHandler(t *testing.T) {
 		form.Set("user_id", "0")
 		ctx, _ := setupFormRequest(http.MethodPost, "/api/v1/date_spot_reviews", form)
 
-		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+		middleware.SetCurrentUser(ctx, newVerifiedUser(1, "alice"))
 		h := handler.PostApiV1DateSpotReviewsHandler{InputPort: mockPort}
 		err := h.PostApiV1DateSpotReviews(ctx)
 
@@ -154,7 +154,7 @@ func TestPostApiV1DateSpotReviewsHandler(t *testing.T) {
 
 		ctx, _ := setupFormRequest(http.MethodPost, "/api/v1/date_spot_reviews", validDateSpotReviewForm())
 
-		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+		middleware.SetCurrentUser(ctx, newVerifiedUser(1, "alice"))
 		h := handler.PostApiV1DateSpotReviewsHandler{InputPort: mockPort}
 		err := h.PostApiV1DateSpotReviews(ctx)
 
@@ -42,9 +42,9 @@ func (h *PostApiV1DateSpotsHandler) PostApiV1DateSpots(ctx echo.Context) error {
 		prefectureID = id
 	}
 
-	var imagePtr *string
-	if image := ctx.FormValue("image"); image != "" {
-		imagePtr = &image
+	image, err := formImage(ctx)
+	if err != nil {
+		return err
 	}
 
 	input := usecase.CreateDateSpotInput{
@@ -52,7 +52,7 @@ func (h *PostApiV1DateSpotsHandler) PostApiV1DateSpots(ctx echo.Context) error {
 		GenreID:      genreID,
 		PrefectureID: prefectureID,
 		CityName:     ctx.FormValue("city_name"),
-		Image:        imagePtr,
+		Image:        image,
 	}
 
 	if err := input.Validate(); err != nil {
@@ -1,9 +1,13 @@
 package handler_test
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"mime/multipart"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 
@@ -13,6 +17,7 @@ import (
 	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -27,7 +32,75 @@ func validDateSpotForm() url.Values {
 	return form
 }
 
+// setupMultipartRequest は form の各フィールドと image のファイルを multipart/form-data で送るリクエストを作ります。
+func setupMultipartRequest(t *testing.T, method, path string, form url.Values, image []byte) (echo.Context, *httptest.ResponseRecorder) {
+	t.Helper()
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	for key, values := range form {
+		for _, v := range values {
+			require.NoError(t, w.WriteField(key, v))
+		}
+	}
+	part, err := w.CreateFormFile("image", "photo.jpg")
+	require.NoError(t, err)
+	_, err = part.Write(image)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	e := echo.New()
+	req := httptest.NewRequest(method, path, &body)
+	req.Header.Set(echo.HeaderContentType, w.FormDataContentType())
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
 func TestPostApiV1DateSpotsHandler(t *testing.T) {
+	t.Run("success_passes_uploaded_image", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockCreateDateSpotInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, input usecase.CreateDateSpotInput) (*usecase.CreateDateSpotOutput, error) {
+				assert.Equal(t, "東京タワー", input.Name)
+				assert.Equal(t, []byte("\xff\xd8image"), input.Image)
+				return &usecase.CreateDateSpotOutput{DateSpotID: 42}, nil
+			})
+
+		ctx, rec := setupMultipartRequest(t, http.MethodPost, "/api/v1/date_spots", validDateSpotForm(), []byte("\xff\xd8image"))
+
+		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "admin", Admin: true})
+		h := handler.PostApiV1DateSpotsHandler{InputPort: mockPort}
+		err := h.PostApiV1DateSpots(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+	})
+
+	// URL 文字列を image に入れてもファイルではないため無視する
+	t.Run("success_ignores_image_text_field", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockCreateDateSpotInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, input usecase.CreateDateSpotInput) (*usecase.CreateDateSpotOutput, error) {
+				assert.Nil(t, input.Image)
+				return &usecase.CreateDateSpotOutput{DateSpotID: 42}, nil
+			})
+
+		form := validDateSpotForm()
+		form.Set("image", "https://example.com/a.jpg")
+		ctx, _ := setupFormRequest(http.MethodPost, "/api/v1/date_spots", form)
+
+		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "admin", Admin: true})
+		h := handler.PostApiV1DateSpotsHandler{InputPort: mockPort}
+		require.NoError(t, h.PostApiV1DateSpots(ctx))
+	})
+
 	t.Run("success_returns_201_with_date_spot_id", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+type PostApiV1EmailVerificationHandler struct {
+	InputPort usecase.RequestEmailVerificationInputPort
+}
+
+func (h *PostApiV1EmailVerificationHandler) PostApiV1EmailVerification(ctx echo.Context) error {
+	// 未確認のユーザーが確認メールを送り直すためのエンドポイントなので、RequireVerifiedUser は使わない。
+	currentUser, err := middleware.RequireCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := h.InputPort.Execute(ctx.Request().Context(), usecase.RequestEmailVerificationInput{
+		UserID: currentUser.ID,
+	}); err != nil {
+		return err
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+type PostApiV1EmailVerificationConfirmHandler struct {
+	InputPort usecase.VerifyEmailInputPort
+}
+
+func (h *PostApiV1EmailVerificationConfirmHandler) PostApiV1EmailVerificationConfirm(ctx echo.Context) error {
+	var req openapi.VerifyEmailRequestData
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := h.InputPort.Execute(ctx.Request().Context(), usecase.VerifyEmailInput{
+		Token: req.Token,
+	}); err != nil {
+		return err
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,52 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostApiV1EmailVerificationConfirmHandler(t *testing.T) {
+	t.Run("success_returns_204", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockVerifyEmailInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.VerifyEmailInput{Token: "verify-token"}).
+			Return(nil)
+
+		ctx, rec := setupJSONRequest(t, http.MethodPost, "/api/v1/email_verification/confirm", map[string]any{"token": "verify-token"})
+
+		h := handler.PostApiV1EmailVerificationConfirmHandler{InputPort: mockPort}
+		err := h.PostApiV1EmailVerificationConfirm(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("error_invalid_link", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockVerifyEmailInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			Return(apperror.UnprocessableEntity("リンクが無効か、有効期限が切れています。もう一度やり直してください"))
+
+		ctx, _ := setupJSONRequest(t, http.MethodPost, "/api/v1/email_verification/confirm", map[string]any{"token": "expired"})
+
+		h := handler.PostApiV1EmailVerificationConfirmHandler{InputPort: mockPort}
+		err := h.PostApiV1EmailVerificationConfirm(ctx)
+
+		statusCode, _, _, _ := apperror.HTTPStatus(err)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+}
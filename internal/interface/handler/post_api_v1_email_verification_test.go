@@ -0,0 +1,54 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostApiV1EmailVerificationHandler(t *testing.T) {
+	t.Run("success_unverified_user_can_resend", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockRequestEmailVerificationInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.RequestEmailVerificationInput{UserID: 1}).
+			Return(nil)
+
+		ctx, rec := setupJSONRequest(t, http.MethodPost, "/api/v1/email_verification", map[string]any{})
+		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+
+		h := handler.PostApiV1EmailVerificationHandler{InputPort: mockPort}
+		err := h.PostApiV1EmailVerification(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("error_unauthorized_without_current_user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockRequestEmailVerificationInputPort(ctrl)
+
+		ctx, _ := setupJSONRequest(t, http.MethodPost, "/api/v1/email_verification", map[string]any{})
+
+		h := handler.PostApiV1EmailVerificationHandler{InputPort: mockPort}
+		err := h.PostApiV1EmailVerification(ctx)
+
+		require.Error(t, err)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, statusCode)
+	})
+}
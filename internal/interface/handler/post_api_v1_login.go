@@ -6,6 +6,7 @@ import (
 	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
 )
 
 type PostApiV1LoginHandler struct {
@@ -19,15 +20,18 @@ func (h *PostApiV1LoginHandler) PostApiV1Login(ctx echo.Context) error {
 	}
 
 	output, err := h.InputPort.Execute(ctx.Request().Context(), usecase.LoginInput{
-		Name:     req.Name,
-		Password: req.Password,
+		Name:      lo.FromPtr(req.Name),
+		Email:     lo.FromPtr(req.Email),
+		Password:  req.Password,
+		IPAddress: ctx.RealIP(),
+		UserAgent: ctx.Request().UserAgent(),
 	})
 	if err != nil {
 		// apperror 型のエラーは CustomHTTPErrorHandler が適切なステータスコードで処理する
 		return err
 	}
 
-	resp, err := openapi.NewLoginResponse(output.User, output.Token)
+	resp, err := openapi.NewLoginResponse(output.User, output.Token, output.RefreshToken)
 	if err != nil {
 		return err
 	}
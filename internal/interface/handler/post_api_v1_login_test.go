@@ -26,7 +26,7 @@ func TestPostApiV1LoginHandler(t *testing.T) {
 		mockPort := usecasemock.NewMockLoginInputPort(ctrl)
 		mockPort.EXPECT().
 			Execute(gomock.Any(), gomock.Any()).
-			Return(&usecase.LoginOutput{User: user, Token: "test-jwt-token"}, nil)
+			Return(&usecase.LoginOutput{User: user, Token: "test-jwt-token", RefreshToken: "test-refresh-token"}, nil)
 
 		form := url.Values{}
 		form.Set("name", "testuser")
@@ -42,6 +42,32 @@ func TestPostApiV1LoginHandler(t *testing.T) {
 		var resp map[string]interface{}
 		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
 		assert.Equal(t, "test-jwt-token", resp["token"])
+		assert.Equal(t, "test-refresh-token", resp["refresh_token"])
+	})
+
+	t.Run("success_passes_email_and_client_info", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		user := &model.User{ID: 1, Name: "testuser", Email: "test@example.com", Gender: model.GenderMale}
+		mockPort := usecasemock.NewMockLoginInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.LoginInput{
+				Email:     "test@example.com",
+				Password:  "password123",
+				IPAddress: "192.0.2.1",
+				UserAgent: "test-agent",
+			}).
+			Return(&usecase.LoginOutput{User: user, Token: "test-jwt-token", RefreshToken: "test-refresh-token"}, nil)
+
+		ctx, rec := setupJSONRequest(t, http.MethodPost, "/api/v1/login", map[string]any{"email": "test@example.com", "password": "password123"})
+		ctx.Request().Header.Set("User-Agent", "test-agent")
+
+		h := handler.PostApiV1LoginHandler{InputPort: mockPort}
+		err := h.PostApiV1Login(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
 	})
 
 	t.Run("error_unauthorized_invalid_credentials", func(t *testing.T) {
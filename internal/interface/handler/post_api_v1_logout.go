@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+)
+
+type PostApiV1LogoutHandler struct {
+	InputPort usecase.LogoutInputPort
+}
+
+func (h *PostApiV1LogoutHandler) PostApiV1Logout(ctx echo.Context) error {
+	var req openapi.LogoutRequestData
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := h.InputPort.Execute(ctx.Request().Context(), usecase.LogoutInput{
+		RefreshToken: req.RefreshToken,
+		All:          lo.FromPtr(req.All),
+	}); err != nil {
+		return err
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,70 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostApiV1LogoutHandler(t *testing.T) {
+	t.Run("success_returns_204", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockLogoutInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.LogoutInput{RefreshToken: "refresh-token"}).
+			Return(nil)
+
+		ctx, rec := setupJSONRequest(t, http.MethodPost, "/api/v1/logout", map[string]any{"refresh_token": "refresh-token"})
+
+		h := handler.PostApiV1LogoutHandler{InputPort: mockPort}
+		err := h.PostApiV1Logout(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("success_all_sessions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockLogoutInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.LogoutInput{RefreshToken: "refresh-token", All: true}).
+			Return(nil)
+
+		ctx, rec := setupJSONRequest(t, http.MethodPost, "/api/v1/logout", map[string]any{"refresh_token": "refresh-token", "all": true})
+
+		h := handler.PostApiV1LogoutHandler{InputPort: mockPort}
+		err := h.PostApiV1Logout(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("error_validation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockLogoutInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			Return(apperror.UnprocessableEntity("リフレッシュトークンを指定してください"))
+
+		ctx, _ := setupJSONRequest(t, http.MethodPost, "/api/v1/logout", map[string]any{})
+
+		h := handler.PostApiV1LogoutHandler{InputPort: mockPort}
+		err := h.PostApiV1Logout(ctx)
+
+		statusCode, _, _, _ := apperror.HTTPStatus(err)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+)
+
+type PostApiV1NotificationsReadHandler struct {
+	InputPort usecase.MarkNotificationsReadInputPort
+}
+
+func (h *PostApiV1NotificationsReadHandler) PostApiV1NotificationsRead(ctx echo.Context) error {
+	currentUser, err := middleware.RequireCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	var req openapi.MarkNotificationsReadRequestData
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+
+	// notification_ids を省略したときは未読の通知をすべて既読にする
+	var ids []uint
+	if req.NotificationIds != nil {
+		ids = lo.Map(*req.NotificationIds, func(id int, _ int) uint { return uint(id) })
+	}
+
+	output, err := h.InputPort.Execute(ctx.Request().Context(), usecase.MarkNotificationsReadInput{
+		UserID:          currentUser.ID,
+		NotificationIDs: ids,
+	})
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.UnreadNotificationCountResponseData{UnreadCount: output.UnreadCount})
+}
@@ -0,0 +1,79 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostApiV1NotificationsReadHandler(t *testing.T) {
+	t.Run("success_marks_given_notifications_read", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockMarkNotificationsReadInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.MarkNotificationsReadInput{
+				UserID:          1,
+				NotificationIDs: []uint{10, 11},
+			}).
+			Return(&usecase.MarkNotificationsReadOutput{UnreadCount: 1}, nil)
+
+		ctx, rec := setupJSONRequest(t, http.MethodPost, "/api/v1/notifications/read", map[string]any{
+			"notification_ids": []int{10, 11},
+		})
+		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+
+		h := handler.PostApiV1NotificationsReadHandler{InputPort: mockPort}
+		require.NoError(t, h.PostApiV1NotificationsRead(ctx))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp openapi.UnreadNotificationCountResponseData
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, 1, resp.UnreadCount)
+	})
+
+	// notification_ids を省略したときは ID を絞らずに渡し、すべて既読にさせる
+	t.Run("success_marks_all_read_without_ids", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockMarkNotificationsReadInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.MarkNotificationsReadInput{UserID: 1}).
+			Return(&usecase.MarkNotificationsReadOutput{UnreadCount: 0}, nil)
+
+		ctx, rec := setupJSONRequest(t, http.MethodPost, "/api/v1/notifications/read", map[string]any{})
+		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+
+		h := handler.PostApiV1NotificationsReadHandler{InputPort: mockPort}
+		require.NoError(t, h.PostApiV1NotificationsRead(ctx))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("error_unauthorized_without_current_user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx, _ := setupJSONRequest(t, http.MethodPost, "/api/v1/notifications/read", map[string]any{})
+
+		h := handler.PostApiV1NotificationsReadHandler{InputPort: usecasemock.NewMockMarkNotificationsReadInputPort(ctrl)}
+		err := h.PostApiV1NotificationsRead(ctx)
+
+		require.Error(t, err)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, statusCode)
+	})
+}
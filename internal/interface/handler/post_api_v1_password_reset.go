@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+type PostApiV1PasswordResetHandler struct {
+	InputPort usecase.RequestPasswordResetInputPort
+}
+
+func (h *PostApiV1PasswordResetHandler) PostApiV1PasswordReset(ctx echo.Context) error {
+	var req openapi.PasswordResetRequestData
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := h.InputPort.Execute(ctx.Request().Context(), usecase.RequestPasswordResetInput{
+		Email: req.Email,
+	}); err != nil {
+		return err
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+type PostApiV1PasswordResetConfirmHandler struct {
+	InputPort usecase.ResetPasswordInputPort
+}
+
+func (h *PostApiV1PasswordResetConfirmHandler) PostApiV1PasswordResetConfirm(ctx echo.Context) error {
+	var req openapi.ResetPasswordRequestData
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := h.InputPort.Execute(ctx.Request().Context(), usecase.ResetPasswordInput{
+		Token:                req.Token,
+		Password:             req.Password,
+		PasswordConfirmation: req.PasswordConfirmation,
+	}); err != nil {
+		return err
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
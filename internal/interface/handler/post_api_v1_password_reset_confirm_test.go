@@ -0,0 +1,64 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostApiV1PasswordResetConfirmHandler(t *testing.T) {
+	t.Run("success_returns_204", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockResetPasswordInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.ResetPasswordInput{
+				Token:                "reset-token",
+				Password:             "newpassword",
+				PasswordConfirmation: "newpassword",
+			}).
+			Return(nil)
+
+		ctx, rec := setupJSONRequest(t, http.MethodPost, "/api/v1/password_reset/confirm", map[string]any{
+			"token":                 "reset-token",
+			"password":              "newpassword",
+			"password_confirmation": "newpassword",
+		})
+
+		h := handler.PostApiV1PasswordResetConfirmHandler{InputPort: mockPort}
+		err := h.PostApiV1PasswordResetConfirm(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("error_invalid_link", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockResetPasswordInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			Return(apperror.UnprocessableEntity("リンクが無効か、有効期限が切れています。もう一度やり直してください"))
+
+		ctx, _ := setupJSONRequest(t, http.MethodPost, "/api/v1/password_reset/confirm", map[string]any{
+			"token":                 "used-token",
+			"password":              "newpassword",
+			"password_confirmation": "newpassword",
+		})
+
+		h := handler.PostApiV1PasswordResetConfirmHandler{InputPort: mockPort}
+		err := h.PostApiV1PasswordResetConfirm(ctx)
+
+		statusCode, _, _, _ := apperror.HTTPStatus(err)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+}
@@ -0,0 +1,52 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostApiV1PasswordResetHandler(t *testing.T) {
+	t.Run("success_returns_204", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockRequestPasswordResetInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.RequestPasswordResetInput{Email: "alice@example.com"}).
+			Return(nil)
+
+		ctx, rec := setupJSONRequest(t, http.MethodPost, "/api/v1/password_reset", map[string]any{"email": "alice@example.com"})
+
+		h := handler.PostApiV1PasswordResetHandler{InputPort: mockPort}
+		err := h.PostApiV1PasswordReset(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("error_validation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockRequestPasswordResetInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			Return(apperror.UnprocessableEntity("メールアドレスを入力してください"))
+
+		ctx, _ := setupJSONRequest(t, http.MethodPost, "/api/v1/password_reset", map[string]any{})
+
+		h := handler.PostApiV1PasswordResetHandler{InputPort: mockPort}
+		err := h.PostApiV1PasswordReset(ctx)
+
+		statusCode, _, _, _ := apperror.HTTPStatus(err)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+}
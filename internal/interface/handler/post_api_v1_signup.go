@@ -16,6 +16,11 @@ type PostApiV1SignupHandler struct {
 
 func (h *PostApiV1SignupHandler) PostApiV1Signup(ctx echo.Context) error {
 	// multipart/form-data からフィールドを取得
+	// image は任意フィールド
+	image, err := formImage(ctx)
+	if err != nil {
+		return err
+	}
 
 	input, err := usecase.NewSignupInput(
 		ctx.FormValue("name"),
@@ -23,24 +28,19 @@ func (h *PostApiV1SignupHandler) PostApiV1Signup(ctx echo.Context) error {
 		ctx.FormValue("gender"),
 		ctx.FormValue("password"),
 		ctx.FormValue("password_confirmation"),
-		ctx.FormValue("image"),
+		image,
 	)
 	if err != nil {
 		return err
 	}
 
-	// image は任意フィールド
-	if image := ctx.FormValue("image"); image != "" {
-		input.Image = &image
-	}
-
 	output, err := h.InputPort.Execute(ctx.Request().Context(), input)
 	if err != nil {
 		// apperror 型のエラーは CustomHTTPErrorHandler が適切なステータスコードで処理する
 		return err
 	}
 
-	response, err := openapi.NewSignupResponse(output.User, output.Token)
+	response, err := openapi.NewSignupResponse(output.User, output.Token, output.RefreshToken)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package handler_test
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/url"
@@ -46,6 +47,29 @@ func TestPostApiV1SignupHandler(t *testing.T) {
 		assert.Equal(t, http.StatusCreated, rec.Code)
 	})
 
+	t.Run("success_passes_uploaded_image", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		user := &model.User{ID: 5, Name: "新規ユーザー", Email: "newuser@example.com", Gender: model.GenderFemale}
+		mockPort := usecasemock.NewMockSignupInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, input usecase.SignupInput) (*usecase.SignupOutput, error) {
+				assert.Equal(t, "newuser@example.com", input.Email)
+				assert.Equal(t, []byte("png"), input.Image)
+				return &usecase.SignupOutput{User: user}, nil
+			})
+
+		ctx, rec := setupMultipartRequest(t, http.MethodPost, "/api/v1/signup", validSignupForm(), []byte("png"))
+
+		h := handler.PostApiV1SignupHandler{InputPort: mockPort}
+		err := h.PostApiV1Signup(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+	})
+
 	t.Run("error_usecase_returns_unprocessable_entity", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+type PostApiV1TokenRefreshHandler struct {
+	InputPort usecase.RefreshTokenInputPort
+}
+
+func (h *PostApiV1TokenRefreshHandler) PostApiV1TokenRefresh(ctx echo.Context) error {
+	var req openapi.RefreshTokenRequestData
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+
+	output, err := h.InputPort.Execute(ctx.Request().Context(), usecase.RefreshTokenInput{
+		RefreshToken: req.RefreshToken,
+	})
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.TokenResponseData{
+		Token:        output.Token,
+		RefreshToken: output.RefreshToken,
+	})
+}
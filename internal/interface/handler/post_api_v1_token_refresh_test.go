@@ -0,0 +1,74 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// setupJSONRequest は body を JSON にしたリクエストを作ります。
+// ログイン系の API は JSON で受け取り、生成された型にも json タグしかないためです。
+func setupJSONRequest(t *testing.T, method, path string, body any) (echo.Context, *httptest.ResponseRecorder) {
+	t.Helper()
+	b, err := json.Marshal(body)
+	require.NoError(t, err)
+	e := echo.New()
+	req := httptest.NewRequest(method, path, bytes.NewReader(b))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestPostApiV1TokenRefreshHandler(t *testing.T) {
+	t.Run("success_returns_200_with_new_tokens", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockRefreshTokenInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.RefreshTokenInput{RefreshToken: "old-refresh-token"}).
+			Return(&usecase.RefreshTokenOutput{Token: "new-jwt-token", RefreshToken: "new-refresh-token"}, nil)
+
+		ctx, rec := setupJSONRequest(t, http.MethodPost, "/api/v1/token/refresh", map[string]any{"refresh_token": "old-refresh-token"})
+
+		h := handler.PostApiV1TokenRefreshHandler{InputPort: mockPort}
+		err := h.PostApiV1TokenRefresh(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp map[string]interface{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "new-jwt-token", resp["token"])
+		assert.Equal(t, "new-refresh-token", resp["refresh_token"])
+	})
+
+	t.Run("error_unauthorized", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockRefreshTokenInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			Return(nil, apperror.Unauthorized("認証に失敗しました。再度ログインしてください。"))
+
+		ctx, _ := setupJSONRequest(t, http.MethodPost, "/api/v1/token/refresh", map[string]any{"refresh_token": "unknown"})
+
+		h := handler.PostApiV1TokenRefreshHandler{InputPort: mockPort}
+		err := h.PostApiV1TokenRefresh(ctx)
+
+		statusCode, _, _, _ := apperror.HTTPStatus(err)
+		assert.Equal(t, http.StatusUnauthorized, statusCode)
+	})
+}
@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+type PostApiV1UsersUserIdMuteHandler struct {
+	InputPort usecase.CreateUserMuteInputPort
+}
+
+func (h *PostApiV1UsersUserIdMuteHandler) PostApiV1UsersUserIdMute(ctx echo.Context, userId int) error {
+	currentUser, err := middleware.RequireCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := h.InputPort.Execute(ctx.Request().Context(), usecase.CreateUserMuteInput{
+		UserID:      currentUser.ID,
+		MutedUserID: uint(userId),
+	}); err != nil {
+		return err
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
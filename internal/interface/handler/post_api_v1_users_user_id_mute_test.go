@@ -0,0 +1,73 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostApiV1UsersUserIdMuteHandler(t *testing.T) {
+	t.Run("success_returns_204", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockCreateUserMuteInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.CreateUserMuteInput{UserID: 10, MutedUserID: 2}).
+			Return(nil)
+
+		ctx, rec := setupJSONRequest(t, http.MethodPost, "/api/v1/users/2/mute", nil)
+		middleware.SetCurrentUser(ctx, &model.User{ID: 10, Name: "alice"})
+
+		h := handler.PostApiV1UsersUserIdMuteHandler{InputPort: mockPort}
+		err := h.PostApiV1UsersUserIdMute(ctx, 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("error_unauthorized_without_current_user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockCreateUserMuteInputPort(ctrl)
+
+		ctx, _ := setupJSONRequest(t, http.MethodPost, "/api/v1/users/2/mute", nil)
+
+		h := handler.PostApiV1UsersUserIdMuteHandler{InputPort: mockPort}
+		err := h.PostApiV1UsersUserIdMute(ctx, 2)
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, statusCode)
+	})
+
+	t.Run("error_usecase_returns_not_found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockCreateUserMuteInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.CreateUserMuteInput{UserID: 10, MutedUserID: 999}).
+			Return(apperror.NotFound())
+
+		ctx, _ := setupJSONRequest(t, http.MethodPost, "/api/v1/users/999/mute", nil)
+		middleware.SetCurrentUser(ctx, &model.User{ID: 10, Name: "alice"})
+
+		h := handler.PostApiV1UsersUserIdMuteHandler{InputPort: mockPort}
+		err := h.PostApiV1UsersUserIdMute(ctx, 999)
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, statusCode)
+	})
+}
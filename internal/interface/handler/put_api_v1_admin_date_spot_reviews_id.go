@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+type PutApiV1AdminDateSpotReviewsIdHandler struct {
+	InputPort usecase.ModerateDateSpotReviewInputPort
+}
+
+func (h *PutApiV1AdminDateSpotReviewsIdHandler) PutApiV1AdminDateSpotReviewsId(ctx echo.Context, id int) error {
+	// レビューを承認・非公開にできるのは管理者だけ
+	if _, err := middleware.RequireAdmin(ctx); err != nil {
+		return err
+	}
+
+	input := usecase.ModerateDateSpotReviewInput{
+		ReviewID: uint(id),
+		Status:   model.ReviewStatus(ctx.FormValue("status")),
+	}
+	output, err := h.InputPort.Execute(ctx.Request().Context(), input)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.NewModerationReviewData(output.Review))
+}
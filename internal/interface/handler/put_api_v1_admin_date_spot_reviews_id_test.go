@@ -0,0 +1,63 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPutApiV1AdminDateSpotReviewsIdHandler(t *testing.T) {
+	t.Run("success_returns_200_with_review", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockModerateDateSpotReviewInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.ModerateDateSpotReviewInput{ReviewID: 7, Status: model.ReviewStatusHidden}).
+			Return(&usecase.ModerateDateSpotReviewOutput{
+				Review: &model.DateSpotReview{ID: 7, UserID: 3, DateSpotID: 4, Status: model.ReviewStatusHidden},
+			}, nil)
+
+		ctx, rec := setupFormRequest(http.MethodPut, "/api/v1/admin/date_spot_reviews/7", url.Values{"status": {"hidden"}})
+		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "admin", Admin: true})
+
+		h := handler.PutApiV1AdminDateSpotReviewsIdHandler{InputPort: mockPort}
+		err := h.PutApiV1AdminDateSpotReviewsId(ctx, 7)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp map[string]interface{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "hidden", resp["status"])
+		assert.Equal(t, []interface{}{}, resp["reports"])
+	})
+
+	t.Run("error_forbidden_for_non_admin", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockModerateDateSpotReviewInputPort(ctrl)
+
+		ctx, _ := setupFormRequest(http.MethodPut, "/api/v1/admin/date_spot_reviews/7", url.Values{"status": {"hidden"}})
+		middleware.SetCurrentUser(ctx, &model.User{ID: 2, Name: "alice"})
+
+		h := handler.PutApiV1AdminDateSpotReviewsIdHandler{InputPort: mockPort}
+		err := h.PutApiV1AdminDateSpotReviewsId(ctx, 7)
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusForbidden, statusCode)
+	})
+}
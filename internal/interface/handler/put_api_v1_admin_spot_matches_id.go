@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+type PutApiV1AdminSpotMatchesIdHandler struct {
+	InputPort usecase.ResolveSpotMatchInputPort
+}
+
+func (h *PutApiV1AdminSpotMatchesIdHandler) PutApiV1AdminSpotMatchesId(ctx echo.Context, id int) error {
+	// スポットを統合・新規登録できるのは管理者だけ
+	if _, err := middleware.RequireAdmin(ctx); err != nil {
+		return err
+	}
+
+	input := usecase.ResolveSpotMatchInput{
+		SpotSourceID: uint(id),
+		Decision:     usecase.SpotMatchDecision(ctx.FormValue("decision")),
+	}
+	output, err := h.InputPort.Execute(ctx.Request().Context(), input)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, openapi.NewSpotMatchData(output.SpotSource))
+}
@@ -0,0 +1,70 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPutApiV1AdminSpotMatchesIdHandler(t *testing.T) {
+	t.Run("success_returns_200_with_linked_match", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockResolveSpotMatchInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.ResolveSpotMatchInput{SpotSourceID: 3, Decision: usecase.SpotMatchDecisionSeparate}).
+			Return(&usecase.ResolveSpotMatchOutput{
+				SpotSource: &model.SpotSource{
+					ID:         3,
+					DateSpotID: 20,
+					Source:     model.DateSpotSourceHotPepper,
+					Name:       "スターバックスコーヒー渋谷店",
+					Status:     model.SpotSourceStatusLinked,
+					DateSpot:   &model.DateSpot{ID: 20, Name: "スターバックスコーヒー渋谷店", Source: model.DateSpotSourceHotPepper},
+				},
+			}, nil)
+
+		ctx, rec := setupFormRequest(http.MethodPut, "/api/v1/admin/spot_matches/3", url.Values{"decision": {"separate"}})
+		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "admin", Admin: true})
+
+		h := handler.PutApiV1AdminSpotMatchesIdHandler{InputPort: mockPort}
+		err := h.PutApiV1AdminSpotMatchesId(ctx, 3)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp map[string]interface{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "linked", resp["status"])
+		assert.Equal(t, float64(20), resp["date_spot"].(map[string]interface{})["id"])
+	})
+
+	t.Run("error_forbidden_for_non_admin", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockResolveSpotMatchInputPort(ctrl)
+
+		ctx, _ := setupFormRequest(http.MethodPut, "/api/v1/admin/spot_matches/3", url.Values{"decision": {"merge"}})
+		middleware.SetCurrentUser(ctx, &model.User{ID: 2, Name: "alice"})
+
+		h := handler.PutApiV1AdminSpotMatchesIdHandler{InputPort: mockPort}
+		err := h.PutApiV1AdminSpotMatchesId(ctx, 3)
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusForbidden, statusCode)
+	})
+}
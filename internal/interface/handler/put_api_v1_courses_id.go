@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+)
+
+type PutApiV1CoursesIdHandler struct {
+	InputPort usecase.UpdateCourseInputPort
+}
+
+func (h *PutApiV1CoursesIdHandler) PutApiV1CoursesId(ctx echo.Context, courseID int) error {
+	// 編集できるのは作成者だけなので、操作主体はトークンから決める
+	currentUser, err := middleware.RequireVerifiedUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	form, err := ctx.FormParams()
+	if err != nil {
+		return apperror.BadRequestWithCause(err)
+	}
+
+	stops, err := parseCourseStops(form)
+	if err != nil {
+		return err
+	}
+
+	output, err := h.InputPort.Execute(ctx.Request().Context(), usecase.UpdateCourseInput{
+		CourseID:   uint(courseID),
+		OperatorID: currentUser.ID,
+		Stops:      stops,
+		TravelMode: form.Get("travel_mode"),
+		Authority:  form.Get("authority"),
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := openapi.NewCourseResponse(output.Course)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
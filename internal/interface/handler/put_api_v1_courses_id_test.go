@@ -0,0 +1,148 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPutApiV1CoursesIdHandler(t *testing.T) {
+	newForm := func() url.Values {
+		form := url.Values{}
+		form.Add("date_spots[]", "20")
+		form.Add("date_spots[]", "10")
+		form.Set("travel_mode", "WALKING")
+		form.Set("authority", "非公開")
+		return form
+	}
+
+	t.Run("success_returns_200_with_updated_course", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockUpdateCourseInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), usecase.UpdateCourseInput{
+				CourseID:   1,
+				OperatorID: 10,
+				Stops:      []usecase.CourseStopInput{{DateSpotID: 20}, {DateSpotID: 10}},
+				TravelMode: "WALKING",
+				Authority:  "非公開",
+			}).
+			Return(&usecase.UpdateCourseOutput{Course: &model.Course{
+				ID:         1,
+				UserID:     10,
+				TravelMode: "WALKING",
+				Authority:  model.CourseAuthorityPrivate,
+				DuringSpots: []*model.DuringSpot{
+					{ID: 7, DateSpotID: 20, Position: 1},
+					{ID: 8, DateSpotID: 10, Position: 2},
+				},
+			}}, nil)
+
+		ctx, rec := setupFormRequest(http.MethodPut, "/api/v1/courses/1", newForm())
+		middleware.SetCurrentUser(ctx, newVerifiedUser(10, "alice"))
+
+		h := handler.PutApiV1CoursesIdHandler{InputPort: mockPort}
+		err := h.PutApiV1CoursesId(ctx, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp map[string]interface{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "WALKING", resp["travel_mode"])
+		duringSpots := resp["during_spots"].([]interface{})
+		require.Len(t, duringSpots, 2)
+		assert.Equal(t, float64(20), duringSpots[0].(map[string]interface{})["date_spot_id"])
+		assert.Equal(t, float64(1), duringSpots[0].(map[string]interface{})["position"])
+	})
+
+	t.Run("error_unauthorized_without_current_user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockUpdateCourseInputPort(ctrl)
+
+		ctx, _ := setupFormRequest(http.MethodPut, "/api/v1/courses/1", newForm())
+
+		h := handler.PutApiV1CoursesIdHandler{InputPort: mockPort}
+		err := h.PutApiV1CoursesId(ctx, 1)
+
+		require.Error(t, err)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, statusCode)
+	})
+
+	t.Run("error_bad_request_invalid_date_spot_id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockUpdateCourseInputPort(ctrl)
+
+		form := newForm()
+		form.Add("date_spots[]", "abc")
+		ctx, _ := setupFormRequest(http.MethodPut, "/api/v1/courses/1", form)
+		middleware.SetCurrentUser(ctx, newVerifiedUser(10, "alice"))
+
+		h := handler.PutApiV1CoursesIdHandler{InputPort: mockPort}
+		err := h.PutApiV1CoursesId(ctx, 1)
+
+		require.Error(t, err)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, statusCode)
+	})
+
+	// 未確認のユーザーが既存のコースを書き換えて投稿の制限をすり抜けられないことを確認する
+	t.Run("error_forbidden_when_email_unverified", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockUpdateCourseInputPort(ctrl)
+
+		ctx, _ := setupFormRequest(http.MethodPut, "/api/v1/courses/1", newForm())
+		middleware.SetCurrentUser(ctx, &model.User{ID: 10, Name: "alice"})
+
+		h := handler.PutApiV1CoursesIdHandler{InputPort: mockPort}
+		err := h.PutApiV1CoursesId(ctx, 1)
+
+		require.Error(t, err)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusForbidden, statusCode)
+	})
+
+	t.Run("error_usecase_returns_forbidden", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockUpdateCourseInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			Return(nil, apperror.Forbidden("他のユーザーのデートコースは編集できません"))
+
+		ctx, _ := setupFormRequest(http.MethodPut, "/api/v1/courses/1", newForm())
+		middleware.SetCurrentUser(ctx, newVerifiedUser(99, "bob"))
+
+		h := handler.PutApiV1CoursesIdHandler{InputPort: mockPort}
+		err := h.PutApiV1CoursesId(ctx, 1)
+
+		require.Error(t, err)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusForbidden, statusCode)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
 )
 
 type PutApiV1DateSpotReviewsIdHandler struct {
@@ -25,7 +26,7 @@ func (h *PutApiV1DateSpotReviewsIdHandler) PutApiV1DateSpotReviewsId(ctx echo.Co
 	}
 
 	// 編集できるのは投稿者だけなので、操作主体はトークンから決める
-	currentUser, err := middleware.RequireCurrentUser(ctx)
+	currentUser, err := middleware.RequireVerifiedUser(ctx)
 	if err != nil {
 		return err
 	}
@@ -36,5 +37,8 @@ func (h *PutApiV1DateSpotReviewsIdHandler) PutApiV1DateSpotReviewsId(ctx echo.Co
 		return err
 	}
 
-	return ctx.JSON(http.StatusOK, openapi.NewDateSpotReviewResponse(output.DateSpotReviews))
+	// 保留になったレビューは一覧に含まれないため、公開状態を返してクライアントが案内できるようにする
+	resp := openapi.NewDateSpotReviewResponse(output.DateSpotReviews)
+	resp.Status = lo.ToPtr(openapi.ReviewStatus(output.Status))
+	return ctx.JSON(http.StatusOK, resp)
 }
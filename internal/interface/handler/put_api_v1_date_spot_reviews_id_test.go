@@ -28,6 +28,7 @@ func TestPutApiV1DateSpotReviewsIdHandler(t *testing.T) {
 			Execute(gomock.Any(), gomock.Any()).
 			Return(&usecase.UpdateDateSpotReviewOutput{
 				ReviewID:        1,
+				Status:          model.ReviewStatusPublished,
 				DateSpotReviews: []*model.DateSpotReview{},
 			}, nil)
 
@@ -37,7 +38,7 @@ func TestPutApiV1DateSpotReviewsIdHandler(t *testing.T) {
 		form.Set("content", "良かった")
 		ctx, rec := setupFormRequest(http.MethodPut, "/api/v1/date_spot_reviews/1", form)
 
-		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+		middleware.SetCurrentUser(ctx, newVerifiedUser(1, "alice"))
 		h := handler.PutApiV1DateSpotReviewsIdHandler{InputPort: mockPort}
 		err := h.PutApiV1DateSpotReviewsId(ctx, 1)
 
@@ -48,6 +49,7 @@ func TestPutApiV1DateSpotReviewsIdHandler(t *testing.T) {
 		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
 		assert.Contains(t, resp, "date_spot_reviews")
 		assert.Contains(t, resp, "review_average_rate")
+		assert.Equal(t, "published", resp["status"])
 	})
 
 	t.Run("error_bad_request_missing_date_spot_id", func(t *testing.T) {
@@ -60,7 +62,7 @@ func TestPutApiV1DateSpotReviewsIdHandler(t *testing.T) {
 		form.Set("rate", "4.5")
 		ctx, _ := setupFormRequest(http.MethodPut, "/api/v1/date_spot_reviews/1", form)
 
-		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+		middleware.SetCurrentUser(ctx, newVerifiedUser(1, "alice"))
 		h := handler.PutApiV1DateSpotReviewsIdHandler{InputPort: mockPort}
 		err := h.PutApiV1DateSpotReviewsId(ctx, 1)
 
@@ -81,7 +83,7 @@ func TestPutApiV1DateSpotReviewsIdHandler(t *testing.T) {
 		form.Set("rate", "not-a-number")
 		ctx, _ := setupFormRequest(http.MethodPut, "/api/v1/date_spot_reviews/1", form)
 
-		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+		middleware.SetCurrentUser(ctx, newVerifiedUser(1, "alice"))
 		h := handler.PutApiV1DateSpotReviewsIdHandler{InputPort: mockPort}
 		err := h.PutApiV1DateSpotReviewsId(ctx, 1)
 
@@ -91,6 +93,29 @@ func TestPutApiV1DateSpotReviewsIdHandler(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, statusCode)
 	})
 
+	// 未確認のユーザーが既存のレビューを書き換えて投稿の制限をすり抜けられないことを確認する
+	t.Run("error_forbidden_when_email_unverified", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockPort := usecasemock.NewMockUpdateDateSpotReviewInputPort(ctrl)
+
+		form := url.Values{}
+		form.Set("date_spot_id", "3")
+		form.Set("rate", "4.5")
+		form.Set("content", "良かった")
+		ctx, _ := setupFormRequest(http.MethodPut, "/api/v1/date_spot_reviews/1", form)
+
+		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+		h := handler.PutApiV1DateSpotReviewsIdHandler{InputPort: mockPort}
+		err := h.PutApiV1DateSpotReviewsId(ctx, 1)
+
+		require.Error(t, err)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusForbidden, statusCode)
+	})
+
 	t.Run("error_usecase_returns_unprocessable_entity", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -104,7 +129,7 @@ func TestPutApiV1DateSpotReviewsIdHandler(t *testing.T) {
 		form.Set("date_spot_id", "3")
 		ctx, _ := setupFormRequest(http.MethodPut, "/api/v1/date_spot_reviews/1", form)
 
-		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+		middleware.SetCurrentUser(ctx, newVerifiedUser(1, "alice"))
 		h := handler.PutApiV1DateSpotReviewsIdHandler{InputPort: mockPort}
 		err := h.PutApiV1DateSpotReviewsId(ctx, 1)
 
@@ -128,7 +153,7 @@ func TestPutApiV1DateSpotReviewsIdHandler(t *testing.T) {
 		form.Set("rate", "4.5")
 		ctx, _ := setupFormRequest(http.MethodPut, "/api/v1/date_spot_reviews/1", form)
 
-		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+		middleware.SetCurrentUser(ctx, newVerifiedUser(1, "alice"))
 		h := handler.PutApiV1DateSpotReviewsIdHandler{InputPort: mockPort}
 		err := h.PutApiV1DateSpotReviewsId(ctx, 1)
 
@@ -20,16 +20,19 @@ func (h *PutApiV1DateSpotsIdHandler) PutApiV1DateSpotsId(ctx echo.Context, id in
 	}
 
 	var req struct {
-		Name         string  `form:"name"`
-		GenreID      int     `form:"genre_id"`
-		PrefectureID int     `form:"prefecture_id"`
-		CityName     string  `form:"city_name"`
-		Image        *string `form:"image"`
+		Name         string `form:"name"`
+		GenreID      int    `form:"genre_id"`
+		PrefectureID int    `form:"prefecture_id"`
+		CityName     string `form:"city_name"`
 	}
 
 	if err := ctx.Bind(&req); err != nil {
 		return err
 	}
+	image, err := formImage(ctx)
+	if err != nil {
+		return err
+	}
 
 	input := usecase.UpdateDateSpotInput{
 		DateSpotID:   uint(id),
@@ -37,7 +40,7 @@ func (h *PutApiV1DateSpotsIdHandler) PutApiV1DateSpotsId(ctx echo.Context, id in
 		GenreID:      req.GenreID,
 		PrefectureID: req.PrefectureID,
 		CityName:     req.CityName,
-		Image:        req.Image,
+		Image:        image,
 	}
 
 	if err := h.InputPort.Execute(ctx.Request().Context(), input); err != nil {
@@ -16,6 +16,10 @@ type PutApiV1UsersIdHandler struct {
 
 func (h *PutApiV1UsersIdHandler) PutApiV1UsersId(ctx echo.Context, id int) error {
 	// multipart/form-data からフィールドを取得
+	image, err := formImage(ctx)
+	if err != nil {
+		return err
+	}
 	input, err := usecase.NewUpdateUserInput(
 		id,
 		ctx.FormValue("name"),
@@ -23,7 +27,7 @@ func (h *PutApiV1UsersIdHandler) PutApiV1UsersId(ctx echo.Context, id int) error
 		ctx.FormValue("gender"),
 		ctx.FormValue("password"),
 		ctx.FormValue("password_confirmation"),
-		ctx.FormValue("image"),
+		image,
 	)
 	if err != nil {
 		return err
@@ -45,6 +49,11 @@ func (h *PutApiV1UsersIdHandler) PutApiV1UsersId(ctx echo.Context, id int) error
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
+	// パスワードを変えたときは、この端末だけログイン状態を保てるよう発行し直したトークンを返す
+	if output.Token != "" {
+		resp.Token = &output.Token
+		resp.RefreshToken = &output.RefreshToken
+	}
 
 	return ctx.JSON(http.StatusOK, resp)
 }
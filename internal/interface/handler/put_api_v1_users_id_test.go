@@ -56,6 +56,35 @@ func TestPutApiV1UsersIdHandler(t *testing.T) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 	})
 
+	// パスワードを変えたときは、発行し直したトークンをプロフィールと一緒に返す
+	t.Run("success_returns_reissued_tokens", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		user := dummyUserWithRelations(1, "テストユーザー")
+		mockPort := usecasemock.NewMockUpdateUserInputPort(ctrl)
+		mockPort.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			Return(&usecase.UpdateUserOutput{UserWithRelations: user, Token: "access", RefreshToken: "refresh"}, nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/users/1", strings.NewReader(validUpdateUserForm().Encode()))
+		req.Header.Set(echo.HeaderContentType, "application/x-www-form-urlencoded")
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+		ctx.SetParamNames("id")
+		ctx.SetParamValues("1")
+
+		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice"})
+
+		h := handler.PutApiV1UsersIdHandler{InputPort: mockPort}
+		err := h.PutApiV1UsersId(ctx, 1)
+
+		require.NoError(t, err)
+		assert.Contains(t, rec.Body.String(), `"token":"access"`)
+		assert.Contains(t, rec.Body.String(), `"refresh_token":"refresh"`)
+	})
+
 	t.Run("error_unauthorized_without_current_user", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
+	"github.com/labstack/echo/v4"
+)
+
+// formImage は multipart/form-data の image フィールドのファイルを読み込みます。
+// ファイルが添付されていなければ nil を返します。
+// 上限を1バイトだけ超えて読むことで、大きすぎるファイルは全体を読まずに ImageService が弾けるようにします。
+func formImage(ctx echo.Context) ([]byte, error) {
+	fh, err := ctx.FormFile("image")
+	if err != nil {
+		if errors.Is(err, http.ErrMissingFile) || errors.Is(err, http.ErrNotMultipart) {
+			return nil, nil
+		}
+		return nil, apperror.BadRequest("画像ファイルを読み込めませんでした")
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(io.LimitReader(f, service.MaxImageBytes+1))
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	return data, nil
+}
@@ -22,12 +22,12 @@ import (
 // 任意認証のルートではトークンが無効でも 401 にせず匿名として扱います。401 にすると、
 // 期限切れトークンを持ったままログイン画面を開いたユーザーがログイン自体を拒否され、
 // 再ログインできなくなるためです。
-func JWTAuthMiddleware(secretKey string, userRepo repository.UserRepository) echo.MiddlewareFunc {
+func JWTAuthMiddleware(keyring *jwtpkg.Keyring, userRepo repository.UserRepository) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(ctx echo.Context) error {
 			req := ctx.Request()
 
-			user, err := authenticate(req, secretKey, userRepo)
+			user, err := authenticate(req, keyring, userRepo)
 			if err != nil {
 				if iface_openapi.RequiresBearerAuth(req.Method, ctx.Path()) {
 					return err
@@ -42,22 +42,28 @@ func JWTAuthMiddleware(secretKey string, userRepo repository.UserRepository) ech
 }
 
 // authenticate は Authorization ヘッダの Bearer トークンを検証し、対応するユーザーを返します。
-func authenticate(req *http.Request, secretKey string, userRepo repository.UserRepository) (*model.User, error) {
+func authenticate(req *http.Request, keyring *jwtpkg.Keyring, userRepo repository.UserRepository) (*model.User, error) {
 	authHeader := req.Header.Get("Authorization")
 	if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
 		return nil, apperror.Unauthorized("認証が必要です。")
 	}
 
 	tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
-	userID, err := jwtpkg.Decode(tokenStr, secretKey)
+	claims, err := keyring.Decode(tokenStr)
 	if err != nil {
 		return nil, err
 	}
 
-	user, err := userRepo.FindByID(req.Context(), userID)
+	user, err := userRepo.FindByID(req.Context(), claims.UserID)
 	if err != nil {
 		return nil, apperror.Unauthorized("認証が必要です。")
 	}
 
+	// ログアウト（全端末）やパスワード変更でユーザーのトークンバージョンが上がると、
+	// それより前に発行したトークンは有効期限内でも使えなくする
+	if claims.TokenVersion != user.TokenVersion {
+		return nil, apperror.Unauthorized("セッションが無効になりました。再度ログインしてください。")
+	}
+
 	return user, nil
 }
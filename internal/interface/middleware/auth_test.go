@@ -18,7 +18,19 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
-const testSecret = "test-secret"
+var testKeyring = newTestKeyring()
+
+func newTestKeyring() *jwtpkg.Keyring {
+	key, err := jwtpkg.NewHMACKey("test", []byte("test-secret"))
+	if err != nil {
+		panic(err)
+	}
+	keyring, err := jwtpkg.NewKeyring("https://api.example.com", "date-courses", key)
+	if err != nil {
+		panic(err)
+	}
+	return keyring
+}
 
 func dummyHandler(ctx echo.Context) error {
 	return ctx.String(http.StatusOK, "ok")
@@ -38,7 +50,7 @@ func newEchoWithAuth(t *testing.T, userRepo *repositorymock.MockUserRepository)
 	t.Helper()
 	e := echo.New()
 	e.HTTPErrorHandler = middleware.CustomHTTPErrorHandler
-	e.Use(middleware.JWTAuthMiddleware(testSecret, userRepo))
+	e.Use(middleware.JWTAuthMiddleware(testKeyring, userRepo))
 	e.POST("/api/v1/login", currentUserHandler)
 	e.POST("/api/v1/signup", dummyHandler)
 	e.GET("/", dummyHandler)
@@ -74,7 +86,7 @@ func TestJWTAuthMiddleware(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(user, nil)
 
-		token, err := jwtpkg.Encode(1, testSecret)
+		token, err := testKeyring.Encode(jwtpkg.Claims{UserID: 1}, time.Hour)
 		require.NoError(t, err)
 
 		e := newEchoWithAuth(t, userRepo)
@@ -121,7 +133,28 @@ func TestJWTAuthMiddleware(t *testing.T) {
 
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 
-		token, err := jwtpkg.EncodeWithExpiry(1, testSecret, time.Now().Add(-1*time.Hour))
+		token, err := testKeyring.EncodeWithExpiry(jwtpkg.Claims{UserID: 1}, time.Now().Add(-1*time.Hour))
+		require.NoError(t, err)
+
+		e := newEchoWithAuth(t, userRepo)
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/courses", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("error_revoked_token_version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// パスワード変更などでバージョンが上がった後は、有効期限内の古いトークンも拒否する
+		user := &model.User{ID: 1, Name: "alice", TokenVersion: 2}
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(user, nil)
+
+		token, err := testKeyring.Encode(jwtpkg.Claims{UserID: 1, TokenVersion: 1}, time.Hour)
 		require.NoError(t, err)
 
 		e := newEchoWithAuth(t, userRepo)
@@ -131,6 +164,7 @@ func TestJWTAuthMiddleware(t *testing.T) {
 		e.ServeHTTP(rec, req)
 
 		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Contains(t, rec.Body.String(), "セッションが無効になりました。再度ログインしてください。")
 	})
 
 	t.Run("skip_login_endpoint", func(t *testing.T) {
@@ -199,7 +233,7 @@ func TestJWTAuthMiddleware(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(user, nil)
 
-		token, err := jwtpkg.Encode(1, testSecret)
+		token, err := testKeyring.Encode(jwtpkg.Claims{UserID: 1}, time.Hour)
 		require.NoError(t, err)
 
 		e := newEchoWithAuth(t, userRepo)
@@ -233,7 +267,7 @@ func TestJWTAuthMiddleware(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(user, nil)
 
-		token, err := jwtpkg.Encode(1, testSecret)
+		token, err := testKeyring.Encode(jwtpkg.Claims{UserID: 1}, time.Hour)
 		require.NoError(t, err)
 
 		e := newEchoWithAuth(t, userRepo)
@@ -258,7 +292,7 @@ func TestJWTAuthMiddleware_OptionalAuth(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(user, nil)
 
-		token, err := jwtpkg.Encode(1, testSecret)
+		token, err := testKeyring.Encode(jwtpkg.Claims{UserID: 1}, time.Hour)
 		require.NoError(t, err)
 
 		e := newEchoWithAuth(t, userRepo)
@@ -311,7 +345,7 @@ func TestJWTAuthMiddleware_OptionalAuth(t *testing.T) {
 
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 
-		token, err := jwtpkg.EncodeWithExpiry(1, testSecret, time.Now().Add(-1*time.Hour))
+		token, err := testKeyring.EncodeWithExpiry(jwtpkg.Claims{UserID: 1}, time.Now().Add(-1*time.Hour))
 		require.NoError(t, err)
 
 		e := newEchoWithAuth(t, userRepo)
@@ -331,7 +365,7 @@ func TestJWTAuthMiddleware_OptionalAuth(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(nil, errors.New("not found"))
 
-		token, err := jwtpkg.Encode(1, testSecret)
+		token, err := testKeyring.Encode(jwtpkg.Claims{UserID: 1}, time.Hour)
 		require.NoError(t, err)
 
 		e := newEchoWithAuth(t, userRepo)
@@ -351,7 +385,7 @@ func TestJWTAuthMiddleware_OptionalAuth(t *testing.T) {
 
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 
-		token, err := jwtpkg.EncodeWithExpiry(1, testSecret, time.Now().Add(-1*time.Hour))
+		token, err := testKeyring.EncodeWithExpiry(jwtpkg.Claims{UserID: 1}, time.Now().Add(-1*time.Hour))
 		require.NoError(t, err)
 
 		e := newEchoWithAuth(t, userRepo)
@@ -386,7 +420,7 @@ func TestCoursesAuthMiddleware(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(user, nil)
 
-		token, err := jwtpkg.Encode(1, testSecret)
+		token, err := testKeyring.Encode(jwtpkg.Claims{UserID: 1}, time.Hour)
 		require.NoError(t, err)
 
 		e := newEchoWithAuth(t, userRepo)
@@ -419,7 +453,7 @@ func TestCoursesAuthMiddleware(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(user, nil)
 
-		token, err := jwtpkg.Encode(1, testSecret)
+		token, err := testKeyring.Encode(jwtpkg.Claims{UserID: 1}, time.Hour)
 		require.NoError(t, err)
 
 		e := newEchoWithAuth(t, userRepo)
@@ -454,7 +488,7 @@ func TestDateSpotReviewsAuthMiddleware(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(user, nil)
 
-		token, err := jwtpkg.Encode(1, testSecret)
+		token, err := testKeyring.Encode(jwtpkg.Claims{UserID: 1}, time.Hour)
 		require.NoError(t, err)
 
 		e := newEchoWithAuth(t, userRepo)
@@ -487,7 +521,7 @@ func TestDateSpotReviewsAuthMiddleware(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(user, nil)
 
-		token, err := jwtpkg.Encode(1, testSecret)
+		token, err := testKeyring.Encode(jwtpkg.Claims{UserID: 1}, time.Hour)
 		require.NoError(t, err)
 
 		e := newEchoWithAuth(t, userRepo)
@@ -520,7 +554,7 @@ func TestDateSpotReviewsAuthMiddleware(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(user, nil)
 
-		token, err := jwtpkg.Encode(1, testSecret)
+		token, err := testKeyring.Encode(jwtpkg.Claims{UserID: 1}, time.Hour)
 		require.NoError(t, err)
 
 		e := newEchoWithAuth(t, userRepo)
@@ -555,7 +589,7 @@ func TestDateSpotsAuthMiddleware(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(user, nil)
 
-		token, err := jwtpkg.Encode(1, testSecret)
+		token, err := testKeyring.Encode(jwtpkg.Claims{UserID: 1}, time.Hour)
 		require.NoError(t, err)
 
 		e := newEchoWithAuth(t, userRepo)
@@ -588,7 +622,7 @@ func TestDateSpotsAuthMiddleware(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(user, nil)
 
-		token, err := jwtpkg.Encode(1, testSecret)
+		token, err := testKeyring.Encode(jwtpkg.Claims{UserID: 1}, time.Hour)
 		require.NoError(t, err)
 
 		e := newEchoWithAuth(t, userRepo)
@@ -621,7 +655,7 @@ func TestDateSpotsAuthMiddleware(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(user, nil)
 
-		token, err := jwtpkg.Encode(1, testSecret)
+		token, err := testKeyring.Encode(jwtpkg.Claims{UserID: 1}, time.Hour)
 		require.NoError(t, err)
 
 		e := newEchoWithAuth(t, userRepo)
@@ -656,7 +690,7 @@ func TestRelationshipsAuthMiddleware(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(user, nil)
 
-		token, err := jwtpkg.Encode(1, testSecret)
+		token, err := testKeyring.Encode(jwtpkg.Claims{UserID: 1}, time.Hour)
 		require.NoError(t, err)
 
 		e := newEchoWithAuth(t, userRepo)
@@ -689,7 +723,7 @@ func TestRelationshipsAuthMiddleware(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(user, nil)
 
-		token, err := jwtpkg.Encode(1, testSecret)
+		token, err := testKeyring.Encode(jwtpkg.Claims{UserID: 1}, time.Hour)
 		require.NoError(t, err)
 
 		e := newEchoWithAuth(t, userRepo)
@@ -724,7 +758,7 @@ func TestUsersIdAuthMiddleware(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(user, nil)
 
-		token, err := jwtpkg.Encode(1, testSecret)
+		token, err := testKeyring.Encode(jwtpkg.Claims{UserID: 1}, time.Hour)
 		require.NoError(t, err)
 
 		e := newEchoWithAuth(t, userRepo)
@@ -757,7 +791,7 @@ func TestUsersIdAuthMiddleware(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(gomock.Any(), uint(1)).Return(user, nil)
 
-		token, err := jwtpkg.Encode(1, testSecret)
+		token, err := testKeyring.Encode(jwtpkg.Claims{UserID: 1}, time.Hour)
 		require.NoError(t, err)
 
 		e := newEchoWithAuth(t, userRepo)
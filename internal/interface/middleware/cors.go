@@ -13,5 +13,7 @@ func CORSMiddleware(allowOrigins []string) echo.MiddlewareFunc {
 		AllowOrigins: allowOrigins,
 		AllowMethods: []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
 		AllowHeaders: []string{"Accept", "Content-Type", "Authorization"},
+		// ブラウザから残り回数や再試行までの時間を読めるようにする
+		ExposeHeaders: []string{"X-RateLimit-Limit", "X-RateLimit-Remaining", "X-RateLimit-Reset", "Retry-After"},
 	})
 }
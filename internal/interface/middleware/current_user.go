@@ -48,6 +48,20 @@ func RequireAdmin(ctx echo.Context) (*model.User, error) {
 	return user, nil
 }
 
+// RequireVerifiedUser はメールアドレスを確認済みの認証済みユーザーを返します。
+// 未認証なら 401、未確認なら 403 を返します。
+// 捨てアドレスで量産したアカウントからの投稿を防ぐため、コース・レビューの投稿や通報で使います。
+func RequireVerifiedUser(ctx echo.Context) (*model.User, error) {
+	user, err := RequireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsEmailVerified() {
+		return nil, apperror.Forbidden("メールアドレスの確認が完了していません。確認メールのリンクを開いてください")
+	}
+	return user, nil
+}
+
 // RequireCurrentUser は認証済みユーザーを返します。未認証の場合は 401 を返します。
 // 認証必須ルートでも、リクエストの user_id ではなく必ずこのユーザーを操作主体として扱います。
 func RequireCurrentUser(ctx echo.Context) (*model.User, error) {
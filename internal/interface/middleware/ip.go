@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/labstack/echo/v4"
+)
+
+// IPExtractor は ctx.RealIP() でクライアントの IP アドレスを求める方法を返します。
+// X-Forwarded-For はクライアントが好きに書けるため、信頼するプロキシ（ループバック・プライベートアドレスと trustedCIDRs）を
+// 経由した分だけをたどり、それより手前の値は使いません。
+// Lambda では API Gateway が接続元のアドレスを RemoteAddr に入れるため、偽の X-Forwarded-For を付けても RemoteAddr が使われます。
+func IPExtractor(trustedCIDRs []string) (echo.IPExtractor, error) {
+	options := make([]echo.TrustOption, 0, len(trustedCIDRs))
+	for _, cidr := range trustedCIDRs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("middleware: invalid trusted proxy CIDR %q: %w", cidr, err)
+		}
+		options = append(options, echo.TrustIPRange(ipNet))
+	}
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
@@ -0,0 +1,43 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIPExtractor(t *testing.T) {
+	request := func(remoteAddr, xff string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/login", nil)
+		req.RemoteAddr = remoteAddr
+		if xff != "" {
+			req.Header.Set("X-Forwarded-For", xff)
+		}
+		return req
+	}
+
+	// Lambda では API Gateway が接続元を RemoteAddr に入れる。クライアントが付けた X-Forwarded-For は使わない
+	t.Run("ignores_spoofed_header_from_untrusted_address", func(t *testing.T) {
+		extract, err := middleware.IPExtractor(nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, "203.0.113.7", extract(request("203.0.113.7", "198.51.100.1")))
+	})
+
+	// 信頼するプロキシを経由した分だけをたどり、その手前のクライアントのアドレスを使う
+	t.Run("uses_client_behind_trusted_proxy", func(t *testing.T) {
+		extract, err := middleware.IPExtractor([]string{"192.0.2.0/24"})
+		require.NoError(t, err)
+
+		assert.Equal(t, "203.0.113.7", extract(request("192.0.2.10:443", "198.51.100.1, 203.0.113.7")))
+	})
+
+	t.Run("error_invalid_cidr", func(t *testing.T) {
+		_, err := middleware.IPExtractor([]string{"192.0.2.0"})
+		assert.Error(t, err)
+	})
+}
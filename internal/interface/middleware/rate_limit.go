@@ -1,76 +1,116 @@
 package middleware
 
 import (
-	"sync"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
 	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
 )
 
-// rateLimitWindow は試行回数をカウントする時間窓です。
-const rateLimitWindow = 1 * time.Minute
+// RateLimitKey はレート制限を数える単位です。
+type RateLimitKey string
 
-// rateLimitedPaths はレート制限をかけるパスです。
-// 認証情報を試行できるエンドポイントに限定し、通常の閲覧は制限しません。
-var rateLimitedPaths = map[string]struct{}{
-	"/api/v1/login":  {},
-	"/api/v1/signup": {},
+const (
+	// RateLimitByIP は接続元の IP アドレスごとに数えます。ログイン前に使うルート向けです。
+	RateLimitByIP RateLimitKey = "ip"
+	// RateLimitByUser はログイン中ならユーザーごと、未ログインなら IP アドレスごとに数えます。
+	// 同じ NAT の下にいる別の利用者を巻き込まないよう、ログイン後のルートはこちらを使います。
+	RateLimitByUser RateLimitKey = "user"
+)
+
+// RateLimitGroup は同じ上限をまとめてかけるルートの集まりです。
+type RateLimitGroup struct {
+	Name string
+	Rule model.RateLimitRule
+	Key  RateLimitKey
+	// Match はリクエストがこのグループに入るかを、メソッドとルートのパス（/api/v1/courses/:id の形）で判定します。
+	Match func(method, path string) bool
 }
 
-// attemptCounter は IP ごとの試行回数を時間窓つきで数えます。
-type attemptCounter struct {
-	mu       sync.Mutex
-	counts   map[string]int
-	resetAt  time.Time
-	window   time.Duration
-	maxCount int
+// MatchPaths は指定したパスのどれかに一致するリクエストを対象にします。
+func MatchPaths(paths ...string) func(method, path string) bool {
+	set := lo.SliceToMap(paths, func(p string) (string, struct{}) { return p, struct{}{} })
+	return func(_, path string) bool {
+		_, ok := set[path]
+		return ok
+	}
 }
 
-func newAttemptCounter(maxCount int, window time.Duration) *attemptCounter {
-	return &attemptCounter{
-		counts:   make(map[string]int),
-		resetAt:  time.Now().Add(window),
-		window:   window,
-		maxCount: maxCount,
+// MatchMethods は指定したメソッドのどれかに一致するリクエストを対象にします。
+func MatchMethods(methods ...string) func(method, path string) bool {
+	return func(method, _ string) bool {
+		return lo.Contains(methods, method)
 	}
 }
 
-// allow は key の試行を1回数え、上限内なら true を返します。
-// 時間窓を過ぎたらカウンタ全体を作り直します。IP ごとにエントリを増やし続けないための措置です。
-func (c *attemptCounter) allow(key string) bool {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+// MatchAll はすべてのリクエストを対象にします。ほかのグループに一致しなかった残りに使います。
+func MatchAll(_, _ string) bool {
+	return true
+}
+
+// enabled は上限が設定されているかを返します。上限が 0 のグループは制限しません。
+func (g RateLimitGroup) enabled() bool {
+	return g.Rule.Limit > 0 && g.Rule.Window > 0
+}
 
-	if time.Now().After(c.resetAt) {
-		c.counts = make(map[string]int)
-		c.resetAt = time.Now().Add(c.window)
+// bucketKey はストアに渡すキーです。グループごとに別のバケットで数えます。
+// ctx.RealIP() は e.IPExtractor に IPExtractor を設定して求めます。設定しないと echo は X-Forwarded-For をそのまま使うため、
+// ヘッダーを書き換えるたびに別のバケットになり、上限をすり抜けられます。
+func (g RateLimitGroup) bucketKey(ctx echo.Context) string {
+	if g.Key == RateLimitByUser {
+		if id := CurrentUserID(ctx); id != 0 {
+			return g.Name + ":user:" + strconv.FormatUint(uint64(id), 10)
+		}
 	}
-
-	c.counts[key]++
-	return c.counts[key] <= c.maxCount
+	return g.Name + ":ip:" + ctx.RealIP()
 }
 
-// LoginRateLimitMiddleware はログイン・新規登録への試行回数を IP ごとに制限します。
-// 制限がないとパスワードのブルートフォースを何度でも試せるため、上限を設けます。
+// RateLimitMiddleware はリクエストを、最初に一致したグループの上限で数えます。
+// どのグループにも一致しないリクエストは制限しません。
+// ユーザーごとに数えるグループのため、JWTAuthMiddleware より後に登録してください。
 //
-// カウンタはプロセス内のメモリに持ちます。Lambda では実行環境ごとに独立し、
-// スケールアウトすると制限が緩くなる点に注意してください。
-// 厳密な制限が必要になったら、API Gateway のスロットリングか外部ストアへ移します。
-func LoginRateLimitMiddleware(maxAttemptsPerMinute int) echo.MiddlewareFunc {
-	counter := newAttemptCounter(maxAttemptsPerMinute, rateLimitWindow)
-
+// 応答には X-RateLimit-Limit・X-RateLimit-Remaining・X-RateLimit-Reset（上限まで回復する時刻の UNIX 秒）を付け、
+// 上限を超えたときは Retry-After（秒）を付けて 429 を返します。
+// ストアの障害でリクエストを止めるとサービス全体が使えなくなるため、数えられなかったときは通します。
+func RateLimitMiddleware(store repository.RateLimitStore, groups ...RateLimitGroup) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(ctx echo.Context) error {
-			if _, limited := rateLimitedPaths[ctx.Path()]; !limited {
+			req := ctx.Request()
+			group, ok := lo.Find(groups, func(g RateLimitGroup) bool {
+				return g.Match(req.Method, ctx.Path())
+			})
+			if !ok || !group.enabled() {
 				return next(ctx)
 			}
 
-			if !counter.allow(ctx.RealIP()) {
-				return apperror.TooManyRequests()
+			result, err := store.Take(req.Context(), group.bucketKey(ctx), group.Rule, time.Now())
+			if err != nil {
+				slog.WarnContext(req.Context(), "rate limit store unavailable, request allowed", "err", err, "group", group.Name)
+				return next(ctx)
 			}
 
+			setRateLimitHeaders(ctx.Response().Header(), result)
+			if !result.Allowed {
+				return apperror.TooManyRequests()
+			}
 			return next(ctx)
 		}
 	}
 }
+
+func setRateLimitHeaders(h http.Header, result model.RateLimitResult) {
+	h.Set("X-RateLimit-Limit", strconv.Itoa(result.Limit))
+	h.Set("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	h.Set("X-RateLimit-Reset", strconv.FormatInt(int64(math.Ceil(float64(result.ResetAt.UnixMilli())/1000)), 10))
+	if !result.Allowed {
+		// 秒未満を切り捨てると、言われた通りに待っても再び拒否されるため切り上げる
+		h.Set("Retry-After", strconv.Itoa(int(math.Ceil(result.RetryAfter.Seconds()))))
+	}
+}
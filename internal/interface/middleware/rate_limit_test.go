@@ -1,38 +1,81 @@
 package middleware_test
 
 import (
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/ratelimit"
 	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 )
 
-func newEchoWithLoginRateLimit(limit int) *echo.Echo {
+// setUserFromHeader はテスト用に X-Test-User-ID ヘッダーのユーザーをログイン中として扱います。
+// 本番では JWTAuthMiddleware が同じ位置でユーザーをセットします。
+func setUserFromHeader(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		if v := ctx.Request().Header.Get("X-Test-User-ID"); v != "" {
+			id, _ := strconv.Atoi(v)
+			middleware.SetCurrentUser(ctx, &model.User{ID: uint(id)})
+		}
+		return next(ctx)
+	}
+}
+
+func newEchoWithRateLimit(authLimit, writeLimit int) *echo.Echo {
 	e := echo.New()
 	e.HTTPErrorHandler = middleware.CustomHTTPErrorHandler
-	e.Use(middleware.LoginRateLimitMiddleware(limit))
+	e.Use(setUserFromHeader)
+	e.Use(middleware.RateLimitMiddleware(ratelimit.NewMemoryStore(),
+		middleware.RateLimitGroup{
+			Name:  "auth",
+			Rule:  model.RateLimitRule{Limit: authLimit, Window: time.Minute},
+			Key:   middleware.RateLimitByIP,
+			Match: middleware.MatchPaths("/api/v1/login", "/api/v1/signup"),
+		},
+		middleware.RateLimitGroup{
+			Name:  "write",
+			Rule:  model.RateLimitRule{Limit: writeLimit, Window: time.Minute},
+			Key:   middleware.RateLimitByUser,
+			Match: middleware.MatchMethods(http.MethodPost),
+		},
+	))
 	e.POST("/api/v1/login", dummyHandler)
 	e.POST("/api/v1/signup", dummyHandler)
+	e.POST("/api/v1/courses", dummyHandler)
 	e.GET("/api/v1/date_spots", dummyHandler)
 	return e
 }
 
-func post(t *testing.T, e *echo.Echo, path, ip string) int {
+func request(t *testing.T, e *echo.Echo, method, path, ip, userID string) *httptest.ResponseRecorder {
 	t.Helper()
-	req := httptest.NewRequest(http.MethodPost, path, nil)
+	req := httptest.NewRequest(method, path, nil)
 	req.RemoteAddr = ip + ":12345"
+	if userID != "" {
+		req.Header.Set("X-Test-User-ID", userID)
+	}
 	rec := httptest.NewRecorder()
 	e.ServeHTTP(rec, req)
-	return rec.Code
+	return rec
 }
 
-func TestLoginRateLimitMiddleware(t *testing.T) {
+func post(t *testing.T, e *echo.Echo, path, ip string) int {
+	t.Helper()
+	return request(t, e, http.MethodPost, path, ip, "").Code
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
 	// ブルートフォースを防ぐため、同一 IP からのログイン試行に上限を設ける
 	t.Run("rejects_after_limit_exceeded", func(t *testing.T) {
-		e := newEchoWithLoginRateLimit(3)
+		e := newEchoWithRateLimit(3, 100)
 
 		for i := 0; i < 3; i++ {
 			assert.Equal(t, http.StatusOK, post(t, e, "/api/v1/login", "10.0.0.1"), "%d 回目は通るはず", i+1)
@@ -42,7 +85,7 @@ func TestLoginRateLimitMiddleware(t *testing.T) {
 
 	// 制限は IP ごと。別の利用者を巻き込まない
 	t.Run("limits_per_ip", func(t *testing.T) {
-		e := newEchoWithLoginRateLimit(2)
+		e := newEchoWithRateLimit(2, 100)
 
 		post(t, e, "/api/v1/login", "10.0.0.1")
 		post(t, e, "/api/v1/login", "10.0.0.1")
@@ -50,24 +93,102 @@ func TestLoginRateLimitMiddleware(t *testing.T) {
 		assert.Equal(t, http.StatusOK, post(t, e, "/api/v1/login", "10.0.0.2"), "別 IP は影響を受けない")
 	})
 
-	// 新規登録もアカウント作成の乱用対象になるため対象に含める
-	t.Run("applies_to_signup", func(t *testing.T) {
-		e := newEchoWithLoginRateLimit(1)
+	// X-Forwarded-For を毎回変えても、信頼しないアドレスから届いたヘッダーは使わず同じバケットで数える
+	t.Run("spoofed_forwarded_for_does_not_reset_limit", func(t *testing.T) {
+		e := newEchoWithRateLimit(2, 100)
+		extractor, err := middleware.IPExtractor(nil)
+		require.NoError(t, err)
+		e.IPExtractor = extractor
 
-		assert.Equal(t, http.StatusOK, post(t, e, "/api/v1/signup", "10.0.0.3"))
+		postWithXFF := func(xff string) int {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/login", nil)
+			req.RemoteAddr = "203.0.113.5:12345"
+			req.Header.Set("X-Forwarded-For", xff)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+			return rec.Code
+		}
+		assert.Equal(t, http.StatusOK, postWithXFF("198.51.100.1"))
+		assert.Equal(t, http.StatusOK, postWithXFF("198.51.100.2"))
+		assert.Equal(t, http.StatusTooManyRequests, postWithXFF("198.51.100.3"))
+	})
+
+	// 同じグループのパスは同じバケットで数える
+	t.Run("shares_bucket_within_group", func(t *testing.T) {
+		e := newEchoWithRateLimit(1, 100)
+
+		assert.Equal(t, http.StatusOK, post(t, e, "/api/v1/login", "10.0.0.3"))
 		assert.Equal(t, http.StatusTooManyRequests, post(t, e, "/api/v1/signup", "10.0.0.3"))
 	})
 
-	// 通常の閲覧は制限しない
-	t.Run("does_not_limit_other_routes", func(t *testing.T) {
-		e := newEchoWithLoginRateLimit(1)
+	// ログイン後の操作はユーザーごとに数え、同じ IP の別ユーザーを巻き込まない
+	t.Run("limits_per_user_behind_same_ip", func(t *testing.T) {
+		e := newEchoWithRateLimit(100, 1)
+
+		assert.Equal(t, http.StatusOK, request(t, e, http.MethodPost, "/api/v1/courses", "10.0.0.5", "1").Code)
+		assert.Equal(t, http.StatusTooManyRequests, request(t, e, http.MethodPost, "/api/v1/courses", "10.0.0.5", "1").Code)
+		assert.Equal(t, http.StatusOK, request(t, e, http.MethodPost, "/api/v1/courses", "10.0.0.5", "2").Code, "別ユーザーは影響を受けない")
+	})
+
+	t.Run("sets_rate_limit_headers", func(t *testing.T) {
+		e := newEchoWithRateLimit(2, 100)
+
+		rec := request(t, e, http.MethodPost, "/api/v1/login", "10.0.0.6", "")
+		assert.Equal(t, "2", rec.Header().Get("X-RateLimit-Limit"))
+		assert.Equal(t, "1", rec.Header().Get("X-RateLimit-Remaining"))
+		reset, err := strconv.ParseInt(rec.Header().Get("X-RateLimit-Reset"), 10, 64)
+		require.NoError(t, err)
+		assert.Greater(t, reset, time.Now().Unix()-1)
+		assert.Empty(t, rec.Header().Get("Retry-After"), "通したときは Retry-After を付けない")
+
+		request(t, e, http.MethodPost, "/api/v1/login", "10.0.0.6", "")
+		rec = request(t, e, http.MethodPost, "/api/v1/login", "10.0.0.6", "")
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		assert.Equal(t, "0", rec.Header().Get("X-RateLimit-Remaining"))
+		// 1分に2回なので、次の1回まで約30秒
+		retryAfter, err := strconv.Atoi(rec.Header().Get("Retry-After"))
+		require.NoError(t, err)
+		assert.InDelta(t, 30, retryAfter, 1)
+	})
+
+	// どのグループにも一致しないリクエストは制限しない
+	t.Run("does_not_limit_unmatched_routes", func(t *testing.T) {
+		e := newEchoWithRateLimit(1, 1)
 
 		for i := 0; i < 5; i++ {
-			req := httptest.NewRequest(http.MethodGet, "/api/v1/date_spots", nil)
-			req.RemoteAddr = "10.0.0.4:12345"
-			rec := httptest.NewRecorder()
-			e.ServeHTTP(rec, req)
+			rec := request(t, e, http.MethodGet, "/api/v1/date_spots", "10.0.0.4", "")
 			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Empty(t, rec.Header().Get("X-RateLimit-Limit"))
+		}
+	})
+
+	// ストアの障害でサービス全体を止めないよう、数えられないときは通す
+	t.Run("allows_request_when_store_fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := repositorymock.NewMockRateLimitStore(ctrl)
+		store.EXPECT().Take(gomock.Any(), "auth:ip:10.0.0.7", gomock.Any(), gomock.Any()).
+			Return(model.RateLimitResult{}, errors.New("db error"))
+
+		e := echo.New()
+		e.Use(middleware.RateLimitMiddleware(store, middleware.RateLimitGroup{
+			Name:  "auth",
+			Rule:  model.RateLimitRule{Limit: 1, Window: time.Minute},
+			Key:   middleware.RateLimitByIP,
+			Match: middleware.MatchAll,
+		}))
+		e.POST("/api/v1/login", dummyHandler)
+
+		assert.Equal(t, http.StatusOK, post(t, e, "/api/v1/login", "10.0.0.7"))
+	})
+
+	// 上限 0 のグループは無効として扱う（RATE_LIMIT_READS_PER_MINUTE=0 など）
+	t.Run("disabled_group_does_not_limit", func(t *testing.T) {
+		e := newEchoWithRateLimit(100, 0)
+
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, http.StatusOK, request(t, e, http.MethodPost, "/api/v1/courses", "10.0.0.8", "1").Code)
 		}
 	})
 }
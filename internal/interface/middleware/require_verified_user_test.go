@@ -0,0 +1,47 @@
+package middleware_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequireVerifiedUser(t *testing.T) {
+	t.Run("returns_user_when_verified", func(t *testing.T) {
+		ctx := newContext()
+		verifiedAt := time.Now()
+		middleware.SetCurrentUser(ctx, &model.User{ID: 1, Name: "alice", EmailVerifiedAt: &verifiedAt})
+
+		user, err := middleware.RequireVerifiedUser(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(1), user.ID)
+	})
+
+	t.Run("error_forbidden_when_unverified", func(t *testing.T) {
+		ctx := newContext()
+		middleware.SetCurrentUser(ctx, &model.User{ID: 2, Name: "bob"})
+
+		_, err := middleware.RequireVerifiedUser(ctx)
+
+		require.Error(t, err)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusForbidden, statusCode)
+	})
+
+	t.Run("error_unauthorized_when_anonymous", func(t *testing.T) {
+		_, err := middleware.RequireVerifiedUser(newContext())
+
+		require.Error(t, err)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, statusCode)
+	})
+}
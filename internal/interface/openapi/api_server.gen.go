@@ -17,6 +17,21 @@ type ServerInterface interface {
 	// (GET /)
 	Get(ctx echo.Context) error
 
+	// (GET /.well-known/jwks.json)
+	GetWellKnownJwksJson(ctx echo.Context) error
+
+	// (GET /api/v1/admin/date_spot_reviews)
+	GetApiV1AdminDateSpotReviews(ctx echo.Context, params GetApiV1AdminDateSpotReviewsParams) error
+
+	// (PUT /api/v1/admin/date_spot_reviews/{id})
+	PutApiV1AdminDateSpotReviewsId(ctx echo.Context, id int) error
+
+	// (GET /api/v1/admin/spot_matches)
+	GetApiV1AdminSpotMatches(ctx echo.Context, params GetApiV1AdminSpotMatchesParams) error
+
+	// (PUT /api/v1/admin/spot_matches/{id})
+	PutApiV1AdminSpotMatchesId(ctx echo.Context, id int) error
+
 	// (GET /api/v1/courses)
 	GetApiV1Courses(ctx echo.Context, params GetApiV1CoursesParams) error
 
@@ -29,6 +44,15 @@ type ServerInterface interface {
 	// (GET /api/v1/courses/{id})
 	GetApiV1CoursesId(ctx echo.Context, id int) error
 
+	// (PUT /api/v1/courses/{id})
+	PutApiV1CoursesId(ctx echo.Context, id int) error
+
+	// (DELETE /api/v1/courses/{id}/favorite)
+	DeleteApiV1CoursesIdFavorite(ctx echo.Context, id int) error
+
+	// (POST /api/v1/courses/{id}/favorite)
+	PostApiV1CoursesIdFavorite(ctx echo.Context, id int) error
+
 	// (POST /api/v1/date_spot_reviews)
 	PostApiV1DateSpotReviews(ctx echo.Context) error
 
@@ -38,6 +62,9 @@ type ServerInterface interface {
 	// (PUT /api/v1/date_spot_reviews/{id})
 	PutApiV1DateSpotReviewsId(ctx echo.Context, id int) error
 
+	// (POST /api/v1/date_spot_reviews/{id}/reports)
+	PostApiV1DateSpotReviewsIdReports(ctx echo.Context, id int) error
+
 	// (GET /api/v1/date_spots)
 	GetApiV1DateSpots(ctx echo.Context, params GetApiV1DateSpotsParams) error
 
@@ -53,12 +80,42 @@ type ServerInterface interface {
 	// (PUT /api/v1/date_spots/{id})
 	PutApiV1DateSpotsId(ctx echo.Context, id int) error
 
+	// (POST /api/v1/email_verification)
+	PostApiV1EmailVerification(ctx echo.Context) error
+
+	// (POST /api/v1/email_verification/confirm)
+	PostApiV1EmailVerificationConfirm(ctx echo.Context) error
+
+	// (GET /api/v1/feed)
+	GetApiV1Feed(ctx echo.Context, params GetApiV1FeedParams) error
+
 	// (GET /api/v1/genres/{id})
 	GetApiV1GenresId(ctx echo.Context, id int) error
 
 	// (POST /api/v1/login)
 	PostApiV1Login(ctx echo.Context) error
 
+	// (GET /api/v1/login_histories)
+	GetApiV1LoginHistories(ctx echo.Context, params GetApiV1LoginHistoriesParams) error
+
+	// (POST /api/v1/logout)
+	PostApiV1Logout(ctx echo.Context) error
+
+	// (GET /api/v1/notifications)
+	GetApiV1Notifications(ctx echo.Context, params GetApiV1NotificationsParams) error
+
+	// (POST /api/v1/notifications/read)
+	PostApiV1NotificationsRead(ctx echo.Context) error
+
+	// (GET /api/v1/notifications/unread_count)
+	GetApiV1NotificationsUnreadCount(ctx echo.Context) error
+
+	// (POST /api/v1/password_reset)
+	PostApiV1PasswordReset(ctx echo.Context) error
+
+	// (POST /api/v1/password_reset/confirm)
+	PostApiV1PasswordResetConfirm(ctx echo.Context) error
+
 	// (GET /api/v1/prefectures/{id})
 	GetApiV1PrefecturesId(ctx echo.Context, id int) error
 
@@ -71,6 +128,9 @@ type ServerInterface interface {
 	// (POST /api/v1/signup)
 	PostApiV1Signup(ctx echo.Context) error
 
+	// (POST /api/v1/token/refresh)
+	PostApiV1TokenRefresh(ctx echo.Context) error
+
 	// (GET /api/v1/top)
 	GetApiV1Top(ctx echo.Context) error
 
@@ -86,11 +146,26 @@ type ServerInterface interface {
 	// (PUT /api/v1/users/{id})
 	PutApiV1UsersId(ctx echo.Context, id int) error
 
+	// (DELETE /api/v1/users/{user_id}/block)
+	DeleteApiV1UsersUserIdBlock(ctx echo.Context, userId int) error
+
+	// (POST /api/v1/users/{user_id}/block)
+	PostApiV1UsersUserIdBlock(ctx echo.Context, userId int) error
+
+	// (GET /api/v1/users/{user_id}/favorite_courses)
+	GetApiV1UsersUserIdFavoriteCourses(ctx echo.Context, userId int, params GetApiV1UsersUserIdFavoriteCoursesParams) error
+
 	// (GET /api/v1/users/{user_id}/followers)
-	GetApiV1UsersUserIdFollowers(ctx echo.Context, userId int) error
+	GetApiV1UsersUserIdFollowers(ctx echo.Context, userId int, params GetApiV1UsersUserIdFollowersParams) error
 
 	// (GET /api/v1/users/{user_id}/followings)
-	GetApiV1UsersUserIdFollowings(ctx echo.Context, userId int) error
+	GetApiV1UsersUserIdFollowings(ctx echo.Context, userId int, params GetApiV1UsersUserIdFollowingsParams) error
+
+	// (DELETE /api/v1/users/{user_id}/mute)
+	DeleteApiV1UsersUserIdMute(ctx echo.Context, userId int) error
+
+	// (POST /api/v1/users/{user_id}/mute)
+	PostApiV1UsersUserIdMute(ctx echo.Context, userId int) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -107,6 +182,126 @@ func (w *ServerInterfaceWrapper) Get(ctx echo.Context) error {
 	return err
 }
 
+// GetWellKnownJwksJson converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWellKnownJwksJson(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWellKnownJwksJson(ctx)
+	return err
+}
+
+// GetApiV1AdminDateSpotReviews converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApiV1AdminDateSpotReviews(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1AdminDateSpotReviewsParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetApiV1AdminDateSpotReviews(ctx, params)
+	return err
+}
+
+// PutApiV1AdminDateSpotReviewsId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutApiV1AdminDateSpotReviewsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutApiV1AdminDateSpotReviewsId(ctx, id)
+	return err
+}
+
+// GetApiV1AdminSpotMatches converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApiV1AdminSpotMatches(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1AdminSpotMatchesParams
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetApiV1AdminSpotMatches(ctx, params)
+	return err
+}
+
+// PutApiV1AdminSpotMatchesId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutApiV1AdminSpotMatchesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutApiV1AdminSpotMatchesId(ctx, id)
+	return err
+}
+
 // GetApiV1Courses converts echo context to params.
 func (w *ServerInterfaceWrapper) GetApiV1Courses(ctx echo.Context) error {
 	var err error
@@ -120,6 +315,27 @@ func (w *ServerInterfaceWrapper) GetApiV1Courses(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter prefecture_id: %s", err))
 	}
 
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetApiV1Courses(ctx, params)
 	return err
@@ -170,6 +386,60 @@ func (w *ServerInterfaceWrapper) GetApiV1CoursesId(ctx echo.Context) error {
 	return err
 }
 
+// PutApiV1CoursesId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutApiV1CoursesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutApiV1CoursesId(ctx, id)
+	return err
+}
+
+// DeleteApiV1CoursesIdFavorite converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteApiV1CoursesIdFavorite(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteApiV1CoursesIdFavorite(ctx, id)
+	return err
+}
+
+// PostApiV1CoursesIdFavorite converts echo context to params.
+func (w *ServerInterfaceWrapper) PostApiV1CoursesIdFavorite(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostApiV1CoursesIdFavorite(ctx, id)
+	return err
+}
+
 // PostApiV1DateSpotReviews converts echo context to params.
 func (w *ServerInterfaceWrapper) PostApiV1DateSpotReviews(ctx echo.Context) error {
 	var err error
@@ -217,6 +487,24 @@ func (w *ServerInterfaceWrapper) PutApiV1DateSpotReviewsId(ctx echo.Context) err
 	return err
 }
 
+// PostApiV1DateSpotReviewsIdReports converts echo context to params.
+func (w *ServerInterfaceWrapper) PostApiV1DateSpotReviewsIdReports(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostApiV1DateSpotReviewsIdReports(ctx, id)
+	return err
+}
+
 // GetApiV1DateSpots converts echo context to params.
 func (w *ServerInterfaceWrapper) GetApiV1DateSpots(ctx echo.Context) error {
 	var err error
@@ -251,6 +539,48 @@ func (w *ServerInterfaceWrapper) GetApiV1DateSpots(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter come_time: %s", err))
 	}
 
+	// ------------- Optional query parameter "lat" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lat", ctx.QueryParams(), &params.Lat)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lat: %s", err))
+	}
+
+	// ------------- Optional query parameter "lng" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lng", ctx.QueryParams(), &params.Lng)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lng: %s", err))
+	}
+
+	// ------------- Optional query parameter "radius_m" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "radius_m", ctx.QueryParams(), &params.RadiusM)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter radius_m: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetApiV1DateSpots(ctx, params)
 	return err
@@ -319,6 +649,53 @@ func (w *ServerInterfaceWrapper) PutApiV1DateSpotsId(ctx echo.Context) error {
 	return err
 }
 
+// PostApiV1EmailVerification converts echo context to params.
+func (w *ServerInterfaceWrapper) PostApiV1EmailVerification(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostApiV1EmailVerification(ctx)
+	return err
+}
+
+// PostApiV1EmailVerificationConfirm converts echo context to params.
+func (w *ServerInterfaceWrapper) PostApiV1EmailVerificationConfirm(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostApiV1EmailVerificationConfirm(ctx)
+	return err
+}
+
+// GetApiV1Feed converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApiV1Feed(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1FeedParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetApiV1Feed(ctx, params)
+	return err
+}
+
 // GetApiV1GenresId converts echo context to params.
 func (w *ServerInterfaceWrapper) GetApiV1GenresId(ctx echo.Context) error {
 	var err error
@@ -344,6 +721,109 @@ func (w *ServerInterfaceWrapper) PostApiV1Login(ctx echo.Context) error {
 	return err
 }
 
+// GetApiV1LoginHistories converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApiV1LoginHistories(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1LoginHistoriesParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetApiV1LoginHistories(ctx, params)
+	return err
+}
+
+// PostApiV1Logout converts echo context to params.
+func (w *ServerInterfaceWrapper) PostApiV1Logout(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostApiV1Logout(ctx)
+	return err
+}
+
+// GetApiV1Notifications converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApiV1Notifications(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1NotificationsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetApiV1Notifications(ctx, params)
+	return err
+}
+
+// PostApiV1NotificationsRead converts echo context to params.
+func (w *ServerInterfaceWrapper) PostApiV1NotificationsRead(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostApiV1NotificationsRead(ctx)
+	return err
+}
+
+// GetApiV1NotificationsUnreadCount converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApiV1NotificationsUnreadCount(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetApiV1NotificationsUnreadCount(ctx)
+	return err
+}
+
+// PostApiV1PasswordReset converts echo context to params.
+func (w *ServerInterfaceWrapper) PostApiV1PasswordReset(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostApiV1PasswordReset(ctx)
+	return err
+}
+
+// PostApiV1PasswordResetConfirm converts echo context to params.
+func (w *ServerInterfaceWrapper) PostApiV1PasswordResetConfirm(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostApiV1PasswordResetConfirm(ctx)
+	return err
+}
+
 // GetApiV1PrefecturesId converts echo context to params.
 func (w *ServerInterfaceWrapper) GetApiV1PrefecturesId(ctx echo.Context) error {
 	var err error
@@ -406,6 +886,15 @@ func (w *ServerInterfaceWrapper) PostApiV1Signup(ctx echo.Context) error {
 	return err
 }
 
+// PostApiV1TokenRefresh converts echo context to params.
+func (w *ServerInterfaceWrapper) PostApiV1TokenRefresh(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostApiV1TokenRefresh(ctx)
+	return err
+}
+
 // GetApiV1Top converts echo context to params.
 func (w *ServerInterfaceWrapper) GetApiV1Top(ctx echo.Context) error {
 	var err error
@@ -428,6 +917,27 @@ func (w *ServerInterfaceWrapper) GetApiV1Users(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetApiV1Users(ctx, params)
 	return err
@@ -485,6 +995,74 @@ func (w *ServerInterfaceWrapper) PutApiV1UsersId(ctx echo.Context) error {
 	return err
 }
 
+// DeleteApiV1UsersUserIdBlock converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteApiV1UsersUserIdBlock(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteApiV1UsersUserIdBlock(ctx, userId)
+	return err
+}
+
+// PostApiV1UsersUserIdBlock converts echo context to params.
+func (w *ServerInterfaceWrapper) PostApiV1UsersUserIdBlock(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostApiV1UsersUserIdBlock(ctx, userId)
+	return err
+}
+
+// GetApiV1UsersUserIdFavoriteCourses converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApiV1UsersUserIdFavoriteCourses(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1UsersUserIdFavoriteCoursesParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetApiV1UsersUserIdFavoriteCourses(ctx, userId, params)
+	return err
+}
+
 // GetApiV1UsersUserIdFollowers converts echo context to params.
 func (w *ServerInterfaceWrapper) GetApiV1UsersUserIdFollowers(ctx echo.Context) error {
 	var err error
@@ -498,8 +1076,31 @@ func (w *ServerInterfaceWrapper) GetApiV1UsersUserIdFollowers(ctx echo.Context)
 
 	ctx.Set(string(BearerAuthScopes), []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1UsersUserIdFollowersParams
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetApiV1UsersUserIdFollowers(ctx, userId)
+	err = w.Handler.GetApiV1UsersUserIdFollowers(ctx, userId, params)
 	return err
 }
 
@@ -516,8 +1117,67 @@ func (w *ServerInterfaceWrapper) GetApiV1UsersUserIdFollowings(ctx echo.Context)
 
 	ctx.Set(string(BearerAuthScopes), []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1UsersUserIdFollowingsParams
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetApiV1UsersUserIdFollowings(ctx, userId, params)
+	return err
+}
+
+// DeleteApiV1UsersUserIdMute converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteApiV1UsersUserIdMute(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteApiV1UsersUserIdMute(ctx, userId)
+	return err
+}
+
+// PostApiV1UsersUserIdMute converts echo context to params.
+func (w *ServerInterfaceWrapper) PostApiV1UsersUserIdMute(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(string(BearerAuthScopes), []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetApiV1UsersUserIdFollowings(ctx, userId)
+	err = w.Handler.PostApiV1UsersUserIdMute(ctx, userId)
 	return err
 }
 
@@ -569,30 +1229,55 @@ func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options
 	}
 
 	router.GET(options.BaseURL+"/", wrapper.Get, options.OperationMiddlewares["Get"]...)
+	router.GET(options.BaseURL+"/.well-known/jwks.json", wrapper.GetWellKnownJwksJson, options.OperationMiddlewares["GetWellKnownJwksJson"]...)
+	router.GET(options.BaseURL+"/api/v1/admin/date_spot_reviews", wrapper.GetApiV1AdminDateSpotReviews, options.OperationMiddlewares["GetApiV1AdminDateSpotReviews"]...)
+	router.PUT(options.BaseURL+"/api/v1/admin/date_spot_reviews/:id", wrapper.PutApiV1AdminDateSpotReviewsId, options.OperationMiddlewares["PutApiV1AdminDateSpotReviewsId"]...)
+	router.GET(options.BaseURL+"/api/v1/admin/spot_matches", wrapper.GetApiV1AdminSpotMatches, options.OperationMiddlewares["GetApiV1AdminSpotMatches"]...)
+	router.PUT(options.BaseURL+"/api/v1/admin/spot_matches/:id", wrapper.PutApiV1AdminSpotMatchesId, options.OperationMiddlewares["PutApiV1AdminSpotMatchesId"]...)
 	router.GET(options.BaseURL+"/api/v1/courses", wrapper.GetApiV1Courses, options.OperationMiddlewares["GetApiV1Courses"]...)
 	router.POST(options.BaseURL+"/api/v1/courses", wrapper.PostApiV1Courses, options.OperationMiddlewares["PostApiV1Courses"]...)
 	router.DELETE(options.BaseURL+"/api/v1/courses/:id", wrapper.DeleteApiV1CoursesId, options.OperationMiddlewares["DeleteApiV1CoursesId"]...)
 	router.GET(options.BaseURL+"/api/v1/courses/:id", wrapper.GetApiV1CoursesId, options.OperationMiddlewares["GetApiV1CoursesId"]...)
+	router.PUT(options.BaseURL+"/api/v1/courses/:id", wrapper.PutApiV1CoursesId, options.OperationMiddlewares["PutApiV1CoursesId"]...)
+	router.DELETE(options.BaseURL+"/api/v1/courses/:id/favorite", wrapper.DeleteApiV1CoursesIdFavorite, options.OperationMiddlewares["DeleteApiV1CoursesIdFavorite"]...)
+	router.POST(options.BaseURL+"/api/v1/courses/:id/favorite", wrapper.PostApiV1CoursesIdFavorite, options.OperationMiddlewares["PostApiV1CoursesIdFavorite"]...)
 	router.POST(options.BaseURL+"/api/v1/date_spot_reviews", wrapper.PostApiV1DateSpotReviews, options.OperationMiddlewares["PostApiV1DateSpotReviews"]...)
 	router.DELETE(options.BaseURL+"/api/v1/date_spot_reviews/:id", wrapper.DeleteApiV1DateSpotReviewsId, options.OperationMiddlewares["DeleteApiV1DateSpotReviewsId"]...)
 	router.PUT(options.BaseURL+"/api/v1/date_spot_reviews/:id", wrapper.PutApiV1DateSpotReviewsId, options.OperationMiddlewares["PutApiV1DateSpotReviewsId"]...)
+	router.POST(options.BaseURL+"/api/v1/date_spot_reviews/:id/reports", wrapper.PostApiV1DateSpotReviewsIdReports, options.OperationMiddlewares["PostApiV1DateSpotReviewsIdReports"]...)
 	router.GET(options.BaseURL+"/api/v1/date_spots", wrapper.GetApiV1DateSpots, options.OperationMiddlewares["GetApiV1DateSpots"]...)
 	router.POST(options.BaseURL+"/api/v1/date_spots", wrapper.PostApiV1DateSpots, options.OperationMiddlewares["PostApiV1DateSpots"]...)
 	router.DELETE(options.BaseURL+"/api/v1/date_spots/:id", wrapper.DeleteApiV1DateSpotsId, options.OperationMiddlewares["DeleteApiV1DateSpotsId"]...)
 	router.GET(options.BaseURL+"/api/v1/date_spots/:id", wrapper.GetApiV1DateSpotsId, options.OperationMiddlewares["GetApiV1DateSpotsId"]...)
 	router.PUT(options.BaseURL+"/api/v1/date_spots/:id", wrapper.PutApiV1DateSpotsId, options.OperationMiddlewares["PutApiV1DateSpotsId"]...)
+	router.POST(options.BaseURL+"/api/v1/email_verification", wrapper.PostApiV1EmailVerification, options.OperationMiddlewares["PostApiV1EmailVerification"]...)
+	router.POST(options.BaseURL+"/api/v1/email_verification/confirm", wrapper.PostApiV1EmailVerificationConfirm, options.OperationMiddlewares["PostApiV1EmailVerificationConfirm"]...)
+	router.GET(options.BaseURL+"/api/v1/feed", wrapper.GetApiV1Feed, options.OperationMiddlewares["GetApiV1Feed"]...)
 	router.GET(options.BaseURL+"/api/v1/genres/:id", wrapper.GetApiV1GenresId, options.OperationMiddlewares["GetApiV1GenresId"]...)
 	router.POST(options.BaseURL+"/api/v1/login", wrapper.PostApiV1Login, options.OperationMiddlewares["PostApiV1Login"]...)
+	router.GET(options.BaseURL+"/api/v1/login_histories", wrapper.GetApiV1LoginHistories, options.OperationMiddlewares["GetApiV1LoginHistories"]...)
+	router.POST(options.BaseURL+"/api/v1/logout", wrapper.PostApiV1Logout, options.OperationMiddlewares["PostApiV1Logout"]...)
+	router.GET(options.BaseURL+"/api/v1/notifications", wrapper.GetApiV1Notifications, options.OperationMiddlewares["GetApiV1Notifications"]...)
+	router.POST(options.BaseURL+"/api/v1/notifications/read", wrapper.PostApiV1NotificationsRead, options.OperationMiddlewares["PostApiV1NotificationsRead"]...)
+	router.GET(options.BaseURL+"/api/v1/notifications/unread_count", wrapper.GetApiV1NotificationsUnreadCount, options.OperationMiddlewares["GetApiV1NotificationsUnreadCount"]...)
+	router.POST(options.BaseURL+"/api/v1/password_reset", wrapper.PostApiV1PasswordReset, options.OperationMiddlewares["PostApiV1PasswordReset"]...)
+	router.POST(options.BaseURL+"/api/v1/password_reset/confirm", wrapper.PostApiV1PasswordResetConfirm, options.OperationMiddlewares["PostApiV1PasswordResetConfirm"]...)
 	router.GET(options.BaseURL+"/api/v1/prefectures/:id", wrapper.GetApiV1PrefecturesId, options.OperationMiddlewares["GetApiV1PrefecturesId"]...)
 	router.POST(options.BaseURL+"/api/v1/relationships", wrapper.PostApiV1Relationships, options.OperationMiddlewares["PostApiV1Relationships"]...)
 	router.DELETE(options.BaseURL+"/api/v1/relationships/:current_user_id/:other_user_id", wrapper.DeleteApiV1RelationshipsCurrentUserIdOtherUserId, options.OperationMiddlewares["DeleteApiV1RelationshipsCurrentUserIdOtherUserId"]...)
 	router.POST(options.BaseURL+"/api/v1/signup", wrapper.PostApiV1Signup, options.OperationMiddlewares["PostApiV1Signup"]...)
+	router.POST(options.BaseURL+"/api/v1/token/refresh", wrapper.PostApiV1TokenRefresh, options.OperationMiddlewares["PostApiV1TokenRefresh"]...)
 	router.GET(options.BaseURL+"/api/v1/top", wrapper.GetApiV1Top, options.OperationMiddlewares["GetApiV1Top"]...)
 	router.GET(options.BaseURL+"/api/v1/users", wrapper.GetApiV1Users, options.OperationMiddlewares["GetApiV1Users"]...)
 	router.DELETE(options.BaseURL+"/api/v1/users/:id", wrapper.DeleteApiV1UsersId, options.OperationMiddlewares["DeleteApiV1UsersId"]...)
 	router.GET(options.BaseURL+"/api/v1/users/:id", wrapper.GetApiV1UsersId, options.OperationMiddlewares["GetApiV1UsersId"]...)
 	router.PUT(options.BaseURL+"/api/v1/users/:id", wrapper.PutApiV1UsersId, options.OperationMiddlewares["PutApiV1UsersId"]...)
+	router.DELETE(options.BaseURL+"/api/v1/users/:user_id/block", wrapper.DeleteApiV1UsersUserIdBlock, options.OperationMiddlewares["DeleteApiV1UsersUserIdBlock"]...)
+	router.POST(options.BaseURL+"/api/v1/users/:user_id/block", wrapper.PostApiV1UsersUserIdBlock, options.OperationMiddlewares["PostApiV1UsersUserIdBlock"]...)
+	router.GET(options.BaseURL+"/api/v1/users/:user_id/favorite_courses", wrapper.GetApiV1UsersUserIdFavoriteCourses, options.OperationMiddlewares["GetApiV1UsersUserIdFavoriteCourses"]...)
 	router.GET(options.BaseURL+"/api/v1/users/:user_id/followers", wrapper.GetApiV1UsersUserIdFollowers, options.OperationMiddlewares["GetApiV1UsersUserIdFollowers"]...)
 	router.GET(options.BaseURL+"/api/v1/users/:user_id/followings", wrapper.GetApiV1UsersUserIdFollowings, options.OperationMiddlewares["GetApiV1UsersUserIdFollowings"]...)
+	router.DELETE(options.BaseURL+"/api/v1/users/:user_id/mute", wrapper.DeleteApiV1UsersUserIdMute, options.OperationMiddlewares["DeleteApiV1UsersUserIdMute"]...)
+	router.POST(options.BaseURL+"/api/v1/users/:user_id/mute", wrapper.PostApiV1UsersUserIdMute, options.OperationMiddlewares["PostApiV1UsersUserIdMute"]...)
 
 }
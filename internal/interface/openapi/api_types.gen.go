@@ -13,6 +13,13 @@ const (
 	BearerAuthScopes bearerAuthContextKey = "bearerAuth.Scopes"
 )
 
+// Defines values for ActivityDataType.
+const (
+	CourseCreated ActivityDataType = "course_created"
+	ReviewCreated ActivityDataType = "review_created"
+	UserFollowed  ActivityDataType = "user_followed"
+)
+
 // Defines values for CourseFormRequestDataAuthority.
 const (
 	CourseFormRequestDataAuthorityEmpty CourseFormRequestDataAuthority = "公開"
@@ -49,8 +56,17 @@ func (e CourseFormRequestDataTravelMode) Valid() bool {
 	}
 }
 
+// Defines values for DateSpotReviewReportFormRequestDataReason.
+const (
+	Abuse         DateSpotReviewReportFormRequestDataReason = "abuse"
+	Inappropriate DateSpotReviewReportFormRequestDataReason = "inappropriate"
+	Other         DateSpotReviewReportFormRequestDataReason = "other"
+	Spam          DateSpotReviewReportFormRequestDataReason = "spam"
+)
+
 // Defines values for DateSpotSummaryDataSource.
 const (
+	Gemini    DateSpotSummaryDataSource = "gemini"
 	Hotpepper DateSpotSummaryDataSource = "hotpepper"
 	Jalan     DateSpotSummaryDataSource = "jalan"
 	Manual    DateSpotSummaryDataSource = "manual"
@@ -59,6 +75,8 @@ const (
 // Valid indicates whether the value is a known member of the DateSpotSummaryDataSource enum.
 func (e DateSpotSummaryDataSource) Valid() bool {
 	switch e {
+	case Gemini:
+		return true
 	case Hotpepper:
 		return true
 	case Jalan:
@@ -88,17 +106,137 @@ func (e Gender) Valid() bool {
 	}
 }
 
+// Defines values for LoginHistoryDataResult.
+const (
+	Failed    LoginHistoryDataResult = "failed"
+	Locked    LoginHistoryDataResult = "locked"
+	Succeeded LoginHistoryDataResult = "succeeded"
+)
+
+// Defines values for ModerationFormRequestDataStatus.
+const (
+	ModerationFormRequestDataStatusApproved ModerationFormRequestDataStatus = "approved"
+	ModerationFormRequestDataStatusHidden   ModerationFormRequestDataStatus = "hidden"
+)
+
+// Defines values for NotificationDataType.
+const (
+	CourseFavorited    NotificationDataType = "course_favorited"
+	CourseSpotReviewed NotificationDataType = "course_spot_reviewed"
+	Followed           NotificationDataType = "followed"
+)
+
+// Defines values for ReviewStatus.
+const (
+	ReviewStatusApproved  ReviewStatus = "approved"
+	ReviewStatusHidden    ReviewStatus = "hidden"
+	ReviewStatusPending   ReviewStatus = "pending"
+	ReviewStatusPublished ReviewStatus = "published"
+)
+
+// Defines values for SpotMatchDataStatus.
+const (
+	SpotMatchDataStatusLinked  SpotMatchDataStatus = "linked"
+	SpotMatchDataStatusPending SpotMatchDataStatus = "pending"
+)
+
+// Defines values for SpotMatchFormRequestDataDecision.
+const (
+	Merge    SpotMatchFormRequestDataDecision = "merge"
+	Separate SpotMatchFormRequestDataDecision = "separate"
+)
+
+// Defines values for GetApiV1AdminDateSpotReviewsParamsSort.
+const (
+	GetApiV1AdminDateSpotReviewsParamsSortNewest GetApiV1AdminDateSpotReviewsParamsSort = "newest"
+	GetApiV1AdminDateSpotReviewsParamsSortOldest GetApiV1AdminDateSpotReviewsParamsSort = "oldest"
+)
+
+// Defines values for GetApiV1AdminSpotMatchesParamsSort.
+const (
+	GetApiV1AdminSpotMatchesParamsSortNewest GetApiV1AdminSpotMatchesParamsSort = "newest"
+	GetApiV1AdminSpotMatchesParamsSortOldest GetApiV1AdminSpotMatchesParamsSort = "oldest"
+)
+
+// Defines values for GetApiV1CoursesParamsSort.
+const (
+	GetApiV1CoursesParamsSortName        GetApiV1CoursesParamsSort = "name"
+	GetApiV1CoursesParamsSortNewest      GetApiV1CoursesParamsSort = "newest"
+	GetApiV1CoursesParamsSortRating      GetApiV1CoursesParamsSort = "rating"
+	GetApiV1CoursesParamsSortReviewCount GetApiV1CoursesParamsSort = "review_count"
+)
+
+// Defines values for GetApiV1DateSpotsParamsSort.
+const (
+	GetApiV1DateSpotsParamsSortDistance    GetApiV1DateSpotsParamsSort = "distance"
+	GetApiV1DateSpotsParamsSortName        GetApiV1DateSpotsParamsSort = "name"
+	GetApiV1DateSpotsParamsSortNewest      GetApiV1DateSpotsParamsSort = "newest"
+	GetApiV1DateSpotsParamsSortRating      GetApiV1DateSpotsParamsSort = "rating"
+	GetApiV1DateSpotsParamsSortRelevance   GetApiV1DateSpotsParamsSort = "relevance"
+	GetApiV1DateSpotsParamsSortReviewCount GetApiV1DateSpotsParamsSort = "review_count"
+)
+
+// Defines values for GetApiV1UsersParamsSort.
+const (
+	GetApiV1UsersParamsSortName   GetApiV1UsersParamsSort = "name"
+	GetApiV1UsersParamsSortNewest GetApiV1UsersParamsSort = "newest"
+)
+
+// Defines values for GetApiV1UsersUserIdFollowersParamsSort.
+const (
+	GetApiV1UsersUserIdFollowersParamsSortName   GetApiV1UsersUserIdFollowersParamsSort = "name"
+	GetApiV1UsersUserIdFollowersParamsSortNewest GetApiV1UsersUserIdFollowersParamsSort = "newest"
+)
+
+// Defines values for GetApiV1UsersUserIdFollowingsParamsSort.
+const (
+	GetApiV1UsersUserIdFollowingsParamsSortName   GetApiV1UsersUserIdFollowingsParamsSort = "name"
+	GetApiV1UsersUserIdFollowingsParamsSortNewest GetApiV1UsersUserIdFollowingsParamsSort = "newest"
+)
+
+// ActivityData defines model for ActivityData.
+type ActivityData struct {
+	Actor          UserData            `json:"actor"`
+	Course         *CourseResponseData `json:"course,omitempty"`
+	CreatedAt      time.Time           `json:"created_at"`
+	DateSpotReview *DateSpotReviewData `json:"date_spot_review,omitempty"`
+	FollowedUser   *UserData           `json:"followed_user,omitempty"`
+
+	// Type course_created は公開コースの作成、review_created はレビューの投稿、user_followed は他のユーザーのフォローを表す
+	Type ActivityDataType `json:"type"`
+}
+
+// ActivityDataType course_created は公開コースの作成、review_created はレビューの投稿、user_followed は他のユーザーのフォローを表す
+type ActivityDataType string
+
 // AreaData defines model for AreaData.
 type AreaData struct {
 	Id   int    `json:"id"`
 	Name string `json:"name"`
 }
 
+// CourseFavoriteResponseData defines model for CourseFavoriteResponseData.
+type CourseFavoriteResponseData struct {
+	CourseId      int  `json:"course_id"`
+	FavoriteCount int  `json:"favorite_count"`
+	FavoritedByMe bool `json:"favorited_by_me"`
+}
+
 // CourseFormRequestData defines model for CourseFormRequestData.
 type CourseFormRequestData struct {
-	Authority  CourseFormRequestDataAuthority  `json:"authority"`
-	DateSpots  []int                           `json:"date_spots"`
-	TravelMode CourseFormRequestDataTravelMode `json:"travel_mode"`
+	// ArrivalTimes date_spots と同じ添字の立ち寄り先の到着予定時刻（HH:MM）。未設定は空文字
+	ArrivalTimes *[]string                      `json:"arrival_times,omitempty"`
+	Authority    CourseFormRequestDataAuthority `json:"authority"`
+
+	// DateSpots 立ち寄り先のデートスポットID。並び順がそのまま訪問順になる
+	DateSpots []int `json:"date_spots"`
+
+	// Memos date_spots と同じ添字の立ち寄り先のメモ（500文字以内）
+	Memos *[]string `json:"memos,omitempty"`
+
+	// StayMinutes date_spots と同じ添字の立ち寄り先の滞在予定時間（分）
+	StayMinutes *[]int                          `json:"stay_minutes,omitempty"`
+	TravelMode  CourseFormRequestDataTravelMode `json:"travel_mode"`
 }
 
 // CourseFormRequestDataAuthority defines model for CourseFormRequestData.Authority.
@@ -112,14 +250,47 @@ type CourseFormResponseData struct {
 	CourseId int `json:"course_id"`
 }
 
+// CourseListResponseData defines model for CourseListResponseData.
+type CourseListResponseData struct {
+	Courses    []CourseResponseData `json:"courses"`
+	Pagination PaginationData       `json:"pagination"`
+}
+
 // CourseResponseData defines model for CourseResponseData.
 type CourseResponseData struct {
-	Authority                  string                `json:"authority"`
-	DateSpots                  []DateSpotSummaryData `json:"date_spots"`
-	Id                         int                   `json:"id"`
+	Authority   string                `json:"authority"`
+	DateSpots   []DateSpotSummaryData `json:"date_spots"`
+	DuringSpots []DuringSpotData      `json:"during_spots"`
+
+	// FavoriteCount お気に入りに入れたユーザーの数。コース取得・一覧・お気に入り一覧・ユーザー詳細のレスポンスでのみ返す
+	FavoriteCount *int `json:"favorite_count,omitempty"`
+
+	// FavoritedByMe ログイン中のユーザーがお気に入りに入れているか。未ログインのときは false。favorite_count と同じレスポンスでのみ返す
+	FavoritedByMe *bool `json:"favorited_by_me,omitempty"`
+	Id            int   `json:"id"`
+
+	// Legs 訪問順で隣り合う立ち寄り先の区間ごとの移動距離・時間
+	Legs                       *[]CourseRouteLegData `json:"legs,omitempty"`
 	NoDuplicatePrefectureNames []string              `json:"no_duplicate_prefecture_names"`
-	TravelMode                 string                `json:"travel_mode"`
-	User                       UserData              `json:"user"`
+
+	// TotalDistanceMeters 立ち寄り先間の移動距離の合計（メートル）。コース取得・一覧・編集のレスポンスでのみ返す
+	TotalDistanceMeters *int `json:"total_distance_meters,omitempty"`
+
+	// TotalDurationSeconds 立ち寄り先間の移動時間の合計（秒）。コース取得・一覧・編集のレスポンスでのみ返す
+	TotalDurationSeconds *int     `json:"total_duration_seconds,omitempty"`
+	TravelMode           string   `json:"travel_mode"`
+	User                 UserData `json:"user"`
+}
+
+// CourseRouteLegData defines model for CourseRouteLegData.
+type CourseRouteLegData struct {
+	// DistanceMeters 緯度経度が未登録のスポットを含む区間は null
+	DistanceMeters *int `json:"distance_meters"`
+
+	// DurationSeconds 緯度経度が未登録のスポットを含む区間は null
+	DurationSeconds *int `json:"duration_seconds"`
+	FromDateSpotId  int  `json:"from_date_spot_id"`
+	ToDateSpotId    int  `json:"to_date_spot_id"`
 }
 
 // DateSpotData defines model for DateSpotData.
@@ -135,11 +306,13 @@ type DateSpotData struct {
 
 // DateSpotFormRequestData defines model for DateSpotFormRequestData.
 type DateSpotFormRequestData struct {
-	CityName     string     `json:"city_name"`
-	GenreId      int        `json:"genre_id"`
-	Image        ImageData1 `json:"image"`
-	Name         string     `json:"name"`
-	PrefectureId int        `json:"prefecture_id"`
+	CityName string `json:"city_name"`
+	GenreId  int    `json:"genre_id"`
+
+	// Image スポットの画像ファイル（JPEG・PNG・GIF、5MB まで）。更新時に省略すると既存の画像を残す
+	Image        *openapi_types.File `json:"image,omitempty"`
+	Name         string              `json:"name"`
+	PrefectureId int                 `json:"prefecture_id"`
 }
 
 // DateSpotFormResponseData defines model for DateSpotFormResponseData.
@@ -147,6 +320,12 @@ type DateSpotFormResponseData struct {
 	DateSpotId int `json:"date_spot_id"`
 }
 
+// DateSpotListResponseData defines model for DateSpotListResponseData.
+type DateSpotListResponseData struct {
+	DateSpots  []DateSpotSummaryData `json:"date_spots"`
+	Pagination PaginationData        `json:"pagination"`
+}
+
 // DateSpotReviewData defines model for DateSpotReviewData.
 type DateSpotReviewData struct {
 	Content  string       `json:"content"`
@@ -162,10 +341,31 @@ type DateSpotReviewFormRequestData struct {
 	Rate       float32 `json:"rate"`
 }
 
+// DateSpotReviewReportFormRequestData defines model for DateSpotReviewReportFormRequestData.
+type DateSpotReviewReportFormRequestData struct {
+	// Comment 通報の補足（500文字以内）
+	Comment *string                                   `json:"comment,omitempty"`
+	Reason  DateSpotReviewReportFormRequestDataReason `json:"reason"`
+}
+
+// DateSpotReviewReportFormRequestDataReason defines model for DateSpotReviewReportFormRequestData.Reason.
+type DateSpotReviewReportFormRequestDataReason string
+
+// DateSpotReviewReportResponseData defines model for DateSpotReviewReportResponseData.
+type DateSpotReviewReportResponseData struct {
+	CreatedAt        time.Time `json:"created_at"`
+	DateSpotReviewId int       `json:"date_spot_review_id"`
+	Id               int       `json:"id"`
+	Reason           string    `json:"reason"`
+}
+
 // DateSpotReviewResponseData defines model for DateSpotReviewResponseData.
 type DateSpotReviewResponseData struct {
 	DateSpotReviews   []DateSpotShowResponseDataDateSpotReviewsInner `json:"date_spot_reviews"`
 	ReviewAverageRate float32                                        `json:"review_average_rate"`
+
+	// Status レビューの公開状態。published・approved だけを公開し、評価の平均や件数に数える
+	Status *ReviewStatus `json:"status,omitempty"`
 }
 
 // DateSpotShowResponseData defines model for DateSpotShowResponseData.
@@ -189,9 +389,12 @@ type DateSpotShowResponseDataDateSpotReviewsInner struct {
 
 // DateSpotSummaryData defines model for DateSpotSummaryData.
 type DateSpotSummaryData struct {
-	AverageRate       float32                    `json:"average_rate"`
-	CityName          string                     `json:"city_name"`
-	DateSpot          DateSpotData               `json:"date_spot"`
+	AverageRate float32      `json:"average_rate"`
+	CityName    string       `json:"city_name"`
+	DateSpot    DateSpotData `json:"date_spot"`
+
+	// DistanceMeters 現在地から検索したときの中心からの直線距離（メートル）。それ以外の検索では返さない
+	DistanceMeters    *int                       `json:"distance_meters,omitempty"`
 	GenreName         string                     `json:"genre_name"`
 	Id                int                        `json:"id"`
 	Latitude          float32                    `json:"latitude"`
@@ -205,12 +408,31 @@ type DateSpotSummaryData struct {
 // DateSpotSummaryDataSource defines model for DateSpotSummaryData.Source.
 type DateSpotSummaryDataSource string
 
+// DuringSpotData defines model for DuringSpotData.
+type DuringSpotData struct {
+	ArrivalTime *string `json:"arrival_time"`
+	DateSpotId  int     `json:"date_spot_id"`
+	Id          int     `json:"id"`
+	Memo        *string `json:"memo"`
+
+	// Position コース内の訪問順（1始まり）
+	Position    int  `json:"position"`
+	StayMinutes *int `json:"stay_minutes"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	// ErrorMessages エラーメッセージの配列
 	ErrorMessages []string `json:"errorMessages"`
 }
 
+// FeedResponseData defines model for FeedResponseData.
+type FeedResponseData struct {
+	// Activities type に応じて course・date_spot_review・followed_user のいずれか1つだけを含む
+	Activities []ActivityData `json:"activities"`
+	Pagination PaginationData `json:"pagination"`
+}
+
 // FollowReauestData defines model for FollowReauestData.
 type FollowReauestData struct {
 	FollowedUserId int `json:"followed_user_id"`
@@ -234,19 +456,150 @@ type GenreData struct {
 
 // ImageData defines model for ImageData.
 type ImageData struct {
-	Url *string `json:"url"`
+	// ThumbnailUrl 一覧表示向けに縮小した画像の URL。画像をアップロードする前に登録されたものは null
+	ThumbnailUrl *string `json:"thumbnail_url"`
+	Url          *string `json:"url"`
+}
+
+// JWKData RFC 7517 の JSON Web Key。RSA 鍵は n・e、楕円曲線鍵は crv・x・y を持つ
+type JWKData struct {
+	Alg string  `json:"alg"`
+	Crv *string `json:"crv,omitempty"`
+	E   *string `json:"e,omitempty"`
+	Kid string  `json:"kid"`
+	Kty string  `json:"kty"`
+	N   *string `json:"n,omitempty"`
+	Use string  `json:"use"`
+	X   *string `json:"x,omitempty"`
+	Y   *string `json:"y,omitempty"`
 }
 
-// ImageData1 defines model for ImageData_1.
-type ImageData1 struct {
-	Url *openapi_types.File `json:"url"`
+// JWKSResponseData defines model for JWKSResponseData.
+type JWKSResponseData struct {
+	Keys []JWKData `json:"keys"`
+}
+
+// LoginHistoryData defines model for LoginHistoryData.
+type LoginHistoryData struct {
+	CreatedAt time.Time `json:"created_at"`
+	Id        int       `json:"id"`
+	IpAddress string    `json:"ip_address"`
+
+	// Result succeeded は成功、failed はパスワードの誤り、locked はロック中のため拒否したことを表す
+	Result    LoginHistoryDataResult `json:"result"`
+	UserAgent string                 `json:"user_agent"`
+}
+
+// LoginHistoryDataResult succeeded は成功、failed はパスワードの誤り、locked はロック中のため拒否したことを表す
+type LoginHistoryDataResult string
+
+// LoginHistoryListResponseData defines model for LoginHistoryListResponseData.
+type LoginHistoryListResponseData struct {
+	LoginHistories []LoginHistoryData `json:"login_histories"`
+	Pagination     PaginationData     `json:"pagination"`
 }
 
 // LoginResponseData defines model for LoginResponseData.
 type LoginResponseData struct {
-	LoginStatus bool     `json:"login_status"`
-	Token       string   `json:"token"`
-	User        UserData `json:"user"`
+	LoginStatus  bool     `json:"login_status"`
+	RefreshToken string   `json:"refresh_token"`
+	Token        string   `json:"token"`
+	User         UserData `json:"user"`
+}
+
+// LogoutRequestData defines model for LogoutRequestData.
+type LogoutRequestData struct {
+	// All true のときはすべての端末のセッションを無効にし、発行済みのアクセストークンも使えなくする
+	All          *bool  `json:"all,omitempty"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// MarkNotificationsReadRequestData defines model for MarkNotificationsReadRequestData.
+type MarkNotificationsReadRequestData struct {
+	// NotificationIds 既読にする通知の ID。省略または空のときは未読の通知をすべて既読にする
+	NotificationIds *[]int `json:"notification_ids,omitempty"`
+}
+
+// ModerationFormRequestData defines model for ModerationFormRequestData.
+type ModerationFormRequestData struct {
+	// Status approved は公開を認め、hidden は非公開にする。どちらも未対応の通報を対応済みにする
+	Status ModerationFormRequestDataStatus `json:"status"`
+}
+
+// ModerationFormRequestDataStatus approved は公開を認め、hidden は非公開にする。どちらも未対応の通報を対応済みにする
+type ModerationFormRequestDataStatus string
+
+// ModerationReportData defines model for ModerationReportData.
+type ModerationReportData struct {
+	Comment   *string   `json:"comment"`
+	CreatedAt time.Time `json:"created_at"`
+	Id        int       `json:"id"`
+	Reason    string    `json:"reason"`
+	UserId    int       `json:"user_id"`
+}
+
+// ModerationReviewData defines model for ModerationReviewData.
+type ModerationReviewData struct {
+	Content      *string   `json:"content"`
+	CreatedAt    time.Time `json:"created_at"`
+	DateSpotId   int       `json:"date_spot_id"`
+	DateSpotName string    `json:"date_spot_name"`
+	Id           int       `json:"id"`
+	Rate         *float32  `json:"rate"`
+
+	// Reports 未対応の通報
+	Reports []ModerationReportData `json:"reports"`
+
+	// Status レビューの公開状態。published・approved だけを公開し、評価の平均や件数に数える
+	Status   ReviewStatus `json:"status"`
+	UserId   int          `json:"user_id"`
+	UserName string       `json:"user_name"`
+}
+
+// ModerationReviewListResponseData defines model for ModerationReviewListResponseData.
+type ModerationReviewListResponseData struct {
+	DateSpotReviews []ModerationReviewData `json:"date_spot_reviews"`
+	Pagination      PaginationData         `json:"pagination"`
+}
+
+// NotificationData defines model for NotificationData.
+type NotificationData struct {
+	Actor UserData `json:"actor"`
+
+	// CourseId type が course_favorited のときだけ返す。コースが削除された後も通知は残る
+	CourseId  *int      `json:"course_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// DateSpotId type が course_spot_reviewed のときだけ返す
+	DateSpotId *int `json:"date_spot_id,omitempty"`
+
+	// DateSpotReviewId type が course_spot_reviewed のときだけ返す
+	DateSpotReviewId *int `json:"date_spot_review_id,omitempty"`
+	Id               int  `json:"id"`
+	Read             bool `json:"read"`
+
+	// Type followed はフォローされた、course_favorited は自分のコースがお気に入りに入れられた、course_spot_reviewed は自分のコースのスポットにレビューが付いたことを表す
+	Type NotificationDataType `json:"type"`
+}
+
+// NotificationDataType followed はフォローされた、course_favorited は自分のコースがお気に入りに入れられた、course_spot_reviewed は自分のコースのスポットにレビューが付いたことを表す
+type NotificationDataType string
+
+// NotificationListResponseData defines model for NotificationListResponseData.
+type NotificationListResponseData struct {
+	Notifications []NotificationData `json:"notifications"`
+	Pagination    PaginationData     `json:"pagination"`
+}
+
+// PaginationData defines model for PaginationData.
+type PaginationData struct {
+	// NextCursor 次のページを取得するときに cursor に渡す値。最後のページでは null
+	NextCursor *string `json:"next_cursor"`
+}
+
+// PasswordResetRequestData defines model for PasswordResetRequestData.
+type PasswordResetRequestData struct {
+	Email string `json:"email"`
 }
 
 // PrefectureData defines model for PrefectureData.
@@ -256,35 +609,102 @@ type PrefectureData struct {
 	Name   string `json:"name"`
 }
 
+// RefreshTokenRequestData defines model for RefreshTokenRequestData.
+type RefreshTokenRequestData struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
 // RelationShipResponsData defines model for RelationShipResponsData.
 type RelationShipResponsData struct {
-	UserName string             `json:"user_name"`
-	Users    []UserResponseData `json:"users"`
+	Pagination PaginationData     `json:"pagination"`
+	UserName   string             `json:"user_name"`
+	Users      []UserResponseData `json:"users"`
+}
+
+// ResetPasswordRequestData defines model for ResetPasswordRequestData.
+type ResetPasswordRequestData struct {
+	Password             string `json:"password"`
+	PasswordConfirmation string `json:"password_confirmation"`
+	Token                string `json:"token"`
 }
 
+// ReviewStatus レビューの公開状態。published・approved だけを公開し、評価の平均や件数に数える
+type ReviewStatus string
+
 // SignUpResponseData defines model for SignUpResponseData.
 type SignUpResponseData struct {
-	LoginStatus bool             `json:"login_status"`
-	Token       string           `json:"token"`
-	User        UserResponseData `json:"user"`
+	LoginStatus  bool             `json:"login_status"`
+	RefreshToken string           `json:"refresh_token"`
+	Token        string           `json:"token"`
+	User         UserResponseData `json:"user"`
 }
 
-// SigninFormRequestData defines model for SigninFormRequestData.
+// SigninFormRequestData name と email はどちらか一方を指定する。両方あるときは email を優先する
 type SigninFormRequestData struct {
-	Name     string `json:"name"`
-	Password string `json:"password"`
+	Email    *string `json:"email,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	Password string  `json:"password"`
 }
 
 // SignupFormRequestData defines model for SignupFormRequestData.
 type SignupFormRequestData struct {
-	Email                string              `json:"email"`
-	Gender               Gender              `json:"gender"`
+	Email  string `json:"email"`
+	Gender Gender `json:"gender"`
+
+	// Image プロフィール画像ファイル（JPEG・PNG・GIF、5MB まで）
 	Image                *openapi_types.File `json:"image,omitempty"`
 	Name                 string              `json:"name"`
 	Password             string              `json:"password"`
 	PasswordConfirmation string              `json:"password_confirmation"`
 }
 
+// SpotMatchData 外部の取得元から取り込んだスポット1件分と、照合相手のスポット
+type SpotMatchData struct {
+	CityName  string              `json:"city_name"`
+	CreatedAt time.Time           `json:"created_at"`
+	DateSpot  DateSpotSummaryData `json:"date_spot"`
+
+	// ExternalId 取得元でのスポットの ID。ID を持たない取得元では null
+	ExternalId *string  `json:"external_id"`
+	Id         int      `json:"id"`
+	Image      *string  `json:"image"`
+	Latitude   *float32 `json:"latitude"`
+	Longitude  *float32 `json:"longitude"`
+
+	// MatchScore 照合相手のスポットとの一致度（0〜1）
+	MatchScore float32 `json:"match_score"`
+	Name       string  `json:"name"`
+	PageUrl    *string `json:"page_url"`
+	Source     string  `json:"source"`
+
+	// Status pending は確認待ち、linked は date_spot に統合済み
+	Status SpotMatchDataStatus `json:"status"`
+}
+
+// SpotMatchDataStatus pending は確認待ち、linked は date_spot に統合済み
+type SpotMatchDataStatus string
+
+// SpotMatchFormRequestData defines model for SpotMatchFormRequestData.
+type SpotMatchFormRequestData struct {
+	// Decision merge は照合相手のスポットに統合し、空いている属性を埋める。separate は別の場所として新しいスポットを作る
+	Decision SpotMatchFormRequestDataDecision `json:"decision"`
+}
+
+// SpotMatchFormRequestDataDecision merge は照合相手のスポットに統合し、空いている属性を埋める。separate は別の場所として新しいスポットを作る
+type SpotMatchFormRequestDataDecision string
+
+// SpotMatchListResponseData defines model for SpotMatchListResponseData.
+type SpotMatchListResponseData struct {
+	Pagination  PaginationData  `json:"pagination"`
+	SpotMatches []SpotMatchData `json:"spot_matches"`
+}
+
+// TokenResponseData defines model for TokenResponseData.
+type TokenResponseData struct {
+	RefreshToken string `json:"refresh_token"`
+	Token        string `json:"token"`
+}
+
 // TopResponseData defines model for TopResponseData.
 type TopResponseData struct {
 	Areas           []AreaData            `json:"areas"`
@@ -301,38 +721,68 @@ type UnFollowResponseData struct {
 	Users          []UserResponseData `json:"users"`
 }
 
+// UnreadNotificationCountResponseData defines model for UnreadNotificationCountResponseData.
+type UnreadNotificationCountResponseData struct {
+	UnreadCount int `json:"unread_count"`
+}
+
 // UserData defines model for UserData.
 type UserData struct {
-	Admin  bool                 `json:"admin"`
-	Email  *openapi_types.Email `json:"email,omitempty"`
-	Gender Gender               `json:"gender"`
-	Id     int                  `json:"id"`
-	Image  ImageData            `json:"image"`
-	Name   string               `json:"name"`
+	Admin bool                 `json:"admin"`
+	Email *openapi_types.Email `json:"email,omitempty"`
+
+	// EmailVerified メールアドレスを確認済みか。email と同じく本人向けのレスポンスにだけ含める
+	EmailVerified *bool     `json:"email_verified,omitempty"`
+	Gender        Gender    `json:"gender"`
+	Id            int       `json:"id"`
+	Image         ImageData `json:"image"`
+	Name          string    `json:"name"`
 }
 
 // UserFormRequestData defines model for UserFormRequestData.
 type UserFormRequestData struct {
-	Email                openapi_types.Email `json:"email"`
-	Gender               Gender              `json:"gender"`
-	Id                   string              `json:"id"`
+	Email  openapi_types.Email `json:"email"`
+	Gender Gender              `json:"gender"`
+	Id     string              `json:"id"`
+
+	// Image プロフィール画像ファイル（JPEG・PNG・GIF、5MB まで）。更新時に省略すると既存の画像を残す
 	Image                *openapi_types.File `json:"image,omitempty"`
 	Name                 string              `json:"name"`
 	Password             string              `json:"password"`
 	PasswordConfirmation string              `json:"password_confirmation"`
 }
 
+// UserListResponseData defines model for UserListResponseData.
+type UserListResponseData struct {
+	Pagination PaginationData     `json:"pagination"`
+	Users      []UserResponseData `json:"users"`
+}
+
 // UserResponseData defines model for UserResponseData.
 type UserResponseData struct {
 	Admin           bool                 `json:"admin"`
 	Courses         []CourseResponseData `json:"courses"`
 	DateSpotReviews []DateSpotReviewData `json:"date_spot_reviews"`
-	FollowerIds     []int                `json:"followerIds"`
-	FollowingIds    []int                `json:"followingIds"`
-	Gender          Gender               `json:"gender"`
-	Id              int                  `json:"id"`
-	Image           ImageData            `json:"image"`
-	Name            string               `json:"name"`
+
+	// FavoriteCourses お気に入りに入れた公開コースの先頭ページ。ユーザー詳細のレスポンスでのみ返す。続きは GET /api/v1/users/{user_id}/favorite_courses で取得する
+	FavoriteCourses *[]CourseResponseData `json:"favorite_courses,omitempty"`
+	FollowerIds     []int                 `json:"followerIds"`
+	FollowingIds    []int                 `json:"followingIds"`
+	Gender          Gender                `json:"gender"`
+	Id              int                   `json:"id"`
+	Image           ImageData             `json:"image"`
+	Name            string                `json:"name"`
+
+	// RefreshToken token と一緒に発行し直したリフレッシュトークン
+	RefreshToken *string `json:"refresh_token,omitempty"`
+
+	// Token パスワードを変えたときに発行し直したアクセストークン。プロフィール更新のレスポンスでのみ返す。他の端末のセッションはすべて無効になる
+	Token *string `json:"token,omitempty"`
+}
+
+// VerifyEmailRequestData defines model for VerifyEmailRequestData.
+type VerifyEmailRequestData struct {
+	Token string `json:"token"`
 }
 
 // WelcomeResponseData defines model for WelcomeResponseData.
@@ -348,47 +798,223 @@ type UnderscoreApiV1GenresIdGet200Response struct {
 // bearerAuthContextKey is the context key for bearerAuth security scheme
 type bearerAuthContextKey string
 
+// GetApiV1AdminDateSpotReviewsParams defines parameters for GetApiV1AdminDateSpotReviews.
+type GetApiV1AdminDateSpotReviewsParams struct {
+	Status *ReviewStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Sort 並び順。oldest（投稿の古い順、既定）か newest
+	Sort *GetApiV1AdminDateSpotReviewsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit 1ページの件数（1〜100、省略時は20）
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスで返した pagination.next_cursor。省略時は先頭から返す
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetApiV1AdminDateSpotReviewsParamsSort defines parameters for GetApiV1AdminDateSpotReviews.
+type GetApiV1AdminDateSpotReviewsParamsSort string
+
+// GetApiV1AdminSpotMatchesParams defines parameters for GetApiV1AdminSpotMatches.
+type GetApiV1AdminSpotMatchesParams struct {
+	// Sort 並び順。oldest（取り込みの古い順、既定）か newest
+	Sort *GetApiV1AdminSpotMatchesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit 1ページの件数（1〜100、省略時は20）
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスで返した pagination.next_cursor。省略時は先頭から返す
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetApiV1AdminSpotMatchesParamsSort defines parameters for GetApiV1AdminSpotMatches.
+type GetApiV1AdminSpotMatchesParamsSort string
+
 // GetApiV1CoursesParams defines parameters for GetApiV1Courses.
 type GetApiV1CoursesParams struct {
 	PrefectureId *int `form:"prefecture_id,omitempty" json:"prefecture_id,omitempty"`
+
+	// Sort 並び順。省略時は newest。rating・review_count は立ち寄り先の公開中レビューの平均評価・件数、name は作成者の名前で並べる
+	Sort *GetApiV1CoursesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit 1ページの件数（1〜100、省略時は20）
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスで返した pagination.next_cursor。省略時は先頭から返す
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
+// GetApiV1CoursesParamsSort defines parameters for GetApiV1Courses.
+type GetApiV1CoursesParamsSort string
+
 // GetApiV1DateSpotsParams defines parameters for GetApiV1DateSpots.
 type GetApiV1DateSpotsParams struct {
+	// DateSpotName キーワード。スポット名・ジャンル名・市区町村から、全角半角・ひらがなカタカナの違いを無視して探す。空白で区切るとすべてを含むスポットに絞る
 	DateSpotName *string `form:"date_spot_name,omitempty" json:"date_spot_name,omitempty"`
 	PrefectureId *int    `form:"prefecture_id,omitempty" json:"prefecture_id,omitempty"`
 	GenreId      *int    `form:"genre_id,omitempty" json:"genre_id,omitempty"`
-	ComeTime     *string `form:"come_time,omitempty" json:"come_time,omitempty"`
+
+	// ComeTime 来店日時（例: 2026-01-02T18:30）。その日時に営業しているスポットだけを返す。オフセットがなければ日本時間として扱う。以前の時刻だけの形式（例: 18:30）は日本時間の今日のその時刻として扱う
+	ComeTime *string `form:"come_time,omitempty" json:"come_time,omitempty"`
+
+	// Lat 現在地の緯度。lng・radius_m と併せて指定すると半径内のスポットを近い順に返す
+	Lat *float64 `form:"lat,omitempty" json:"lat,omitempty"`
+
+	// Lng 現在地の経度
+	Lng *float64 `form:"lng,omitempty" json:"lng,omitempty"`
+
+	// RadiusM 検索半径（メートル、1〜50000）
+	RadiusM *int `form:"radius_m,omitempty" json:"radius_m,omitempty"`
+
+	// Sort 並び順。省略時は newest、キーワードで検索するときは relevance、現在地から検索するときは distance。distance は現在地から、relevance はキーワードで検索するときだけ指定できる
+	Sort *GetApiV1DateSpotsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit 1ページの件数（1〜100、省略時は20）
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスで返した pagination.next_cursor。省略時は先頭から返す
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetApiV1DateSpotsParamsSort defines parameters for GetApiV1DateSpots.
+type GetApiV1DateSpotsParamsSort string
+
+// GetApiV1FeedParams defines parameters for GetApiV1Feed.
+type GetApiV1FeedParams struct {
+	// Limit 1ページの件数（1〜100、省略時は20）
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスで返した pagination.next_cursor。省略時は先頭から返す
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetApiV1LoginHistoriesParams defines parameters for GetApiV1LoginHistories.
+type GetApiV1LoginHistoriesParams struct {
+	// Limit 1ページの件数（1〜100、省略時は20）
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスで返した pagination.next_cursor。省略時は先頭から返す
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetApiV1NotificationsParams defines parameters for GetApiV1Notifications.
+type GetApiV1NotificationsParams struct {
+	// Limit 1ページの件数（1〜100、省略時は20）
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスで返した pagination.next_cursor。省略時は先頭から返す
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
 // GetApiV1UsersParams defines parameters for GetApiV1Users.
 type GetApiV1UsersParams struct {
 	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// Sort 並び順。省略時は newest（登録日時の新しい順）
+	Sort *GetApiV1UsersParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit 1ページの件数（1〜100、省略時は20）
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスで返した pagination.next_cursor。省略時は先頭から返す
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
+// GetApiV1UsersParamsSort defines parameters for GetApiV1Users.
+type GetApiV1UsersParamsSort string
+
+// GetApiV1UsersUserIdFavoriteCoursesParams defines parameters for GetApiV1UsersUserIdFavoriteCourses.
+type GetApiV1UsersUserIdFavoriteCoursesParams struct {
+	// Limit 1ページの件数（1〜100、省略時は20）
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスで返した pagination.next_cursor。省略時は先頭から返す
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetApiV1UsersUserIdFollowersParams defines parameters for GetApiV1UsersUserIdFollowers.
+type GetApiV1UsersUserIdFollowersParams struct {
+	// Sort 並び順。省略時は newest（フォローした日時の新しい順）
+	Sort *GetApiV1UsersUserIdFollowersParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit 1ページの件数（1〜100、省略時は20）
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスで返した pagination.next_cursor。省略時は先頭から返す
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetApiV1UsersUserIdFollowersParamsSort defines parameters for GetApiV1UsersUserIdFollowers.
+type GetApiV1UsersUserIdFollowersParamsSort string
+
+// GetApiV1UsersUserIdFollowingsParams defines parameters for GetApiV1UsersUserIdFollowings.
+type GetApiV1UsersUserIdFollowingsParams struct {
+	// Sort 並び順。省略時は newest（フォローした日時の新しい順）
+	Sort *GetApiV1UsersUserIdFollowingsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit 1ページの件数（1〜100、省略時は20）
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスで返した pagination.next_cursor。省略時は先頭から返す
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetApiV1UsersUserIdFollowingsParamsSort defines parameters for GetApiV1UsersUserIdFollowings.
+type GetApiV1UsersUserIdFollowingsParamsSort string
+
+// PutApiV1AdminDateSpotReviewsIdFormdataRequestBody defines body for PutApiV1AdminDateSpotReviewsId for application/x-www-form-urlencoded ContentType.
+type PutApiV1AdminDateSpotReviewsIdFormdataRequestBody = ModerationFormRequestData
+
+// PutApiV1AdminSpotMatchesIdFormdataRequestBody defines body for PutApiV1AdminSpotMatchesId for application/x-www-form-urlencoded ContentType.
+type PutApiV1AdminSpotMatchesIdFormdataRequestBody = SpotMatchFormRequestData
+
 // PostApiV1CoursesFormdataRequestBody defines body for PostApiV1Courses for application/x-www-form-urlencoded ContentType.
 type PostApiV1CoursesFormdataRequestBody = CourseFormRequestData
 
+// PutApiV1CoursesIdFormdataRequestBody defines body for PutApiV1CoursesId for application/x-www-form-urlencoded ContentType.
+type PutApiV1CoursesIdFormdataRequestBody = CourseFormRequestData
+
 // PostApiV1DateSpotReviewsMultipartRequestBody defines body for PostApiV1DateSpotReviews for multipart/form-data ContentType.
 type PostApiV1DateSpotReviewsMultipartRequestBody = DateSpotReviewFormRequestData
 
 // PutApiV1DateSpotReviewsIdMultipartRequestBody defines body for PutApiV1DateSpotReviewsId for multipart/form-data ContentType.
 type PutApiV1DateSpotReviewsIdMultipartRequestBody = DateSpotReviewFormRequestData
 
+// PostApiV1DateSpotReviewsIdReportsFormdataRequestBody defines body for PostApiV1DateSpotReviewsIdReports for application/x-www-form-urlencoded ContentType.
+type PostApiV1DateSpotReviewsIdReportsFormdataRequestBody = DateSpotReviewReportFormRequestData
+
 // PostApiV1DateSpotsMultipartRequestBody defines body for PostApiV1DateSpots for multipart/form-data ContentType.
 type PostApiV1DateSpotsMultipartRequestBody = DateSpotFormRequestData
 
 // PutApiV1DateSpotsIdMultipartRequestBody defines body for PutApiV1DateSpotsId for multipart/form-data ContentType.
 type PutApiV1DateSpotsIdMultipartRequestBody = DateSpotFormRequestData
 
+// PostApiV1EmailVerificationConfirmJSONRequestBody defines body for PostApiV1EmailVerificationConfirm for application/json ContentType.
+type PostApiV1EmailVerificationConfirmJSONRequestBody = VerifyEmailRequestData
+
 // PostApiV1LoginJSONRequestBody defines body for PostApiV1Login for application/json ContentType.
 type PostApiV1LoginJSONRequestBody = SigninFormRequestData
 
+// PostApiV1LogoutJSONRequestBody defines body for PostApiV1Logout for application/json ContentType.
+type PostApiV1LogoutJSONRequestBody = LogoutRequestData
+
+// PostApiV1NotificationsReadJSONRequestBody defines body for PostApiV1NotificationsRead for application/json ContentType.
+type PostApiV1NotificationsReadJSONRequestBody = MarkNotificationsReadRequestData
+
+// PostApiV1PasswordResetJSONRequestBody defines body for PostApiV1PasswordReset for application/json ContentType.
+type PostApiV1PasswordResetJSONRequestBody = PasswordResetRequestData
+
+// PostApiV1PasswordResetConfirmJSONRequestBody defines body for PostApiV1PasswordResetConfirm for application/json ContentType.
+type PostApiV1PasswordResetConfirmJSONRequestBody = ResetPasswordRequestData
+
 // PostApiV1RelationshipsFormdataRequestBody defines body for PostApiV1Relationships for application/x-www-form-urlencoded ContentType.
 type PostApiV1RelationshipsFormdataRequestBody = FollowReauestData
 
 // PostApiV1SignupMultipartRequestBody defines body for PostApiV1Signup for multipart/form-data ContentType.
 type PostApiV1SignupMultipartRequestBody = SignupFormRequestData
 
+// PostApiV1TokenRefreshJSONRequestBody defines body for PostApiV1TokenRefresh for application/json ContentType.
+type PostApiV1TokenRefreshJSONRequestBody = RefreshTokenRequestData
+
 // PutApiV1UsersIdMultipartRequestBody defines body for PutApiV1UsersId for multipart/form-data ContentType.
 type PutApiV1UsersIdMultipartRequestBody = UserFormRequestData
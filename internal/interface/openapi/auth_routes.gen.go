@@ -6,20 +6,38 @@ package openapi
 // bearerAuthRoutes は Bearer JWT 認証が必要なルートの集合です。
 // キー形式: "METHOD /echo/path/pattern"
 var bearerAuthRoutes = map[string]struct{}{
+	"GET /api/v1/admin/date_spot_reviews":                          {},
+	"PUT /api/v1/admin/date_spot_reviews/:id":                      {},
+	"GET /api/v1/admin/spot_matches":                               {},
+	"PUT /api/v1/admin/spot_matches/:id":                           {},
 	"POST /api/v1/courses":                                         {},
 	"DELETE /api/v1/courses/:id":                                   {},
+	"PUT /api/v1/courses/:id":                                      {},
+	"DELETE /api/v1/courses/:id/favorite":                          {},
+	"POST /api/v1/courses/:id/favorite":                            {},
 	"POST /api/v1/date_spot_reviews":                               {},
 	"DELETE /api/v1/date_spot_reviews/:id":                         {},
 	"PUT /api/v1/date_spot_reviews/:id":                            {},
+	"POST /api/v1/date_spot_reviews/:id/reports":                   {},
 	"POST /api/v1/date_spots":                                      {},
 	"DELETE /api/v1/date_spots/:id":                                {},
 	"PUT /api/v1/date_spots/:id":                                   {},
+	"POST /api/v1/email_verification":                              {},
+	"GET /api/v1/feed":                                             {},
+	"GET /api/v1/login_histories":                                  {},
+	"GET /api/v1/notifications":                                    {},
+	"POST /api/v1/notifications/read":                              {},
+	"GET /api/v1/notifications/unread_count":                       {},
 	"POST /api/v1/relationships":                                   {},
 	"DELETE /api/v1/relationships/:current_user_id/:other_user_id": {},
 	"DELETE /api/v1/users/:id":                                     {},
 	"PUT /api/v1/users/:id":                                        {},
+	"DELETE /api/v1/users/:user_id/block":                          {},
+	"POST /api/v1/users/:user_id/block":                            {},
 	"GET /api/v1/users/:user_id/followers":                         {},
 	"GET /api/v1/users/:user_id/followings":                        {},
+	"DELETE /api/v1/users/:user_id/mute":                           {},
+	"POST /api/v1/users/:user_id/mute":                             {},
 }
 
 // RequiresBearerAuth は指定の HTTP メソッドと Echo ルートパターンが
@@ -24,3 +24,13 @@ func NewCourseResponse(course *model.Course) (CourseResponseData, error) {
 func NewCreateCourseResponse(courseID uint) CourseFormResponseData {
 	return CourseFormResponseData{CourseId: int(courseID)}
 }
+
+// NewCourseFavoriteResponse はお気に入りの追加・解除後の件数から CourseFavoriteResponseData を構築します。
+func NewCourseFavoriteResponse(courseID uint, favorites *model.CourseFavoriteSummary) CourseFavoriteResponseData {
+	resp := CourseFavoriteResponseData{CourseId: int(courseID)}
+	if favorites != nil {
+		resp.FavoriteCount = favorites.Count
+		resp.FavoritedByMe = favorites.FavoritedByViewer
+	}
+	return resp
+}
@@ -28,7 +28,7 @@ func toDateSpotReviewInners(reviews []*model.DateSpotReview) []DateSpotShowRespo
 		if r.User != nil {
 			item.UserName = r.User.Name
 			item.UserGender = string(r.User.Gender)
-			item.UserImage = ImageData{Url: r.User.Image}
+			item.UserImage = ImageData{Url: r.User.Image, ThumbnailUrl: r.User.ImageThumbnail}
 		}
 		items = append(items, item)
 	}
@@ -1,6 +1,8 @@
 package openapi
 
 import (
+	"math"
+
 	"github.com/daisuke-harada/date-courses-go/internal/domain/master"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/samber/lo"
@@ -31,7 +33,7 @@ func newDateSpotShowResponseDataDateSpotReviewsInner(reviews []*model.DateSpotRe
 		if r.User != nil {
 			item.UserName = r.User.Name
 			item.UserGender = string(r.User.Gender)
-			item.UserImage = ImageData{Url: r.User.Image}
+			item.UserImage = ImageData{Url: r.User.Image, ThumbnailUrl: r.User.ImageThumbnail}
 		}
 		return item
 	})
@@ -81,6 +83,11 @@ func newDateSpotSummaryData(ds *model.DateSpot) DateSpotSummaryData {
 
 	source := DateSpotSummaryDataSource(ds.Source)
 
+	var distanceMeters *int
+	if ds.DistanceMeters != nil {
+		distanceMeters = lo.ToPtr(int(math.Round(*ds.DistanceMeters)))
+	}
+
 	return DateSpotSummaryData{
 		Id:                int(ds.ID),
 		CityName:          ds.CityName,
@@ -92,6 +99,7 @@ func newDateSpotSummaryData(ds *model.DateSpot) DateSpotSummaryData {
 		ReviewTotalNumber: ds.ReviewTotalNumber,
 		Source:            &source,
 		MapsUrl:           ds.MapsURL,
+		DistanceMeters:    distanceMeters,
 		DateSpot:          newDateSpotData(ds),
 	}
 }
@@ -105,7 +113,7 @@ func newDateSpotData(ds *model.DateSpot) DateSpotData {
 	return DateSpotData{
 		Id:          int(ds.ID),
 		Name:        ds.Name,
-		Image:       ImageData{Url: ds.Image},
+		Image:       ImageData{Url: ds.Image, ThumbnailUrl: ds.ImageThumbnail},
 		GenreId:     genreId,
 		AverageRate: float32(ds.AverageRate),
 		CreatedAt:   ds.CreatedAt,
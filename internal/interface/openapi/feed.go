@@ -0,0 +1,54 @@
+package openapi
+
+import (
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+)
+
+// NewFeedResponse はフィードの1ページ分を構築します。
+func NewFeedResponse(activities []*model.Activity, next *pagination.Cursor) (FeedResponseData, error) {
+	data := make([]ActivityData, 0, len(activities))
+	for _, activity := range activities {
+		d, err := newActivityData(activity)
+		if err != nil {
+			return FeedResponseData{}, err
+		}
+		data = append(data, d)
+	}
+	return FeedResponseData{
+		Activities: data,
+		Pagination: NewPaginationData(next),
+	}, nil
+}
+
+// newActivityData は出来事の種類に応じて、コース・レビュー・フォローされたユーザーのいずれかを載せます。
+func newActivityData(activity *model.Activity) (ActivityData, error) {
+	actor, err := newUserData(activity.Actor)
+	if err != nil {
+		return ActivityData{}, err
+	}
+	data := ActivityData{
+		Type:      ActivityDataType(activity.Type),
+		CreatedAt: activity.CreatedAt,
+		Actor:     actor,
+	}
+
+	switch {
+	case activity.Course != nil:
+		course, err := buildCourseResponseBody(activity.Course)
+		if err != nil {
+			return ActivityData{}, err
+		}
+		data.Course = &course
+	case activity.DateSpotReview != nil:
+		review := newDateSpotReviewData(activity.DateSpotReview)
+		data.DateSpotReview = &review
+	case activity.FollowedUser != nil:
+		followed, err := newUserData(activity.FollowedUser)
+		if err != nil {
+			return ActivityData{}, err
+		}
+		data.FollowedUser = &followed
+	}
+	return data, nil
+}
@@ -0,0 +1,25 @@
+package openapi
+
+import (
+	"github.com/samber/lo"
+
+	jwtpkg "github.com/daisuke-harada/date-courses-go/internal/pkg/jwt"
+)
+
+func NewJWKSResponse(keys []jwtpkg.JWK) JWKSResponseData {
+	return JWKSResponseData{
+		Keys: lo.Map(keys, func(k jwtpkg.JWK, _ int) JWKData {
+			return JWKData{
+				Kty: k.KeyType,
+				Kid: k.KeyID,
+				Use: k.Use,
+				Alg: k.Algorithm,
+				N:   lo.EmptyableToPtr(k.N),
+				E:   lo.EmptyableToPtr(k.E),
+				Crv: lo.EmptyableToPtr(k.Curve),
+				X:   lo.EmptyableToPtr(k.X),
+				Y:   lo.EmptyableToPtr(k.Y),
+			}
+		}),
+	}
+}
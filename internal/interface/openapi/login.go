@@ -2,11 +2,12 @@ package openapi
 
 import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/samber/lo"
 
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 )
 
-func NewLoginResponse(user *model.User, token string) (LoginResponseData, error) {
+func NewLoginResponse(user *model.User, token string, refreshToken string) (LoginResponseData, error) {
 	gender, err := NewGender(user.Gender)
 	if err != nil {
 		return LoginResponseData{}, err
@@ -17,12 +18,15 @@ func NewLoginResponse(user *model.User, token string) (LoginResponseData, error)
 			Id:   int(user.ID),
 			Name: user.Name,
 			// ログインは本人向けのレスポンスなのでメールアドレスを含める
-			Email:  &email,
-			Gender: gender,
-			Admin:  user.Admin,
-			Image:  ImageData{Url: user.Image},
+			Email: &email,
+			// 未確認なら投稿前に確認を促せるよう、確認状態も本人にだけ返す
+			EmailVerified: lo.ToPtr(user.IsEmailVerified()),
+			Gender:        gender,
+			Admin:         user.Admin,
+			Image:         ImageData{Url: user.Image, ThumbnailUrl: user.ImageThumbnail},
 		},
-		LoginStatus: true,
-		Token:       token,
+		LoginStatus:  true,
+		Token:        token,
+		RefreshToken: refreshToken,
 	}, nil
 }
@@ -0,0 +1,47 @@
+package openapi
+
+import (
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/samber/lo"
+)
+
+// NewDateSpotReviewReportResponse は受け付けた通報から DateSpotReviewReportResponseData を構築します。
+func NewDateSpotReviewReportResponse(report *model.DateSpotReviewReport) DateSpotReviewReportResponseData {
+	return DateSpotReviewReportResponseData{
+		Id:               int(report.ID),
+		DateSpotReviewId: int(report.DateSpotReviewID),
+		Reason:           string(report.Reason),
+		CreatedAt:        report.CreatedAt,
+	}
+}
+
+// NewModerationReviewData は管理者向けに、公開状態と未対応の通報を含めたレビューを構築します。
+func NewModerationReviewData(review *model.DateSpotReview) ModerationReviewData {
+	data := ModerationReviewData{
+		Id:         int(review.ID),
+		Content:    review.Content,
+		Status:     ReviewStatus(review.Status),
+		UserId:     int(review.UserID),
+		DateSpotId: int(review.DateSpotID),
+		CreatedAt:  review.CreatedAt,
+		Reports: lo.Map(review.Reports, func(r *model.DateSpotReviewReport, _ int) ModerationReportData {
+			return ModerationReportData{
+				Id:        int(r.ID),
+				UserId:    int(r.UserID),
+				Reason:    string(r.Reason),
+				Comment:   r.Comment,
+				CreatedAt: r.CreatedAt,
+			}
+		}),
+	}
+	if review.Rate != nil {
+		data.Rate = lo.ToPtr(float32(*review.Rate))
+	}
+	if review.User != nil {
+		data.UserName = review.User.Name
+	}
+	if review.DateSpot != nil {
+		data.DateSpotName = review.DateSpot.Name
+	}
+	return data
+}
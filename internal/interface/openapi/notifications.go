@@ -0,0 +1,48 @@
+package openapi
+
+import (
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+)
+
+// NewNotificationListResponse は通知一覧の1ページ分を構築します。
+func NewNotificationListResponse(notifications []*model.Notification, next *pagination.Cursor) (NotificationListResponseData, error) {
+	data := make([]NotificationData, 0, len(notifications))
+	for _, n := range notifications {
+		d, err := newNotificationData(n)
+		if err != nil {
+			return NotificationListResponseData{}, err
+		}
+		data = append(data, d)
+	}
+	return NotificationListResponseData{
+		Notifications: data,
+		Pagination:    NewPaginationData(next),
+	}, nil
+}
+
+func newNotificationData(n *model.Notification) (NotificationData, error) {
+	actor, err := newUserData(n.Actor)
+	if err != nil {
+		return NotificationData{}, err
+	}
+	return NotificationData{
+		Id:               int(n.ID),
+		Type:             NotificationDataType(n.Type),
+		Actor:            actor,
+		CourseId:         toIntPtr(n.CourseID),
+		DateSpotId:       toIntPtr(n.DateSpotID),
+		DateSpotReviewId: toIntPtr(n.DateSpotReviewID),
+		Read:             n.ReadAt != nil,
+		CreatedAt:        n.CreatedAt,
+	}, nil
+}
+
+// toIntPtr は任意の ID をレスポンスの型にします。nil のときは項目ごと省略します。
+func toIntPtr(id *uint) *int {
+	if id == nil {
+		return nil
+	}
+	v := int(*id)
+	return &v
+}
@@ -0,0 +1,97 @@
+package openapi
+
+import (
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/samber/lo"
+)
+
+// NewPaginationData は次のページのカーソルをレスポンス用の文字列にします。
+func NewPaginationData(next *pagination.Cursor) PaginationData {
+	if next == nil {
+		return PaginationData{}
+	}
+	encoded := next.Encode()
+	return PaginationData{NextCursor: &encoded}
+}
+
+// NewDateSpotListResponse はデートスポット一覧の1ページ分を構築します。
+func NewDateSpotListResponse(dateSpots []*model.DateSpot, next *pagination.Cursor) DateSpotListResponseData {
+	return DateSpotListResponseData{
+		DateSpots:  NewDateSpotsResponse(dateSpots),
+		Pagination: NewPaginationData(next),
+	}
+}
+
+// NewCourseListResponse はコース一覧の1ページ分を構築します。
+func NewCourseListResponse(courses []*model.Course, next *pagination.Cursor) (CourseListResponseData, error) {
+	responses, err := NewCoursesResponse(courses)
+	if err != nil {
+		return CourseListResponseData{}, err
+	}
+	return CourseListResponseData{
+		Courses:    responses,
+		Pagination: NewPaginationData(next),
+	}, nil
+}
+
+// NewUserListResponse はユーザー一覧の1ページ分を構築します。
+func NewUserListResponse(users []*model.UserWithRelations, next *pagination.Cursor) (UserListResponseData, error) {
+	responses, err := NewGetUsersResponse(users)
+	if err != nil {
+		return UserListResponseData{}, err
+	}
+	return UserListResponseData{
+		Users:      responses,
+		Pagination: NewPaginationData(next),
+	}, nil
+}
+
+// NewRelationshipResponse はフォロー一覧・フォロワー一覧の1ページ分を、一覧の持ち主の名前とともに構築します。
+func NewRelationshipResponse(userName string, users []*model.UserWithRelations, next *pagination.Cursor) (RelationShipResponsData, error) {
+	responses, err := NewGetUsersResponse(users)
+	if err != nil {
+		return RelationShipResponsData{}, err
+	}
+	return RelationShipResponsData{
+		UserName:   userName,
+		Users:      responses,
+		Pagination: NewPaginationData(next),
+	}, nil
+}
+
+// NewModerationReviewListResponse は管理者向けのレビュー一覧の1ページ分を構築します。
+func NewModerationReviewListResponse(reviews []*model.DateSpotReview, next *pagination.Cursor) ModerationReviewListResponseData {
+	return ModerationReviewListResponseData{
+		DateSpotReviews: lo.Map(reviews, func(r *model.DateSpotReview, _ int) ModerationReviewData {
+			return NewModerationReviewData(r)
+		}),
+		Pagination: NewPaginationData(next),
+	}
+}
+
+// NewSpotMatchListResponse は確認待ちの取り込み結果の1ページ分を構築します。
+func NewSpotMatchListResponse(sources []*model.SpotSource, next *pagination.Cursor) SpotMatchListResponseData {
+	return SpotMatchListResponseData{
+		SpotMatches: lo.Map(sources, func(s *model.SpotSource, _ int) SpotMatchData {
+			return NewSpotMatchData(s)
+		}),
+		Pagination: NewPaginationData(next),
+	}
+}
+
+// NewLoginHistoryListResponse はログイン履歴の1ページ分を構築します。
+func NewLoginHistoryListResponse(histories []*model.LoginHistory, next *pagination.Cursor) LoginHistoryListResponseData {
+	return LoginHistoryListResponseData{
+		LoginHistories: lo.Map(histories, func(h *model.LoginHistory, _ int) LoginHistoryData {
+			return LoginHistoryData{
+				Id:        int(h.ID),
+				Result:    LoginHistoryDataResult(h.Result),
+				IpAddress: h.IPAddress,
+				UserAgent: h.UserAgent,
+				CreatedAt: h.CreatedAt,
+			}
+		}),
+		Pagination: NewPaginationData(next),
+	}
+}
@@ -7,7 +7,7 @@ import (
 
 // NewSignupResponse は model.User から SignupResponseBody を生成します。
 // 新規ユーザーのため followerIds, followingIds, courses, date_spot_reviews は空配列です。
-func NewSignupResponse(user *model.User, token string) (SignUpResponseData, error) {
+func NewSignupResponse(user *model.User, token string, refreshToken string) (SignUpResponseData, error) {
 	gender, err := NewGender(user.Gender)
 	if err != nil {
 		return SignUpResponseData{}, err
@@ -17,15 +17,16 @@ func NewSignupResponse(user *model.User, token string) (SignUpResponseData, erro
 			Id:              int(user.ID),
 			Admin:           user.Admin,
 			Gender:          gender,
-			Image:           ImageData{Url: user.Image},
+			Image:           ImageData{Url: user.Image, ThumbnailUrl: user.ImageThumbnail},
 			Name:            user.Name,
 			FollowerIds:     []int{},
 			FollowingIds:    []int{},
 			Courses:         []CourseResponseData{},
 			DateSpotReviews: []DateSpotReviewData{},
 		},
-		LoginStatus: true,
-		Token:       token,
+		LoginStatus:  true,
+		Token:        token,
+		RefreshToken: refreshToken,
 	}, nil
 }
 
@@ -0,0 +1,32 @@
+package openapi
+
+import (
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/samber/lo"
+)
+
+// NewSpotMatchData は管理者向けに、取り込み結果と照合相手のスポットを構築します。
+func NewSpotMatchData(source *model.SpotSource) SpotMatchData {
+	data := SpotMatchData{
+		Id:         int(source.ID),
+		Source:     string(source.Source),
+		ExternalId: source.ExternalID,
+		Name:       source.Name,
+		CityName:   source.CityName,
+		Image:      source.Image,
+		PageUrl:    source.PageURL,
+		MatchScore: float32(source.MatchScore),
+		Status:     SpotMatchDataStatus(source.Status),
+		CreatedAt:  source.CreatedAt,
+	}
+	if source.Latitude != nil {
+		data.Latitude = lo.ToPtr(float32(*source.Latitude))
+	}
+	if source.Longitude != nil {
+		data.Longitude = lo.ToPtr(float32(*source.Longitude))
+	}
+	if source.DateSpot != nil {
+		data.DateSpot = newDateSpotSummaryData(source.DateSpot)
+	}
+	return data
+}
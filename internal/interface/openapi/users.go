@@ -42,7 +42,7 @@ func NewUserResponseData(
 		Id:              int(user.ID),
 		Admin:           user.Admin,
 		Gender:          gender,
-		Image:           ImageData{Url: user.Image},
+		Image:           ImageData{Url: user.Image, ThumbnailUrl: user.ImageThumbnail},
 		Name:            user.Name,
 		FollowerIds:     followerIDs,
 		FollowingIds:    followingIDs,
@@ -65,6 +65,10 @@ func buildCourseResponseBody(course *model.Course) (CourseResponseData, error) {
 		}
 	}
 
+	duringSpots := lo.Map(course.DuringSpots, func(ds *model.DuringSpot, _ int) DuringSpotData {
+		return newDuringSpotData(ds)
+	})
+
 	prefectureNames := make([]string, 0, len(prefectureIDSet))
 	for id := range prefectureIDSet {
 		name := master.PrefectureNameByID(id)
@@ -75,29 +79,69 @@ func buildCourseResponseBody(course *model.Course) (CourseResponseData, error) {
 
 	var courseUser UserData
 	if course.User != nil {
-		gender, err := NewGender(course.User.Gender)
-		if err != nil {
+		var err error
+		if courseUser, err = newUserData(course.User); err != nil {
 			return CourseResponseData{}, err
 		}
-		courseUser = UserData{
-			Id:     int(course.User.ID),
-			Name:   course.User.Name,
-			Gender: gender,
-			Image:  ImageData{Url: course.User.Image},
-			Admin:  course.User.Admin,
-		}
 	}
 
-	return CourseResponseData{
+	resp := CourseResponseData{
 		Id:                         int(course.ID),
 		Authority:                  string(course.Authority),
 		TravelMode:                 course.TravelMode,
 		DateSpots:                  dateSpots,
+		DuringSpots:                duringSpots,
 		NoDuplicatePrefectureNames: prefectureNames,
 		User:                       courseUser,
+	}
+	// 経路はコース取得系の usecase でだけ組み立てるため、無ければ項目ごと省略する
+	if course.Route != nil {
+		legs := lo.Map(course.Route.Legs, func(leg *model.CourseRouteLeg, _ int) CourseRouteLegData {
+			return CourseRouteLegData{
+				FromDateSpotId:  int(leg.FromDateSpotID),
+				ToDateSpotId:    int(leg.ToDateSpotID),
+				DistanceMeters:  leg.DistanceMeters,
+				DurationSeconds: leg.DurationSeconds,
+			}
+		})
+		resp.TotalDistanceMeters = &course.Route.TotalDistanceMeters
+		resp.TotalDurationSeconds = &course.Route.TotalDurationSeconds
+		resp.Legs = &legs
+	}
+	// お気に入りの件数も同様に、集計した usecase でだけ返す
+	if course.Favorites != nil {
+		resp.FavoriteCount = &course.Favorites.Count
+		resp.FavoritedByMe = &course.Favorites.FavoritedByViewer
+	}
+	return resp, nil
+}
+
+// newUserData は他のユーザーにも見せる投稿者などの UserData を構築します。email は含めません。
+func newUserData(user *model.User) (UserData, error) {
+	gender, err := NewGender(user.Gender)
+	if err != nil {
+		return UserData{}, err
+	}
+	return UserData{
+		Id:     int(user.ID),
+		Name:   user.Name,
+		Gender: gender,
+		Image:  ImageData{Url: user.Image, ThumbnailUrl: user.ImageThumbnail},
+		Admin:  user.Admin,
 	}, nil
 }
 
+func newDuringSpotData(ds *model.DuringSpot) DuringSpotData {
+	return DuringSpotData{
+		Id:          int(ds.ID),
+		DateSpotId:  int(ds.DateSpotID),
+		Position:    ds.Position,
+		ArrivalTime: ds.ArrivalTime,
+		StayMinutes: ds.StayMinutes,
+		Memo:        ds.Memo,
+	}
+}
+
 func newDateSpotReviewData(review *model.DateSpotReview) DateSpotReviewData {
 	var rate float32
 	if review.Rate != nil {
@@ -140,6 +184,18 @@ func NewGetUsersResponse(users []*model.UserWithRelations) ([]UserResponseData,
 }
 
 // NewUserWithRelationsResponse は *model.UserWithRelations から UserResponseBody を構築します。
+// お気に入りのコースはユーザー詳細でだけ取得するため、取得していなければ項目ごと省略します。
 func NewUserWithRelationsResponse(uwr *model.UserWithRelations) (UserResponseData, error) {
-	return NewUserResponseData(uwr.User, uwr.FollowerIDs, uwr.FollowingIDs, uwr.Courses, uwr.Reviews)
+	resp, err := NewUserResponseData(uwr.User, uwr.FollowerIDs, uwr.FollowingIDs, uwr.Courses, uwr.Reviews)
+	if err != nil {
+		return UserResponseData{}, err
+	}
+	if uwr.FavoriteCourses != nil {
+		favorites, err := NewCoursesResponse(uwr.FavoriteCourses)
+		if err != nil {
+			return UserResponseData{}, err
+		}
+		resp.FavoriteCourses = &favorites
+	}
+	return resp, nil
 }
@@ -12,10 +12,12 @@ import (
 
 	"github.com/daisuke-harada/date-courses-go/internal/config"
 	"github.com/daisuke-harada/date-courses-go/internal/di"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
 	"github.com/daisuke-harada/date-courses-go/internal/interface/handler"
 	"github.com/daisuke-harada/date-courses-go/internal/interface/middleware"
 	"github.com/daisuke-harada/date-courses-go/internal/interface/openapi"
+	jwtpkg "github.com/daisuke-harada/date-courses-go/internal/pkg/jwt"
 	"github.com/labstack/echo/v4"
 	echoMiddleware "github.com/labstack/echo/v4/middleware"
 )
@@ -91,15 +93,52 @@ func Run(ctx context.Context) error {
 	return nil
 }
 
-func NewEcho(cfg *config.Config, userRepo repository.UserRepository) *echo.Echo {
+// authRateLimitedPaths は IP ごとに試行回数を絞るパスです。
+// パスワードを総当たりできるエンドポイントと、メールを送信するエンドポイントを対象にします。
+var authRateLimitedPaths = []string{
+	"/api/v1/login",
+	"/api/v1/signup",
+	"/api/v1/email_verification",
+	"/api/v1/email_verification/confirm",
+	"/api/v1/password_reset",
+	"/api/v1/password_reset/confirm",
+}
+
+// newRateLimitGroups は設定からルートグループごとのレート制限を組み立てます。
+// リクエストは先頭から順に見て、最初に一致したグループだけで数えます。
+func newRateLimitGroups(rc config.RateLimitConfig) []middleware.RateLimitGroup {
+	perMinute := func(limit int) model.RateLimitRule {
+		return model.RateLimitRule{Limit: limit, Window: time.Minute}
+	}
+	return []middleware.RateLimitGroup{
+		{Name: "auth", Rule: perMinute(rc.LoginAttemptsPerMinute), Key: middleware.RateLimitByIP, Match: middleware.MatchPaths(authRateLimitedPaths...)},
+		{Name: "write", Rule: perMinute(rc.WritesPerMinute), Key: middleware.RateLimitByUser, Match: middleware.MatchMethods(http.MethodPost, http.MethodPut, http.MethodDelete)},
+		{Name: "read", Rule: perMinute(rc.ReadsPerMinute), Key: middleware.RateLimitByUser, Match: middleware.MatchAll},
+	}
+}
+
+func NewEcho(cfg *config.Config, keyring *jwtpkg.Keyring, userRepo repository.UserRepository, rateLimitStore repository.RateLimitStore) (*echo.Echo, error) {
+	ipExtractor, err := middleware.IPExtractor(cfg.Proxy.TrustedCIDRs)
+	if err != nil {
+		return nil, err
+	}
+
 	e := echo.New()
+	// ログイン履歴・レート制限に使う ctx.RealIP() を、書き換えられた X-Forwarded-For で偽らせない
+	e.IPExtractor = ipExtractor
 	e.HTTPErrorHandler = middleware.CustomHTTPErrorHandler
 	e.Use(echoMiddleware.Recover())
+	// 画像アップロード（上限 5MB）に multipart の区切りなどの分を足した大きさを超える本文は読まずに 413 で断る
+	e.Use(echoMiddleware.BodyLimit("6M"))
 	e.Use(echoMiddleware.RequestID())
 	e.Use(middleware.CORSMiddleware(cfg.CORS.AllowOrigins))
-	e.Use(middleware.LoginRateLimitMiddleware(cfg.RateLimit.LoginAttemptsPerMinute))
 	e.Use(middleware.RequestIDMiddleware)
 	e.Use(middleware.AccessLogMiddleware)
-	e.Use(middleware.JWTAuthMiddleware(cfg.JWT.SecretKey, userRepo))
-	return e
+	e.Use(middleware.JWTAuthMiddleware(keyring, userRepo))
+	e.Use(middleware.RateLimitMiddleware(rateLimitStore, newRateLimitGroups(cfg.RateLimit)...))
+	// ローカルに保存した画像は API サーバー自身が配信する
+	if cfg.Storage.Driver != "s3" {
+		e.Static("/uploads", cfg.Storage.LocalDir)
+	}
+	return e, nil
 }
@@ -0,0 +1,56 @@
+package geo
+
+import "math"
+
+// EarthRadiusMeters は地球を球とみなしたときの平均半径です。
+// SQL 側で距離を計算するときも同じ値を使い、アプリ側の計算と結果を揃えます。
+const EarthRadiusMeters = 6371008.8
+
+// DistanceMeters は2地点間の大圏距離（メートル）を haversine 公式で求めます。
+// 道のりではなく直線距離なので、移動距離の見積もりには迂回係数を掛けて使います。
+func DistanceMeters(lat1, lng1, lat2, lng2 float64) float64 {
+	lat1Rad := toRadians(lat1)
+	lat2Rad := toRadians(lat2)
+	dLat := toRadians(lat2 - lat1)
+	dLng := toRadians(lng2 - lng1)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1Rad)*math.Cos(lat2Rad)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * EarthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// BoundingBox は中心から半径 radiusMeters の円を囲む緯度経度の矩形です。
+type BoundingBox struct {
+	MinLatitude  float64
+	MaxLatitude  float64
+	MinLongitude float64
+	MaxLongitude float64
+}
+
+// NewBoundingBox は中心 (lat, lng) から半径 radiusMeters の円を内包する矩形を返します。
+// インデックスで絞り込むための粗い範囲なので、正確な距離判定は DistanceMeters で行います。
+// 極に近く経度方向の幅が求まらない場合や、日付変更線をまたぐ場合は経度の範囲を全周に広げます。
+func NewBoundingBox(lat, lng, radiusMeters float64) BoundingBox {
+	dLat := radiusMeters / EarthRadiusMeters * 180 / math.Pi
+	box := BoundingBox{
+		MinLatitude:  math.Max(-90, lat-dLat),
+		MaxLatitude:  math.Min(90, lat+dLat),
+		MinLongitude: -180,
+		MaxLongitude: 180,
+	}
+
+	cosLat := math.Cos(toRadians(lat))
+	if box.MinLatitude <= -90 || box.MaxLatitude >= 90 || cosLat <= 0 {
+		return box
+	}
+	dLng := dLat / cosLat
+	if lng-dLng < -180 || lng+dLng > 180 {
+		return box
+	}
+	box.MinLongitude = lng - dLng
+	box.MaxLongitude = lng + dLng
+	return box
+}
+
+func toRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
@@ -0,0 +1,52 @@
+package geo_test
+
+import (
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/geo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistanceMeters(t *testing.T) {
+	t.Run("same_point_is_zero", func(t *testing.T) {
+		assert.InDelta(t, 0, geo.DistanceMeters(35.681236, 139.767125, 35.681236, 139.767125), 1e-6)
+	})
+
+	// 東京駅 → 新宿駅はおよそ 6.1km
+	t.Run("tokyo_to_shinjuku", func(t *testing.T) {
+		assert.InDelta(t, 6100, geo.DistanceMeters(35.681236, 139.767125, 35.690921, 139.700258), 100)
+	})
+
+	t.Run("symmetric", func(t *testing.T) {
+		d1 := geo.DistanceMeters(34.702485, 135.495951, 35.011564, 135.768149)
+		d2 := geo.DistanceMeters(35.011564, 135.768149, 34.702485, 135.495951)
+		assert.InDelta(t, d1, d2, 1e-6)
+	})
+}
+
+func TestNewBoundingBox(t *testing.T) {
+	// 矩形の辺上の点は中心からちょうど半径の距離にある
+	t.Run("edges_are_radius_away_from_center", func(t *testing.T) {
+		box := geo.NewBoundingBox(35.681236, 139.767125, 1000)
+
+		assert.InDelta(t, 1000, geo.DistanceMeters(35.681236, 139.767125, box.MaxLatitude, 139.767125), 1)
+		assert.InDelta(t, 1000, geo.DistanceMeters(35.681236, 139.767125, box.MinLatitude, 139.767125), 1)
+		assert.InDelta(t, 1000, geo.DistanceMeters(35.681236, 139.767125, 35.681236, box.MaxLongitude), 1)
+		assert.InDelta(t, 1000, geo.DistanceMeters(35.681236, 139.767125, 35.681236, box.MinLongitude), 1)
+	})
+
+	t.Run("near_pole_covers_all_longitudes", func(t *testing.T) {
+		box := geo.NewBoundingBox(89.99, 0, 5000)
+
+		assert.Equal(t, 90.0, box.MaxLatitude)
+		assert.Equal(t, -180.0, box.MinLongitude)
+		assert.Equal(t, 180.0, box.MaxLongitude)
+	})
+
+	t.Run("crossing_antimeridian_covers_all_longitudes", func(t *testing.T) {
+		box := geo.NewBoundingBox(0, 179.999, 5000)
+
+		assert.Equal(t, -180.0, box.MinLongitude)
+		assert.Equal(t, 180.0, box.MaxLongitude)
+	})
+}
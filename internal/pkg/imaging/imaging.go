@@ -0,0 +1,121 @@
+// Package imaging はアップロードされた画像の検証・縮小・再エンコードを行います。
+//
+// 外部ライブラリに頼らず標準ライブラリのデコーダだけを使うため、受け付ける形式は JPEG・PNG・GIF です。
+// 再エンコードで EXIF などのメタデータ（撮影位置を含むことがある）は取り除かれるため、
+// JPEG の向き情報だけは先に読み取って画素に反映します。
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif" // GIF のデコーダを登録する
+	"image/jpeg"
+	_ "image/png" // PNG のデコーダを登録する
+	"net/http"
+)
+
+// MaxPixels は受け付ける画像の画素数の上限です。
+// ファイルサイズが小さくても展開すると巨大になる画像でメモリを使い切らないよう、展開前に確かめます。
+const MaxPixels = 40_000_000
+
+var (
+	ErrUnsupportedFormat = errors.New("imaging: unsupported format")
+	ErrTooManyPixels     = errors.New("imaging: too many pixels")
+)
+
+// supportedContentTypes はファイル先頭のバイト列から判定した、受け付ける形式です。
+// クライアントが申告する Content-Type は信用しません。
+var supportedContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+}
+
+// Decode は data を画像として読み込みます。JPEG の向き情報があれば正しい向きに直します。
+func Decode(data []byte) (image.Image, error) {
+	if !supportedContentTypes[http.DetectContentType(data)] {
+		return nil, ErrUnsupportedFormat
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > MaxPixels {
+		return nil, ErrTooManyPixels
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	return orient(img, jpegOrientation(data)), nil
+}
+
+// Fit は長辺が maxEdge 以下になるよう縦横比を保って縮小します。
+// 縮小は出力1画素に対応する元画像の範囲の平均をとる方式で、縮小率が大きくてもちらつきが出ません。
+// すでに収まっている画像は拡大しません。
+func Fit(img image.Image, maxEdge int) image.Image {
+	src := toRGBA(img)
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+	if sw <= maxEdge && sh <= maxEdge {
+		return src
+	}
+	dw, dh := maxEdge, maxEdge
+	if sw >= sh {
+		dh = max(1, sh*maxEdge/sw)
+	} else {
+		dw = max(1, sw*maxEdge/sh)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for dy := 0; dy < dh; dy++ {
+		y0, y1 := dy*sh/dh, max((dy+1)*sh/dh, dy*sh/dh+1)
+		for dx := 0; dx < dw; dx++ {
+			x0, x1 := dx*sw/dw, max((dx+1)*sw/dw, dx*sw/dw+1)
+			var r, g, b, a, n int
+			for y := y0; y < y1; y++ {
+				off := src.PixOffset(x0, y)
+				for x := x0; x < x1; x++ {
+					r += int(src.Pix[off])
+					g += int(src.Pix[off+1])
+					b += int(src.Pix[off+2])
+					a += int(src.Pix[off+3])
+					off += 4
+					n++
+				}
+			}
+			off := dst.PixOffset(dx, dy)
+			dst.Pix[off] = uint8(r / n)
+			dst.Pix[off+1] = uint8(g / n)
+			dst.Pix[off+2] = uint8(b / n)
+			dst.Pix[off+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
+
+// EncodeJPEG は img を JPEG にします。JPEG は透過を持てないため、透明な部分は白で塗ります。
+func EncodeJPEG(img image.Image, quality int) ([]byte, error) {
+	flat := image.NewRGBA(img.Bounds())
+	draw.Draw(flat, flat.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(flat, flat.Bounds(), img, img.Bounds().Min, draw.Over)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, flat, &jpeg.Options{Quality: quality}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// toRGBA は左上を原点とする *image.RGBA に変換します。
+func toRGBA(img image.Image) *image.RGBA {
+	if rgba, ok := img.(*image.RGBA); ok && rgba.Bounds().Min == (image.Point{}) {
+		return rgba
+	}
+	b := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), img, b.Min, draw.Src)
+	return rgba
+}
@@ -0,0 +1,188 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecode(t *testing.T) {
+	t.Run("success_png", func(t *testing.T) {
+		img, err := Decode(encodePNG(t, 30, 20))
+
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 30, 20), img.Bounds())
+	})
+
+	t.Run("error_unsupported_format", func(t *testing.T) {
+		_, err := Decode([]byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"))
+
+		assert.ErrorIs(t, err, ErrUnsupportedFormat)
+	})
+
+	t.Run("error_too_many_pixels", func(t *testing.T) {
+		// 画素データを持たないヘッダーだけの PNG でも、展開前に画素数で弾く
+		data := encodePNG(t, 1, 1)
+		binary.BigEndian.PutUint32(data[16:], 10000)
+		binary.BigEndian.PutUint32(data[20:], 10000)
+		binary.BigEndian.PutUint32(data[29:], crc32.ChecksumIEEE(data[12:29]))
+
+		_, err := Decode(data)
+
+		assert.ErrorIs(t, err, ErrTooManyPixels)
+	})
+
+	t.Run("success_applies_exif_orientation", func(t *testing.T) {
+		// 横長で保存され、向き 6（時計回りに90度回転して表示）が付いた写真
+		img, err := Decode(withOrientation(t, encodeJPEG(t, 40, 10), 6))
+
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 10, 40), img.Bounds())
+	})
+}
+
+func TestJpegOrientation(t *testing.T) {
+	t.Run("reads_big_and_little_endian", func(t *testing.T) {
+		data := encodeJPEG(t, 4, 4)
+
+		assert.Equal(t, 8, jpegOrientation(withOrientation(t, data, 8)))
+		assert.Equal(t, 3, jpegOrientation(withOrientationLE(t, data, 3)))
+	})
+
+	t.Run("defaults_to_1", func(t *testing.T) {
+		assert.Equal(t, 1, jpegOrientation(encodeJPEG(t, 4, 4)))
+		assert.Equal(t, 1, jpegOrientation(encodePNG(t, 4, 4)))
+		assert.Equal(t, 1, jpegOrientation([]byte{0xFF, 0xD8, 0xFF}))
+	})
+}
+
+func TestOrient(t *testing.T) {
+	// 2x1 の画像の左を赤、右を青にして、変換後の左上の色で向きを確かめる
+	src := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	src.Set(0, 0, color.RGBA{R: 255, A: 255})
+	src.Set(1, 0, color.RGBA{B: 255, A: 255})
+	red, blue := color.RGBA{R: 255, A: 255}, color.RGBA{B: 255, A: 255}
+
+	tests := []struct {
+		orientation int
+		size        image.Point
+		topLeft     color.RGBA
+	}{
+		{1, image.Pt(2, 1), red},
+		{2, image.Pt(2, 1), blue},
+		{3, image.Pt(2, 1), blue},
+		{4, image.Pt(2, 1), red},
+		{5, image.Pt(1, 2), red},
+		{6, image.Pt(1, 2), red},
+		{7, image.Pt(1, 2), blue},
+		{8, image.Pt(1, 2), blue},
+	}
+	for _, tt := range tests {
+		got := toRGBA(orient(src, tt.orientation))
+
+		assert.Equal(t, tt.size, got.Bounds().Size(), "orientation %d", tt.orientation)
+		assert.Equal(t, tt.topLeft, got.RGBAAt(0, 0), "orientation %d", tt.orientation)
+	}
+}
+
+func TestFit(t *testing.T) {
+	t.Run("shrinks_keeping_aspect_ratio", func(t *testing.T) {
+		got := Fit(image.NewRGBA(image.Rect(0, 0, 1600, 900)), 320)
+
+		assert.Equal(t, image.Pt(320, 180), got.Bounds().Size())
+	})
+
+	t.Run("portrait", func(t *testing.T) {
+		got := Fit(image.NewRGBA(image.Rect(0, 0, 900, 1600)), 320)
+
+		assert.Equal(t, image.Pt(180, 320), got.Bounds().Size())
+	})
+
+	t.Run("does_not_upscale", func(t *testing.T) {
+		got := Fit(image.NewRGBA(image.Rect(0, 0, 100, 50)), 320)
+
+		assert.Equal(t, image.Pt(100, 50), got.Bounds().Size())
+	})
+
+	t.Run("averages_pixels", func(t *testing.T) {
+		src := image.NewRGBA(image.Rect(0, 0, 2, 2))
+		src.Set(0, 0, color.RGBA{R: 200, A: 255})
+		src.Set(1, 0, color.RGBA{R: 100, A: 255})
+		src.Set(0, 1, color.RGBA{R: 0, A: 255})
+		src.Set(1, 1, color.RGBA{R: 100, A: 255})
+
+		got := toRGBA(Fit(src, 1))
+
+		assert.Equal(t, color.RGBA{R: 100, A: 255}, got.RGBAAt(0, 0))
+	})
+}
+
+func TestEncodeJPEG(t *testing.T) {
+	// 透明な PNG も白で塗りつぶした JPEG になる
+	src := image.NewNRGBA(image.Rect(0, 0, 8, 8))
+
+	data, err := EncodeJPEG(src, 85)
+	require.NoError(t, err)
+
+	img, err := jpeg.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	r, g, b, _ := img.At(4, 4).RGBA()
+	assert.Greater(t, r>>8, uint32(250))
+	assert.Greater(t, g>>8, uint32(250))
+	assert.Greater(t, b>>8, uint32(250))
+}
+
+func encodePNG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, w, h))))
+	return buf.Bytes()
+}
+
+func encodeJPEG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, w, h)), nil))
+	return buf.Bytes()
+}
+
+// withOrientation は SOI の直後に向きタグだけを持つ EXIF（ビッグエンディアン）を差し込みます。
+func withOrientation(t *testing.T, data []byte, o uint16) []byte {
+	t.Helper()
+	return insertExif(data, binary.BigEndian, []byte("MM"), o)
+}
+
+// withOrientationLE は withOrientation のリトルエンディアン版です。
+func withOrientationLE(t *testing.T, data []byte, o uint16) []byte {
+	t.Helper()
+	return insertExif(data, binary.LittleEndian, []byte("II"), o)
+}
+
+func insertExif(data []byte, order binary.ByteOrder, mark []byte, o uint16) []byte {
+	tiff := make([]byte, 8+2+12+4)
+	copy(tiff, mark)
+	order.PutUint16(tiff[2:], 42)
+	order.PutUint32(tiff[4:], 8)
+	order.PutUint16(tiff[8:], 1)
+	order.PutUint16(tiff[10:], exifOrientationTag)
+	order.PutUint16(tiff[12:], 3) // SHORT
+	order.PutUint32(tiff[14:], 1)
+	order.PutUint16(tiff[18:], o)
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	out := append([]byte{}, data[:2]...)
+	out = append(out, segment...)
+	return append(out, data[2:]...)
+}
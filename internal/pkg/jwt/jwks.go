@@ -0,0 +1,66 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"slices"
+	"strings"
+)
+
+// JWK は JSON Web Key（RFC 7517）形式の公開鍵です。
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	// RSA の公開鍵
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// 楕円曲線の公開鍵
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+	Y     string `json:"y,omitempty"`
+}
+
+// JWKS は検証に使える公開鍵の一覧を返します。
+// HS256 の共通鍵は公開すると誰でも署名できてしまうため含めません。
+func (k *Keyring) JWKS() []JWK {
+	jwks := []JWK{}
+	for _, key := range k.keys {
+		if jwk, ok := key.jwk(); ok {
+			jwks = append(jwks, jwk)
+		}
+	}
+	slices.SortFunc(jwks, func(a, b JWK) int { return strings.Compare(a.KeyID, b.KeyID) })
+	return jwks
+}
+
+func (k *Key) jwk() (JWK, bool) {
+	jwk := JWK{KeyID: k.ID, Use: "sig", Algorithm: k.Algorithm()}
+	switch p := k.public.(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = base64URL(p.N.Bytes())
+		jwk.E = base64URL(big.NewInt(int64(p.E)).Bytes())
+	case *ecdsa.PublicKey:
+		// 非圧縮形式（0x04 || X || Y）から、32 バイトずつの X・Y を取り出す
+		point, err := p.Bytes()
+		if err != nil {
+			return JWK{}, false
+		}
+		size := (len(point) - 1) / 2
+		jwk.KeyType = "EC"
+		jwk.Curve = "P-256"
+		jwk.X = base64URL(point[1 : 1+size])
+		jwk.Y = base64URL(point[1+size:])
+	default:
+		return JWK{}, false
+	}
+	return jwk, true
+}
+
+func base64URL(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
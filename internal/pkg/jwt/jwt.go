@@ -1,62 +1,203 @@
+// Package jwt はアクセストークン（JWT）の発行と検証を行います。
+//
+// 署名には RS256・ES256 の秘密鍵を使い、検証用の公開鍵は JWKS として公開します。
+// 他のサービスは共通鍵を共有しなくても、JWKS の公開鍵でこの API のトークンを検証できます。
+// トークンのヘッダーの kid で検証に使う鍵を選ぶため、鍵を入れ替えても古い鍵を検証用に残しておけば、
+// それまでに発行したトークンも期限まで使えます（全員がログアウトさせられることはありません）。
 package jwt
 
 import (
 	"errors"
+	"fmt"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// clockSkew は iat・exp を確かめるときに許す、サーバー間の時計のずれです。
+const clockSkew = 30 * time.Second
+
+// Claims はアクセストークンに載せる情報です。
+type Claims struct {
+	UserID uint
+	// TokenVersion は発行時点のユーザーのトークンバージョンです。
+	// ユーザー側のバージョンが上がると、それより前に発行したトークンは使えなくなります。
+	TokenVersion uint
+}
+
+// claims はトークンのペイロードです。ユーザー ID は標準の sub に入れます。
 type claims struct {
-	UserID uint `json:"user_id"`
+	TokenVersion uint `json:"ver"`
+	jwt.RegisteredClaims
+}
+
+// legacyClaims は鍵の切り替え前に JWT_SECRET_KEY の HS256 で発行していたトークンのペイロードです。
+// kid・iss・aud を持たず、ユーザー ID は user_id に入っています。
+type legacyClaims struct {
+	UserID       uint `json:"user_id"`
+	TokenVersion uint `json:"ver"`
 	jwt.RegisteredClaims
 }
 
-func Encode(userID uint, secretKey string) (string, error) {
-	return EncodeWithExpiry(userID, secretKey, time.Now().Add(24*time.Hour))
+// legacySecret は旧形式のトークンを受け付ける共通鍵と、受け付ける期限です。
+type legacySecret struct {
+	secret []byte
+	until  time.Time
 }
 
-func EncodeWithExpiry(userID uint, secretKey string, expiry time.Time) (string, error) {
-	c := claims{
-		UserID: userID,
+// Keyring はトークンの署名に使う鍵1つと、検証に使える鍵の集まりです。
+type Keyring struct {
+	issuer   string
+	audience string
+	signing  *Key
+	keys     map[string]*Key
+	methods  []string
+	legacy   *legacySecret
+}
+
+// NewKeyring は signing で署名し、signing と verification のどれでも検証できる Keyring を作ります。
+// 発行するトークンの iss・aud には issuer・audience を入れ、検証時も一致を確かめます。
+func NewKeyring(issuer, audience string, signing *Key, verification ...*Key) (*Keyring, error) {
+	if issuer == "" || audience == "" {
+		return nil, errors.New("jwt: issuer and audience are required")
+	}
+	if signing == nil || !signing.canSign() {
+		return nil, errors.New("jwt: signing key must have a private key")
+	}
+	k := &Keyring{issuer: issuer, audience: audience, signing: signing, keys: map[string]*Key{}}
+	for _, key := range append([]*Key{signing}, verification...) {
+		if _, dup := k.keys[key.ID]; dup {
+			return nil, fmt.Errorf("jwt: duplicate key id %q", key.ID)
+		}
+		k.keys[key.ID] = key
+		if !slices.Contains(k.methods, key.Algorithm()) {
+			k.methods = append(k.methods, key.Algorithm())
+		}
+	}
+	return k, nil
+}
+
+// AcceptLegacyTokens は until までの間、鍵の切り替え前の形式（kid なし・secret による HS256・user_id クレーム）の
+// トークンも受け付けます。切り替えのデプロイで発行済みのアクセストークンを一斉に拒否し、全員をログアウトさせないためです。
+// until はデプロイからアクセストークンの有効期間が過ぎるまでにします。
+func (k *Keyring) AcceptLegacyTokens(secret []byte, until time.Time) error {
+	if len(secret) == 0 {
+		return errors.New("jwt: legacy secret key is empty")
+	}
+	k.legacy = &legacySecret{secret: secret, until: until}
+	return nil
+}
+
+func (k *Keyring) Encode(c Claims, ttl time.Duration) (string, error) {
+	return k.EncodeWithExpiry(c, time.Now().Add(ttl))
+}
+
+func (k *Keyring) EncodeWithExpiry(c Claims, expiry time.Time) (string, error) {
+	token := jwt.NewWithClaims(k.signing.method, claims{
+		TokenVersion: c.TokenVersion,
 		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    k.issuer,
+			Subject:   strconv.FormatUint(uint64(c.UserID), 10),
+			Audience:  jwt.ClaimStrings{k.audience},
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			ExpiresAt: jwt.NewNumericDate(expiry),
 		},
-	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, c)
-	return token.SignedString([]byte(secretKey))
+	})
+	token.Header["kid"] = k.signing.ID
+	return token.SignedString(k.signing.private)
 }
 
-func Decode(tokenStr string, secretKey string) (uint, error) {
-	// jwt.ParseWithClaims は次の処理を行います：
+func (k *Keyring) Decode(tokenStr string) (Claims, error) {
+	if k.isLegacy(tokenStr) {
+		return k.decodeLegacy(tokenStr)
+	}
+
+	// ParseWithClaims は次の処理を行います：
 	// 1) トークンを 3 部分に分割し、ヘッダー／ペイロードをデコードして構造体に Unmarshal する
-	// 2) ヘッダーの "alg" から署名方式を決定し、ここで渡す keyfunc を呼んで検証用キーを取得する
+	// 2) ヘッダーの "alg" が WithValidMethods の一覧にあるかを確かめ、keyfunc で検証用キーを取得する
 	// 3) 取得したキーで署名を検証する（署名不正ならエラー）
-	// 4) Claims.Valid() を呼んで RegisteredClaims の検証（exp / nbf 等）を行う
-	//    - 期限切れの場合は jwt.ErrTokenExpired が返される
-	// つまり署名検証と有効期限チェックはこの呼び出し内で行われます。
-	token, err := jwt.ParseWithClaims(tokenStr, &claims{}, func(t *jwt.Token) (any, error) {
-		// keyfunc: ライブラリが署名検証のために呼び出します。
-		// ここでは署名方式が HMAC 系であることを確認し、検証に使う対称鍵を返します。
-		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("unexpected signing method")
-		}
-		// 実際の検証はライブラリ側で行われます。ここではキーを返すだけです。
-		return []byte(secretKey), nil
-	})
+	// 4) exp・iat・iss・aud を検証する（期限切れの場合は jwt.ErrTokenExpired が返される）
+	parser := jwt.NewParser(
+		jwt.WithValidMethods(k.methods),
+		jwt.WithIssuer(k.issuer),
+		jwt.WithAudience(k.audience),
+		jwt.WithIssuedAt(),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(clockSkew),
+	)
+	token, err := parser.ParseWithClaims(tokenStr, &claims{}, k.keyFunc)
 	if err != nil {
-		if errors.Is(err, jwt.ErrTokenExpired) {
-			return 0, apperror.Unauthorized("トークンの有効期限が切れています。再度ログインしてください。")
-		}
-		return 0, apperror.Unauthorized("認証に失敗しました。")
+		return Claims{}, unauthorized(err)
 	}
 
-	// パース済みトークンからクレームを取り出し、妥当性を確認する
-	// (jwt.ParseWithClaims で署名検証と期限チェックは既に行われている)
 	c, ok := token.Claims.(*claims)
 	if !ok || !token.Valid {
-		return 0, apperror.Unauthorized("認証に失敗しました。")
+		return Claims{}, apperror.Unauthorized("認証に失敗しました。")
+	}
+	userID, err := strconv.ParseUint(c.Subject, 10, 64)
+	if err != nil || userID == 0 {
+		return Claims{}, apperror.Unauthorized("認証に失敗しました。")
+	}
+	return Claims{UserID: uint(userID), TokenVersion: c.TokenVersion}, nil
+}
+
+// keyFunc はヘッダーの kid で検証に使う鍵を選びます。
+// alg は鍵ごとに決まっており、ヘッダーの alg が鍵と違うトークンは受け付けません。
+// RS256 の公開鍵を HS256 の共通鍵として使わせる、いわゆるアルゴリズム混同攻撃を防ぐためです。
+func (k *Keyring) keyFunc(t *jwt.Token) (any, error) {
+	kid, _ := t.Header["kid"].(string)
+	key, ok := k.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	if t.Method.Alg() != key.Algorithm() {
+		return nil, errors.New("unexpected signing method")
+	}
+	return key.public, nil
+}
+
+// isLegacy は旧形式のトークンを受け付ける期間中に、kid を持たないトークンが来たかを返します。
+// ここでは署名を確かめず、decodeLegacy で検証します。
+func (k *Keyring) isLegacy(tokenStr string) bool {
+	if k.legacy == nil || !time.Now().Before(k.legacy.until) {
+		return false
+	}
+	token, _, err := jwt.NewParser().ParseUnverified(tokenStr, &legacyClaims{})
+	if err != nil {
+		return false
+	}
+	_, hasKid := token.Header["kid"]
+	return !hasKid
+}
+
+// decodeLegacy は鍵の切り替え前の形式のトークンを、旧来の共通鍵で検証します。
+func (k *Keyring) decodeLegacy(tokenStr string) (Claims, error) {
+	parser := jwt.NewParser(
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuedAt(),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(clockSkew),
+	)
+	token, err := parser.ParseWithClaims(tokenStr, &legacyClaims{}, func(*jwt.Token) (any, error) {
+		return k.legacy.secret, nil
+	})
+	if err != nil {
+		return Claims{}, unauthorized(err)
+	}
+	c, ok := token.Claims.(*legacyClaims)
+	if !ok || !token.Valid || c.UserID == 0 {
+		return Claims{}, apperror.Unauthorized("認証に失敗しました。")
+	}
+	return Claims{UserID: c.UserID, TokenVersion: c.TokenVersion}, nil
+}
+
+// unauthorized は検証に失敗したトークンのエラーを、期限切れとそれ以外で分けて返します。
+func unauthorized(err error) error {
+	if errors.Is(err, jwt.ErrTokenExpired) {
+		return apperror.Unauthorized("トークンの有効期限が切れています。再度ログインしてください。")
 	}
-	return c.UserID, nil
+	return apperror.Unauthorized("認証に失敗しました。")
 }
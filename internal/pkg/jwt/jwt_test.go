@@ -1,55 +1,389 @@
 package jwt_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
 	"testing"
 	"time"
 
 	jwtpkg "github.com/daisuke-harada/date-courses-go/internal/pkg/jwt"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-const testSecret = "test-secret-key"
+const (
+	testIssuer   = "https://api.example.com"
+	testAudience = "date-courses"
+)
+
+func newRSAKey(t *testing.T, id string) (*jwtpkg.Key, *rsa.PrivateKey) {
+	t.Helper()
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	require.NoError(t, err)
+	key, err := jwtpkg.ParsePrivateKeyPEM(id, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	require.NoError(t, err)
+	return key, private
+}
+
+func newECKey(t *testing.T, id string) (*jwtpkg.Key, *ecdsa.PrivateKey) {
+	t.Helper()
+	private, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalECPrivateKey(private)
+	require.NoError(t, err)
+	key, err := jwtpkg.ParsePrivateKeyPEM(id, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}))
+	require.NoError(t, err)
+	return key, private
+}
+
+func newKeyring(t *testing.T, signing *jwtpkg.Key, verification ...*jwtpkg.Key) *jwtpkg.Keyring {
+	t.Helper()
+	keyring, err := jwtpkg.NewKeyring(testIssuer, testAudience, signing, verification...)
+	require.NoError(t, err)
+	return keyring
+}
+
+// publicKeyPEM は検証用の鍵として渡す、kid ヘッダー付きの公開鍵の PEM を作ります。
+func publicKeyPEM(t *testing.T, id string, public any) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(public)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Headers: map[string]string{"kid": id}, Bytes: der})
+}
+
+func TestKeyring_EncodeDecode(t *testing.T) {
+	rsaKey, _ := newRSAKey(t, "rsa-1")
+	ecKey, _ := newECKey(t, "ec-1")
+	hmacKey, err := jwtpkg.NewHMACKey("hs-1", []byte("test-secret-key"))
+	require.NoError(t, err)
+
+	for _, key := range []*jwtpkg.Key{rsaKey, ecKey, hmacKey} {
+		t.Run("round_trip_"+key.Algorithm(), func(t *testing.T) {
+			keyring := newKeyring(t, key)
+
+			token, err := keyring.Encode(jwtpkg.Claims{UserID: 42, TokenVersion: 3}, time.Hour)
+			require.NoError(t, err)
+			claims, err := keyring.Decode(token)
+
+			require.NoError(t, err)
+			assert.Equal(t, jwtpkg.Claims{UserID: 42, TokenVersion: 3}, claims)
+		})
+	}
+
+	t.Run("sets_standard_claims_and_kid", func(t *testing.T) {
+		token, err := newKeyring(t, ecKey).Encode(jwtpkg.Claims{UserID: 42}, time.Hour)
+		require.NoError(t, err)
 
-func TestEncode(t *testing.T) {
-	t.Run("success_returns_non_empty_token", func(t *testing.T) {
-		token, err := jwtpkg.Encode(1, testSecret)
+		parsed, _, err := jwt.NewParser().ParseUnverified(token, jwt.MapClaims{})
 		require.NoError(t, err)
-		assert.NotEmpty(t, token)
+		assert.Equal(t, "ec-1", parsed.Header["kid"])
+		assert.Equal(t, "ES256", parsed.Header["alg"])
+		c := parsed.Claims.(jwt.MapClaims)
+		assert.Equal(t, "42", c["sub"])
+		assert.Equal(t, testIssuer, c["iss"])
+		assert.Equal(t, []any{testAudience}, c["aud"])
+		assert.NotNil(t, c["iat"])
 	})
-}
 
-func TestDecode(t *testing.T) {
-	t.Run("success_returns_user_id", func(t *testing.T) {
-		token, err := jwtpkg.Encode(42, testSecret)
+	// 鍵を入れ替えても、古い鍵を検証用に残しておけば発行済みのトークンは期限まで使える
+	t.Run("rotation_keeps_old_tokens_valid", func(t *testing.T) {
+		newKey, _ := newRSAKey(t, "rsa-2")
+		_, oldPrivate := newRSAKey(t, "unused")
+		oldKey, err := jwtpkg.ParsePrivateKeyPEM("rsa-old", pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(oldPrivate)}))
+		require.NoError(t, err)
+		oldToken, err := newKeyring(t, oldKey).Encode(jwtpkg.Claims{UserID: 1}, time.Hour)
+		require.NoError(t, err)
+
+		verification, err := jwtpkg.ParseVerificationKeysPEM(publicKeyPEM(t, "rsa-old", &oldPrivate.PublicKey))
 		require.NoError(t, err)
+		rotated := newKeyring(t, newKey, verification...)
+
+		claims, err := rotated.Decode(oldToken)
+		require.NoError(t, err)
+		assert.Equal(t, uint(1), claims.UserID)
+
+		// 検証用から外したら使えなくなる
+		_, err = newKeyring(t, newKey).Decode(oldToken)
+		assert.ErrorContains(t, err, "認証に失敗しました。")
+	})
 
-		userID, err := jwtpkg.Decode(token, testSecret)
+	t.Run("error_expired_token", func(t *testing.T) {
+		keyring := newKeyring(t, ecKey)
+		token, err := keyring.EncodeWithExpiry(jwtpkg.Claims{UserID: 1}, time.Now().Add(-1*time.Hour))
 		require.NoError(t, err)
-		assert.Equal(t, uint(42), userID)
+
+		_, err = keyring.Decode(token)
+		assert.ErrorContains(t, err, "トークンの有効期限が切れています。再度ログインしてください。")
 	})
 
 	t.Run("error_invalid_token", func(t *testing.T) {
-		_, err := jwtpkg.Decode("invalid.token.string", testSecret)
+		_, err := newKeyring(t, ecKey).Decode("invalid.token.string")
+		assert.ErrorContains(t, err, "認証に失敗しました。")
+	})
+
+	t.Run("error_wrong_issuer_or_audience", func(t *testing.T) {
+		other, err := jwtpkg.NewKeyring("https://other.example.com", testAudience, ecKey)
+		require.NoError(t, err)
+		token, err := other.Encode(jwtpkg.Claims{UserID: 1}, time.Hour)
+		require.NoError(t, err)
+		_, err = newKeyring(t, ecKey).Decode(token)
+		assert.Error(t, err)
+
+		other, err = jwtpkg.NewKeyring(testIssuer, "other-service", ecKey)
+		require.NoError(t, err)
+		token, err = other.Encode(jwtpkg.Claims{UserID: 1}, time.Hour)
+		require.NoError(t, err)
+		_, err = newKeyring(t, ecKey).Decode(token)
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "認証に失敗しました。")
 	})
 
-	t.Run("error_wrong_secret", func(t *testing.T) {
-		token, err := jwtpkg.Encode(1, testSecret)
+	t.Run("error_issued_in_future", func(t *testing.T) {
+		_, private := newECKey(t, "ec-1")
+		token := signRaw(t, jwt.SigningMethodES256, "ec-1", private, jwt.MapClaims{
+			"sub": "1", "iss": testIssuer, "aud": testAudience,
+			"iat": time.Now().Add(time.Hour).Unix(), "exp": time.Now().Add(2 * time.Hour).Unix(),
+		})
+		_, err := newKeyring(t, newKeyFromPrivate(t, "ec-1", private)).Decode(token)
+		assert.Error(t, err)
+	})
+
+	t.Run("error_missing_subject", func(t *testing.T) {
+		_, private := newECKey(t, "ec-1")
+		token := signRaw(t, jwt.SigningMethodES256, "ec-1", private, jwt.MapClaims{
+			"iss": testIssuer, "aud": testAudience,
+			"iat": time.Now().Unix(), "exp": time.Now().Add(time.Hour).Unix(),
+		})
+		_, err := newKeyring(t, newKeyFromPrivate(t, "ec-1", private)).Decode(token)
+		assert.Error(t, err)
+	})
+
+	t.Run("error_unknown_kid", func(t *testing.T) {
+		other, _ := newECKey(t, "ec-unknown")
+		token, err := newKeyring(t, other).Encode(jwtpkg.Claims{UserID: 1}, time.Hour)
 		require.NoError(t, err)
 
-		_, err = jwtpkg.Decode(token, "wrong-secret")
+		_, err = newKeyring(t, ecKey).Decode(token)
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "認証に失敗しました。")
 	})
 
-	t.Run("error_expired_token", func(t *testing.T) {
-		token, err := jwtpkg.EncodeWithExpiry(1, testSecret, time.Now().Add(-1*time.Hour))
+	// RS256 の公開鍵を HS256 の共通鍵として署名したトークンを受け付けない
+	t.Run("error_algorithm_confusion", func(t *testing.T) {
+		_, private := newRSAKey(t, "rsa-1")
+		publicPEM := publicKeyPEM(t, "rsa-1", &private.PublicKey)
+		token := signRaw(t, jwt.SigningMethodHS256, "rsa-1", publicPEM, jwt.MapClaims{
+			"sub": "1", "iss": testIssuer, "aud": testAudience,
+			"iat": time.Now().Unix(), "exp": time.Now().Add(time.Hour).Unix(),
+		})
+		hmacKey, err := jwtpkg.NewHMACKey("hs-1", []byte("test-secret-key"))
 		require.NoError(t, err)
 
-		_, err = jwtpkg.Decode(token, testSecret)
+		_, err = newKeyring(t, hmacKey, newKeyFromPrivate(t, "rsa-1", private)).Decode(token)
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "トークンの有効期限が切れています。再度ログインしてください。")
 	})
 }
+
+func TestKeyring_AcceptLegacyTokens(t *testing.T) {
+	secret := []byte("legacy-secret")
+	ecKey, _ := newECKey(t, "ec-1")
+	// 鍵の切り替え前の形式。kid・iss・aud が無く、ユーザー ID は user_id に入っている
+	legacyToken := func(t *testing.T, key []byte) string {
+		t.Helper()
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"user_id": 7,
+			"ver":     2,
+			"iat":     time.Now().Unix(),
+			"exp":     time.Now().Add(time.Hour).Unix(),
+		}).SignedString(key)
+		require.NoError(t, err)
+		return token
+	}
+
+	// 切り替えのデプロイで全員をログアウトさせない
+	t.Run("accepts_legacy_token_until_deadline", func(t *testing.T) {
+		keyring := newKeyring(t, ecKey)
+		require.NoError(t, keyring.AcceptLegacyTokens(secret, time.Now().Add(time.Hour)))
+
+		claims, err := keyring.Decode(legacyToken(t, secret))
+
+		require.NoError(t, err)
+		assert.Equal(t, jwtpkg.Claims{UserID: 7, TokenVersion: 2}, claims)
+	})
+
+	t.Run("error_after_deadline", func(t *testing.T) {
+		keyring := newKeyring(t, ecKey)
+		require.NoError(t, keyring.AcceptLegacyTokens(secret, time.Now().Add(-time.Second)))
+
+		_, err := keyring.Decode(legacyToken(t, secret))
+		assert.ErrorContains(t, err, "認証に失敗しました。")
+	})
+
+	t.Run("error_without_legacy_secret", func(t *testing.T) {
+		_, err := newKeyring(t, ecKey).Decode(legacyToken(t, secret))
+		assert.ErrorContains(t, err, "認証に失敗しました。")
+	})
+
+	t.Run("error_legacy_token_with_wrong_secret", func(t *testing.T) {
+		keyring := newKeyring(t, ecKey)
+		require.NoError(t, keyring.AcceptLegacyTokens(secret, time.Now().Add(time.Hour)))
+
+		_, err := keyring.Decode(legacyToken(t, []byte("forged")))
+		assert.ErrorContains(t, err, "認証に失敗しました。")
+	})
+
+	// 受け付ける期間中も、今の形式のトークンはこれまでどおり検証する
+	t.Run("keeps_current_tokens_valid", func(t *testing.T) {
+		keyring := newKeyring(t, ecKey)
+		require.NoError(t, keyring.AcceptLegacyTokens(secret, time.Now().Add(time.Hour)))
+		token, err := keyring.Encode(jwtpkg.Claims{UserID: 1}, time.Hour)
+		require.NoError(t, err)
+
+		claims, err := keyring.Decode(token)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(1), claims.UserID)
+	})
+}
+
+func TestNewKeyring(t *testing.T) {
+	t.Run("error_signing_key_without_private_key", func(t *testing.T) {
+		_, private := newECKey(t, "ec-1")
+		keys, err := jwtpkg.ParseVerificationKeysPEM(publicKeyPEM(t, "ec-1", &private.PublicKey))
+		require.NoError(t, err)
+
+		_, err = jwtpkg.NewKeyring(testIssuer, testAudience, keys[0])
+		assert.Error(t, err)
+	})
+
+	t.Run("error_duplicate_kid", func(t *testing.T) {
+		a, _ := newECKey(t, "same")
+		b, _ := newECKey(t, "same")
+
+		_, err := jwtpkg.NewKeyring(testIssuer, testAudience, a, b)
+		assert.Error(t, err)
+	})
+}
+
+func TestParseKeys(t *testing.T) {
+	t.Run("error_weak_rsa_key", func(t *testing.T) {
+		private, err := rsa.GenerateKey(rand.Reader, 1024)
+		require.NoError(t, err)
+
+		_, err = jwtpkg.ParsePrivateKeyPEM("weak", pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(private)}))
+		assert.Error(t, err)
+	})
+
+	t.Run("error_non_p256_curve", func(t *testing.T) {
+		private, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		require.NoError(t, err)
+		der, err := x509.MarshalECPrivateKey(private)
+		require.NoError(t, err)
+
+		_, err = jwtpkg.ParsePrivateKeyPEM("p384", pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}))
+		assert.Error(t, err)
+	})
+
+	t.Run("error_missing_key_id", func(t *testing.T) {
+		_, private := newECKey(t, "ec-1")
+		der, err := x509.MarshalPKIXPublicKey(&private.PublicKey)
+		require.NoError(t, err)
+
+		_, err = jwtpkg.ParseVerificationKeysPEM(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+		assert.Error(t, err)
+		_, err = jwtpkg.ParsePrivateKeyPEM("", nil)
+		assert.Error(t, err)
+	})
+
+	// 書き間違えた鍵を黙って読み飛ばさない
+	t.Run("error_data_that_is_not_pem", func(t *testing.T) {
+		_, a := newECKey(t, "a")
+
+		_, err := jwtpkg.ParseVerificationKeysPEM([]byte("not a pem"))
+		assert.Error(t, err)
+		_, err = jwtpkg.ParseVerificationKeysPEM(append([]byte("garbage\n"), publicKeyPEM(t, "a", &a.PublicKey)...))
+		assert.Error(t, err)
+		_, err = jwtpkg.ParseVerificationKeysPEM(append(publicKeyPEM(t, "a", &a.PublicKey), "garbage"...))
+		assert.Error(t, err)
+	})
+
+	t.Run("accepts_empty_input", func(t *testing.T) {
+		keys, err := jwtpkg.ParseVerificationKeysPEM([]byte(" \n"))
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("reads_multiple_blocks", func(t *testing.T) {
+		_, a := newECKey(t, "a")
+		_, b := newRSAKey(t, "b")
+		data := append(publicKeyPEM(t, "a", &a.PublicKey), publicKeyPEM(t, "b", &b.PublicKey)...)
+
+		keys, err := jwtpkg.ParseVerificationKeysPEM(data)
+
+		require.NoError(t, err)
+		require.Len(t, keys, 2)
+		assert.Equal(t, "a", keys[0].ID)
+		assert.Equal(t, "ES256", keys[0].Algorithm())
+		assert.Equal(t, "b", keys[1].ID)
+		assert.Equal(t, "RS256", keys[1].Algorithm())
+	})
+}
+
+func TestKeyring_JWKS(t *testing.T) {
+	rsaKey, rsaPrivate := newRSAKey(t, "rsa-1")
+	ecKey, ecPrivate := newECKey(t, "ec-1")
+	hmacKey, err := jwtpkg.NewHMACKey("hs-1", []byte("test-secret-key"))
+	require.NoError(t, err)
+
+	jwks := newKeyring(t, hmacKey, rsaKey, ecKey).JWKS()
+
+	require.Len(t, jwks, 2, "共通鍵は公開しない")
+	assert.Equal(t, "ec-1", jwks[0].KeyID)
+	assert.Equal(t, "EC", jwks[0].KeyType)
+	assert.Equal(t, "P-256", jwks[0].Curve)
+	assert.Equal(t, "ES256", jwks[0].Algorithm)
+	ecPoint, err := ecPrivate.PublicKey.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, ecPoint[1:33], decodeBase64URL(t, jwks[0].X))
+	assert.Equal(t, ecPoint[33:], decodeBase64URL(t, jwks[0].Y))
+
+	assert.Equal(t, "rsa-1", jwks[1].KeyID)
+	assert.Equal(t, "RSA", jwks[1].KeyType)
+	assert.Equal(t, "RS256", jwks[1].Algorithm)
+	assert.Equal(t, "sig", jwks[1].Use)
+	assert.Equal(t, rsaPrivate.N, new(big.Int).SetBytes(decodeBase64URL(t, jwks[1].N)))
+	assert.Equal(t, "AQAB", jwks[1].E)
+}
+
+func newKeyFromPrivate(t *testing.T, id string, private any) *jwtpkg.Key {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	require.NoError(t, err)
+	key, err := jwtpkg.ParsePrivateKeyPEM(id, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	require.NoError(t, err)
+	return key
+}
+
+// signRaw は Keyring を通さずに、任意のヘッダー・クレームでトークンを署名します。
+func signRaw(t *testing.T, method jwt.SigningMethod, kid string, key any, c jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, c)
+	token.Header["kid"] = kid
+	s, err := token.SignedString(key)
+	require.NoError(t, err)
+	return s
+}
+
+func decodeBase64URL(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
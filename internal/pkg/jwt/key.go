@@ -0,0 +1,172 @@
+package jwt
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// minRSAKeyBits は受け付ける RSA 鍵の最小の長さです。
+const minRSAKeyBits = 2048
+
+// kidHeader は検証用の鍵の PEM ブロックに鍵 ID を書くヘッダー名です。
+const kidHeader = "kid"
+
+// Key はトークンの署名・検証に使う鍵です。
+// 秘密鍵を持つ鍵は署名にも使え、公開鍵だけの鍵は検証にだけ使えます。
+type Key struct {
+	// ID はトークンのヘッダーの kid に入れる鍵 ID です。検証時はこの ID で鍵を選びます。
+	ID      string
+	method  jwt.SigningMethod
+	private any
+	public  any
+}
+
+// Algorithm は鍵の署名方式（RS256・ES256・HS256）を返します。
+func (k *Key) Algorithm() string {
+	return k.method.Alg()
+}
+
+// canSign は署名に使える（秘密鍵を持つ）かを返します。
+func (k *Key) canSign() bool {
+	return k.private != nil
+}
+
+// NewHMACKey は共通鍵で HS256 署名する鍵を作ります。
+// 共通鍵は検証する側にも渡す必要があり、JWKS でも公開できないため、ローカル開発用です。
+func NewHMACKey(id string, secret []byte) (*Key, error) {
+	if id == "" {
+		return nil, errors.New("jwt: key id is required")
+	}
+	if len(secret) == 0 {
+		return nil, errors.New("jwt: secret key is empty")
+	}
+	return &Key{ID: id, method: jwt.SigningMethodHS256, private: secret, public: secret}, nil
+}
+
+// ParsePrivateKeyPEM は PEM 形式の秘密鍵（PKCS#8・PKCS#1・SEC 1）から署名用の鍵を作ります。
+// RSA 鍵は RS256、P-256 の楕円曲線鍵は ES256 で署名します。
+func ParsePrivateKeyPEM(id string, data []byte) (*Key, error) {
+	if id == "" {
+		return nil, errors.New("jwt: key id is required")
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("jwt: key %q is not PEM", id)
+	}
+	private, err := parsePrivateKey(block)
+	if err != nil {
+		return nil, fmt.Errorf("jwt: key %q: %w", id, err)
+	}
+	return newAsymmetricKey(id, private)
+}
+
+// ParseVerificationKeysPEM は PEM ブロックを並べたデータから検証用の鍵を作ります。
+// 各ブロックには "kid: 鍵ID" のヘッダーが必要です。公開鍵（PKIX）のほか秘密鍵も受け付けますが、
+// 署名には使いません。鍵を入れ替えた後も、古い鍵で署名したトークンを期限まで受け付けるために使います。
+// 設定の書き間違いで鍵が黙って抜け落ちないよう、PEM ブロック以外の文字があればエラーにします。
+func ParseVerificationKeysPEM(data []byte) ([]*Key, error) {
+	var keys []*Key
+	for {
+		data = bytes.TrimSpace(data)
+		if len(data) == 0 {
+			break
+		}
+		// pem.Decode はブロックの前の文字を読み飛ばすため、ブロックで始まっているかを先に確かめる
+		if !bytes.HasPrefix(data, []byte("-----BEGIN ")) {
+			return nil, errors.New("jwt: verification keys contain data that is not PEM")
+		}
+		block, rest := pem.Decode(data)
+		if block == nil {
+			return nil, errors.New("jwt: verification keys contain a malformed PEM block")
+		}
+		data = rest
+
+		id := block.Headers[kidHeader]
+		if id == "" {
+			return nil, fmt.Errorf("jwt: %s block has no %s header", block.Type, kidHeader)
+		}
+		var key *Key
+		if block.Type == "PUBLIC KEY" {
+			public, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("jwt: key %q: %w", id, err)
+			}
+			key, err = newVerificationKey(id, public)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			private, err := parsePrivateKey(block)
+			if err != nil {
+				return nil, fmt.Errorf("jwt: key %q: %w", id, err)
+			}
+			key, err = newAsymmetricKey(id, private)
+			if err != nil {
+				return nil, err
+			}
+			key.private = nil
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func parsePrivateKey(block *pem.Block) (any, error) {
+	switch block.Type {
+	case "PRIVATE KEY":
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+}
+
+func newAsymmetricKey(id string, private any) (*Key, error) {
+	switch p := private.(type) {
+	case *rsa.PrivateKey:
+		key, err := newVerificationKey(id, &p.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		key.private = p
+		return key, nil
+	case *ecdsa.PrivateKey:
+		key, err := newVerificationKey(id, &p.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		key.private = p
+		return key, nil
+	default:
+		return nil, fmt.Errorf("jwt: key %q: unsupported key type %T", id, private)
+	}
+}
+
+func newVerificationKey(id string, public any) (*Key, error) {
+	switch p := public.(type) {
+	case *rsa.PublicKey:
+		if p.N.BitLen() < minRSAKeyBits {
+			return nil, fmt.Errorf("jwt: key %q: RSA key must be at least %d bits", id, minRSAKeyBits)
+		}
+		return &Key{ID: id, method: jwt.SigningMethodRS256, public: p}, nil
+	case *ecdsa.PublicKey:
+		// ES256 は P-256 の鍵と決まっている。ほかの曲線の鍵で ES256 を名乗らせない
+		if p.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("jwt: key %q: EC key must use P-256", id)
+		}
+		return &Key{ID: id, method: jwt.SigningMethodES256, public: p}, nil
+	default:
+		return nil, fmt.Errorf("jwt: key %q: unsupported key type %T", id, public)
+	}
+}
@@ -0,0 +1,152 @@
+// Package pagination は一覧 API のカーソル方式ページングで共有する型を提供します。
+//
+// カーソルは直前のページ最後の行の並び替えキーと ID を持ち、次のページは
+// 「そのキーより後ろ」を WHERE / HAVING で引き直します。OFFSET と違い、
+// 深いページでも読み飛ばす行が増えず、ページ間で行が追加・削除されてもずれません。
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultLimit は limit 省略時の1ページの件数です。
+	DefaultLimit = 20
+	// MaxLimit は1ページで返せる件数の上限です。
+	MaxLimit = 100
+)
+
+// Sort は一覧の並び順です。一覧ごとに使える並び順は異なります。
+type Sort string
+
+const (
+	SortNewest      Sort = "newest"
+	SortRating      Sort = "rating"
+	SortReviewCount Sort = "review_count"
+	SortName        Sort = "name"
+	// SortDistance は現在地から検索したときだけ使える並び順です。
+	SortDistance Sort = "distance"
+	// SortRelevance はキーワードで検索したときだけ使える、検索語との関連度の順です。
+	SortRelevance Sort = "relevance"
+	// SortOldest は古い順です。管理者の確認待ち一覧のように、先に来たものから処理する一覧で使います。
+	SortOldest Sort = "oldest"
+)
+
+// Cursor は直前のページ最後の行の位置です。
+// Value は並び替えキーの値を文字列にしたもので、型は並び順で決まります。
+// newest・oldest は日時、rating・distance・relevance は小数、review_count は整数、name は文字列です。
+// ID は並び替えキーが同じ行の順序を決めるための ID です。
+type Cursor struct {
+	Sort  Sort   `json:"s"`
+	Value string `json:"v"`
+	ID    uint   `json:"id"`
+}
+
+var ErrInvalidCursor = errors.New("pagination: invalid cursor")
+
+// NewTimeCursor・NewFloatCursor・NewIntCursor・NewStringCursor は並び替えキーの型に合わせて Cursor を作ります。
+func NewTimeCursor(sort Sort, value time.Time, id uint) Cursor {
+	return Cursor{Sort: sort, Value: value.Format(time.RFC3339Nano), ID: id}
+}
+
+func NewFloatCursor(sort Sort, value float64, id uint) Cursor {
+	// 'g' と精度 -1 で、読み戻したときに元の値と完全に一致する表現にする
+	return Cursor{Sort: sort, Value: strconv.FormatFloat(value, 'g', -1, 64), ID: id}
+}
+
+func NewIntCursor(sort Sort, value int, id uint) Cursor {
+	return Cursor{Sort: sort, Value: strconv.Itoa(value), ID: id}
+}
+
+func NewStringCursor(sort Sort, value string, id uint) Cursor {
+	return Cursor{Sort: sort, Value: value, ID: id}
+}
+
+// TimeValue・FloatValue・IntValue は Value を並び順に応じた型で返します。
+// Decode で検証済みのカーソルに対して使います。
+func (c Cursor) TimeValue() time.Time {
+	t, _ := time.Parse(time.RFC3339Nano, c.Value)
+	return t
+}
+
+func (c Cursor) FloatValue() float64 {
+	f, _ := strconv.ParseFloat(c.Value, 64)
+	return f
+}
+
+func (c Cursor) IntValue() int {
+	n, _ := strconv.Atoi(c.Value)
+	return n
+}
+
+// Encode はクライアントへ返す不透明な文字列にします。
+func (c Cursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Decode は Encode した文字列を Cursor に戻します。
+// 改ざんなどで Value が並び順の型として読めない場合も ErrInvalidCursor を返します。
+func Decode(s string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c Cursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	switch c.Sort {
+	case SortNewest, SortOldest:
+		_, err = time.Parse(time.RFC3339Nano, c.Value)
+	case SortRating, SortDistance, SortRelevance:
+		_, err = strconv.ParseFloat(c.Value, 64)
+	case SortReviewCount:
+		_, err = strconv.Atoi(c.Value)
+	case SortName:
+	default:
+		err = ErrInvalidCursor
+	}
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// Params はリポジトリへ渡すページ指定です。
+// Limit が0のときは件数を絞らず、条件に合う行をすべて返します。
+type Params struct {
+	Limit  int
+	Cursor *Cursor
+}
+
+// Page は1ページ分の結果です。続きがないときは NextCursor が nil になります。
+type Page[T any] struct {
+	Items      []T
+	NextCursor *Cursor
+}
+
+// NewPage は FetchLimit 件まで取得した items から1ページ分を切り出します。
+// 1件多く取れたときだけ続きがあるとみなし、ページ最後の行から次のカーソルを作ります。
+func NewPage[T any](items []T, params Params, cursorOf func(T) Cursor) Page[T] {
+	if params.Limit <= 0 || len(items) <= params.Limit {
+		return Page[T]{Items: items}
+	}
+	items = items[:params.Limit]
+	next := cursorOf(items[len(items)-1])
+	return Page[T]{Items: items, NextCursor: &next}
+}
+
+// FetchLimit は続きの有無を判定するため、Limit より1件多い取得件数を返します。
+// Limit が0のときは0を返し、件数を絞らないことを表します。
+func (p Params) FetchLimit() int {
+	if p.Limit <= 0 {
+		return 0
+	}
+	return p.Limit + 1
+}
@@ -0,0 +1,87 @@
+package pagination_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor_EncodeDecode(t *testing.T) {
+	t.Run("round_trips_each_value_type", func(t *testing.T) {
+		createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		cursors := []pagination.Cursor{
+			pagination.NewTimeCursor(pagination.SortNewest, createdAt, 10),
+			pagination.NewFloatCursor(pagination.SortRating, 4.333333333333333, 11),
+			pagination.NewIntCursor(pagination.SortReviewCount, 42, 12),
+			pagination.NewStringCursor(pagination.SortName, "東京タワー", 13),
+			pagination.NewFloatCursor(pagination.SortDistance, 120.5, 14),
+			pagination.NewFloatCursor(pagination.SortRelevance, 7, 15),
+			pagination.NewTimeCursor(pagination.SortOldest, createdAt, 16),
+		}
+
+		for _, c := range cursors {
+			decoded, err := pagination.Decode(c.Encode())
+			require.NoError(t, err)
+			assert.Equal(t, c, *decoded)
+		}
+	})
+
+	// 小数は読み戻したときに元の値と完全に一致しないと、同じ値の行を読み飛ばしたり重複したりする
+	t.Run("float_value_is_exact", func(t *testing.T) {
+		c := pagination.NewFloatCursor(pagination.SortRating, 1.0/3, 1)
+		decoded, err := pagination.Decode(c.Encode())
+		require.NoError(t, err)
+		assert.Equal(t, 1.0/3, decoded.FloatValue())
+	})
+
+	t.Run("error_not_base64", func(t *testing.T) {
+		_, err := pagination.Decode("%%%")
+		assert.ErrorIs(t, err, pagination.ErrInvalidCursor)
+	})
+
+	t.Run("error_value_does_not_match_sort", func(t *testing.T) {
+		c := pagination.Cursor{Sort: pagination.SortNewest, Value: "abc", ID: 1}
+		_, err := pagination.Decode(c.Encode())
+		assert.ErrorIs(t, err, pagination.ErrInvalidCursor)
+	})
+
+	t.Run("error_unknown_sort", func(t *testing.T) {
+		c := pagination.Cursor{Sort: "popular", Value: "1", ID: 1}
+		_, err := pagination.Decode(c.Encode())
+		assert.ErrorIs(t, err, pagination.ErrInvalidCursor)
+	})
+}
+
+func TestNewPage(t *testing.T) {
+	cursorOf := func(n int) pagination.Cursor {
+		return pagination.NewIntCursor(pagination.SortReviewCount, n*10, uint(n))
+	}
+
+	t.Run("has_next_when_fetched_more_than_limit", func(t *testing.T) {
+		params := pagination.Params{Limit: 2}
+		page := pagination.NewPage([]int{1, 2, 3}, params, cursorOf)
+
+		assert.Equal(t, []int{1, 2}, page.Items)
+		require.NotNil(t, page.NextCursor)
+		assert.Equal(t, cursorOf(2), *page.NextCursor)
+	})
+
+	t.Run("last_page_has_no_next_cursor", func(t *testing.T) {
+		params := pagination.Params{Limit: 2}
+		page := pagination.NewPage([]int{1, 2}, params, cursorOf)
+
+		assert.Equal(t, []int{1, 2}, page.Items)
+		assert.Nil(t, page.NextCursor)
+	})
+
+	t.Run("zero_limit_returns_all", func(t *testing.T) {
+		page := pagination.NewPage([]int{1, 2, 3}, pagination.Params{}, cursorOf)
+
+		assert.Equal(t, []int{1, 2, 3}, page.Items)
+		assert.Nil(t, page.NextCursor)
+		assert.Equal(t, 0, pagination.Params{}.FetchLimit())
+	})
+}
@@ -0,0 +1,239 @@
+// Package textsearch はスポット名のような短い日本語の文字列向けの n-gram 検索インデックスを提供します。
+//
+// 文字列は NFKC 正規化・小文字化・カタカナのひらがな化をしてから、1文字と2文字の n-gram で索引します。
+// 検索語の n-gram をすべて含む文書を候補にし、正規化後の文字列に検索語が含まれるものだけを
+// 「完全一致 > 前方一致 > 部分一致」とフィールドの重みで順位付けして返します。
+package textsearch
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalize は検索で同一視する表記ゆれを揃えます。
+// 全角・半角（NFKC）、大文字・小文字、カタカナ・ひらがなの違いと空白を無視します。
+func Normalize(s string) string {
+	s = strings.ToLower(norm.NFKC.String(s))
+	return strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsSpace(r):
+			return -1
+		case r >= 'ァ' && r <= 'ヶ':
+			// カタカナとひらがなは Unicode 上で 0x60 離れて同じ並びになっている
+			return r - 0x60
+		}
+		return r
+	}, s)
+}
+
+// Terms は検索語を空白で区切り、それぞれ正規化します。空の語は除きます。
+func Terms(query string) []string {
+	var terms []string
+	for _, f := range strings.Fields(norm.NFKC.String(query)) {
+		if t := Normalize(f); t != "" {
+			terms = append(terms, t)
+		}
+	}
+	return terms
+}
+
+// grams は s の1文字と2文字の n-gram を重複なく返します。
+func grams(s string) []string {
+	runes := []rune(s)
+	seen := make(map[string]struct{}, len(runes)*2)
+	var out []string
+	add := func(g string) {
+		if _, ok := seen[g]; !ok {
+			seen[g] = struct{}{}
+			out = append(out, g)
+		}
+	}
+	for i := range runes {
+		add(string(runes[i]))
+		if i+1 < len(runes) {
+			add(string(runes[i : i+2]))
+		}
+	}
+	return out
+}
+
+// queryGrams は検索語を候補の絞り込みに使う n-gram にします。
+// 2文字以上なら2文字の n-gram だけで十分絞れるため、1文字の n-gram は使いません。
+func queryGrams(term string) []string {
+	runes := []rune(term)
+	if len(runes) < 2 {
+		return []string{term}
+	}
+	out := make([]string, 0, len(runes)-1)
+	for i := 0; i+1 < len(runes); i++ {
+		out = append(out, string(runes[i:i+2]))
+	}
+	return out
+}
+
+// Hit は検索でヒットした文書と関連度です。
+type Hit struct {
+	ID    uint
+	Score float64
+}
+
+// Index は n-gram の転置インデックスです。複数の goroutine から同時に使えます。
+type Index struct {
+	mu       sync.RWMutex
+	weights  []float64
+	docs     map[uint][]string
+	postings map[string]map[uint]struct{}
+}
+
+// NewIndex はフィールドごとの重みを受け取り、空のインデックスを作ります。
+// Put で渡すフィールドは weights と同じ順番で並べます。
+func NewIndex(weights ...float64) *Index {
+	return &Index{
+		weights:  weights,
+		docs:     map[uint][]string{},
+		postings: map[string]map[uint]struct{}{},
+	}
+}
+
+// Put は文書を登録します。同じ ID が登録済みなら置き換えます。
+func (x *Index) Put(id uint, fields ...string) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	x.remove(id)
+	normalized := make([]string, len(x.weights))
+	for i := range normalized {
+		if i < len(fields) {
+			normalized[i] = Normalize(fields[i])
+		}
+		for _, g := range grams(normalized[i]) {
+			if x.postings[g] == nil {
+				x.postings[g] = map[uint]struct{}{}
+			}
+			x.postings[g][id] = struct{}{}
+		}
+	}
+	x.docs[id] = normalized
+}
+
+// Remove は文書を取り除きます。
+func (x *Index) Remove(id uint) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.remove(id)
+}
+
+func (x *Index) remove(id uint) {
+	fields, ok := x.docs[id]
+	if !ok {
+		return
+	}
+	for _, f := range fields {
+		for _, g := range grams(f) {
+			delete(x.postings[g], id)
+			if len(x.postings[g]) == 0 {
+				delete(x.postings, g)
+			}
+		}
+	}
+	delete(x.docs, id)
+}
+
+// Len は登録されている文書数を返します。
+func (x *Index) Len() int {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return len(x.docs)
+}
+
+// Search は検索語をすべて含む文書を関連度の高い順に最大 limit 件返します。
+// 関連度が同じ文書は ID の小さい順に並べます。limit が0以下なら件数を絞りません。
+func (x *Index) Search(query string, limit int) []Hit {
+	terms := Terms(query)
+	if len(terms) == 0 {
+		return nil
+	}
+
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	var hits []Hit
+	for id := range x.candidates(terms) {
+		score, ok := x.score(x.docs[id], terms)
+		if ok {
+			hits = append(hits, Hit{ID: id, Score: score})
+		}
+	}
+	sort.Slice(hits, func(a, b int) bool {
+		if hits[a].Score != hits[b].Score {
+			return hits[a].Score > hits[b].Score
+		}
+		return hits[a].ID < hits[b].ID
+	})
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits
+}
+
+// candidates は検索語の n-gram をすべて含む文書の ID を返します。
+// 件数の少ない posting から順に積集合をとり、途中で空になれば打ち切ります。
+func (x *Index) candidates(terms []string) map[uint]struct{} {
+	var lists []map[uint]struct{}
+	for _, t := range terms {
+		for _, g := range queryGrams(t) {
+			p, ok := x.postings[g]
+			if !ok {
+				return nil
+			}
+			lists = append(lists, p)
+		}
+	}
+	sort.Slice(lists, func(a, b int) bool { return len(lists[a]) < len(lists[b]) })
+
+	result := make(map[uint]struct{}, len(lists[0]))
+	for id := range lists[0] {
+		result[id] = struct{}{}
+	}
+	for _, p := range lists[1:] {
+		for id := range result {
+			if _, ok := p[id]; !ok {
+				delete(result, id)
+			}
+		}
+		if len(result) == 0 {
+			return nil
+		}
+	}
+	return result
+}
+
+// score は各検索語について最も一致度の高いフィールドの点を合計します。
+// n-gram がそろっていても連続していない語は一致とみなさず、ok に false を返します。
+func (x *Index) score(fields []string, terms []string) (float64, bool) {
+	var total float64
+	for _, t := range terms {
+		var best float64
+		for i, f := range fields {
+			var match float64
+			switch {
+			case f == t:
+				match = 3
+			case strings.HasPrefix(f, t):
+				match = 2
+			case strings.Contains(f, t):
+				match = 1
+			}
+			best = max(best, match*x.weights[i])
+		}
+		if best == 0 {
+			return 0, false
+		}
+		total += best
+	}
+	return total, true
+}
@@ -0,0 +1,94 @@
+package textsearch_test
+
+import (
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/textsearch"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Run("folds_width_case_kana_and_spaces", func(t *testing.T) {
+		assert.Equal(t, "かふぇabc", textsearch.Normalize("ｶﾌｪ ＡＢＣ"))
+		assert.Equal(t, "かふぇabc", textsearch.Normalize("カフェ　abc"))
+		assert.Equal(t, "ゔぃれっじ", textsearch.Normalize("ヴィレッジ"))
+	})
+
+	t.Run("keeps_kanji_and_long_vowel", func(t *testing.T) {
+		assert.Equal(t, "東京たわー", textsearch.Normalize("東京タワー"))
+	})
+}
+
+func TestIndex_Search(t *testing.T) {
+	// フィールドは 名前・ジャンル・市区町村 の順で、名前の一致を最も重くする
+	newIndex := func() *textsearch.Index {
+		x := textsearch.NewIndex(3, 2, 1)
+		x.Put(1, "東京タワー", "ランドマーク", "東京都港区芝公園")
+		x.Put(2, "カフェ バッハ", "カフェ・スイーツ", "東京都台東区日本堤")
+		x.Put(3, "タワーカフェ", "カフェ・スイーツ", "大阪府大阪市")
+		x.Put(4, "港区のバル", "ダイニングバー・バル", "東京都港区六本木")
+		return x
+	}
+
+	t.Run("hiragana_query_matches_katakana_name", func(t *testing.T) {
+		hits := newIndex().Search("たわー", 0)
+
+		assert.Equal(t, []uint{3, 1}, ids(hits), "前方一致のタワーカフェが部分一致の東京タワーより上")
+	})
+
+	t.Run("half_width_query_matches_full_width", func(t *testing.T) {
+		hits := newIndex().Search("ｶﾌｪ", 0)
+
+		assert.Equal(t, []uint{2, 3}, ids(hits))
+	})
+
+	t.Run("name_ranks_above_city", func(t *testing.T) {
+		hits := newIndex().Search("港区", 0)
+
+		assert.Equal(t, []uint{4, 1}, ids(hits), "名前に含む港区のバルが住所だけの東京タワーより上")
+	})
+
+	t.Run("all_terms_must_match", func(t *testing.T) {
+		hits := newIndex().Search("東京 カフェ", 0)
+
+		assert.Equal(t, []uint{2}, ids(hits))
+	})
+
+	t.Run("grams_must_be_contiguous", func(t *testing.T) {
+		x := textsearch.NewIndex(1)
+		x.Put(1, "カフェ フカヒレ")
+
+		assert.Empty(t, x.Search("かふか", 0), "かふ・ふか はどちらも含むが、かふか という並びはない")
+		assert.Len(t, x.Search("ぇふか", 0), 1, "空白を除いた並びで一致する")
+	})
+
+	t.Run("single_character_query", func(t *testing.T) {
+		assert.Equal(t, []uint{2, 4}, ids(newIndex().Search("バ", 0)), "同じ関連度なら ID の小さい順")
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		assert.Len(t, newIndex().Search("東京", 1), 1)
+	})
+
+	t.Run("put_replaces_and_remove_deletes", func(t *testing.T) {
+		x := newIndex()
+		x.Put(1, "スカイツリー", "ランドマーク", "東京都墨田区")
+		x.Remove(2)
+
+		assert.Empty(t, x.Search("タワー 東京", 0))
+		assert.Empty(t, x.Search("バッハ", 0))
+		assert.Equal(t, 3, x.Len())
+	})
+
+	t.Run("empty_query_returns_nothing", func(t *testing.T) {
+		assert.Empty(t, newIndex().Search("  ", 0))
+	})
+}
+
+func ids(hits []textsearch.Hit) []uint {
+	out := make([]uint, 0, len(hits))
+	for _, h := range hits {
+		out = append(out, h.ID)
+	}
+	return out
+}
@@ -6,26 +6,43 @@ import (
 	"log/slog"
 	"net/url"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
 	"golang.org/x/text/unicode/norm"
 )
 
 // SpotCandidate は外部 API から取得した実在スポット候補です。
 type SpotCandidate struct {
-	Name      string
-	CityName  string
-	Latitude  *float64
-	Longitude *float64
-	ImageURL  *string
-	PageURL   string
+	// ExternalID は取得元でのスポットの ID です。ID を持たない取得元では空です。
+	ExternalID string
+	Name       string
+	CityName   string
+	Latitude   *float64
+	Longitude  *float64
+	ImageURL   *string
+	PageURL    string
+	// Source は取得元です。空なら hotpepper として登録します。
+	Source model.DateSpotSource
+	// 営業時間・休業日は取得できたときだけ入ります。
+	OpeningHours []*model.DateSpotOpeningHour
+	Closures     []*model.DateSpotClosure
 }
 
 // SpotFetcher は外部 API からスポット情報を取得するインターフェースです。
 type SpotFetcher interface {
 	FetchSpots(ctx context.Context, prefCode string, prefectureName string, genreID int, count int) ([]SpotCandidate, error)
+	// FetchOpeningHours は c の営業時間・休業日を取得して埋めます。取れなくても失敗にはしません。
+	// 呼び出しに費用がかかるため、新しく登録すると決まった候補についてだけ呼びます。
+	FetchOpeningHours(ctx context.Context, c *SpotCandidate)
+}
+
+// BatchCreateDateSpotsInputPort は1つの都道府県 × ジャンルのスポットを取得して登録するユースケースの入力ポートです。
+type BatchCreateDateSpotsInputPort interface {
+	Execute(context.Context, BatchCreateDateSpotsInput) (*BatchCreateDateSpotsOutput, error)
 }
 
 // BatchCreateDateSpotsInput はバッチ実行の入力パラメータです。
@@ -35,34 +52,71 @@ type BatchCreateDateSpotsInput struct {
 	PrefCode       string
 	GenreID        int
 	GenreName      string
+	// DryRun は取得と照合までを行い、何も書き込みません。
+	DryRun bool
+}
+
+// BatchCreateDateSpotsOutput は1つの組み合わせで取り込んだ結果です。DryRun のときは取り込むはずだった結果です。
+type BatchCreateDateSpotsOutput struct {
+	// Skipped は既存のスポットが十分にあり、取得しなかったことを表します。
+	Skipped bool
+	// NewSpots は新しく登録したスポットです。DryRun のときは ID が入りません。
+	NewSpots []*model.DateSpot
+	// Merged は既存スポットに統合した件数、Pending は管理者の確認待ちにした件数です。
+	Merged  int
+	Pending int
 }
 
 // BatchCreateDateSpotsInteractor はデートスポット自動収集バッチのユースケースです。
+// 取得した候補は SpotMatcher で既存スポットと照合し、同じ場所なら既存スポットに統合、
+// 判断がつかなければ管理者の確認に回し、どれとも違えば新しいスポットとして登録します。
 type BatchCreateDateSpotsInteractor struct {
+	unitOfWork       repository.UnitOfWork
 	repo             repository.DateSpotRepository
+	sourceRepo       repository.SpotSourceRepository
+	matcher          service.SpotMatcher
 	fetcher          SpotFetcher
 	minExistingSpots int
 	spotsPerRun      int
 }
 
 func NewBatchCreateDateSpotsInteractor(
+	unitOfWork repository.UnitOfWork,
 	repo repository.DateSpotRepository,
+	sourceRepo repository.SpotSourceRepository,
+	matcher service.SpotMatcher,
 	fetcher SpotFetcher,
 	minExistingSpots int,
 	spotsPerRun int,
 ) *BatchCreateDateSpotsInteractor {
 	return &BatchCreateDateSpotsInteractor{
+		unitOfWork:       unitOfWork,
 		repo:             repo,
+		sourceRepo:       sourceRepo,
+		matcher:          matcher,
 		fetcher:          fetcher,
 		minExistingSpots: minExistingSpots,
 		spotsPerRun:      spotsPerRun,
 	}
 }
 
-func (i *BatchCreateDateSpotsInteractor) Execute(ctx context.Context, input BatchCreateDateSpotsInput) error {
+// spotImport は取り込み記録と、そのつなぎ先のスポットです。
+// つなぎ先が今回新しく作るスポットのときは、登録するまで ID が決まりません。
+type spotImport struct {
+	source *model.SpotSource
+	target *model.DateSpot
+}
+
+// spotMerge は既存スポットの空いている属性を埋める値です。
+type spotMerge struct {
+	dateSpotID uint
+	from       *model.DateSpot
+}
+
+func (i *BatchCreateDateSpotsInteractor) Execute(ctx context.Context, input BatchCreateDateSpotsInput) (*BatchCreateDateSpotsOutput, error) {
 	count, err := i.repo.CountByPrefectureAndGenre(ctx, input.PrefectureID, input.GenreID)
 	if err != nil {
-		return fmt.Errorf("batch: count spots: %w", err)
+		return nil, fmt.Errorf("batch: count spots: %w", err)
 	}
 	if count >= int64(i.minExistingSpots) {
 		slog.InfoContext(ctx, "batch: skip combination, enough spots exist",
@@ -70,51 +124,175 @@ func (i *BatchCreateDateSpotsInteractor) Execute(ctx context.Context, input Batc
 			"genre_id", input.GenreID,
 			"count", count,
 		)
-		return nil
+		return &BatchCreateDateSpotsOutput{Skipped: true}, nil
 	}
 
 	candidates, err := i.fetcher.FetchSpots(ctx, input.PrefCode, input.PrefectureName, input.GenreID, i.spotsPerRun)
 	if err != nil {
-		return fmt.Errorf("batch: fetch spots prefecture=%s genre=%s: %w",
+		return nil, fmt.Errorf("batch: fetch spots prefecture=%s genre=%s: %w",
 			input.PrefectureName, input.GenreName, err)
 	}
 
-	var newSpots []*model.DateSpot
-	for _, c := range candidates {
-		normalized := NormalizeName(c.Name)
+	imported, err := i.importedKeys(ctx, candidates, input.PrefectureID)
+	if err != nil {
+		return nil, fmt.Errorf("batch: find imported spots: %w", err)
+	}
+	// 照合相手はジャンルをまたいで都道府県内の全スポット。同じ店が別ジャンルで取れることもある
+	known, err := i.repo.FindByPrefecture(ctx, input.PrefectureID)
+	if err != nil {
+		return nil, fmt.Errorf("batch: find spots in prefecture: %w", err)
+	}
 
-		exists, err := i.repo.ExistsByNormalizedNameAndPrefecture(ctx, normalized, input.PrefectureID)
-		if err != nil {
-			slog.ErrorContext(ctx, "batch: check existence failed, skipping", "name", c.Name, "err", err)
-			continue
-		}
-		if exists {
-			slog.InfoContext(ctx, "batch: skip duplicate spot", "name", c.Name)
+	now := time.Now()
+	var (
+		newSpots []*model.DateSpot
+		imports  []spotImport
+		merges   []spotMerge
+		pending  int
+	)
+	for _, c := range candidates {
+		source := buildSpotSource(c, input)
+		key := candidateImportKey(c)
+		if imported[key] {
+			slog.InfoContext(ctx, "batch: skip imported spot", "name", c.Name, "external_id", c.ExternalID)
 			continue
 		}
+		imported[key] = true
 
-		spot := i.buildDateSpot(c, input, normalized)
-		newSpots = append(newSpots, spot)
+		spot := i.buildDateSpot(c, input, NormalizeName(c.Name))
+		match := i.matcher.Match(spot, known)
+		source.MatchScore = match.Score
+
+		switch match.Decision {
+		case service.SpotMatchMerge:
+			slog.InfoContext(ctx, "batch: merge into existing spot", "name", c.Name, "date_spot", match.Spot.Name, "score", match.Score)
+			if match.Spot.ID == 0 {
+				fillMissing(match.Spot, spot)
+			} else {
+				merges = append(merges, spotMerge{dateSpotID: match.Spot.ID, from: spot})
+			}
+			imports = append(imports, spotImport{source: source, target: match.Spot})
+		case service.SpotMatchReview:
+			slog.InfoContext(ctx, "batch: hold spot for review", "name", c.Name, "date_spot", match.Spot.Name, "score", match.Score)
+			source.Status = model.SpotSourceStatusPending
+			imports = append(imports, spotImport{source: source, target: match.Spot})
+			pending++
+		default:
+			i.fetcher.FetchOpeningHours(ctx, &c)
+			spot.OpeningHours, spot.Closures = c.OpeningHours, c.Closures
+			source.MatchScore = 1
+			spot.LastSeenAt = &now
+			newSpots = append(newSpots, spot)
+			// 今回登録するスポットも照合相手に加え、複数の取得元が同じ店を返したときに二重に作らない
+			known = append(known, spot)
+			imports = append(imports, spotImport{source: source, target: spot})
+		}
 	}
 
-	if len(newSpots) == 0 {
+	output := &BatchCreateDateSpotsOutput{
+		NewSpots: newSpots,
+		Merged:   len(imports) - len(newSpots) - pending,
+		Pending:  pending,
+	}
+	if len(imports) == 0 {
 		slog.InfoContext(ctx, "batch: no new spots to create",
 			"prefecture_id", input.PrefectureID,
 			"genre_id", input.GenreID,
 		)
-		return nil
+		return output, nil
+	}
+	if input.DryRun {
+		return output, nil
 	}
 
-	if err := i.repo.CreateBatch(ctx, newSpots); err != nil {
-		return fmt.Errorf("batch: create batch: %w", err)
+	err = i.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := i.repo.CreateBatch(ctx, newSpots); err != nil {
+			return err
+		}
+		for _, m := range merges {
+			if err := i.repo.FillMissing(ctx, m.dateSpotID, m.from); err != nil {
+				return err
+			}
+		}
+		sources := make([]*model.SpotSource, 0, len(imports))
+		for _, im := range imports {
+			im.source.DateSpotID = im.target.ID
+			sources = append(sources, im.source)
+		}
+		return i.sourceRepo.CreateBatch(ctx, sources)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("batch: save spots: %w", err)
 	}
 
-	slog.InfoContext(ctx, "batch: created spots",
+	slog.InfoContext(ctx, "batch: imported spots",
 		"prefecture_id", input.PrefectureID,
 		"genre_id", input.GenreID,
-		"count", len(newSpots),
+		"created", len(output.NewSpots),
+		"merged", output.Merged,
+		"pending", output.Pending,
 	)
-	return nil
+	return output, nil
+}
+
+// importedKeys は candidates のうち取り込み済みのものを candidateImportKey の集合で返します。
+// 取得元の ID を持つ候補は ID で、持たない候補は同じ都道府県で取り込んだ記録の名前で見分けます。
+func (i *BatchCreateDateSpotsInteractor) importedKeys(ctx context.Context, candidates []SpotCandidate, prefectureID int) (map[string]bool, error) {
+	bySource := map[model.DateSpotSource][]string{}
+	namedSources := map[model.DateSpotSource]bool{}
+	for _, c := range candidates {
+		source := candidateSource(c)
+		if c.ExternalID != "" {
+			bySource[source] = append(bySource[source], c.ExternalID)
+		} else {
+			namedSources[source] = true
+		}
+	}
+
+	imported := map[string]bool{}
+	for source, ids := range bySource {
+		found, err := i.sourceRepo.FindImportedExternalIDs(ctx, source, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range found {
+			imported[importKey(source, id)] = true
+		}
+	}
+	for source := range namedSources {
+		names, err := i.sourceRepo.FindImportedNames(ctx, source, prefectureID)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			imported[importNameKey(source, NormalizeName(name))] = true
+		}
+	}
+	return imported, nil
+}
+
+func importKey(source model.DateSpotSource, externalID string) string {
+	return string(source) + ":" + externalID
+}
+
+// importNameKey は取得元の ID を持たない候補の取り込み済みを見分けるキーです。取得元の ID と重ならないよう区切りを変えます。
+func importNameKey(source model.DateSpotSource, normalizedName string) string {
+	return string(source) + "#" + normalizedName
+}
+
+// candidateImportKey は c が取り込み済みかを見分けるキーです。
+func candidateImportKey(c SpotCandidate) string {
+	if c.ExternalID != "" {
+		return importKey(candidateSource(c), c.ExternalID)
+	}
+	return importNameKey(candidateSource(c), NormalizeName(c.Name))
+}
+
+func candidateSource(c SpotCandidate) model.DateSpotSource {
+	if c.Source == "" {
+		return model.DateSpotSourceHotPepper
+	}
+	return c.Source
 }
 
 func (i *BatchCreateDateSpotsInteractor) buildDateSpot(
@@ -131,24 +309,65 @@ func (i *BatchCreateDateSpotsInteractor) buildDateSpot(
 		mapsURL = &u
 	}
 
-	// スポットはすべて HotPepper グルメ API から取得する
-	source := model.DateSpotSourceHotPepper
-
 	spot := &model.DateSpot{
 		Name:           c.Name,
 		CityName:       c.CityName,
 		GenreID:        &input.GenreID,
 		PrefectureID:   &input.PrefectureID,
-		Source:         source,
+		Source:         candidateSource(c),
 		MapsURL:        mapsURL,
 		NormalizedName: normalized,
 		Image:          c.ImageURL,
 		Latitude:       c.Latitude,
 		Longitude:      c.Longitude,
+		OpeningHours:   c.OpeningHours,
+		Closures:       c.Closures,
 	}
 	return spot
 }
 
+// buildSpotSource は取得結果をそのまま取り込み記録にします。つなぎ先と一致度は照合のあとで決めます。
+func buildSpotSource(c SpotCandidate, input BatchCreateDateSpotsInput) *model.SpotSource {
+	source := &model.SpotSource{
+		GenreID:      input.GenreID,
+		PrefectureID: input.PrefectureID,
+		Source:       candidateSource(c),
+		Name:         c.Name,
+		CityName:     c.CityName,
+		Latitude:     c.Latitude,
+		Longitude:    c.Longitude,
+		Image:        c.ImageURL,
+		Status:       model.SpotSourceStatusLinked,
+	}
+	if c.ExternalID != "" {
+		source.ExternalID = &c.ExternalID
+	}
+	if c.PageURL != "" {
+		source.PageURL = &c.PageURL
+	}
+	return source
+}
+
+// fillMissing は spot の空いている属性を from の値で埋めます。
+// 永続化前のスポットどうしを統合するときに使い、登録済みのスポットは DateSpotRepository.FillMissing で埋めます。
+func fillMissing(spot, from *model.DateSpot) {
+	if spot.Image == nil {
+		spot.Image = from.Image
+	}
+	if spot.Latitude == nil || spot.Longitude == nil {
+		spot.Latitude, spot.Longitude = from.Latitude, from.Longitude
+	}
+	if spot.MapsURL == nil {
+		spot.MapsURL = from.MapsURL
+	}
+	if len(spot.OpeningHours) == 0 {
+		spot.OpeningHours = from.OpeningHours
+	}
+	if len(spot.Closures) == 0 {
+		spot.Closures = from.Closures
+	}
+}
+
 // NormalizeName は重複チェック用に名前を正規化します（全角→半角・スペース除去・小文字化）。
 func NormalizeName(name string) string {
 	normalized := norm.NFKC.String(name)
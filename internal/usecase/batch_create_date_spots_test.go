@@ -5,8 +5,11 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	repomock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -14,27 +17,41 @@ import (
 
 func TestBatchCreateDateSpotsInteractor_Execute(t *testing.T) {
 	ctx := context.Background()
+	input := usecase.BatchCreateDateSpotsInput{
+		PrefectureID:   13,
+		PrefectureName: "東京都",
+		PrefCode:       "13",
+		GenreID:        3,
+		GenreName:      "カフェ・スイーツ",
+	}
+
+	newInteractor := func(ctrl *gomock.Controller, repo *repomock.MockDateSpotRepository, sourceRepo *repomock.MockSpotSourceRepository, fetcher usecase.SpotFetcher) *usecase.BatchCreateDateSpotsInteractor {
+		return usecase.NewBatchCreateDateSpotsInteractor(
+			newPassThroughUnitOfWork(ctrl),
+			repo,
+			sourceRepo,
+			service.NewSpotMatcher(),
+			fetcher,
+			5,
+			3,
+		)
+	}
 
 	t.Run("success_skips_when_enough_spots_exist", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		mockRepo := repomock.NewMockDateSpotRepository(ctrl)
+		mockSourceRepo := repomock.NewMockSpotSourceRepository(ctrl)
 		mockFetcher := &mockSpotFetcher{}
 
 		mockRepo.EXPECT().
 			CountByPrefectureAndGenre(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(int64(5), nil).AnyTimes()
 
-		interactor := usecase.NewBatchCreateDateSpotsInteractor(mockRepo, mockFetcher, 5, 3)
-		err := interactor.Execute(ctx, usecase.BatchCreateDateSpotsInput{
-			PrefectureID:   13,
-			PrefectureName: "東京都",
-			PrefCode:       "13",
-			GenreID:        1,
-			GenreName:      "ショッピングモール",
-		})
+		got, err := newInteractor(ctrl, mockRepo, mockSourceRepo, mockFetcher).Execute(ctx, input)
 		require.NoError(t, err)
+		assert.True(t, got.Skipped)
 	})
 
 	t.Run("success_creates_new_spots", func(t *testing.T) {
@@ -42,131 +59,263 @@ func TestBatchCreateDateSpotsInteractor_Execute(t *testing.T) {
 		defer ctrl.Finish()
 
 		mockRepo := repomock.NewMockDateSpotRepository(ctrl)
+		mockSourceRepo := repomock.NewMockSpotSourceRepository(ctrl)
 		mockFetcher := &mockSpotFetcher{
 			candidates: []usecase.SpotCandidate{
-				{Name: "新宿マルイ", CityName: "新宿区"},
-				{Name: "渋谷ヒカリエ", CityName: "渋谷区"},
+				{ExternalID: "J001", Name: "新宿マルイ", CityName: "新宿区", Source: model.DateSpotSourceHotPepper},
+				{ExternalID: "J002", Name: "渋谷ヒカリエ", CityName: "渋谷区", Source: model.DateSpotSourceHotPepper},
 			},
 		}
 
-		mockRepo.EXPECT().
-			CountByPrefectureAndGenre(gomock.Any(), 13, 1).
-			Return(int64(0), nil)
-		mockRepo.EXPECT().
-			ExistsByNormalizedNameAndPrefecture(gomock.Any(), gomock.Any(), 13).
-			Return(false, nil).Times(2)
+		mockRepo.EXPECT().CountByPrefectureAndGenre(gomock.Any(), 13, 3).Return(int64(0), nil)
+		mockSourceRepo.EXPECT().
+			FindImportedExternalIDs(gomock.Any(), model.DateSpotSourceHotPepper, []string{"J001", "J002"}).
+			Return([]string{}, nil)
+		mockRepo.EXPECT().FindByPrefecture(gomock.Any(), 13).Return(nil, nil)
 		mockRepo.EXPECT().
 			CreateBatch(gomock.Any(), gomock.Len(2)).
-			Return(nil)
-
-		interactor := usecase.NewBatchCreateDateSpotsInteractor(mockRepo, mockFetcher, 5, 3)
-		err := interactor.Execute(ctx, usecase.BatchCreateDateSpotsInput{
-			PrefectureID:   13,
-			PrefectureName: "東京都",
-			PrefCode:       "13",
-			GenreID:        1,
-			GenreName:      "ショッピングモール",
-		})
+			DoAndReturn(func(_ context.Context, spots []*model.DateSpot) error {
+				for n, s := range spots {
+					assert.Len(t, s.OpeningHours, 1)
+					s.ID = uint(100 + n)
+				}
+				return nil
+			})
+		mockSourceRepo.EXPECT().
+			CreateBatch(gomock.Any(), gomock.Len(2)).
+			DoAndReturn(func(_ context.Context, sources []*model.SpotSource) error {
+				assert.Equal(t, uint(100), sources[0].DateSpotID)
+				assert.Equal(t, uint(101), sources[1].DateSpotID)
+				assert.Equal(t, "J001", *sources[0].ExternalID)
+				assert.Equal(t, model.SpotSourceStatusLinked, sources[0].Status)
+				return nil
+			})
+
+		_, err := newInteractor(ctrl, mockRepo, mockSourceRepo, mockFetcher).Execute(ctx, input)
 		require.NoError(t, err)
+		assert.Equal(t, []string{"新宿マルイ", "渋谷ヒカリエ"}, mockFetcher.hoursFetched)
 	})
 
-	t.Run("success_skips_duplicate_spots", func(t *testing.T) {
+	t.Run("success_merges_name_variant_into_existing_spot", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		mockRepo := repomock.NewMockDateSpotRepository(ctrl)
+		mockSourceRepo := repomock.NewMockSpotSourceRepository(ctrl)
+		image := "https://example.com/starbucks.jpg"
 		mockFetcher := &mockSpotFetcher{
 			candidates: []usecase.SpotCandidate{
-				{Name: "新宿マルイ", CityName: "新宿区"},
-				{Name: "渋谷ヒカリエ", CityName: "渋谷区"},
+				{
+					ExternalID: "J003", Name: "スターバックスコーヒー渋谷店", CityName: "東京都渋谷区道玄坂1-1",
+					Latitude: lo.ToPtr(35.6596), Longitude: lo.ToPtr(139.7006), ImageURL: &image,
+				},
 			},
 		}
 
+		mockRepo.EXPECT().CountByPrefectureAndGenre(gomock.Any(), 13, 3).Return(int64(0), nil)
+		mockSourceRepo.EXPECT().FindImportedExternalIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{}, nil)
+		mockRepo.EXPECT().FindByPrefecture(gomock.Any(), 13).Return([]*model.DateSpot{
+			{ID: 7, Name: "スターバックス 渋谷店", CityName: "渋谷区", Latitude: lo.ToPtr(35.6595), Longitude: lo.ToPtr(139.7005)},
+		}, nil)
+		mockRepo.EXPECT().CreateBatch(gomock.Any(), gomock.Len(0)).Return(nil)
 		mockRepo.EXPECT().
-			CountByPrefectureAndGenre(gomock.Any(), 13, 1).
-			Return(int64(0), nil)
-		gomock.InOrder(
-			mockRepo.EXPECT().ExistsByNormalizedNameAndPrefecture(gomock.Any(), gomock.Any(), 13).Return(true, nil),
-			mockRepo.EXPECT().ExistsByNormalizedNameAndPrefecture(gomock.Any(), gomock.Any(), 13).Return(false, nil),
-		)
-		mockRepo.EXPECT().
+			FillMissing(gomock.Any(), uint(7), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint, from *model.DateSpot) error {
+				assert.Equal(t, &image, from.Image)
+				return nil
+			})
+		mockSourceRepo.EXPECT().
 			CreateBatch(gomock.Any(), gomock.Len(1)).
-			Return(nil)
-
-		interactor := usecase.NewBatchCreateDateSpotsInteractor(mockRepo, mockFetcher, 5, 3)
-		err := interactor.Execute(ctx, usecase.BatchCreateDateSpotsInput{
-			PrefectureID:   13,
-			PrefectureName: "東京都",
-			PrefCode:       "13",
-			GenreID:        1,
-			GenreName:      "ショッピングモール",
-		})
+			DoAndReturn(func(_ context.Context, sources []*model.SpotSource) error {
+				assert.Equal(t, uint(7), sources[0].DateSpotID)
+				assert.Equal(t, model.SpotSourceStatusLinked, sources[0].Status)
+				assert.GreaterOrEqual(t, sources[0].MatchScore, service.SpotMatchMergeScore)
+				return nil
+			})
+
+		_, err := newInteractor(ctrl, mockRepo, mockSourceRepo, mockFetcher).Execute(ctx, input)
 		require.NoError(t, err)
+		// 既存スポットに統合する候補の営業時間は取りに行かない
+		assert.Empty(t, mockFetcher.hoursFetched)
 	})
 
-	t.Run("error_fetcher_returns_error", func(t *testing.T) {
+	t.Run("success_holds_low_confidence_match_for_review", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		mockRepo := repomock.NewMockDateSpotRepository(ctrl)
+		mockSourceRepo := repomock.NewMockSpotSourceRepository(ctrl)
 		mockFetcher := &mockSpotFetcher{
-			err: errors.New("api error"),
+			candidates: []usecase.SpotCandidate{
+				{ExternalID: "J004", Name: "スターバックスコーヒー渋谷店", CityName: "東京都渋谷区道玄坂1-1"},
+			},
 		}
 
-		mockRepo.EXPECT().
-			CountByPrefectureAndGenre(gomock.Any(), 13, 1).
-			Return(int64(0), nil)
-
-		interactor := usecase.NewBatchCreateDateSpotsInteractor(mockRepo, mockFetcher, 5, 3)
-		err := interactor.Execute(ctx, usecase.BatchCreateDateSpotsInput{
-			PrefectureID:   13,
-			PrefectureName: "東京都",
-			PrefCode:       "13",
-			GenreID:        1,
-			GenreName:      "ショッピングモール",
-		})
-		assert.Error(t, err)
+		mockRepo.EXPECT().CountByPrefectureAndGenre(gomock.Any(), 13, 3).Return(int64(0), nil)
+		mockSourceRepo.EXPECT().FindImportedExternalIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{}, nil)
+		mockRepo.EXPECT().FindByPrefecture(gomock.Any(), 13).Return([]*model.DateSpot{
+			{ID: 7, Name: "スターバックス 渋谷店", CityName: "渋谷区"},
+		}, nil)
+		mockRepo.EXPECT().CreateBatch(gomock.Any(), gomock.Len(0)).Return(nil)
+		mockSourceRepo.EXPECT().
+			CreateBatch(gomock.Any(), gomock.Len(1)).
+			DoAndReturn(func(_ context.Context, sources []*model.SpotSource) error {
+				assert.Equal(t, uint(7), sources[0].DateSpotID)
+				assert.Equal(t, model.SpotSourceStatusPending, sources[0].Status)
+				return nil
+			})
+
+		_, err := newInteractor(ctrl, mockRepo, mockSourceRepo, mockFetcher).Execute(ctx, input)
+		require.NoError(t, err)
 	})
 
-	t.Run("success_no_candidates_after_filtering", func(t *testing.T) {
+	t.Run("success_merges_duplicates_within_one_fetch", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		mockRepo := repomock.NewMockDateSpotRepository(ctrl)
+		mockSourceRepo := repomock.NewMockSpotSourceRepository(ctrl)
 		mockFetcher := &mockSpotFetcher{
 			candidates: []usecase.SpotCandidate{
-				{Name: "既存スポット", CityName: "新宿区"},
+				{ExternalID: "J005", Name: "渋谷ヒカリエ", CityName: "東京都渋谷区渋谷2-21-1", Source: model.DateSpotSourceHotPepper},
+				{Name: "渋谷ヒカリエ", CityName: "渋谷区", Source: model.DateSpotSourceGemini, Latitude: lo.ToPtr(35.659), Longitude: lo.ToPtr(139.703)},
 			},
 		}
 
+		mockRepo.EXPECT().CountByPrefectureAndGenre(gomock.Any(), 13, 3).Return(int64(0), nil)
+		mockSourceRepo.EXPECT().FindImportedExternalIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{}, nil)
+		mockSourceRepo.EXPECT().FindImportedNames(gomock.Any(), model.DateSpotSourceGemini, 13).Return([]string{}, nil)
+		mockRepo.EXPECT().FindByPrefecture(gomock.Any(), 13).Return(nil, nil)
 		mockRepo.EXPECT().
-			CountByPrefectureAndGenre(gomock.Any(), 13, 1).
-			Return(int64(0), nil)
-		mockRepo.EXPECT().
-			ExistsByNormalizedNameAndPrefecture(gomock.Any(), gomock.Any(), 13).
-			Return(true, nil)
-
-		interactor := usecase.NewBatchCreateDateSpotsInteractor(mockRepo, mockFetcher, 5, 3)
-		err := interactor.Execute(ctx, usecase.BatchCreateDateSpotsInput{
-			PrefectureID:   13,
-			PrefectureName: "東京都",
-			PrefCode:       "13",
-			GenreID:        1,
-			GenreName:      "ショッピングモール",
-		})
+			CreateBatch(gomock.Any(), gomock.Len(1)).
+			DoAndReturn(func(_ context.Context, spots []*model.DateSpot) error {
+				// 座標は後から来た取得元の分で埋まる
+				assert.NotNil(t, spots[0].Latitude)
+				spots[0].ID = 100
+				return nil
+			})
+		mockSourceRepo.EXPECT().
+			CreateBatch(gomock.Any(), gomock.Len(2)).
+			DoAndReturn(func(_ context.Context, sources []*model.SpotSource) error {
+				assert.Equal(t, uint(100), sources[0].DateSpotID)
+				assert.Equal(t, uint(100), sources[1].DateSpotID)
+				assert.Equal(t, model.DateSpotSourceGemini, sources[1].Source)
+				return nil
+			})
+
+		_, err := newInteractor(ctrl, mockRepo, mockSourceRepo, mockFetcher).Execute(ctx, input)
+		require.NoError(t, err)
+	})
+
+	t.Run("success_skips_already_imported_spots", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := repomock.NewMockDateSpotRepository(ctrl)
+		mockSourceRepo := repomock.NewMockSpotSourceRepository(ctrl)
+		mockFetcher := &mockSpotFetcher{
+			candidates: []usecase.SpotCandidate{
+				{ExternalID: "J001", Name: "既存スポット", CityName: "新宿区"},
+			},
+		}
+
+		mockRepo.EXPECT().CountByPrefectureAndGenre(gomock.Any(), 13, 3).Return(int64(0), nil)
+		mockSourceRepo.EXPECT().
+			FindImportedExternalIDs(gomock.Any(), model.DateSpotSourceHotPepper, []string{"J001"}).
+			Return([]string{"J001"}, nil)
+		mockRepo.EXPECT().FindByPrefecture(gomock.Any(), 13).Return(nil, nil)
+
+		_, err := newInteractor(ctrl, mockRepo, mockSourceRepo, mockFetcher).Execute(ctx, input)
 		require.NoError(t, err)
+		assert.Empty(t, mockFetcher.hoursFetched)
+	})
+
+	// 取得元の ID を持たない取得元は、同じ都道府県で取り込んだ記録と正規化した名前が同じなら取り込み済みとみなす
+	t.Run("success_skips_spots_imported_by_name", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := repomock.NewMockDateSpotRepository(ctrl)
+		mockSourceRepo := repomock.NewMockSpotSourceRepository(ctrl)
+		mockFetcher := &mockSpotFetcher{
+			candidates: []usecase.SpotCandidate{
+				{Name: "渋谷 ヒカリエ", CityName: "渋谷区", Source: model.DateSpotSourceGemini},
+			},
+		}
+
+		mockRepo.EXPECT().CountByPrefectureAndGenre(gomock.Any(), 13, 3).Return(int64(0), nil)
+		mockSourceRepo.EXPECT().FindImportedNames(gomock.Any(), model.DateSpotSourceGemini, 13).Return([]string{"渋谷ヒカリエ"}, nil)
+		mockRepo.EXPECT().FindByPrefecture(gomock.Any(), 13).Return(nil, nil)
+
+		got, err := newInteractor(ctrl, mockRepo, mockSourceRepo, mockFetcher).Execute(ctx, input)
+		require.NoError(t, err)
+		assert.Empty(t, got.NewSpots)
+		assert.Empty(t, mockFetcher.hoursFetched)
+	})
+
+	// ドライランは照合まで行い、登録するはずのスポットを返すだけで何も書き込まない
+	t.Run("success_dry_run_writes_nothing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := repomock.NewMockDateSpotRepository(ctrl)
+		mockSourceRepo := repomock.NewMockSpotSourceRepository(ctrl)
+		mockFetcher := &mockSpotFetcher{
+			candidates: []usecase.SpotCandidate{
+				{ExternalID: "J001", Name: "新宿マルイ", CityName: "新宿区"},
+				{ExternalID: "J002", Name: "スターバックスコーヒー渋谷店", CityName: "東京都渋谷区道玄坂1-1"},
+			},
+		}
+
+		mockRepo.EXPECT().CountByPrefectureAndGenre(gomock.Any(), 13, 3).Return(int64(0), nil)
+		mockSourceRepo.EXPECT().FindImportedExternalIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{}, nil)
+		mockRepo.EXPECT().FindByPrefecture(gomock.Any(), 13).Return([]*model.DateSpot{
+			{ID: 7, Name: "スターバックス 渋谷店", CityName: "渋谷区"},
+		}, nil)
+
+		dryRun := input
+		dryRun.DryRun = true
+		got, err := newInteractor(ctrl, mockRepo, mockSourceRepo, mockFetcher).Execute(ctx, dryRun)
+		require.NoError(t, err)
+		require.Len(t, got.NewSpots, 1)
+		assert.Equal(t, "新宿マルイ", got.NewSpots[0].Name)
+		assert.Equal(t, 1, got.Pending)
+		assert.Zero(t, got.Merged)
+	})
+
+	t.Run("error_fetcher_returns_error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := repomock.NewMockDateSpotRepository(ctrl)
+		mockSourceRepo := repomock.NewMockSpotSourceRepository(ctrl)
+		mockFetcher := &mockSpotFetcher{
+			err: errors.New("api error"),
+		}
+
+		mockRepo.EXPECT().CountByPrefectureAndGenre(gomock.Any(), 13, 3).Return(int64(0), nil)
+
+		_, err := newInteractor(ctrl, mockRepo, mockSourceRepo, mockFetcher).Execute(ctx, input)
+		assert.Error(t, err)
 	})
 }
 
 type mockSpotFetcher struct {
 	candidates []usecase.SpotCandidate
 	err        error
+	// hoursFetched は営業時間を取得した候補の名前です。
+	hoursFetched []string
 }
 
 func (m *mockSpotFetcher) FetchSpots(_ context.Context, _, _ string, _ int, _ int) ([]usecase.SpotCandidate, error) {
 	return m.candidates, m.err
 }
 
+func (m *mockSpotFetcher) FetchOpeningHours(_ context.Context, c *usecase.SpotCandidate) {
+	m.hoursFetched = append(m.hoursFetched, c.Name)
+	c.OpeningHours = []*model.DateSpotOpeningHour{{Weekday: 1, OpenMinute: 600, CloseMinute: 1200}}
+}
+
 func TestNormalizeName(t *testing.T) {
 	tests := []struct {
 		input    string
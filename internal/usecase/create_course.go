@@ -2,10 +2,17 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/samber/lo"
 )
 
 type CreateCourseInputPort interface {
@@ -13,28 +20,89 @@ type CreateCourseInputPort interface {
 }
 
 type CreateCourseInput struct {
-	UserID      uint
-	DateSpotIDs []uint
-	TravelMode  string
-	Authority   string
+	UserID uint
+	// Stops はコースの立ち寄り先です。スライスの並びがそのまま訪問順になります。
+	Stops      []CourseStopInput
+	TravelMode string
+	Authority  string
 }
 
-func (i *CreateCourseInput) Validate() error {
+// CourseStopInput はコースの立ち寄り先1件分の入力です。
+// 到着予定時刻・滞在時間・メモは任意項目のため、未入力は nil で表します。
+type CourseStopInput struct {
+	DateSpotID  uint
+	ArrivalTime *string
+	StayMinutes *int
+	Memo        *string
+}
+
+// arrivalTimeRegex は到着予定時刻の形式（"HH:MM" の24時間表記）です。
+var arrivalTimeRegex = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+const (
+	maxStayMinutes = 24 * 60
+	maxStopMemoLen = 500
+)
+
+// validateCourseForm はコースの作成・編集で共通の入力（立ち寄り先・移動手段・公開設定）を検証します。
+func validateCourseForm(stops []CourseStopInput, travelMode, authority string) []string {
 	var errs []string
-	if i.UserID == 0 {
-		errs = append(errs, "ユーザーIDを入力してください")
-	}
-	if len(i.DateSpotIDs) == 0 {
+	if len(stops) == 0 {
 		errs = append(errs, "デートスポットを1件以上入力してください")
 	}
+	errs = append(errs, validateCourseStops(stops)...)
 	validTravelModes := map[string]bool{"DRIVING": true, "WALKING": true}
-	if !validTravelModes[i.TravelMode] {
+	if !validTravelModes[travelMode] {
 		errs = append(errs, "移動手段はDRIVINGまたはWALKINGを指定してください")
 	}
 	validAuthorities := map[string]bool{"公開": true, "非公開": true}
-	if !validAuthorities[i.Authority] {
+	if !validAuthorities[authority] {
 		errs = append(errs, "公開設定は公開または非公開を指定してください")
 	}
+	return errs
+}
+
+// validateCourseStops は立ち寄り先ごとの任意項目を検証します。
+// どの立ち寄り先の入力が誤っているか分かるよう、メッセージには訪問順を含めます。
+func validateCourseStops(stops []CourseStopInput) []string {
+	var errs []string
+	for idx, stop := range stops {
+		position := idx + 1
+		if stop.ArrivalTime != nil && !arrivalTimeRegex.MatchString(*stop.ArrivalTime) {
+			errs = append(errs, fmt.Sprintf("%d件目の到着予定時刻はHH:MM形式で入力してください", position))
+		}
+		if stop.StayMinutes != nil && (*stop.StayMinutes < 1 || *stop.StayMinutes > maxStayMinutes) {
+			errs = append(errs, fmt.Sprintf("%d件目の滞在時間は1分以上%d分以内で入力してください", position, maxStayMinutes))
+		}
+		if stop.Memo != nil && utf8.RuneCountInString(*stop.Memo) > maxStopMemoLen {
+			errs = append(errs, fmt.Sprintf("%d件目のメモは%d文字以内で入力してください", position, maxStopMemoLen))
+		}
+	}
+	return errs
+}
+
+// newDuringSpots は立ち寄り先の入力から、訪問順（1 始まり）を振った DuringSpot を組み立てます。
+func newDuringSpots(courseID uint, stops []CourseStopInput) []*model.DuringSpot {
+	duringSpots := make([]*model.DuringSpot, 0, len(stops))
+	for idx, stop := range stops {
+		duringSpots = append(duringSpots, &model.DuringSpot{
+			CourseID:    courseID,
+			DateSpotID:  stop.DateSpotID,
+			Position:    idx + 1,
+			ArrivalTime: stop.ArrivalTime,
+			StayMinutes: stop.StayMinutes,
+			Memo:        stop.Memo,
+		})
+	}
+	return duringSpots
+}
+
+func (i *CreateCourseInput) Validate() error {
+	var errs []string
+	if i.UserID == 0 {
+		errs = append(errs, "ユーザーIDを入力してください")
+	}
+	errs = append(errs, validateCourseForm(i.Stops, i.TravelMode, i.Authority)...)
 	if len(errs) > 0 {
 		return apperror.UnprocessableEntity(errs...)
 	}
@@ -46,17 +114,26 @@ type CreateCourseOutput struct {
 }
 
 type CreateCourseInteractor struct {
+	UnitOfWork           repository.UnitOfWork
 	CourseRepository     repository.CourseRepository
 	DuringSpotRepository repository.DuringSpotRepository
+	DateSpotRepository   repository.DateSpotRepository
+	EventPublisher       event.Publisher
 }
 
 func NewCreateCourseUsecase(
+	unitOfWork repository.UnitOfWork,
 	courseRepo repository.CourseRepository,
 	duringSpotRepo repository.DuringSpotRepository,
+	dateSpotRepo repository.DateSpotRepository,
+	eventPublisher event.Publisher,
 ) CreateCourseInputPort {
 	return &CreateCourseInteractor{
+		UnitOfWork:           unitOfWork,
 		CourseRepository:     courseRepo,
 		DuringSpotRepository: duringSpotRepo,
+		DateSpotRepository:   dateSpotRepo,
+		EventPublisher:       eventPublisher,
 	}
 }
 
@@ -64,22 +141,57 @@ func (i *CreateCourseInteractor) Execute(ctx context.Context, input CreateCourse
 	if err := input.Validate(); err != nil {
 		return nil, err
 	}
+
 	course := &model.Course{
 		UserID:     input.UserID,
 		TravelMode: input.TravelMode,
 		Authority:  model.CourseAuthority(input.Authority),
 	}
-	if err := i.CourseRepository.Create(ctx, course); err != nil {
-		return nil, apperror.InternalServerError(err)
-	}
-	for _, dateSpotID := range input.DateSpotIDs {
-		duringSpot := &model.DuringSpot{
-			CourseID:   course.ID,
-			DateSpotID: dateSpotID,
+	// 立ち寄り先の登録が1件でも失敗したら、コースごとなかったことにする
+	err := i.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := ensureDateSpotsExist(ctx, i.DateSpotRepository, courseStopDateSpotIDs(input.Stops)); err != nil {
+			return err
 		}
-		if err := i.DuringSpotRepository.Create(ctx, duringSpot); err != nil {
-			return nil, apperror.InternalServerError(err)
+		if err := i.CourseRepository.Create(ctx, course); err != nil {
+			return apperror.InternalServerError(err)
 		}
+		for _, duringSpot := range newDuringSpots(course.ID, input.Stops) {
+			if err := i.DuringSpotRepository.Create(ctx, duringSpot); err != nil {
+				return apperror.InternalServerError(err)
+			}
+		}
+		if err := i.EventPublisher.Publish(ctx, event.CourseCreated{
+			CourseID:  course.ID,
+			UserID:    course.UserID,
+			Authority: course.Authority,
+		}); err != nil {
+			return apperror.InternalServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	return &CreateCourseOutput{CourseID: course.ID}, nil
 }
+
+// courseStopDateSpotIDs は立ち寄り先が参照するデートスポットの ID を訪問順に返します。
+func courseStopDateSpotIDs(stops []CourseStopInput) []uint {
+	return lo.Map(stops, func(stop CourseStopInput, _ int) uint { return stop.DateSpotID })
+}
+
+// ensureDateSpotsExist は ids がすべて実在するデートスポットかを確認します。
+// 存在しない ID があれば、どれが誤っているか分かるよう ID を列挙した 422 を返します。
+func ensureDateSpotsExist(ctx context.Context, dateSpotRepo repository.DateSpotRepository, ids []uint) error {
+	ids = lo.Uniq(ids)
+	existing, err := dateSpotRepo.FindExistingIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	missing := lo.Without(ids, existing...)
+	if len(missing) == 0 {
+		return nil
+	}
+	missingStrs := lo.Map(missing, func(id uint, _ int) string { return strconv.FormatUint(uint64(id), 10) })
+	return apperror.UnprocessableEntity(fmt.Sprintf("存在しないデートスポットが指定されています（ID: %s）", strings.Join(missingStrs, ", ")))
+}
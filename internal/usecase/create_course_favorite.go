@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+)
+
+// CreateCourseFavoriteInputPort はコースをお気に入りに入れるユースケースの入力ポートです。
+type CreateCourseFavoriteInputPort interface {
+	Execute(context.Context, CreateCourseFavoriteInput) (*CreateCourseFavoriteOutput, error)
+}
+
+type CreateCourseFavoriteInput struct {
+	CourseID uint
+	// UserID はお気に入りに入れるユーザー（トークンの currentUser）の ID です。
+	UserID uint
+}
+
+type CreateCourseFavoriteOutput struct {
+	CourseID  uint
+	Favorites *model.CourseFavoriteSummary
+}
+
+type CreateCourseFavoriteInteractor struct {
+	UnitOfWork               repository.UnitOfWork
+	CourseRepository         repository.CourseRepository
+	CourseFavoriteRepository repository.CourseFavoriteRepository
+	EventPublisher           event.Publisher
+}
+
+func NewCreateCourseFavoriteUsecase(
+	unitOfWork repository.UnitOfWork,
+	courseRepository repository.CourseRepository,
+	courseFavoriteRepository repository.CourseFavoriteRepository,
+	eventPublisher event.Publisher,
+) CreateCourseFavoriteInputPort {
+	return &CreateCourseFavoriteInteractor{
+		UnitOfWork:               unitOfWork,
+		CourseRepository:         courseRepository,
+		CourseFavoriteRepository: courseFavoriteRepository,
+		EventPublisher:           eventPublisher,
+	}
+}
+
+func (i *CreateCourseFavoriteInteractor) Execute(ctx context.Context, input CreateCourseFavoriteInput) (*CreateCourseFavoriteOutput, error) {
+	// 他人の非公開コースは存在を隠すため、見つからなかった場合と同じ扱いにする
+	course, err := i.CourseRepository.FindByID(ctx, input.CourseID, input.UserID)
+	if err != nil {
+		return nil, apperror.NotFound()
+	}
+	// 自分のコースはマイページから辿れるため、お気に入りの対象にしない
+	if course.UserID == input.UserID {
+		return nil, apperror.UnprocessableEntity("自分のコースはお気に入りにできません")
+	}
+
+	err = i.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		created, err := i.CourseFavoriteRepository.Create(ctx, &model.CourseFavorite{
+			UserID:   input.UserID,
+			CourseID: course.ID,
+		})
+		if err != nil {
+			return apperror.InternalServerError(err)
+		}
+		// 連打や再送で同じお気に入りが来たときに、作成者へ何度も通知しない
+		if !created {
+			return nil
+		}
+		if err := i.EventPublisher.Publish(ctx, event.CourseFavorited{
+			CourseID:      course.ID,
+			CourseOwnerID: course.UserID,
+			UserID:        input.UserID,
+		}); err != nil {
+			return apperror.InternalServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := attachCourseFavorites(ctx, i.CourseFavoriteRepository, input.UserID, course); err != nil {
+		return nil, err
+	}
+	return &CreateCourseFavoriteOutput{CourseID: course.ID, Favorites: course.Favorites}, nil
+}
@@ -0,0 +1,140 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
+	eventmock "github.com/daisuke-harada/date-courses-go/internal/domain/event/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateCourseFavoriteInteractor_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		courseRepo := repositorymock.NewMockCourseRepository(ctrl)
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
+		publisher := eventmock.NewMockPublisher(ctrl)
+		courseRepo.EXPECT().
+			FindByID(ctx, uint(10), uint(1)).
+			Return(&model.Course{ID: 10, UserID: 2, Authority: model.CourseAuthorityPublic}, nil)
+		favoriteRepo.EXPECT().
+			Create(ctx, &model.CourseFavorite{UserID: 1, CourseID: 10}).
+			Return(true, nil)
+		publisher.EXPECT().
+			Publish(ctx, event.CourseFavorited{CourseID: 10, CourseOwnerID: 2, UserID: 1}).
+			Return(nil)
+		favoriteRepo.EXPECT().
+			SummarizeByCourseIDs(ctx, []uint{10}, uint(1)).
+			Return(map[uint]*model.CourseFavoriteSummary{10: {Count: 5, FavoritedByViewer: true}}, nil)
+
+		interactor := usecase.NewCreateCourseFavoriteUsecase(newPassThroughUnitOfWork(ctrl), courseRepo, favoriteRepo, publisher)
+		output, err := interactor.Execute(ctx, usecase.CreateCourseFavoriteInput{CourseID: 10, UserID: 1})
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(10), output.CourseID)
+		assert.Equal(t, &model.CourseFavoriteSummary{Count: 5, FavoritedByViewer: true}, output.Favorites)
+	})
+
+	// 既にお気に入りに入れていたコースは保存済みの集計を返すだけで、作成者に通知しない
+	t.Run("success_already_favorited_does_not_notify", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		courseRepo := repositorymock.NewMockCourseRepository(ctrl)
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
+		publisher := eventmock.NewMockPublisher(ctrl)
+		courseRepo.EXPECT().
+			FindByID(ctx, uint(10), uint(1)).
+			Return(&model.Course{ID: 10, UserID: 2, Authority: model.CourseAuthorityPublic}, nil)
+		favoriteRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			Return(false, nil)
+		favoriteRepo.EXPECT().
+			SummarizeByCourseIDs(ctx, []uint{10}, uint(1)).
+			Return(map[uint]*model.CourseFavoriteSummary{10: {Count: 5, FavoritedByViewer: true}}, nil)
+
+		interactor := usecase.NewCreateCourseFavoriteUsecase(newPassThroughUnitOfWork(ctrl), courseRepo, favoriteRepo, publisher)
+		output, err := interactor.Execute(ctx, usecase.CreateCourseFavoriteInput{CourseID: 10, UserID: 1})
+
+		require.NoError(t, err)
+		assert.Equal(t, 5, output.Favorites.Count)
+	})
+
+	// 他人の非公開コースは repository が返さないので、存在しないものとして 404 にする
+	t.Run("error_not_found_when_invisible", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		courseRepo := repositorymock.NewMockCourseRepository(ctrl)
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
+		publisher := eventmock.NewMockPublisher(ctrl)
+		courseRepo.EXPECT().
+			FindByID(ctx, uint(10), uint(1)).
+			Return(nil, errors.New("record not found"))
+
+		interactor := usecase.NewCreateCourseFavoriteUsecase(newPassThroughUnitOfWork(ctrl), courseRepo, favoriteRepo, publisher)
+		output, err := interactor.Execute(ctx, usecase.CreateCourseFavoriteInput{CourseID: 10, UserID: 1})
+
+		assert.Nil(t, output)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, statusCode)
+	})
+
+	t.Run("error_own_course", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		courseRepo := repositorymock.NewMockCourseRepository(ctrl)
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
+		publisher := eventmock.NewMockPublisher(ctrl)
+		courseRepo.EXPECT().
+			FindByID(ctx, uint(10), uint(1)).
+			Return(&model.Course{ID: 10, UserID: 1, Authority: model.CourseAuthorityPublic}, nil)
+
+		interactor := usecase.NewCreateCourseFavoriteUsecase(newPassThroughUnitOfWork(ctrl), courseRepo, favoriteRepo, publisher)
+		output, err := interactor.Execute(ctx, usecase.CreateCourseFavoriteInput{CourseID: 10, UserID: 1})
+
+		assert.Nil(t, output)
+		statusCode, messages, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+		assert.Contains(t, messages, "自分のコースはお気に入りにできません")
+	})
+
+	t.Run("error_create_failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		courseRepo := repositorymock.NewMockCourseRepository(ctrl)
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
+		publisher := eventmock.NewMockPublisher(ctrl)
+		courseRepo.EXPECT().
+			FindByID(ctx, uint(10), uint(1)).
+			Return(&model.Course{ID: 10, UserID: 2}, nil)
+		favoriteRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			Return(false, errors.New("db error"))
+
+		interactor := usecase.NewCreateCourseFavoriteUsecase(newPassThroughUnitOfWork(ctrl), courseRepo, favoriteRepo, publisher)
+		output, err := interactor.Execute(ctx, usecase.CreateCourseFavoriteInput{CourseID: 10, UserID: 1})
+
+		assert.Nil(t, output)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusInternalServerError, statusCode)
+	})
+}
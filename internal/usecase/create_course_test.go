@@ -4,17 +4,39 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
+	eventmock "github.com/daisuke-harada/date-courses-go/internal/domain/event/mock"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	repomock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
+// newPassThroughUnitOfWork は fn をそのまま実行する UnitOfWork のモックを返します。
+// トランザクションの張り方は persistence 側の責務なので、usecase のテストでは中で呼ぶリポジトリだけを検証する。
+func newPassThroughUnitOfWork(ctrl *gomock.Controller) *repomock.MockUnitOfWork {
+	uow := repomock.NewMockUnitOfWork(ctrl)
+	uow.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).
+		AnyTimes()
+	return uow
+}
+
+// allDateSpotsExist は FindExistingIDs のモック用で、渡された ID をすべて実在扱いにします。
+func allDateSpotsExist(_ context.Context, ids []uint) ([]uint, error) {
+	return ids, nil
+}
+
 func TestCreateCourseUsecase(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
@@ -22,7 +44,11 @@ func TestCreateCourseUsecase(t *testing.T) {
 
 		mockCourseRepo := repomock.NewMockCourseRepository(ctrl)
 		mockDuringSpotRepo := repomock.NewMockDuringSpotRepository(ctrl)
+		mockDateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
 
+		mockDateSpotRepo.EXPECT().
+			FindExistingIDs(gomock.Any(), gomock.Any()).
+			DoAndReturn(allDateSpotsExist)
 		mockCourseRepo.EXPECT().
 			Create(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, course *model.Course) error {
@@ -30,37 +56,155 @@ func TestCreateCourseUsecase(t *testing.T) {
 				return nil
 			})
 		mockDuringSpotRepo.EXPECT().
-			Create(gomock.Any(), &model.DuringSpot{CourseID: 10, DateSpotID: 1}).
+			Create(gomock.Any(), &model.DuringSpot{CourseID: 10, DateSpotID: 1, Position: 1}).
 			Return(nil)
 		mockDuringSpotRepo.EXPECT().
-			Create(gomock.Any(), &model.DuringSpot{CourseID: 10, DateSpotID: 2}).
+			Create(gomock.Any(), &model.DuringSpot{CourseID: 10, DateSpotID: 2, Position: 2}).
+			Return(nil)
+		publisher := eventmock.NewMockPublisher(ctrl)
+		publisher.EXPECT().
+			Publish(gomock.Any(), event.CourseCreated{CourseID: 10, UserID: 1, Authority: model.CourseAuthorityPublic}).
 			Return(nil)
 
-		uc := usecase.NewCreateCourseUsecase(mockCourseRepo, mockDuringSpotRepo)
+		uc := usecase.NewCreateCourseUsecase(newPassThroughUnitOfWork(ctrl), mockCourseRepo, mockDuringSpotRepo, mockDateSpotRepo, publisher)
 		out, err := uc.Execute(context.Background(), usecase.CreateCourseInput{
-			UserID:      1,
-			DateSpotIDs: []uint{1, 2},
-			TravelMode:  "DRIVING",
-			Authority:   "公開",
+			UserID:     1,
+			Stops:      []usecase.CourseStopInput{{DateSpotID: 1}, {DateSpotID: 2}},
+			TravelMode: "DRIVING",
+			Authority:  "公開",
 		})
 
 		require.NoError(t, err)
 		assert.Equal(t, uint(10), out.CourseID)
 	})
 
+	t.Run("success_persists_stop_details", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCourseRepo := repomock.NewMockCourseRepository(ctrl)
+		mockDuringSpotRepo := repomock.NewMockDuringSpotRepository(ctrl)
+		mockDateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
+
+		mockDateSpotRepo.EXPECT().
+			FindExistingIDs(gomock.Any(), gomock.Any()).
+			DoAndReturn(allDateSpotsExist)
+		mockCourseRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, course *model.Course) error {
+				course.ID = 10
+				return nil
+			})
+		mockDuringSpotRepo.EXPECT().
+			Create(gomock.Any(), &model.DuringSpot{
+				CourseID:    10,
+				DateSpotID:  3,
+				Position:    1,
+				ArrivalTime: lo.ToPtr("10:30"),
+				StayMinutes: lo.ToPtr(90),
+				Memo:        lo.ToPtr("開店直後が空いている"),
+			}).
+			Return(nil)
+		mockDuringSpotRepo.EXPECT().
+			Create(gomock.Any(), &model.DuringSpot{CourseID: 10, DateSpotID: 1, Position: 2}).
+			Return(nil)
+		publisher := eventmock.NewMockPublisher(ctrl)
+		publisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil)
+
+		uc := usecase.NewCreateCourseUsecase(newPassThroughUnitOfWork(ctrl), mockCourseRepo, mockDuringSpotRepo, mockDateSpotRepo, publisher)
+		_, err := uc.Execute(context.Background(), usecase.CreateCourseInput{
+			UserID: 1,
+			Stops: []usecase.CourseStopInput{
+				{DateSpotID: 3, ArrivalTime: lo.ToPtr("10:30"), StayMinutes: lo.ToPtr(90), Memo: lo.ToPtr("開店直後が空いている")},
+				{DateSpotID: 1},
+			},
+			TravelMode: "DRIVING",
+			Authority:  "公開",
+		})
+
+		require.NoError(t, err)
+	})
+
+	// outbox に書けなければコースごと取り消すため、作成も失敗として返す
+	t.Run("error_publish_failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCourseRepo := repomock.NewMockCourseRepository(ctrl)
+		mockDuringSpotRepo := repomock.NewMockDuringSpotRepository(ctrl)
+		mockDateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
+
+		mockDateSpotRepo.EXPECT().
+			FindExistingIDs(gomock.Any(), gomock.Any()).
+			DoAndReturn(allDateSpotsExist)
+		mockCourseRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+		mockDuringSpotRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+		publisher := eventmock.NewMockPublisher(ctrl)
+		publisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+
+		uc := usecase.NewCreateCourseUsecase(newPassThroughUnitOfWork(ctrl), mockCourseRepo, mockDuringSpotRepo, mockDateSpotRepo, publisher)
+		out, err := uc.Execute(context.Background(), usecase.CreateCourseInput{
+			UserID:     1,
+			Stops:      []usecase.CourseStopInput{{DateSpotID: 1}},
+			TravelMode: "DRIVING",
+			Authority:  "公開",
+		})
+
+		assert.Nil(t, out)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusInternalServerError, statusCode)
+	})
+
+	t.Run("error_validation_invalid_stop_details", func(t *testing.T) {
+		tests := []struct {
+			name string
+			stop usecase.CourseStopInput
+		}{
+			{name: "arrival_time_format", stop: usecase.CourseStopInput{DateSpotID: 1, ArrivalTime: lo.ToPtr("25:00")}},
+			{name: "stay_minutes_negative", stop: usecase.CourseStopInput{DateSpotID: 1, StayMinutes: lo.ToPtr(-1)}},
+			{name: "stay_minutes_too_long", stop: usecase.CourseStopInput{DateSpotID: 1, StayMinutes: lo.ToPtr(1441)}},
+			{name: "memo_too_long", stop: usecase.CourseStopInput{DateSpotID: 1, Memo: lo.ToPtr(strings.Repeat("あ", 501))}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockCourseRepo := repomock.NewMockCourseRepository(ctrl)
+				mockDuringSpotRepo := repomock.NewMockDuringSpotRepository(ctrl)
+				mockDateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
+
+				uc := usecase.NewCreateCourseUsecase(newPassThroughUnitOfWork(ctrl), mockCourseRepo, mockDuringSpotRepo, mockDateSpotRepo, eventmock.NewMockPublisher(ctrl))
+				_, err := uc.Execute(context.Background(), usecase.CreateCourseInput{
+					UserID:     1,
+					Stops:      []usecase.CourseStopInput{tt.stop},
+					TravelMode: "DRIVING",
+					Authority:  "公開",
+				})
+
+				require.Error(t, err)
+				statusCode, _, _, ok := apperror.HTTPStatus(err)
+				assert.True(t, ok)
+				assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+			})
+		}
+	})
+
 	t.Run("error_validation_missing_user_id", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		mockCourseRepo := repomock.NewMockCourseRepository(ctrl)
 		mockDuringSpotRepo := repomock.NewMockDuringSpotRepository(ctrl)
+		mockDateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
 
-		uc := usecase.NewCreateCourseUsecase(mockCourseRepo, mockDuringSpotRepo)
+		uc := usecase.NewCreateCourseUsecase(newPassThroughUnitOfWork(ctrl), mockCourseRepo, mockDuringSpotRepo, mockDateSpotRepo, eventmock.NewMockPublisher(ctrl))
 		_, err := uc.Execute(context.Background(), usecase.CreateCourseInput{
-			UserID:      0,
-			DateSpotIDs: []uint{1},
-			TravelMode:  "DRIVING",
-			Authority:   "公開",
+			UserID:     0,
+			Stops:      []usecase.CourseStopInput{{DateSpotID: 1}},
+			TravelMode: "DRIVING",
+			Authority:  "公開",
 		})
 
 		require.Error(t, err)
@@ -75,13 +219,14 @@ func TestCreateCourseUsecase(t *testing.T) {
 
 		mockCourseRepo := repomock.NewMockCourseRepository(ctrl)
 		mockDuringSpotRepo := repomock.NewMockDuringSpotRepository(ctrl)
+		mockDateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
 
-		uc := usecase.NewCreateCourseUsecase(mockCourseRepo, mockDuringSpotRepo)
+		uc := usecase.NewCreateCourseUsecase(newPassThroughUnitOfWork(ctrl), mockCourseRepo, mockDuringSpotRepo, mockDateSpotRepo, eventmock.NewMockPublisher(ctrl))
 		_, err := uc.Execute(context.Background(), usecase.CreateCourseInput{
-			UserID:      1,
-			DateSpotIDs: []uint{},
-			TravelMode:  "DRIVING",
-			Authority:   "公開",
+			UserID:     1,
+			Stops:      []usecase.CourseStopInput{},
+			TravelMode: "DRIVING",
+			Authority:  "公開",
 		})
 
 		require.Error(t, err)
@@ -96,13 +241,14 @@ func TestCreateCourseUsecase(t *testing.T) {
 
 		mockCourseRepo := repomock.NewMockCourseRepository(ctrl)
 		mockDuringSpotRepo := repomock.NewMockDuringSpotRepository(ctrl)
+		mockDateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
 
-		uc := usecase.NewCreateCourseUsecase(mockCourseRepo, mockDuringSpotRepo)
+		uc := usecase.NewCreateCourseUsecase(newPassThroughUnitOfWork(ctrl), mockCourseRepo, mockDuringSpotRepo, mockDateSpotRepo, eventmock.NewMockPublisher(ctrl))
 		_, err := uc.Execute(context.Background(), usecase.CreateCourseInput{
-			UserID:      1,
-			DateSpotIDs: []uint{1},
-			TravelMode:  "FLYING",
-			Authority:   "公開",
+			UserID:     1,
+			Stops:      []usecase.CourseStopInput{{DateSpotID: 1}},
+			TravelMode: "FLYING",
+			Authority:  "公開",
 		})
 
 		require.Error(t, err)
@@ -117,13 +263,14 @@ func TestCreateCourseUsecase(t *testing.T) {
 
 		mockCourseRepo := repomock.NewMockCourseRepository(ctrl)
 		mockDuringSpotRepo := repomock.NewMockDuringSpotRepository(ctrl)
+		mockDateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
 
-		uc := usecase.NewCreateCourseUsecase(mockCourseRepo, mockDuringSpotRepo)
+		uc := usecase.NewCreateCourseUsecase(newPassThroughUnitOfWork(ctrl), mockCourseRepo, mockDuringSpotRepo, mockDateSpotRepo, eventmock.NewMockPublisher(ctrl))
 		_, err := uc.Execute(context.Background(), usecase.CreateCourseInput{
-			UserID:      1,
-			DateSpotIDs: []uint{1},
-			TravelMode:  "DRIVING",
-			Authority:   "未設定",
+			UserID:     1,
+			Stops:      []usecase.CourseStopInput{{DateSpotID: 1}},
+			TravelMode: "DRIVING",
+			Authority:  "未設定",
 		})
 
 		require.Error(t, err)
@@ -132,23 +279,85 @@ func TestCreateCourseUsecase(t *testing.T) {
 		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
 	})
 
+	// 存在しないスポットが混ざっていたら、コースを作らずに該当 ID を 422 で返す
+	t.Run("error_unknown_date_spot_ids", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCourseRepo := repomock.NewMockCourseRepository(ctrl)
+		mockDuringSpotRepo := repomock.NewMockDuringSpotRepository(ctrl)
+		mockDateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
+
+		mockDateSpotRepo.EXPECT().
+			FindExistingIDs(gomock.Any(), []uint{1, 404, 2, 405}).
+			Return([]uint{1, 2}, nil)
+
+		uc := usecase.NewCreateCourseUsecase(newPassThroughUnitOfWork(ctrl), mockCourseRepo, mockDuringSpotRepo, mockDateSpotRepo, eventmock.NewMockPublisher(ctrl))
+		_, err := uc.Execute(context.Background(), usecase.CreateCourseInput{
+			UserID: 1,
+			Stops: []usecase.CourseStopInput{
+				{DateSpotID: 1}, {DateSpotID: 404}, {DateSpotID: 2}, {DateSpotID: 404}, {DateSpotID: 405},
+			},
+			TravelMode: "DRIVING",
+			Authority:  "公開",
+		})
+
+		require.Error(t, err)
+		statusCode, messages, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+		assert.Equal(t, []string{"存在しないデートスポットが指定されています（ID: 404, 405）"}, messages)
+	})
+
+	// トランザクションが失敗を返したら、そのまま呼び出し元に伝える
+	t.Run("error_unit_of_work_failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockUnitOfWork := repomock.NewMockUnitOfWork(ctrl)
+		mockCourseRepo := repomock.NewMockCourseRepository(ctrl)
+		mockDuringSpotRepo := repomock.NewMockDuringSpotRepository(ctrl)
+		mockDateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
+
+		mockUnitOfWork.EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			Return(apperror.InternalServerError(errors.New("commit failed")))
+
+		uc := usecase.NewCreateCourseUsecase(mockUnitOfWork, mockCourseRepo, mockDuringSpotRepo, mockDateSpotRepo, eventmock.NewMockPublisher(ctrl))
+		_, err := uc.Execute(context.Background(), usecase.CreateCourseInput{
+			UserID:     1,
+			Stops:      []usecase.CourseStopInput{{DateSpotID: 1}},
+			TravelMode: "DRIVING",
+			Authority:  "公開",
+		})
+
+		require.Error(t, err)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusInternalServerError, statusCode)
+	})
+
 	t.Run("error_course_repository_create_failed", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		mockCourseRepo := repomock.NewMockCourseRepository(ctrl)
 		mockDuringSpotRepo := repomock.NewMockDuringSpotRepository(ctrl)
+		mockDateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
 
+		mockDateSpotRepo.EXPECT().
+			FindExistingIDs(gomock.Any(), gomock.Any()).
+			DoAndReturn(allDateSpotsExist)
 		mockCourseRepo.EXPECT().
 			Create(gomock.Any(), gomock.Any()).
 			Return(errors.New("db error"))
 
-		uc := usecase.NewCreateCourseUsecase(mockCourseRepo, mockDuringSpotRepo)
+		uc := usecase.NewCreateCourseUsecase(newPassThroughUnitOfWork(ctrl), mockCourseRepo, mockDuringSpotRepo, mockDateSpotRepo, eventmock.NewMockPublisher(ctrl))
 		_, err := uc.Execute(context.Background(), usecase.CreateCourseInput{
-			UserID:      1,
-			DateSpotIDs: []uint{1},
-			TravelMode:  "DRIVING",
-			Authority:   "公開",
+			UserID:     1,
+			Stops:      []usecase.CourseStopInput{{DateSpotID: 1}},
+			TravelMode: "DRIVING",
+			Authority:  "公開",
 		})
 
 		require.Error(t, err)
@@ -163,7 +372,11 @@ func TestCreateCourseUsecase(t *testing.T) {
 
 		mockCourseRepo := repomock.NewMockCourseRepository(ctrl)
 		mockDuringSpotRepo := repomock.NewMockDuringSpotRepository(ctrl)
+		mockDateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
 
+		mockDateSpotRepo.EXPECT().
+			FindExistingIDs(gomock.Any(), gomock.Any()).
+			DoAndReturn(allDateSpotsExist)
 		mockCourseRepo.EXPECT().
 			Create(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(_ context.Context, course *model.Course) error {
@@ -174,12 +387,12 @@ func TestCreateCourseUsecase(t *testing.T) {
 			Create(gomock.Any(), gomock.Any()).
 			Return(errors.New("db error"))
 
-		uc := usecase.NewCreateCourseUsecase(mockCourseRepo, mockDuringSpotRepo)
+		uc := usecase.NewCreateCourseUsecase(newPassThroughUnitOfWork(ctrl), mockCourseRepo, mockDuringSpotRepo, mockDateSpotRepo, eventmock.NewMockPublisher(ctrl))
 		_, err := uc.Execute(context.Background(), usecase.CreateCourseInput{
-			UserID:      1,
-			DateSpotIDs: []uint{1},
-			TravelMode:  "DRIVING",
-			Authority:   "公開",
+			UserID:     1,
+			Stops:      []usecase.CourseStopInput{{DateSpotID: 1}},
+			TravelMode: "DRIVING",
+			Authority:  "公開",
 		})
 
 		require.Error(t, err)
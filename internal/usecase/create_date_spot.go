@@ -2,11 +2,13 @@ package usecase
 
 import (
 	"context"
+	"log/slog"
 	"strings"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
 )
 
 // CreateDateSpotInputPort はデートスポット作成ユースケースの入力ポートです。
@@ -20,7 +22,8 @@ type CreateDateSpotInput struct {
 	GenreID      int
 	PrefectureID int
 	CityName     string
-	Image        *string
+	// Image はアップロードされた画像ファイルの中身です。nil のときは画像なしで登録します。
+	Image []byte
 }
 
 // Validate はデートスポット��成の入力デー��をバリデーションします��
@@ -52,14 +55,20 @@ type CreateDateSpotOutput struct {
 }
 
 type CreateDateSpotInteractor struct {
-	DateSpotRepository repository.DateSpotRepository
+	DateSpotRepository  repository.DateSpotRepository
+	DateSpotSearchIndex repository.DateSpotSearchIndex
+	ImageService        service.ImageService
 }
 
 func NewCreateDateSpotUsecase(
 	dateSpotRepository repository.DateSpotRepository,
+	dateSpotSearchIndex repository.DateSpotSearchIndex,
+	imageService service.ImageService,
 ) CreateDateSpotInputPort {
 	return &CreateDateSpotInteractor{
-		DateSpotRepository: dateSpotRepository,
+		DateSpotRepository:  dateSpotRepository,
+		DateSpotSearchIndex: dateSpotSearchIndex,
+		ImageService:        imageService,
 	}
 }
 
@@ -73,12 +82,22 @@ func (i *CreateDateSpotInteractor) Execute(ctx context.Context, input CreateDate
 		GenreID:      &input.GenreID,
 		PrefectureID: &input.PrefectureID,
 		CityName:     input.CityName,
-		Image:        input.Image,
+	}
+	if input.Image != nil {
+		image, err := i.ImageService.Store(ctx, model.ImageKindDateSpot, input.Image)
+		if err != nil {
+			return nil, err
+		}
+		dateSpot.SetImage(image)
 	}
 
 	if err := i.DateSpotRepository.Create(ctx, dateSpot); err != nil {
 		return nil, apperror.InternalServerError(err)
 	}
+	// 検索インデックスは定期的に DB から作り直されるため、反映に失敗しても書き込み自体は成功とする
+	if err := i.DateSpotSearchIndex.Put(ctx, dateSpot); err != nil {
+		slog.WarnContext(ctx, "dateSpotSearchIndex.Put failed", "err", err, "date_spot_id", dateSpot.ID)
+	}
 
 	return &CreateDateSpotOutput{DateSpotID: dateSpot.ID}, nil
 }
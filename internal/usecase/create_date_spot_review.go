@@ -6,8 +6,10 @@ import (
 	"strings"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
 )
 
 type CreateDateSpotReviewInputPort interface {
@@ -84,39 +86,102 @@ func NewCreateDateSpotReviewInputFromStrings(userID uint, dateSpotIDStr, rateStr
 }
 
 type CreateDateSpotReviewOutput struct {
-	ReviewID        uint
+	ReviewID uint
+	// Status は投稿したレビューの公開状態です。pending のときは管理者の確認まで DateSpotReviews に含まれません。
+	Status          model.ReviewStatus
 	DateSpotReviews []*model.DateSpotReview
 }
 
 type CreateDateSpotReviewInteractor struct {
+	UnitOfWork               repository.UnitOfWork
 	DateSpotReviewRepository repository.DateSpotReviewRepository
+	DateSpotRepository       repository.DateSpotRepository
+	ContentFilter            service.ContentFilter
+	EventPublisher           event.Publisher
 }
 
-func NewCreateDateSpotReviewUsecase(dateSpotReviewRepository repository.DateSpotReviewRepository) CreateDateSpotReviewInputPort {
-	return &CreateDateSpotReviewInteractor{DateSpotReviewRepository: dateSpotReviewRepository}
+func NewCreateDateSpotReviewUsecase(
+	unitOfWork repository.UnitOfWork,
+	dateSpotReviewRepository repository.DateSpotReviewRepository,
+	dateSpotRepository repository.DateSpotRepository,
+	contentFilter service.ContentFilter,
+	eventPublisher event.Publisher,
+) CreateDateSpotReviewInputPort {
+	return &CreateDateSpotReviewInteractor{
+		UnitOfWork:               unitOfWork,
+		DateSpotReviewRepository: dateSpotReviewRepository,
+		DateSpotRepository:       dateSpotRepository,
+		ContentFilter:            contentFilter,
+		EventPublisher:           eventPublisher,
+	}
 }
 
 func (i *CreateDateSpotReviewInteractor) Execute(ctx context.Context, input CreateDateSpotReviewInput) (*CreateDateSpotReviewOutput, error) {
 	if err := input.Validate(); err != nil {
 		return nil, err
 	}
+	verdict, err := checkReviewContent(ctx, i.ContentFilter, input.Content)
+	if err != nil {
+		return nil, err
+	}
 	review := &model.DateSpotReview{
 		UserID:     input.UserID,
 		DateSpotID: input.DateSpotID,
 		Rate:       input.Rate,
 		Content:    input.Content,
+		Status:     model.ReviewStatusPublished,
 	}
-	if err := i.DateSpotReviewRepository.Create(ctx, review); err != nil {
-		return nil, apperror.InternalServerError(err)
+	if verdict == service.ContentHeld {
+		review.Status = model.ReviewStatusPending
 	}
 
-	reviews, err := i.DateSpotReviewRepository.FindByDateSpotID(ctx, input.DateSpotID)
+	var reviews []*model.DateSpotReview
+	err = i.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := ensureDateSpotsExist(ctx, i.DateSpotRepository, []uint{input.DateSpotID}); err != nil {
+			return err
+		}
+		if err := i.DateSpotReviewRepository.Create(ctx, review); err != nil {
+			return apperror.InternalServerError(err)
+		}
+		if err := i.EventPublisher.Publish(ctx, event.DateSpotReviewed{
+			ReviewID:   review.ID,
+			DateSpotID: review.DateSpotID,
+			ReviewerID: review.UserID,
+			Status:     review.Status,
+		}); err != nil {
+			return apperror.InternalServerError(err)
+		}
+
+		var err error
+		reviews, err = i.DateSpotReviewRepository.FindByDateSpotID(ctx, input.DateSpotID, input.UserID)
+		if err != nil {
+			return apperror.InternalServerError(err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, apperror.InternalServerError(err)
+		return nil, err
 	}
 
 	return &CreateDateSpotReviewOutput{
 		ReviewID:        review.ID,
+		Status:          review.Status,
 		DateSpotReviews: reviews,
 	}, nil
 }
+
+// checkReviewContent はレビュー本文を ContentFilter で確かめます。
+// 投稿を受け付けない内容なら 422 を返し、それ以外は判定結果を返します。本文がなければ確かめません。
+func checkReviewContent(ctx context.Context, filter service.ContentFilter, content *string) (service.ContentVerdict, error) {
+	if content == nil {
+		return service.ContentAllowed, nil
+	}
+	verdict, err := filter.Check(ctx, *content)
+	if err != nil {
+		return 0, apperror.InternalServerError(err)
+	}
+	if verdict == service.ContentRejected {
+		return 0, apperror.UnprocessableEntity("レビューに使用できない表現が含まれています")
+	}
+	return verdict, nil
+}
@@ -7,14 +7,22 @@ import (
 	"testing"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
+	eventmock "github.com/daisuke-harada/date-courses-go/internal/domain/event/mock"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	repomock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
+// newTestContentFilter は「バカ」を拒否し、「最悪」を保留にする ContentFilter を返します。
+func newTestContentFilter() service.ContentFilter {
+	return service.NewNGWordFilter([]string{"バカ"}, []string{"最悪"})
+}
+
 func TestCreateDateSpotReviewInteractor_Execute(t *testing.T) {
 	ctx := context.Background()
 
@@ -26,6 +34,11 @@ func TestCreateDateSpotReviewInteractor_Execute(t *testing.T) {
 		defer ctrl.Finish()
 
 		reviewRepo := repomock.NewMockDateSpotReviewRepository(ctrl)
+		dateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
+		publisher := eventmock.NewMockPublisher(ctrl)
+		dateSpotRepo.EXPECT().
+			FindExistingIDs(ctx, []uint{2}).
+			Return([]uint{2}, nil)
 		reviewRepo.EXPECT().
 			Create(ctx, gomock.Any()).
 			DoAndReturn(func(_ context.Context, r *model.DateSpotReview) error {
@@ -33,10 +46,13 @@ func TestCreateDateSpotReviewInteractor_Execute(t *testing.T) {
 				return nil
 			})
 		reviewRepo.EXPECT().
-			FindByDateSpotID(ctx, uint(2)).
+			FindByDateSpotID(ctx, uint(2), uint(1)).
 			Return([]*model.DateSpotReview{}, nil)
+		publisher.EXPECT().
+			Publish(ctx, event.DateSpotReviewed{ReviewID: 10, DateSpotID: 2, ReviewerID: 1, Status: model.ReviewStatusPublished}).
+			Return(nil)
 
-		interactor := usecase.NewCreateDateSpotReviewUsecase(reviewRepo)
+		interactor := usecase.NewCreateDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo, dateSpotRepo, newTestContentFilter(), publisher)
 		output, err := interactor.Execute(ctx, usecase.CreateDateSpotReviewInput{
 			UserID:     1,
 			DateSpotID: 2,
@@ -46,16 +62,80 @@ func TestCreateDateSpotReviewInteractor_Execute(t *testing.T) {
 
 		require.NoError(t, err)
 		assert.Equal(t, uint(10), output.ReviewID)
+		assert.Equal(t, model.ReviewStatusPublished, output.Status)
 		assert.NotNil(t, output.DateSpotReviews)
 	})
 
+	// 保留の NG ワードを含むレビューは、管理者が確認するまで公開しない
+	t.Run("success_held_content_is_pending", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		held := "接客が最悪でした"
+		reviewRepo := repomock.NewMockDateSpotReviewRepository(ctrl)
+		dateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
+		publisher := eventmock.NewMockPublisher(ctrl)
+		dateSpotRepo.EXPECT().
+			FindExistingIDs(ctx, []uint{2}).
+			Return([]uint{2}, nil)
+		reviewRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, r *model.DateSpotReview) error {
+				assert.Equal(t, model.ReviewStatusPending, r.Status)
+				r.ID = 11
+				return nil
+			})
+		reviewRepo.EXPECT().
+			FindByDateSpotID(ctx, uint(2), uint(1)).
+			Return([]*model.DateSpotReview{}, nil)
+		// 保留かどうかは購読する側で確かめるため、イベントは状態付きで発行する
+		publisher.EXPECT().
+			Publish(ctx, event.DateSpotReviewed{ReviewID: 11, DateSpotID: 2, ReviewerID: 1, Status: model.ReviewStatusPending}).
+			Return(nil)
+
+		interactor := usecase.NewCreateDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo, dateSpotRepo, newTestContentFilter(), publisher)
+		output, err := interactor.Execute(ctx, usecase.CreateDateSpotReviewInput{
+			UserID:     1,
+			DateSpotID: 2,
+			Content:    &held,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, model.ReviewStatusPending, output.Status)
+	})
+
+	t.Run("error_rejected_content", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rejected := "店員がﾊﾞｶだった"
+		reviewRepo := repomock.NewMockDateSpotReviewRepository(ctrl)
+		dateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
+		publisher := eventmock.NewMockPublisher(ctrl)
+
+		interactor := usecase.NewCreateDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo, dateSpotRepo, newTestContentFilter(), publisher)
+		output, err := interactor.Execute(ctx, usecase.CreateDateSpotReviewInput{
+			UserID:     1,
+			DateSpotID: 2,
+			Content:    &rejected,
+		})
+
+		assert.Nil(t, output)
+		statusCode, messages, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+		assert.Equal(t, []string{"レビューに使用できない表現が含まれています"}, messages)
+	})
+
 	t.Run("error_validation_missing_user_id", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		reviewRepo := repomock.NewMockDateSpotReviewRepository(ctrl)
+		dateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
+		publisher := eventmock.NewMockPublisher(ctrl)
 
-		interactor := usecase.NewCreateDateSpotReviewUsecase(reviewRepo)
+		interactor := usecase.NewCreateDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo, dateSpotRepo, newTestContentFilter(), publisher)
 		output, err := interactor.Execute(ctx, usecase.CreateDateSpotReviewInput{
 			UserID:     0,
 			DateSpotID: 2,
@@ -73,8 +153,10 @@ func TestCreateDateSpotReviewInteractor_Execute(t *testing.T) {
 		defer ctrl.Finish()
 
 		reviewRepo := repomock.NewMockDateSpotReviewRepository(ctrl)
+		dateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
+		publisher := eventmock.NewMockPublisher(ctrl)
 
-		interactor := usecase.NewCreateDateSpotReviewUsecase(reviewRepo)
+		interactor := usecase.NewCreateDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo, dateSpotRepo, newTestContentFilter(), publisher)
 		output, err := interactor.Execute(ctx, usecase.CreateDateSpotReviewInput{
 			UserID:     1,
 			DateSpotID: 0,
@@ -87,16 +169,47 @@ func TestCreateDateSpotReviewInteractor_Execute(t *testing.T) {
 		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
 	})
 
+	t.Run("error_unknown_date_spot_id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reviewRepo := repomock.NewMockDateSpotReviewRepository(ctrl)
+		dateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
+		publisher := eventmock.NewMockPublisher(ctrl)
+		dateSpotRepo.EXPECT().
+			FindExistingIDs(ctx, []uint{404}).
+			Return([]uint{}, nil)
+
+		interactor := usecase.NewCreateDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo, dateSpotRepo, newTestContentFilter(), publisher)
+		output, err := interactor.Execute(ctx, usecase.CreateDateSpotReviewInput{
+			UserID:     1,
+			DateSpotID: 404,
+			Rate:       &rate,
+		})
+
+		assert.Error(t, err)
+		assert.Nil(t, output)
+		statusCode, messages, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+		assert.Equal(t, []string{"存在しないデートスポットが指定されています（ID: 404）"}, messages)
+	})
+
 	t.Run("error_repository_create_failed", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		reviewRepo := repomock.NewMockDateSpotReviewRepository(ctrl)
+		dateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
+		publisher := eventmock.NewMockPublisher(ctrl)
+		dateSpotRepo.EXPECT().
+			FindExistingIDs(ctx, []uint{2}).
+			Return([]uint{2}, nil)
 		reviewRepo.EXPECT().
 			Create(ctx, gomock.Any()).
 			Return(errors.New("db error"))
 
-		interactor := usecase.NewCreateDateSpotReviewUsecase(reviewRepo)
+		interactor := usecase.NewCreateDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo, dateSpotRepo, newTestContentFilter(), publisher)
 		output, err := interactor.Execute(ctx, usecase.CreateDateSpotReviewInput{
 			UserID:     1,
 			DateSpotID: 2,
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	servicemock "github.com/daisuke-harada/date-courses-go/internal/domain/service/mock"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -28,7 +30,14 @@ func TestCreateDateSpotInteractor_Execute(t *testing.T) {
 				return nil
 			})
 
-		interactor := usecase.NewCreateDateSpotUsecase(dateSpotRepo)
+		searchIndex := repositorymock.NewMockDateSpotSearchIndex(ctrl)
+		searchIndex.EXPECT().
+			Put(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, ds *model.DateSpot) error {
+				assert.Equal(t, uint(10), ds.ID)
+				return nil
+			})
+		interactor := usecase.NewCreateDateSpotUsecase(dateSpotRepo, searchIndex, servicemock.NewMockImageService(ctrl))
 		output, err := interactor.Execute(ctx, usecase.CreateDateSpotInput{
 			Name:         "テストスポット",
 			GenreID:      1,
@@ -41,6 +50,99 @@ func TestCreateDateSpotInteractor_Execute(t *testing.T) {
 		assert.Equal(t, uint(10), output.DateSpotID)
 	})
 
+	t.Run("success_with_image", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		data := []byte("image bytes")
+
+		imageService := servicemock.NewMockImageService(ctrl)
+		imageService.EXPECT().
+			Store(ctx, model.ImageKindDateSpot, data).
+			Return(&model.Image{URL: "http://localhost/uploads/a.jpg", ThumbnailURL: "http://localhost/uploads/a_thumb.jpg"}, nil)
+
+		dateSpotRepo := repositorymock.NewMockDateSpotRepository(ctrl)
+		dateSpotRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, ds *model.DateSpot) error {
+				assert.Equal(t, "http://localhost/uploads/a.jpg", *ds.Image)
+				assert.Equal(t, "http://localhost/uploads/a_thumb.jpg", *ds.ImageThumbnail)
+				return nil
+			})
+		searchIndex := repositorymock.NewMockDateSpotSearchIndex(ctrl)
+		searchIndex.EXPECT().Put(ctx, gomock.Any()).Return(nil)
+
+		interactor := usecase.NewCreateDateSpotUsecase(dateSpotRepo, searchIndex, imageService)
+		_, err := interactor.Execute(ctx, usecase.CreateDateSpotInput{
+			Name:         "テストスポット",
+			GenreID:      1,
+			PrefectureID: 13,
+			CityName:     "渋谷区",
+			Image:        data,
+		})
+
+		require.NoError(t, err)
+	})
+
+	// 画像を受け付けられないときはスポットを作らない
+	t.Run("error_invalid_image", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+
+		imageService := servicemock.NewMockImageService(ctrl)
+		imageService.EXPECT().
+			Store(ctx, model.ImageKindDateSpot, gomock.Any()).
+			Return(nil, apperror.UnprocessableEntity("画像は JPEG・PNG・GIF のいずれかを指定してください"))
+
+		dateSpotRepo := repositorymock.NewMockDateSpotRepository(ctrl)
+		searchIndex := repositorymock.NewMockDateSpotSearchIndex(ctrl)
+
+		interactor := usecase.NewCreateDateSpotUsecase(dateSpotRepo, searchIndex, imageService)
+		output, err := interactor.Execute(ctx, usecase.CreateDateSpotInput{
+			Name:         "テストスポット",
+			GenreID:      1,
+			PrefectureID: 13,
+			CityName:     "渋谷区",
+			Image:        []byte("not an image"),
+		})
+
+		assert.Nil(t, output)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, 422, statusCode)
+	})
+
+	// 検索インデックスは定期的に作り直されるため、反映に失敗しても作成は成功とする
+	t.Run("success_even_if_search_index_put_failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+
+		dateSpotRepo := repositorymock.NewMockDateSpotRepository(ctrl)
+		dateSpotRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			Return(nil)
+		searchIndex := repositorymock.NewMockDateSpotSearchIndex(ctrl)
+		searchIndex.EXPECT().
+			Put(ctx, gomock.Any()).
+			Return(errors.New("db error"))
+
+		interactor := usecase.NewCreateDateSpotUsecase(dateSpotRepo, searchIndex, servicemock.NewMockImageService(ctrl))
+		output, err := interactor.Execute(ctx, usecase.CreateDateSpotInput{
+			Name:         "テストスポット",
+			GenreID:      1,
+			PrefectureID: 13,
+			CityName:     "渋谷区",
+		})
+
+		require.NoError(t, err)
+		assert.NotNil(t, output)
+	})
+
 	t.Run("error_repository_create_failed", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -52,7 +154,8 @@ func TestCreateDateSpotInteractor_Execute(t *testing.T) {
 			Create(ctx, gomock.Any()).
 			Return(errors.New("db error"))
 
-		interactor := usecase.NewCreateDateSpotUsecase(dateSpotRepo)
+		searchIndex := repositorymock.NewMockDateSpotSearchIndex(ctrl)
+		interactor := usecase.NewCreateDateSpotUsecase(dateSpotRepo, searchIndex, servicemock.NewMockImageService(ctrl))
 		output, err := interactor.Execute(ctx, usecase.CreateDateSpotInput{
 			Name:         "テストスポット",
 			GenreID:      1,
@@ -2,9 +2,11 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"strconv"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
@@ -47,20 +49,26 @@ type CreateRelationshipOutput struct {
 }
 
 type CreateRelationshipInteractor struct {
+	UnitOfWork             repository.UnitOfWork
 	UserRepository         repository.UserRepository
 	RelationshipRepository repository.RelationshipRepository
 	UserService            service.UserService
+	EventPublisher         event.Publisher
 }
 
 func NewCreateRelationshipUsecase(
+	unitOfWork repository.UnitOfWork,
 	userRepository repository.UserRepository,
 	relationshipRepository repository.RelationshipRepository,
 	userService service.UserService,
+	eventPublisher event.Publisher,
 ) CreateRelationshipInputPort {
 	return &CreateRelationshipInteractor{
+		UnitOfWork:             unitOfWork,
 		UserRepository:         userRepository,
 		RelationshipRepository: relationshipRepository,
 		UserService:            userService,
+		EventPublisher:         eventPublisher,
 	}
 }
 
@@ -82,23 +90,43 @@ func (i *CreateRelationshipInteractor) Execute(ctx context.Context, input Create
 		return nil, apperror.NotFound()
 	}
 
-	// フォロー関係を作成
+	// フォロー関係を作成し、同じトランザクションでイベントを outbox に残す
 	relationship := &model.Relationship{
 		UserID:   input.CurrentUserID,
 		FollowID: input.FollowedUserID,
 	}
-	if err := i.RelationshipRepository.Create(ctx, relationship); err != nil {
-		return nil, apperror.InternalServerError(err)
+	err = i.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		// ブロックの作成と同じ順で2人の行をロックし、確認と保存の間にブロックが割り込まないようにする
+		if err := i.UserRepository.LockForUpdate(ctx, input.CurrentUserID, input.FollowedUserID); err != nil {
+			return apperror.InternalServerError(err)
+		}
+		if err := i.RelationshipRepository.Create(ctx, relationship); err != nil {
+			// どちらがブロックしたかは相手に知らせない
+			if errors.Is(err, repository.ErrBlocked) {
+				return apperror.UnprocessableEntity("このユーザーはフォローできません")
+			}
+			return apperror.InternalServerError(err)
+		}
+		if err := i.EventPublisher.Publish(ctx, event.UserFollowed{
+			FollowerID:     input.CurrentUserID,
+			FollowedUserID: input.FollowedUserID,
+		}); err != nil {
+			return apperror.InternalServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 全ユーザー一覧（non_admins）を取得
-	allUsers, err := i.UserRepository.Search(ctx, nil)
+	// 全ユーザー一覧（non_admins）を取得。Limit を指定しないので全件返る。ブロック・ミュートしている相手は除く
+	allUsers, err := i.UserRepository.Search(ctx, repository.UserSearchParams{ViewerID: input.CurrentUserID})
 	if err != nil {
 		return nil, apperror.InternalServerError(err)
 	}
 
 	// 全ユーザーの関連データを構築
-	usersWithRelations, err := i.UserService.BuildUsersWithRelations(ctx, allUsers)
+	usersWithRelations, err := i.UserService.BuildUsersWithRelations(ctx, allUsers.Items)
 	if err != nil {
 		return nil, err
 	}
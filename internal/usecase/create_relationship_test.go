@@ -3,11 +3,17 @@ package usecase_test
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
 
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
+	eventmock "github.com/daisuke-harada/date-courses-go/internal/domain/event/mock"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
 	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
 	servicemock "github.com/daisuke-harada/date-courses-go/internal/domain/service/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -43,16 +49,21 @@ func TestCreateRelationshipInteractor_Execute(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		relationshipRepo := repositorymock.NewMockRelationshipRepository(ctrl)
 		userService := servicemock.NewMockUserService(ctrl)
+		publisher := eventmock.NewMockPublisher(ctrl)
 
 		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(currentUser, nil)
 		userRepo.EXPECT().FindByID(ctx, uint(2)).Return(followedUser, nil)
-		relationshipRepo.EXPECT().Create(ctx, &model.Relationship{UserID: 1, FollowID: 2}).Return(nil)
-		userRepo.EXPECT().Search(ctx, (*string)(nil)).Return(allUsers, nil)
+		gomock.InOrder(
+			userRepo.EXPECT().LockForUpdate(ctx, uint(1), uint(2)).Return(nil),
+			relationshipRepo.EXPECT().Create(ctx, &model.Relationship{UserID: 1, FollowID: 2}).Return(nil),
+		)
+		publisher.EXPECT().Publish(ctx, event.UserFollowed{FollowerID: 1, FollowedUserID: 2}).Return(nil)
+		userRepo.EXPECT().Search(ctx, repository.UserSearchParams{ViewerID: 1}).Return(pagination.Page[*model.User]{Items: allUsers}, nil)
 		userService.EXPECT().BuildUsersWithRelations(ctx, allUsers).Return(allUwrs, nil)
 		userService.EXPECT().BuildUserWithRelations(ctx, currentUser).Return(currentUwr, nil)
 		userService.EXPECT().BuildUserWithRelations(ctx, followedUser).Return(followedUwr, nil)
 
-		interactor := usecase.NewCreateRelationshipUsecase(userRepo, relationshipRepo, userService)
+		interactor := usecase.NewCreateRelationshipUsecase(newPassThroughUnitOfWork(ctrl), userRepo, relationshipRepo, userService, publisher)
 		output, err := interactor.Execute(ctx, usecase.CreateRelationshipInput{
 			CurrentUserID:  1,
 			FollowedUserID: 2,
@@ -74,9 +85,10 @@ func TestCreateRelationshipInteractor_Execute(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		relationshipRepo := repositorymock.NewMockRelationshipRepository(ctrl)
 		userService := servicemock.NewMockUserService(ctrl)
+		publisher := eventmock.NewMockPublisher(ctrl)
 		// FindByID / Create は呼ばれないので EXPECT 不要
 
-		interactor := usecase.NewCreateRelationshipUsecase(userRepo, relationshipRepo, userService)
+		interactor := usecase.NewCreateRelationshipUsecase(newPassThroughUnitOfWork(ctrl), userRepo, relationshipRepo, userService, publisher)
 		output, err := interactor.Execute(ctx, usecase.CreateRelationshipInput{
 			CurrentUserID:  1,
 			FollowedUserID: 1,
@@ -87,6 +99,35 @@ func TestCreateRelationshipInteractor_Execute(t *testing.T) {
 		assert.Contains(t, err.Error(), "自分自身")
 	})
 
+	// どちらかがブロックしていればフォローできない。イベントも出さない
+	t.Run("error_blocked", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		relationshipRepo := repositorymock.NewMockRelationshipRepository(ctrl)
+		userService := servicemock.NewMockUserService(ctrl)
+		publisher := eventmock.NewMockPublisher(ctrl)
+
+		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(newTestUser(1, "alice"), nil)
+		userRepo.EXPECT().FindByID(ctx, uint(2)).Return(newTestUser(2, "bob"), nil)
+		userRepo.EXPECT().LockForUpdate(ctx, uint(1), uint(2)).Return(nil)
+		relationshipRepo.EXPECT().Create(ctx, &model.Relationship{UserID: 1, FollowID: 2}).Return(repository.ErrBlocked)
+
+		interactor := usecase.NewCreateRelationshipUsecase(newPassThroughUnitOfWork(ctrl), userRepo, relationshipRepo, userService, publisher)
+		output, err := interactor.Execute(ctx, usecase.CreateRelationshipInput{
+			CurrentUserID:  1,
+			FollowedUserID: 2,
+		})
+
+		assert.Nil(t, output)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+
 	t.Run("error_current_user_not_found", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -96,11 +137,12 @@ func TestCreateRelationshipInteractor_Execute(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		relationshipRepo := repositorymock.NewMockRelationshipRepository(ctrl)
 		userService := servicemock.NewMockUserService(ctrl)
+		publisher := eventmock.NewMockPublisher(ctrl)
 
 		userRepo.EXPECT().FindByID(ctx, uint(999)).Return(nil, errors.New("not found"))
 		// Create は呼ばれないので EXPECT 不要
 
-		interactor := usecase.NewCreateRelationshipUsecase(userRepo, relationshipRepo, userService)
+		interactor := usecase.NewCreateRelationshipUsecase(newPassThroughUnitOfWork(ctrl), userRepo, relationshipRepo, userService, publisher)
 		output, err := interactor.Execute(ctx, usecase.CreateRelationshipInput{
 			CurrentUserID:  999,
 			FollowedUserID: 2,
@@ -121,12 +163,13 @@ func TestCreateRelationshipInteractor_Execute(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		relationshipRepo := repositorymock.NewMockRelationshipRepository(ctrl)
 		userService := servicemock.NewMockUserService(ctrl)
+		publisher := eventmock.NewMockPublisher(ctrl)
 
 		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(currentUser, nil)
 		userRepo.EXPECT().FindByID(ctx, uint(999)).Return(nil, errors.New("not found"))
 		// Create は呼ばれないので EXPECT 不要
 
-		interactor := usecase.NewCreateRelationshipUsecase(userRepo, relationshipRepo, userService)
+		interactor := usecase.NewCreateRelationshipUsecase(newPassThroughUnitOfWork(ctrl), userRepo, relationshipRepo, userService, publisher)
 		output, err := interactor.Execute(ctx, usecase.CreateRelationshipInput{
 			CurrentUserID:  1,
 			FollowedUserID: 999,
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+)
+
+// CreateUserBlockInputPort はユーザーをブロックするユースケースの入力ポートです。
+type CreateUserBlockInputPort interface {
+	Execute(context.Context, CreateUserBlockInput) error
+}
+
+type CreateUserBlockInput struct {
+	// UserID はブロックするユーザー（トークンの currentUser）の ID です。
+	UserID        uint
+	BlockedUserID uint
+}
+
+type CreateUserBlockInteractor struct {
+	UnitOfWork             repository.UnitOfWork
+	UserRepository         repository.UserRepository
+	UserBlockRepository    repository.UserBlockRepository
+	RelationshipRepository repository.RelationshipRepository
+}
+
+func NewCreateUserBlockUsecase(
+	unitOfWork repository.UnitOfWork,
+	userRepository repository.UserRepository,
+	userBlockRepository repository.UserBlockRepository,
+	relationshipRepository repository.RelationshipRepository,
+) CreateUserBlockInputPort {
+	return &CreateUserBlockInteractor{
+		UnitOfWork:             unitOfWork,
+		UserRepository:         userRepository,
+		UserBlockRepository:    userBlockRepository,
+		RelationshipRepository: relationshipRepository,
+	}
+}
+
+// Execute はブロックを保存し、2人の間のフォローを両方向とも外します。
+// ブロックした後もフォローが残ると、フォロー一覧やフィードから相手の出来事が届いてしまうためです。
+func (i *CreateUserBlockInteractor) Execute(ctx context.Context, input CreateUserBlockInput) error {
+	if input.UserID == input.BlockedUserID {
+		return apperror.UnprocessableEntity("自分自身をブロックすることはできません")
+	}
+
+	blockedUser, err := i.UserRepository.FindByID(ctx, input.BlockedUserID)
+	if err != nil {
+		return apperror.NotFoundWithCause(err)
+	}
+
+	return i.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		// フォローの作成と同じ順で2人の行をロックし、フォローを外した後に新しいフォローが残らないようにする
+		if err := i.UserRepository.LockForUpdate(ctx, input.UserID, blockedUser.ID); err != nil {
+			return apperror.InternalServerError(err)
+		}
+		if err := i.UserBlockRepository.Create(ctx, &model.UserBlock{
+			UserID:        input.UserID,
+			BlockedUserID: blockedUser.ID,
+		}); err != nil {
+			return apperror.InternalServerError(err)
+		}
+		if err := i.RelationshipRepository.DeleteByUserIDs(ctx, input.UserID, blockedUser.ID); err != nil {
+			return apperror.InternalServerError(err)
+		}
+		if err := i.RelationshipRepository.DeleteByUserIDs(ctx, blockedUser.ID, input.UserID); err != nil {
+			return apperror.InternalServerError(err)
+		}
+		return nil
+	})
+}
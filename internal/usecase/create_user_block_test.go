@@ -0,0 +1,101 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateUserBlockInteractor_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	// ブロックと同じトランザクションで、2人の間のフォローを両方向とも外す
+	t.Run("success_removes_follows_in_both_directions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		blockRepo := repositorymock.NewMockUserBlockRepository(ctrl)
+		relationshipRepo := repositorymock.NewMockRelationshipRepository(ctrl)
+
+		userRepo.EXPECT().FindByID(ctx, uint(2)).Return(&model.User{ID: 2}, nil)
+		gomock.InOrder(
+			userRepo.EXPECT().LockForUpdate(ctx, uint(1), uint(2)).Return(nil),
+			blockRepo.EXPECT().Create(ctx, &model.UserBlock{UserID: 1, BlockedUserID: 2}).Return(nil),
+			relationshipRepo.EXPECT().DeleteByUserIDs(ctx, uint(1), uint(2)).Return(nil),
+			relationshipRepo.EXPECT().DeleteByUserIDs(ctx, uint(2), uint(1)).Return(nil),
+		)
+
+		interactor := usecase.NewCreateUserBlockUsecase(newPassThroughUnitOfWork(ctrl), userRepo, blockRepo, relationshipRepo)
+		err := interactor.Execute(ctx, usecase.CreateUserBlockInput{UserID: 1, BlockedUserID: 2})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("error_block_self", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		interactor := usecase.NewCreateUserBlockUsecase(
+			newPassThroughUnitOfWork(ctrl),
+			repositorymock.NewMockUserRepository(ctrl),
+			repositorymock.NewMockUserBlockRepository(ctrl),
+			repositorymock.NewMockRelationshipRepository(ctrl),
+		)
+		err := interactor.Execute(ctx, usecase.CreateUserBlockInput{UserID: 1, BlockedUserID: 1})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+
+	t.Run("error_user_not_found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().FindByID(ctx, uint(999)).Return(nil, errors.New("record not found"))
+
+		interactor := usecase.NewCreateUserBlockUsecase(
+			newPassThroughUnitOfWork(ctrl),
+			userRepo,
+			repositorymock.NewMockUserBlockRepository(ctrl),
+			repositorymock.NewMockRelationshipRepository(ctrl),
+		)
+		err := interactor.Execute(ctx, usecase.CreateUserBlockInput{UserID: 1, BlockedUserID: 999})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, statusCode)
+	})
+
+	t.Run("error_unfollow_failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		blockRepo := repositorymock.NewMockUserBlockRepository(ctrl)
+		relationshipRepo := repositorymock.NewMockRelationshipRepository(ctrl)
+
+		userRepo.EXPECT().FindByID(ctx, uint(2)).Return(&model.User{ID: 2}, nil)
+		userRepo.EXPECT().LockForUpdate(ctx, uint(1), uint(2)).Return(nil)
+		blockRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		relationshipRepo.EXPECT().DeleteByUserIDs(ctx, uint(1), uint(2)).Return(errors.New("db error"))
+
+		interactor := usecase.NewCreateUserBlockUsecase(newPassThroughUnitOfWork(ctrl), userRepo, blockRepo, relationshipRepo)
+		err := interactor.Execute(ctx, usecase.CreateUserBlockInput{UserID: 1, BlockedUserID: 2})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusInternalServerError, statusCode)
+	})
+}
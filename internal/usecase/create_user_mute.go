@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+)
+
+// CreateUserMuteInputPort はユーザーをミュートするユースケースの入力ポートです。
+type CreateUserMuteInputPort interface {
+	Execute(context.Context, CreateUserMuteInput) error
+}
+
+type CreateUserMuteInput struct {
+	// UserID はミュートするユーザー（トークンの currentUser）の ID です。
+	UserID      uint
+	MutedUserID uint
+}
+
+type CreateUserMuteInteractor struct {
+	UserRepository     repository.UserRepository
+	UserMuteRepository repository.UserMuteRepository
+}
+
+func NewCreateUserMuteUsecase(
+	userRepository repository.UserRepository,
+	userMuteRepository repository.UserMuteRepository,
+) CreateUserMuteInputPort {
+	return &CreateUserMuteInteractor{
+		UserRepository:     userRepository,
+		UserMuteRepository: userMuteRepository,
+	}
+}
+
+// Execute はミュートを保存します。相手に気付かれないよう、フォローは外さず通知も出しません。
+func (i *CreateUserMuteInteractor) Execute(ctx context.Context, input CreateUserMuteInput) error {
+	if input.UserID == input.MutedUserID {
+		return apperror.UnprocessableEntity("自分自身をミュートすることはできません")
+	}
+
+	mutedUser, err := i.UserRepository.FindByID(ctx, input.MutedUserID)
+	if err != nil {
+		return apperror.NotFoundWithCause(err)
+	}
+
+	if err := i.UserMuteRepository.Create(ctx, &model.UserMute{
+		UserID:      input.UserID,
+		MutedUserID: mutedUser.ID,
+	}); err != nil {
+		return apperror.InternalServerError(err)
+	}
+	return nil
+}
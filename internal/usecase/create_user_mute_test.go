@@ -0,0 +1,66 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateUserMuteInteractor_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		muteRepo := repositorymock.NewMockUserMuteRepository(ctrl)
+
+		userRepo.EXPECT().FindByID(ctx, uint(2)).Return(&model.User{ID: 2}, nil)
+		muteRepo.EXPECT().Create(ctx, &model.UserMute{UserID: 1, MutedUserID: 2}).Return(nil)
+
+		interactor := usecase.NewCreateUserMuteUsecase(userRepo, muteRepo)
+		err := interactor.Execute(ctx, usecase.CreateUserMuteInput{UserID: 1, MutedUserID: 2})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("error_mute_self", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		interactor := usecase.NewCreateUserMuteUsecase(
+			repositorymock.NewMockUserRepository(ctrl),
+			repositorymock.NewMockUserMuteRepository(ctrl),
+		)
+		err := interactor.Execute(ctx, usecase.CreateUserMuteInput{UserID: 1, MutedUserID: 1})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+
+	t.Run("error_user_not_found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().FindByID(ctx, uint(999)).Return(nil, errors.New("record not found"))
+
+		interactor := usecase.NewCreateUserMuteUsecase(userRepo, repositorymock.NewMockUserMuteRepository(ctrl))
+		err := interactor.Execute(ctx, usecase.CreateUserMuteInput{UserID: 1, MutedUserID: 999})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, statusCode)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
 )
 
@@ -18,11 +19,21 @@ type DeleteCourseInput struct {
 }
 
 type DeleteCourseInteractor struct {
+	UnitOfWork       repository.UnitOfWork
 	CourseRepository repository.CourseRepository
+	EventPublisher   event.Publisher
 }
 
-func NewDeleteCourseUsecase(courseRepository repository.CourseRepository) DeleteCourseInputPort {
-	return &DeleteCourseInteractor{CourseRepository: courseRepository}
+func NewDeleteCourseUsecase(
+	unitOfWork repository.UnitOfWork,
+	courseRepository repository.CourseRepository,
+	eventPublisher event.Publisher,
+) DeleteCourseInputPort {
+	return &DeleteCourseInteractor{
+		UnitOfWork:       unitOfWork,
+		CourseRepository: courseRepository,
+		EventPublisher:   eventPublisher,
+	}
 }
 
 func (i *DeleteCourseInteractor) Execute(ctx context.Context, input DeleteCourseInput) error {
@@ -37,8 +48,16 @@ func (i *DeleteCourseInteractor) Execute(ctx context.Context, input DeleteCourse
 		return apperror.Forbidden("他のユーザーのデートコースは削除できません")
 	}
 
-	if err := i.CourseRepository.DeleteByID(ctx, input.CourseID); err != nil {
-		return apperror.InternalServerError(err)
-	}
-	return nil
+	return i.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := i.CourseRepository.DeleteByID(ctx, input.CourseID); err != nil {
+			return apperror.InternalServerError(err)
+		}
+		if err := i.EventPublisher.Publish(ctx, event.CourseDeleted{
+			CourseID: course.ID,
+			UserID:   course.UserID,
+		}); err != nil {
+			return apperror.InternalServerError(err)
+		}
+		return nil
+	})
 }
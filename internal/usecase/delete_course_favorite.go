@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+)
+
+// DeleteCourseFavoriteInputPort はコースをお気に入りから外すユースケースの入力ポートです。
+type DeleteCourseFavoriteInputPort interface {
+	Execute(context.Context, DeleteCourseFavoriteInput) (*DeleteCourseFavoriteOutput, error)
+}
+
+type DeleteCourseFavoriteInput struct {
+	CourseID uint
+	// UserID はお気に入りから外すユーザー（トークンの currentUser）の ID です。
+	UserID uint
+}
+
+type DeleteCourseFavoriteOutput struct {
+	CourseID  uint
+	Favorites *model.CourseFavoriteSummary
+}
+
+type DeleteCourseFavoriteInteractor struct {
+	CourseFavoriteRepository repository.CourseFavoriteRepository
+}
+
+func NewDeleteCourseFavoriteUsecase(
+	courseFavoriteRepository repository.CourseFavoriteRepository,
+) DeleteCourseFavoriteInputPort {
+	return &DeleteCourseFavoriteInteractor{
+		CourseFavoriteRepository: courseFavoriteRepository,
+	}
+}
+
+// Execute はコースの公開設定を確かめずにお気に入りを外します。
+// お気に入りに入れた後で非公開になったコースも、一覧に出ないだけで行は残るため、外せるようにしておく。
+func (i *DeleteCourseFavoriteInteractor) Execute(ctx context.Context, input DeleteCourseFavoriteInput) (*DeleteCourseFavoriteOutput, error) {
+	if err := i.CourseFavoriteRepository.DeleteByUserIDAndCourseID(ctx, input.UserID, input.CourseID); err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	summaries, err := i.CourseFavoriteRepository.SummarizeByCourseIDs(ctx, []uint{input.CourseID}, input.UserID)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	return &DeleteCourseFavoriteOutput{CourseID: input.CourseID, Favorites: summaries[input.CourseID]}, nil
+}
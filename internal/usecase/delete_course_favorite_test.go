@@ -0,0 +1,59 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeleteCourseFavoriteInteractor_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	// 外した後の件数を返し、画面のボタンと件数をそのまま更新できるようにする
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
+		favoriteRepo.EXPECT().
+			DeleteByUserIDAndCourseID(ctx, uint(1), uint(10)).
+			Return(nil)
+		favoriteRepo.EXPECT().
+			SummarizeByCourseIDs(ctx, []uint{10}, uint(1)).
+			Return(map[uint]*model.CourseFavoriteSummary{10: {Count: 4}}, nil)
+
+		interactor := usecase.NewDeleteCourseFavoriteUsecase(favoriteRepo)
+		output, err := interactor.Execute(ctx, usecase.DeleteCourseFavoriteInput{CourseID: 10, UserID: 1})
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(10), output.CourseID)
+		assert.Equal(t, &model.CourseFavoriteSummary{Count: 4}, output.Favorites)
+	})
+
+	t.Run("error_delete_failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
+		favoriteRepo.EXPECT().
+			DeleteByUserIDAndCourseID(ctx, uint(1), uint(10)).
+			Return(errors.New("db error"))
+
+		interactor := usecase.NewDeleteCourseFavoriteUsecase(favoriteRepo)
+		output, err := interactor.Execute(ctx, usecase.DeleteCourseFavoriteInput{CourseID: 10, UserID: 1})
+
+		assert.Nil(t, output)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusInternalServerError, statusCode)
+	})
+}
@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
+	eventmock "github.com/daisuke-harada/date-courses-go/internal/domain/event/mock"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	repomock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
@@ -27,8 +29,12 @@ func TestDeleteCourseUsecase(t *testing.T) {
 		mockCourseRepo.EXPECT().
 			DeleteByID(gomock.Any(), uint(1)).
 			Return(nil)
+		publisher := eventmock.NewMockPublisher(ctrl)
+		publisher.EXPECT().
+			Publish(gomock.Any(), event.CourseDeleted{CourseID: 1, UserID: 10}).
+			Return(nil)
 
-		uc := usecase.NewDeleteCourseUsecase(mockCourseRepo)
+		uc := usecase.NewDeleteCourseUsecase(newPassThroughUnitOfWork(ctrl), mockCourseRepo, publisher)
 		require.NoError(t, uc.Execute(context.Background(), usecase.DeleteCourseInput{CourseID: 1, OperatorID: 10}))
 	})
 
@@ -42,7 +48,7 @@ func TestDeleteCourseUsecase(t *testing.T) {
 			FindByID(gomock.Any(), uint(1), gomock.Any()).
 			Return(&model.Course{ID: 1, UserID: 10}, nil)
 
-		uc := usecase.NewDeleteCourseUsecase(mockCourseRepo)
+		uc := usecase.NewDeleteCourseUsecase(newPassThroughUnitOfWork(ctrl), mockCourseRepo, eventmock.NewMockPublisher(ctrl))
 		err := uc.Execute(context.Background(), usecase.DeleteCourseInput{CourseID: 1, OperatorID: 99})
 
 		require.Error(t, err)
@@ -60,7 +66,7 @@ func TestDeleteCourseUsecase(t *testing.T) {
 			FindByID(gomock.Any(), uint(1), gomock.Any()).
 			Return(nil, errors.New("record not found"))
 
-		uc := usecase.NewDeleteCourseUsecase(mockCourseRepo)
+		uc := usecase.NewDeleteCourseUsecase(newPassThroughUnitOfWork(ctrl), mockCourseRepo, eventmock.NewMockPublisher(ctrl))
 		err := uc.Execute(context.Background(), usecase.DeleteCourseInput{CourseID: 1, OperatorID: 10})
 
 		require.Error(t, err)
@@ -81,7 +87,7 @@ func TestDeleteCourseUsecase(t *testing.T) {
 			DeleteByID(gomock.Any(), uint(1)).
 			Return(errors.New("db error"))
 
-		uc := usecase.NewDeleteCourseUsecase(mockCourseRepo)
+		uc := usecase.NewDeleteCourseUsecase(newPassThroughUnitOfWork(ctrl), mockCourseRepo, eventmock.NewMockPublisher(ctrl))
 		err := uc.Execute(context.Background(), usecase.DeleteCourseInput{CourseID: 1, OperatorID: 10})
 
 		require.Error(t, err)
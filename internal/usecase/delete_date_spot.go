@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"log/slog"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
@@ -18,14 +19,17 @@ type DeleteDateSpotInput struct {
 }
 
 type DeleteDateSpotInteractor struct {
-	DateSpotRepository repository.DateSpotRepository
+	DateSpotRepository  repository.DateSpotRepository
+	DateSpotSearchIndex repository.DateSpotSearchIndex
 }
 
 func NewDeleteDateSpotUsecase(
 	dateSpotRepository repository.DateSpotRepository,
+	dateSpotSearchIndex repository.DateSpotSearchIndex,
 ) DeleteDateSpotInputPort {
 	return &DeleteDateSpotInteractor{
-		DateSpotRepository: dateSpotRepository,
+		DateSpotRepository:  dateSpotRepository,
+		DateSpotSearchIndex: dateSpotSearchIndex,
 	}
 }
 
@@ -33,5 +37,9 @@ func (i *DeleteDateSpotInteractor) Execute(ctx context.Context, input DeleteDate
 	if err := i.DateSpotRepository.Delete(ctx, input.DateSpotID); err != nil {
 		return apperror.InternalServerError(err)
 	}
+	// 検索インデックスは定期的に DB から作り直されるため、反映に失敗しても削除自体は成功とする
+	if err := i.DateSpotSearchIndex.Remove(ctx, input.DateSpotID); err != nil {
+		slog.WarnContext(ctx, "dateSpotSearchIndex.Remove failed", "err", err, "date_spot_id", input.DateSpotID)
+	}
 	return nil
 }
@@ -23,33 +23,45 @@ type DeleteDateSpotReviewOutput struct {
 }
 
 type DeleteDateSpotReviewInteractor struct {
+	UnitOfWork               repository.UnitOfWork
 	DateSpotReviewRepository repository.DateSpotReviewRepository
 }
 
-func NewDeleteDateSpotReviewUsecase(dateSpotReviewRepository repository.DateSpotReviewRepository) DeleteDateSpotReviewInputPort {
-	return &DeleteDateSpotReviewInteractor{DateSpotReviewRepository: dateSpotReviewRepository}
+func NewDeleteDateSpotReviewUsecase(
+	unitOfWork repository.UnitOfWork,
+	dateSpotReviewRepository repository.DateSpotReviewRepository,
+) DeleteDateSpotReviewInputPort {
+	return &DeleteDateSpotReviewInteractor{
+		UnitOfWork:               unitOfWork,
+		DateSpotReviewRepository: dateSpotReviewRepository,
+	}
 }
 
 func (i *DeleteDateSpotReviewInteractor) Execute(ctx context.Context, input DeleteDateSpotReviewInput) (*DeleteDateSpotReviewOutput, error) {
-	review, err := i.DateSpotReviewRepository.FindByID(ctx, input.ReviewID)
-	if err != nil {
-		return nil, apperror.NotFound()
-	}
+	var reviews []*model.DateSpotReview
+	err := i.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		review, err := i.DateSpotReviewRepository.FindByID(ctx, input.ReviewID)
+		if err != nil {
+			return apperror.NotFound()
+		}
 
-	// レビューを削除できるのは投稿者だけ
-	if review.UserID != input.OperatorID {
-		return nil, apperror.Forbidden("他のユーザーのレビューは削除できません")
-	}
-
-	dateSpotID := review.DateSpotID
+		// レビューを削除できるのは投稿者だけ
+		if review.UserID != input.OperatorID {
+			return apperror.Forbidden("他のユーザーのレビューは削除できません")
+		}
 
-	if err := i.DateSpotReviewRepository.DeleteByID(ctx, input.ReviewID); err != nil {
-		return nil, apperror.InternalServerError(err)
-	}
+		if err := i.DateSpotReviewRepository.DeleteByID(ctx, input.ReviewID); err != nil {
+			return apperror.InternalServerError(err)
+		}
 
-	reviews, err := i.DateSpotReviewRepository.FindByDateSpotID(ctx, dateSpotID)
+		reviews, err = i.DateSpotReviewRepository.FindByDateSpotID(ctx, review.DateSpotID, input.OperatorID)
+		if err != nil {
+			return apperror.InternalServerError(err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, apperror.InternalServerError(err)
+		return nil, err
 	}
 
 	return &DeleteDateSpotReviewOutput{
@@ -28,7 +28,7 @@ func TestDeleteDateSpotReviewInteractor_Execute(t *testing.T) {
 			FindByID(ctx, uint(1)).
 			Return(&model.DateSpotReview{ID: 1, UserID: 1, DateSpotID: 3}, nil)
 
-		interactor := usecase.NewDeleteDateSpotReviewUsecase(reviewRepo)
+		interactor := usecase.NewDeleteDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo)
 		output, err := interactor.Execute(ctx, usecase.DeleteDateSpotReviewInput{ReviewID: 1, OperatorID: 99})
 
 		assert.Nil(t, output)
@@ -52,10 +52,10 @@ func TestDeleteDateSpotReviewInteractor_Execute(t *testing.T) {
 			DeleteByID(ctx, uint(10)).
 			Return(nil)
 		reviewRepo.EXPECT().
-			FindByDateSpotID(ctx, uint(5)).
+			FindByDateSpotID(ctx, uint(5), uint(1)).
 			Return([]*model.DateSpotReview{}, nil)
 
-		interactor := usecase.NewDeleteDateSpotReviewUsecase(reviewRepo)
+		interactor := usecase.NewDeleteDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo)
 		output, err := interactor.Execute(ctx, usecase.DeleteDateSpotReviewInput{ReviewID: 10, OperatorID: 1})
 
 		require.NoError(t, err)
@@ -72,7 +72,7 @@ func TestDeleteDateSpotReviewInteractor_Execute(t *testing.T) {
 			FindByID(ctx, uint(10)).
 			Return(nil, errors.New("not found"))
 
-		interactor := usecase.NewDeleteDateSpotReviewUsecase(reviewRepo)
+		interactor := usecase.NewDeleteDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo)
 		output, err := interactor.Execute(ctx, usecase.DeleteDateSpotReviewInput{ReviewID: 10, OperatorID: 1})
 
 		assert.Error(t, err)
@@ -94,7 +94,7 @@ func TestDeleteDateSpotReviewInteractor_Execute(t *testing.T) {
 			DeleteByID(ctx, uint(10)).
 			Return(errors.New("db error"))
 
-		interactor := usecase.NewDeleteDateSpotReviewUsecase(reviewRepo)
+		interactor := usecase.NewDeleteDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo)
 		output, err := interactor.Execute(ctx, usecase.DeleteDateSpotReviewInput{ReviewID: 10, OperatorID: 1})
 
 		assert.Error(t, err)
@@ -24,7 +24,11 @@ func TestDeleteDateSpotInteractor_Execute(t *testing.T) {
 			Delete(ctx, uint(10)).
 			Return(nil)
 
-		interactor := usecase.NewDeleteDateSpotUsecase(dateSpotRepo)
+		searchIndex := repositorymock.NewMockDateSpotSearchIndex(ctrl)
+		searchIndex.EXPECT().
+			Remove(ctx, uint(10)).
+			Return(nil)
+		interactor := usecase.NewDeleteDateSpotUsecase(dateSpotRepo, searchIndex)
 		err := interactor.Execute(ctx, usecase.DeleteDateSpotInput{DateSpotID: 10})
 
 		require.NoError(t, err)
@@ -41,7 +45,8 @@ func TestDeleteDateSpotInteractor_Execute(t *testing.T) {
 			Delete(ctx, uint(10)).
 			Return(errors.New("not found"))
 
-		interactor := usecase.NewDeleteDateSpotUsecase(dateSpotRepo)
+		searchIndex := repositorymock.NewMockDateSpotSearchIndex(ctrl)
+		interactor := usecase.NewDeleteDateSpotUsecase(dateSpotRepo, searchIndex)
 		err := interactor.Execute(ctx, usecase.DeleteDateSpotInput{DateSpotID: 10})
 
 		assert.Error(t, err)
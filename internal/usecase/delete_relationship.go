@@ -65,12 +65,12 @@ func (i *DeleteRelationshipInteractor) Execute(ctx context.Context, input Delete
 		return nil, apperror.InternalServerError(err)
 	}
 
-	allUsers, err := i.UserRepository.Search(ctx, nil)
+	allUsers, err := i.UserRepository.Search(ctx, repository.UserSearchParams{ViewerID: input.OperatorID})
 	if err != nil {
 		return nil, apperror.InternalServerError(err)
 	}
 
-	usersWithRelations, err := i.UserService.BuildUsersWithRelations(ctx, allUsers)
+	usersWithRelations, err := i.UserService.BuildUsersWithRelations(ctx, allUsers.Items)
 	if err != nil {
 		return nil, err
 	}
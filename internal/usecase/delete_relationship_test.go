@@ -8,8 +8,10 @@ import (
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
 	repomock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
 	servicemock "github.com/daisuke-harada/date-courses-go/internal/domain/service/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -56,7 +58,9 @@ func TestDeleteRelationshipInteractor_Execute(t *testing.T) {
 		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(currentUser, nil)
 		userRepo.EXPECT().FindByID(ctx, uint(2)).Return(unfollowedUser, nil)
 		relationshipRepo.EXPECT().DeleteByUserIDs(ctx, uint(1), uint(2)).Return(nil)
-		userRepo.EXPECT().Search(ctx, nil).Return([]*model.User{currentUser, unfollowedUser}, nil)
+		userRepo.EXPECT().
+			Search(ctx, repository.UserSearchParams{ViewerID: 1}).
+			Return(pagination.Page[*model.User]{Items: []*model.User{currentUser, unfollowedUser}}, nil)
 		userService.EXPECT().
 			BuildUsersWithRelations(ctx, gomock.Any()).
 			Return([]*model.UserWithRelations{uwr, unfollowedUwr}, nil)
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
 )
 
@@ -19,16 +20,22 @@ type DeleteUserInput struct {
 }
 
 type DeleteUserInteractor struct {
+	UnitOfWork     repository.UnitOfWork
 	UserRepository repository.UserRepository
+	EventPublisher event.Publisher
 	DemoUserName   DemoUserName
 }
 
 func NewDeleteUserUsecase(
+	unitOfWork repository.UnitOfWork,
 	userRepository repository.UserRepository,
+	eventPublisher event.Publisher,
 	demoUserName DemoUserName,
 ) DeleteUserInputPort {
 	return &DeleteUserInteractor{
+		UnitOfWork:     unitOfWork,
 		UserRepository: userRepository,
+		EventPublisher: eventPublisher,
 		DemoUserName:   demoUserName,
 	}
 }
@@ -48,9 +55,13 @@ func (i *DeleteUserInteractor) Execute(ctx context.Context, input DeleteUserInpu
 		return err
 	}
 
-	if err := i.UserRepository.Delete(ctx, user.ID); err != nil {
-		return apperror.InternalServerError(err)
-	}
-
-	return nil
+	return i.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := i.UserRepository.Delete(ctx, user.ID); err != nil {
+			return apperror.InternalServerError(err)
+		}
+		if err := i.EventPublisher.Publish(ctx, event.UserDeleted{UserID: user.ID}); err != nil {
+			return apperror.InternalServerError(err)
+		}
+		return nil
+	})
 }
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+)
+
+// DeleteUserBlockInputPort はユーザーのブロックを解除するユースケースの入力ポートです。
+type DeleteUserBlockInputPort interface {
+	Execute(context.Context, DeleteUserBlockInput) error
+}
+
+type DeleteUserBlockInput struct {
+	// UserID はブロックを解除するユーザー（トークンの currentUser）の ID です。
+	UserID        uint
+	BlockedUserID uint
+}
+
+type DeleteUserBlockInteractor struct {
+	UserBlockRepository repository.UserBlockRepository
+}
+
+func NewDeleteUserBlockUsecase(
+	userBlockRepository repository.UserBlockRepository,
+) DeleteUserBlockInputPort {
+	return &DeleteUserBlockInteractor{
+		UserBlockRepository: userBlockRepository,
+	}
+}
+
+// Execute は本人がしたブロックだけを解除します。ブロックで外したフォローは元に戻しません。
+func (i *DeleteUserBlockInteractor) Execute(ctx context.Context, input DeleteUserBlockInput) error {
+	if err := i.UserBlockRepository.DeleteByUserIDs(ctx, input.UserID, input.BlockedUserID); err != nil {
+		return apperror.InternalServerError(err)
+	}
+	return nil
+}
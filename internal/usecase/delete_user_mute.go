@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+)
+
+// DeleteUserMuteInputPort はユーザーのミュートを解除するユースケースの入力ポートです。
+type DeleteUserMuteInputPort interface {
+	Execute(context.Context, DeleteUserMuteInput) error
+}
+
+type DeleteUserMuteInput struct {
+	// UserID はミュートを解除するユーザー（トークンの currentUser）の ID です。
+	UserID      uint
+	MutedUserID uint
+}
+
+type DeleteUserMuteInteractor struct {
+	UserMuteRepository repository.UserMuteRepository
+}
+
+func NewDeleteUserMuteUsecase(
+	userMuteRepository repository.UserMuteRepository,
+) DeleteUserMuteInputPort {
+	return &DeleteUserMuteInteractor{
+		UserMuteRepository: userMuteRepository,
+	}
+}
+
+func (i *DeleteUserMuteInteractor) Execute(ctx context.Context, input DeleteUserMuteInput) error {
+	if err := i.UserMuteRepository.DeleteByUserIDs(ctx, input.UserID, input.MutedUserID); err != nil {
+		return apperror.InternalServerError(err)
+	}
+	return nil
+}
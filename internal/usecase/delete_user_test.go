@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
+	eventmock "github.com/daisuke-harada/date-courses-go/internal/domain/event/mock"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
@@ -27,7 +29,7 @@ func TestDeleteUserInteractor_Execute(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(demo, nil)
 
-		interactor := usecase.NewDeleteUserUsecase(userRepo, "guest")
+		interactor := usecase.NewDeleteUserUsecase(newPassThroughUnitOfWork(ctrl), userRepo, eventmock.NewMockPublisher(ctrl), "guest")
 		err := interactor.Execute(ctx, usecase.DeleteUserInput{ID: 1, OperatorID: 1})
 
 		require.Error(t, err)
@@ -46,8 +48,10 @@ func TestDeleteUserInteractor_Execute(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(user, nil)
 		userRepo.EXPECT().Delete(ctx, uint(1)).Return(nil)
+		publisher := eventmock.NewMockPublisher(ctrl)
+		publisher.EXPECT().Publish(ctx, event.UserDeleted{UserID: 1}).Return(nil)
 
-		interactor := usecase.NewDeleteUserUsecase(userRepo, "guest")
+		interactor := usecase.NewDeleteUserUsecase(newPassThroughUnitOfWork(ctrl), userRepo, publisher, "guest")
 		err := interactor.Execute(ctx, usecase.DeleteUserInput{ID: 1, OperatorID: 1})
 
 		require.NoError(t, err)
@@ -64,7 +68,7 @@ func TestDeleteUserInteractor_Execute(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(user, nil)
 
-		interactor := usecase.NewDeleteUserUsecase(userRepo, "guest")
+		interactor := usecase.NewDeleteUserUsecase(newPassThroughUnitOfWork(ctrl), userRepo, eventmock.NewMockPublisher(ctrl), "guest")
 		err := interactor.Execute(ctx, usecase.DeleteUserInput{ID: 1, OperatorID: 99})
 
 		require.Error(t, err)
@@ -82,7 +86,7 @@ func TestDeleteUserInteractor_Execute(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(ctx, uint(999)).Return(nil, errors.New("not found"))
 
-		interactor := usecase.NewDeleteUserUsecase(userRepo, "guest")
+		interactor := usecase.NewDeleteUserUsecase(newPassThroughUnitOfWork(ctrl), userRepo, eventmock.NewMockPublisher(ctrl), "guest")
 		err := interactor.Execute(ctx, usecase.DeleteUserInput{ID: 999, OperatorID: 999})
 
 		assert.Error(t, err)
@@ -102,7 +106,7 @@ func TestDeleteUserInteractor_Execute(t *testing.T) {
 		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(user, nil)
 		userRepo.EXPECT().Delete(ctx, uint(1)).Return(errors.New("db error"))
 
-		interactor := usecase.NewDeleteUserUsecase(userRepo, "guest")
+		interactor := usecase.NewDeleteUserUsecase(newPassThroughUnitOfWork(ctrl), userRepo, eventmock.NewMockPublisher(ctrl), "guest")
 		err := interactor.Execute(ctx, usecase.DeleteUserInput{ID: 1, OperatorID: 1})
 
 		assert.Error(t, err)
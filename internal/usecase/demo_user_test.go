@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	eventmock "github.com/daisuke-harada/date-courses-go/internal/domain/event/mock"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
@@ -26,8 +27,10 @@ func TestDemoUserProtection(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(ctx, uint(2)).Return(user, nil)
 		userRepo.EXPECT().Delete(ctx, uint(2)).Return(nil)
+		publisher := eventmock.NewMockPublisher(ctrl)
+		publisher.EXPECT().Publish(ctx, gomock.Any()).Return(nil)
 
-		interactor := usecase.NewDeleteUserUsecase(userRepo, "guest")
+		interactor := usecase.NewDeleteUserUsecase(newPassThroughUnitOfWork(ctrl), userRepo, publisher, "guest")
 		require.NoError(t, interactor.Execute(ctx, usecase.DeleteUserInput{ID: 2, OperatorID: 2}))
 	})
 
@@ -42,8 +45,10 @@ func TestDemoUserProtection(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(user, nil)
 		userRepo.EXPECT().Delete(ctx, uint(1)).Return(nil)
+		publisher := eventmock.NewMockPublisher(ctrl)
+		publisher.EXPECT().Publish(ctx, gomock.Any()).Return(nil)
 
-		interactor := usecase.NewDeleteUserUsecase(userRepo, "")
+		interactor := usecase.NewDeleteUserUsecase(newPassThroughUnitOfWork(ctrl), userRepo, publisher, "")
 		require.NoError(t, interactor.Execute(ctx, usecase.DeleteUserInput{ID: 1, OperatorID: 1}))
 	})
 
@@ -58,7 +63,7 @@ func TestDemoUserProtection(t *testing.T) {
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
 		userRepo.EXPECT().FindByID(ctx, uint(5)).Return(user, nil)
 
-		interactor := usecase.NewDeleteUserUsecase(userRepo, "demo")
+		interactor := usecase.NewDeleteUserUsecase(newPassThroughUnitOfWork(ctrl), userRepo, eventmock.NewMockPublisher(ctrl), "demo")
 		err := interactor.Execute(ctx, usecase.DeleteUserInput{ID: 5, OperatorID: 5})
 
 		require.Error(t, err)
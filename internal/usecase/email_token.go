@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// EmailTokenSettings はメールで送るリンクの基点と有効期間です。
+// 設定から DI 経由で注入します。
+type EmailTokenSettings struct {
+	// LinkBaseURL はリンク先（フロントエンド）の URL の基点です。
+	LinkBaseURL      string
+	VerifyEmailTTL   time.Duration
+	ResetPasswordTTL time.Duration
+}
+
+const (
+	verifyEmailPath   = "/verify-email"
+	resetPasswordPath = "/password/reset"
+)
+
+// errInvalidEmailLink は知らない・使用済み・期限切れのトークンに返すエラーです。
+// どれに当たるかを区別して返すと、推測したトークンの状態を探れてしまうため同じメッセージにします。
+var errInvalidEmailLink = apperror.UnprocessableEntity("リンクが無効か、有効期限が切れています。もう一度やり直してください")
+
+// issueEmailToken は user の purpose のトークンを保存し、メールに載せる平文のトークンを返します。
+// 送り直したときに古いメールのリンクが使えないよう、未使用のトークンは先に無効にします。
+func issueEmailToken(
+	ctx context.Context,
+	emailTokenRepository repository.EmailTokenRepository,
+	user *model.User,
+	purpose model.EmailTokenPurpose,
+	ttl time.Duration,
+) (string, error) {
+	now := time.Now()
+	if err := emailTokenRepository.InvalidateByUserID(ctx, user.ID, purpose, now); err != nil {
+		return "", apperror.InternalServerError(err)
+	}
+	stored, token, err := model.NewEmailToken(user, purpose, now.Add(ttl))
+	if err != nil {
+		return "", apperror.InternalServerError(err)
+	}
+	if err := emailTokenRepository.Create(ctx, stored); err != nil {
+		return "", apperror.InternalServerError(err)
+	}
+	return token, nil
+}
+
+// consumeEmailToken は purpose のトークンを照合して使用済みにし、送り先のユーザーを返します。
+// 送った後にユーザーがメールアドレスを変えていたら、古いアドレス宛てのリンクとして拒否します。
+func consumeEmailToken(
+	ctx context.Context,
+	emailTokenRepository repository.EmailTokenRepository,
+	userRepository repository.UserRepository,
+	purpose model.EmailTokenPurpose,
+	token string,
+) (*model.User, error) {
+	stored, err := emailTokenRepository.FindByHash(ctx, purpose, model.HashEmailToken(token))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errInvalidEmailLink
+		}
+		return nil, apperror.InternalServerError(err)
+	}
+	now := time.Now()
+	if stored.IsUsed() || stored.IsExpired(now) {
+		return nil, errInvalidEmailLink
+	}
+	// 同じリンクが同時に開かれたときは、先に使用済みにした方だけを通す
+	used, err := emailTokenRepository.MarkUsed(ctx, stored.ID, now)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if !used {
+		return nil, errInvalidEmailLink
+	}
+
+	user, err := userRepository.FindByID(ctx, stored.UserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errInvalidEmailLink
+		}
+		return nil, apperror.InternalServerError(err)
+	}
+	if user.Email != stored.Email {
+		return nil, errInvalidEmailLink
+	}
+	return user, nil
+}
+
+// emailLink はメールに載せる、token 付きのリンクを作ります。
+func emailLink(baseURL, path, token string) string {
+	return strings.TrimRight(baseURL, "/") + path + "?token=" + url.QueryEscape(token)
+}
+
+func newVerifyEmailMessage(user *model.User, settings EmailTokenSettings, token string) repository.MailMessage {
+	return repository.MailMessage{
+		To:      user.Email,
+		Subject: "【DateCourses】メールアドレスの確認",
+		Body: fmt.Sprintf(`%s さん
+
+DateCourses にご登録いただきありがとうございます。
+以下のリンクを開いて、メールアドレスの確認を完了してください。
+確認が済むまで、デートコースやレビューの投稿はできません。
+
+%s
+
+このリンクの有効期限は %s です。
+お心当たりのない場合は、このメールを破棄してください。
+`, user.Name, emailLink(settings.LinkBaseURL, verifyEmailPath, token), formatTTL(settings.VerifyEmailTTL)),
+	}
+}
+
+func newResetPasswordMessage(user *model.User, settings EmailTokenSettings, token string) repository.MailMessage {
+	return repository.MailMessage{
+		To:      user.Email,
+		Subject: "【DateCourses】パスワードの再設定",
+		Body: fmt.Sprintf(`%s さん
+
+パスワードの再設定を受け付けました。
+以下のリンクを開いて、新しいパスワードを設定してください。
+
+%s
+
+このリンクの有効期限は %s です。
+お心当たりのない場合は、このメールを破棄してください。パスワードは変更されません。
+`, user.Name, emailLink(settings.LinkBaseURL, resetPasswordPath, token), formatTTL(settings.ResetPasswordTTL)),
+	}
+}
+
+// formatTTL は有効期間を「24時間」「30分」のようにメール本文向けに整えます。
+func formatTTL(ttl time.Duration) string {
+	if ttl >= time.Hour && ttl%time.Hour == 0 {
+		return fmt.Sprintf("%d時間", int(ttl/time.Hour))
+	}
+	return fmt.Sprintf("%d分", int(ttl/time.Minute))
+}
+
+// sendMailQuietly はメールを送り、失敗してもログに残すだけにします。
+// 送信に失敗しても、登録などの本来の処理は取り消さずに送り直してもらうためです。
+func sendMailQuietly(ctx context.Context, mailer repository.Mailer, msg repository.MailMessage) {
+	if err := mailer.Send(ctx, msg); err != nil {
+		slog.ErrorContext(ctx, "failed to send mail", "err", err, "subject", msg.Subject)
+	}
+}
@@ -6,8 +6,39 @@ import (
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
+	"github.com/samber/lo"
 )
 
+// attachCourseRoutes は各コースに立ち寄り先間の経路の見積もりを載せます。
+func attachCourseRoutes(ctx context.Context, courseRouteService service.CourseRouteService, courses ...*model.Course) error {
+	for _, course := range courses {
+		route, err := courseRouteService.BuildRoute(ctx, course)
+		if err != nil {
+			return apperror.InternalServerError(err)
+		}
+		course.Route = route
+	}
+	return nil
+}
+
+// attachCourseFavorites は各コースにお気に入りの件数と、viewerID がお気に入りに入れているかを載せます。
+// コースの数によらず集計は1回のクエリで済ませます。
+func attachCourseFavorites(ctx context.Context, courseFavoriteRepository repository.CourseFavoriteRepository, viewerID uint, courses ...*model.Course) error {
+	if len(courses) == 0 {
+		return nil
+	}
+	courseIDs := lo.Map(courses, func(c *model.Course, _ int) uint { return c.ID })
+	summaries, err := courseFavoriteRepository.SummarizeByCourseIDs(ctx, courseIDs, viewerID)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+	for _, course := range courses {
+		course.Favorites = summaries[course.ID]
+	}
+	return nil
+}
+
 type GetCourseInputPort interface {
 	Execute(context.Context, GetCourseInput) (*GetCourseOutput, error)
 }
@@ -23,12 +54,23 @@ type GetCourseOutput struct {
 }
 
 type GetCourseInteractor struct {
-	CourseRepository repository.CourseRepository
+	CourseRepository         repository.CourseRepository
+	CourseFavoriteRepository repository.CourseFavoriteRepository
+	UserBlockRepository      repository.UserBlockRepository
+	CourseRouteService       service.CourseRouteService
 }
 
-func NewGetCourseUsecase(courseRepository repository.CourseRepository) GetCourseInputPort {
+func NewGetCourseUsecase(
+	courseRepository repository.CourseRepository,
+	courseFavoriteRepository repository.CourseFavoriteRepository,
+	userBlockRepository repository.UserBlockRepository,
+	courseRouteService service.CourseRouteService,
+) GetCourseInputPort {
 	return &GetCourseInteractor{
-		CourseRepository: courseRepository,
+		CourseRepository:         courseRepository,
+		CourseFavoriteRepository: courseFavoriteRepository,
+		UserBlockRepository:      userBlockRepository,
+		CourseRouteService:       courseRouteService,
 	}
 }
 
@@ -37,5 +79,14 @@ func (i *GetCourseInteractor) Execute(ctx context.Context, input GetCourseInput)
 	if err != nil {
 		return nil, apperror.NotFound()
 	}
+	if err := ensureNotBlocked(ctx, i.UserBlockRepository, input.ViewerID, course.UserID); err != nil {
+		return nil, err
+	}
+	if err := attachCourseRoutes(ctx, i.CourseRouteService, course); err != nil {
+		return nil, err
+	}
+	if err := attachCourseFavorites(ctx, i.CourseFavoriteRepository, input.ViewerID, course); err != nil {
+		return nil, err
+	}
 	return &GetCourseOutput{Course: course}, nil
 }
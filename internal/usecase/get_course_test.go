@@ -3,10 +3,13 @@ package usecase_test
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
 
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	repomock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	servicemock "github.com/daisuke-harada/date-courses-go/internal/domain/service/mock"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -21,16 +24,27 @@ func TestGetCourseInteractor_Execute(t *testing.T) {
 		defer ctrl.Finish()
 
 		courseRepo := repomock.NewMockCourseRepository(ctrl)
+		favoriteRepo := repomock.NewMockCourseFavoriteRepository(ctrl)
+		routeService := servicemock.NewMockCourseRouteService(ctrl)
 		courseRepo.EXPECT().
 			FindByID(ctx, uint(1), gomock.Any()).
 			Return(&model.Course{ID: 1, UserID: 2, TravelMode: "DRIVING", Authority: "公開"}, nil)
-
-		interactor := usecase.NewGetCourseUsecase(courseRepo)
+		routeService.EXPECT().
+			BuildRoute(ctx, gomock.Any()).
+			Return(&model.CourseRoute{TotalDistanceMeters: 1200, TotalDurationSeconds: 144}, nil)
+		favoriteRepo.EXPECT().
+			SummarizeByCourseIDs(ctx, []uint{1}, uint(0)).
+			Return(map[uint]*model.CourseFavoriteSummary{1: {Count: 3}}, nil)
+
+		interactor := usecase.NewGetCourseUsecase(courseRepo, favoriteRepo, repomock.NewMockUserBlockRepository(ctrl), routeService)
 		output, err := interactor.Execute(ctx, usecase.GetCourseInput{CourseID: 1})
 
 		require.NoError(t, err)
 		assert.Equal(t, uint(1), output.Course.ID)
 		assert.Equal(t, uint(2), output.Course.UserID)
+		require.NotNil(t, output.Course.Route)
+		assert.Equal(t, 1200, output.Course.Route.TotalDistanceMeters)
+		assert.Equal(t, &model.CourseFavoriteSummary{Count: 3}, output.Course.Favorites)
 	})
 
 	// 閲覧者の ID がそのまま repository に渡り、SQL 側で可視性が絞られる
@@ -39,15 +53,24 @@ func TestGetCourseInteractor_Execute(t *testing.T) {
 		defer ctrl.Finish()
 
 		courseRepo := repomock.NewMockCourseRepository(ctrl)
+		favoriteRepo := repomock.NewMockCourseFavoriteRepository(ctrl)
+		routeService := servicemock.NewMockCourseRouteService(ctrl)
 		courseRepo.EXPECT().
 			FindByID(ctx, uint(1), uint(7)).
 			Return(&model.Course{ID: 1, UserID: 7, Authority: model.CourseAuthorityPrivate}, nil)
-
-		interactor := usecase.NewGetCourseUsecase(courseRepo)
+		routeService.EXPECT().
+			BuildRoute(ctx, gomock.Any()).
+			Return(&model.CourseRoute{}, nil)
+		favoriteRepo.EXPECT().
+			SummarizeByCourseIDs(ctx, []uint{1}, uint(7)).
+			Return(map[uint]*model.CourseFavoriteSummary{1: {Count: 1, FavoritedByViewer: true}}, nil)
+
+		interactor := usecase.NewGetCourseUsecase(courseRepo, favoriteRepo, repomock.NewMockUserBlockRepository(ctrl), routeService)
 		output, err := interactor.Execute(ctx, usecase.GetCourseInput{CourseID: 1, ViewerID: 7})
 
 		require.NoError(t, err)
 		assert.Equal(t, uint(1), output.Course.ID)
+		assert.True(t, output.Course.Favorites.FavoritedByViewer, "閲覧者のお気に入り有無も同じ ID で集計する")
 	})
 
 	// 見えないコースは repository が返さないので 404 になる
@@ -56,11 +79,13 @@ func TestGetCourseInteractor_Execute(t *testing.T) {
 		defer ctrl.Finish()
 
 		courseRepo := repomock.NewMockCourseRepository(ctrl)
+		favoriteRepo := repomock.NewMockCourseFavoriteRepository(ctrl)
+		routeService := servicemock.NewMockCourseRouteService(ctrl)
 		courseRepo.EXPECT().
 			FindByID(ctx, uint(1), uint(0)).
 			Return(nil, errors.New("record not found"))
 
-		interactor := usecase.NewGetCourseUsecase(courseRepo)
+		interactor := usecase.NewGetCourseUsecase(courseRepo, favoriteRepo, repomock.NewMockUserBlockRepository(ctrl), routeService)
 		output, err := interactor.Execute(ctx, usecase.GetCourseInput{CourseID: 1, ViewerID: 0})
 
 		assert.Nil(t, output)
@@ -72,14 +97,86 @@ func TestGetCourseInteractor_Execute(t *testing.T) {
 		defer ctrl.Finish()
 
 		courseRepo := repomock.NewMockCourseRepository(ctrl)
+		favoriteRepo := repomock.NewMockCourseFavoriteRepository(ctrl)
+		routeService := servicemock.NewMockCourseRouteService(ctrl)
 		courseRepo.EXPECT().
 			FindByID(ctx, uint(999), gomock.Any()).
 			Return(nil, errors.New("record not found"))
 
-		interactor := usecase.NewGetCourseUsecase(courseRepo)
+		interactor := usecase.NewGetCourseUsecase(courseRepo, favoriteRepo, repomock.NewMockUserBlockRepository(ctrl), routeService)
 		output, err := interactor.Execute(ctx, usecase.GetCourseInput{CourseID: 999})
 
 		assert.Error(t, err)
 		assert.Nil(t, output)
 	})
+	t.Run("error_route_service_failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		courseRepo := repomock.NewMockCourseRepository(ctrl)
+		favoriteRepo := repomock.NewMockCourseFavoriteRepository(ctrl)
+		routeService := servicemock.NewMockCourseRouteService(ctrl)
+		courseRepo.EXPECT().
+			FindByID(ctx, uint(1), gomock.Any()).
+			Return(&model.Course{ID: 1, UserID: 2}, nil)
+		routeService.EXPECT().
+			BuildRoute(ctx, gomock.Any()).
+			Return(nil, errors.New("routing engine unavailable"))
+
+		interactor := usecase.NewGetCourseUsecase(courseRepo, favoriteRepo, repomock.NewMockUserBlockRepository(ctrl), routeService)
+		output, err := interactor.Execute(ctx, usecase.GetCourseInput{CourseID: 1})
+
+		assert.Nil(t, output)
+		require.Error(t, err)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusInternalServerError, statusCode)
+	})
+
+	t.Run("error_favorite_summary_failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		courseRepo := repomock.NewMockCourseRepository(ctrl)
+		favoriteRepo := repomock.NewMockCourseFavoriteRepository(ctrl)
+		routeService := servicemock.NewMockCourseRouteService(ctrl)
+		courseRepo.EXPECT().
+			FindByID(ctx, uint(1), gomock.Any()).
+			Return(&model.Course{ID: 1, UserID: 2}, nil)
+		routeService.EXPECT().
+			BuildRoute(ctx, gomock.Any()).
+			Return(&model.CourseRoute{}, nil)
+		favoriteRepo.EXPECT().
+			SummarizeByCourseIDs(ctx, []uint{1}, uint(0)).
+			Return(nil, errors.New("db error"))
+
+		interactor := usecase.NewGetCourseUsecase(courseRepo, favoriteRepo, repomock.NewMockUserBlockRepository(ctrl), routeService)
+		output, err := interactor.Execute(ctx, usecase.GetCourseInput{CourseID: 1})
+
+		assert.Nil(t, output)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusInternalServerError, statusCode)
+	})
+
+	// ブロックした相手・された相手のコースは、見えないコースと同じく 404 にする
+	t.Run("error_not_found_when_author_blocked", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		courseRepo := repomock.NewMockCourseRepository(ctrl)
+		blockRepo := repomock.NewMockUserBlockRepository(ctrl)
+		courseRepo.EXPECT().
+			FindByID(ctx, uint(1), uint(7)).
+			Return(&model.Course{ID: 1, UserID: 2}, nil)
+		blockRepo.EXPECT().ExistsBetween(ctx, uint(7), uint(2)).Return(true, nil)
+
+		interactor := usecase.NewGetCourseUsecase(courseRepo, repomock.NewMockCourseFavoriteRepository(ctrl), blockRepo, servicemock.NewMockCourseRouteService(ctrl))
+		output, err := interactor.Execute(ctx, usecase.GetCourseInput{CourseID: 1, ViewerID: 7})
+
+		assert.Nil(t, output)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, statusCode)
+	})
 }
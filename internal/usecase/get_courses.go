@@ -3,8 +3,11 @@ package usecase
 import (
 	"context"
 
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 )
 
 type GetCoursesInputPort interface {
@@ -13,33 +16,58 @@ type GetCoursesInputPort interface {
 
 type GetCoursesInput struct {
 	PrefectureID *int
+	Page         PageInput
+	// ViewerID は閲覧しているユーザーの ID です。未ログインの場合は 0 になります。
+	ViewerID uint
 }
 
 type GetCoursesOutput struct {
-	Courses []*model.Course
+	Courses    []*model.Course
+	NextCursor *pagination.Cursor
 }
 
 type GetCoursesInteractor struct {
-	CourseRepository repository.CourseRepository
+	CourseRepository         repository.CourseRepository
+	CourseFavoriteRepository repository.CourseFavoriteRepository
+	CourseRouteService       service.CourseRouteService
 }
 
 func NewGetCoursesUsecase(
 	courseRepository repository.CourseRepository,
+	courseFavoriteRepository repository.CourseFavoriteRepository,
+	courseRouteService service.CourseRouteService,
 ) GetCoursesInputPort {
 	return &GetCoursesInteractor{
-		CourseRepository: courseRepository,
+		CourseRepository:         courseRepository,
+		CourseFavoriteRepository: courseFavoriteRepository,
+		CourseRouteService:       courseRouteService,
 	}
 }
 
 func (i *GetCoursesInteractor) Execute(ctx context.Context, input GetCoursesInput) (*GetCoursesOutput, error) {
+	sort, page, errs := input.Page.resolve(pagination.SortNewest, pagination.SortRating, pagination.SortReviewCount, pagination.SortName)
+	if len(errs) > 0 {
+		return nil, apperror.UnprocessableEntity(errs...)
+	}
+
 	courses, err := i.CourseRepository.Search(ctx, repository.CourseSearchParams{
 		PrefectureID: input.PrefectureID,
+		Sort:         sort,
+		Page:         page,
+		ViewerID:     input.ViewerID,
 	})
 	if err != nil {
 		return nil, err
 	}
+	if err := attachCourseRoutes(ctx, i.CourseRouteService, courses.Items...); err != nil {
+		return nil, err
+	}
+	if err := attachCourseFavorites(ctx, i.CourseFavoriteRepository, input.ViewerID, courses.Items...); err != nil {
+		return nil, err
+	}
 
 	return &GetCoursesOutput{
-		Courses: courses,
+		Courses:    courses.Items,
+		NextCursor: courses.NextCursor,
 	}, nil
 }
@@ -8,6 +8,8 @@ import (
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
 	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	servicemock "github.com/daisuke-harada/date-courses-go/internal/domain/service/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -20,21 +22,58 @@ func TestGetCoursesInteractor_Execute(t *testing.T) {
 		defer ctrl.Finish()
 
 		mockRepo := repositorymock.NewMockCourseRepository(ctrl)
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
+		routeService := servicemock.NewMockCourseRouteService(ctrl)
 		courses := []*model.Course{
 			{ID: 1, UserID: 1, TravelMode: "car", Authority: "public"},
 			{ID: 2, UserID: 2, TravelMode: "walk", Authority: "public"},
 		}
 		mockRepo.EXPECT().
-			Search(gomock.Any(), repository.CourseSearchParams{}).
-			Return(courses, nil)
-
-		interactor := usecase.NewGetCoursesUsecase(mockRepo)
+			Search(gomock.Any(), repository.CourseSearchParams{Sort: pagination.SortNewest, Page: defaultPage}).
+			Return(pagination.Page[*model.Course]{Items: courses}, nil)
+		routeService.EXPECT().
+			BuildRoute(gomock.Any(), gomock.Any()).
+			Return(&model.CourseRoute{}, nil).
+			Times(2)
+		favoriteRepo.EXPECT().
+			SummarizeByCourseIDs(gomock.Any(), []uint{1, 2}, uint(0)).
+			Return(map[uint]*model.CourseFavoriteSummary{1: {Count: 2}, 2: {}}, nil)
+
+		interactor := usecase.NewGetCoursesUsecase(mockRepo, favoriteRepo, routeService)
 		output, err := interactor.Execute(context.Background(), usecase.GetCoursesInput{})
 
 		require.NoError(t, err)
 		assert.Equal(t, 2, len(output.Courses))
 		assert.Equal(t, uint(1), output.Courses[0].ID)
 		assert.Equal(t, uint(2), output.Courses[1].ID)
+		assert.NotNil(t, output.Courses[0].Route)
+		assert.Equal(t, 2, output.Courses[0].Favorites.Count)
+	})
+
+	// ログイン中なら、閲覧者がお気に入りに入れているかも一覧に載せる
+	t.Run("success_attaches_favorites_for_viewer", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := repositorymock.NewMockCourseRepository(ctrl)
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
+		routeService := servicemock.NewMockCourseRouteService(ctrl)
+		courses := []*model.Course{{ID: 1, UserID: 1}}
+		mockRepo.EXPECT().
+			Search(gomock.Any(), gomock.Any()).
+			Return(pagination.Page[*model.Course]{Items: courses}, nil)
+		routeService.EXPECT().
+			BuildRoute(gomock.Any(), courses[0]).
+			Return(&model.CourseRoute{}, nil)
+		favoriteRepo.EXPECT().
+			SummarizeByCourseIDs(gomock.Any(), []uint{1}, uint(5)).
+			Return(map[uint]*model.CourseFavoriteSummary{1: {Count: 1, FavoritedByViewer: true}}, nil)
+
+		interactor := usecase.NewGetCoursesUsecase(mockRepo, favoriteRepo, routeService)
+		output, err := interactor.Execute(context.Background(), usecase.GetCoursesInput{ViewerID: 5})
+
+		require.NoError(t, err)
+		assert.True(t, output.Courses[0].Favorites.FavoritedByViewer)
 	})
 
 	t.Run("success_with_prefecture_id_filter", func(t *testing.T) {
@@ -42,15 +81,23 @@ func TestGetCoursesInteractor_Execute(t *testing.T) {
 		defer ctrl.Finish()
 
 		mockRepo := repositorymock.NewMockCourseRepository(ctrl)
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
+		routeService := servicemock.NewMockCourseRouteService(ctrl)
 		prefectureID := 13
 		courses := []*model.Course{
 			{ID: 1, UserID: 1, TravelMode: "car", Authority: "public"},
 		}
 		mockRepo.EXPECT().
-			Search(gomock.Any(), repository.CourseSearchParams{PrefectureID: &prefectureID}).
-			Return(courses, nil)
-
-		interactor := usecase.NewGetCoursesUsecase(mockRepo)
+			Search(gomock.Any(), repository.CourseSearchParams{PrefectureID: &prefectureID, Sort: pagination.SortNewest, Page: defaultPage}).
+			Return(pagination.Page[*model.Course]{Items: courses}, nil)
+		routeService.EXPECT().
+			BuildRoute(gomock.Any(), courses[0]).
+			Return(&model.CourseRoute{}, nil)
+		favoriteRepo.EXPECT().
+			SummarizeByCourseIDs(gomock.Any(), []uint{1}, uint(0)).
+			Return(map[uint]*model.CourseFavoriteSummary{1: {}}, nil)
+
+		interactor := usecase.NewGetCoursesUsecase(mockRepo, favoriteRepo, routeService)
 		output, err := interactor.Execute(context.Background(), usecase.GetCoursesInput{PrefectureID: &prefectureID})
 
 		require.NoError(t, err)
@@ -62,11 +109,13 @@ func TestGetCoursesInteractor_Execute(t *testing.T) {
 		defer ctrl.Finish()
 
 		mockRepo := repositorymock.NewMockCourseRepository(ctrl)
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
+		routeService := servicemock.NewMockCourseRouteService(ctrl)
 		mockRepo.EXPECT().
-			Search(gomock.Any(), repository.CourseSearchParams{}).
-			Return([]*model.Course{}, nil)
+			Search(gomock.Any(), repository.CourseSearchParams{Sort: pagination.SortNewest, Page: defaultPage}).
+			Return(pagination.Page[*model.Course]{Items: []*model.Course{}}, nil)
 
-		interactor := usecase.NewGetCoursesUsecase(mockRepo)
+		interactor := usecase.NewGetCoursesUsecase(mockRepo, favoriteRepo, routeService)
 		output, err := interactor.Execute(context.Background(), usecase.GetCoursesInput{})
 
 		require.NoError(t, err)
@@ -78,11 +127,13 @@ func TestGetCoursesInteractor_Execute(t *testing.T) {
 		defer ctrl.Finish()
 
 		mockRepo := repositorymock.NewMockCourseRepository(ctrl)
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
+		routeService := servicemock.NewMockCourseRouteService(ctrl)
 		mockRepo.EXPECT().
-			Search(gomock.Any(), repository.CourseSearchParams{}).
-			Return(nil, apperror.InternalServerError(nil))
+			Search(gomock.Any(), repository.CourseSearchParams{Sort: pagination.SortNewest, Page: defaultPage}).
+			Return(pagination.Page[*model.Course]{}, apperror.InternalServerError(nil))
 
-		interactor := usecase.NewGetCoursesUsecase(mockRepo)
+		interactor := usecase.NewGetCoursesUsecase(mockRepo, favoriteRepo, routeService)
 		output, err := interactor.Execute(context.Background(), usecase.GetCoursesInput{})
 
 		assert.Nil(t, output)
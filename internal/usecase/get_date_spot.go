@@ -13,6 +13,8 @@ type GetDateSpotInputPort interface {
 
 type GetDateSpotInput struct {
 	ID uint
+	// ViewerID は閲覧しているユーザーの ID です。未ログインの場合は 0 になります。
+	ViewerID uint
 }
 
 type GetDateSpotOutput struct {
@@ -41,7 +43,7 @@ func (i *GetDateSpotInteractor) Execute(ctx context.Context, input GetDateSpotIn
 		return nil, err
 	}
 
-	reviews, err := i.DateSpotReviewRepository.FindByDateSpotID(ctx, input.ID)
+	reviews, err := i.DateSpotReviewRepository.FindByDateSpotID(ctx, input.ID, input.ViewerID)
 	if err != nil {
 		return nil, err
 	}
@@ -29,11 +29,11 @@ func TestGetDateSpotInteractor_Execute(t *testing.T) {
 
 		reviewRepo := repositorymock.NewMockDateSpotReviewRepository(ctrl)
 		reviewRepo.EXPECT().
-			FindByDateSpotID(ctx, uint(1)).
+			FindByDateSpotID(ctx, uint(1), uint(4)).
 			Return(reviews, nil)
 
 		interactor := usecase.NewGetDateSpotUsecase(dateSpotRepo, reviewRepo)
-		output, err := interactor.Execute(ctx, usecase.GetDateSpotInput{ID: 1})
+		output, err := interactor.Execute(ctx, usecase.GetDateSpotInput{ID: 1, ViewerID: 4})
 
 		require.NoError(t, err)
 		require.NotNil(t, output)
@@ -78,7 +78,7 @@ func TestGetDateSpotInteractor_Execute(t *testing.T) {
 
 		reviewRepo := repositorymock.NewMockDateSpotReviewRepository(ctrl)
 		reviewRepo.EXPECT().
-			FindByDateSpotID(ctx, uint(1)).
+			FindByDateSpotID(ctx, uint(1), uint(0)).
 			Return(nil, apperror.InternalServerError(nil))
 
 		interactor := usecase.NewGetDateSpotUsecase(dateSpotRepo, reviewRepo)
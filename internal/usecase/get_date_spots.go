@@ -2,9 +2,14 @@ package usecase
 
 import (
 	"context"
+	"strings"
+	"time"
 
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/samber/lo"
 )
 
 type GetDateSpotsInputPort interface {
@@ -17,38 +22,150 @@ type GetDateSpotsInput struct {
 	DateSpotName *string
 	PrefectureID *int
 	GenreID      *int
-	ComeTime     *string
+	// ComeTime は来店日時です。指定するとその日時に営業しているスポットだけを返します。
+	ComeTime *string
+	// 現在地からの検索条件。3つとも指定するか、すべて省略する
+	Latitude     *float64
+	Longitude    *float64
+	RadiusMeters *int
+	Page         PageInput
+}
+
+// maxNearRadiusMeters は現在地検索で指定できる半径の上限です。
+// 広すぎる半径では矩形による絞り込みが効かず、全件に距離計算が走るため制限します。
+const maxNearRadiusMeters = 50000
+
+// keyword はキーワード検索の検索語を返します。空白だけなら検索しません。
+func (i *GetDateSpotsInput) keyword() string {
+	if i.DateSpotName == nil {
+		return ""
+	}
+	return strings.TrimSpace(*i.DateSpotName)
+}
+
+// hasNear は現在地からの検索条件が揃っているかを返します。
+func (i *GetDateSpotsInput) hasNear() bool {
+	return i.Latitude != nil && i.Longitude != nil && i.RadiusMeters != nil
+}
+
+// sorts は指定できる並び順を返します。先頭が sort 省略時の並び順です。
+// 距離順は現在地から、関連度順はキーワードで検索したときだけ使え、そのときはそれを既定にします。
+// 両方で検索したときは距離順を既定にします。
+func (i *GetDateSpotsInput) sorts() []pagination.Sort {
+	sorts := []pagination.Sort{pagination.SortNewest, pagination.SortRating, pagination.SortReviewCount, pagination.SortName}
+	if i.keyword() != "" {
+		sorts = append([]pagination.Sort{pagination.SortRelevance}, sorts...)
+	}
+	if i.hasNear() {
+		sorts = append([]pagination.Sort{pagination.SortDistance}, sorts...)
+	}
+	return sorts
+}
+
+func (i *GetDateSpotsInput) validateNear() []string {
+	var errs []string
+
+	specified := lo.Count([]bool{i.Latitude != nil, i.Longitude != nil, i.RadiusMeters != nil}, true)
+	if specified != 0 && specified != 3 {
+		errs = append(errs, "現在地から検索するには緯度・経度・半径をすべて指定してください")
+	}
+	if i.Latitude != nil && (*i.Latitude < -90 || *i.Latitude > 90) {
+		errs = append(errs, "緯度は-90から90の範囲で指定してください")
+	}
+	if i.Longitude != nil && (*i.Longitude < -180 || *i.Longitude > 180) {
+		errs = append(errs, "経度は-180から180の範囲で指定してください")
+	}
+	if i.RadiusMeters != nil && (*i.RadiusMeters < 1 || *i.RadiusMeters > maxNearRadiusMeters) {
+		errs = append(errs, "検索半径は1から50000メートルの範囲で指定してください")
+	}
+	return errs
+}
+
+// comeTimeLayouts は来店日時として受け付ける書式です。
+// オフセットのない書式はスポットの所在地である日本時間として解釈します。
+var comeTimeLayouts = []string{time.RFC3339, "2006-01-02T15:04"}
+
+// comeTimeOfDayLayouts は日付を含まない以前の来店時刻の書式です。
+// 既存のクライアントのために受け付け、日本時間の今日のその時刻として扱います。
+var comeTimeOfDayLayouts = []string{"15:04", "15:04:05"}
+
+// openAt は来店日時を営業時間の絞り込み条件に変換します。
+func (i *GetDateSpotsInput) openAt(now time.Time) (*model.OpenAt, []string) {
+	if i.ComeTime == nil || *i.ComeTime == "" {
+		return nil, nil
+	}
+	for _, layout := range comeTimeLayouts {
+		if t, err := time.ParseInLocation(layout, *i.ComeTime, model.JST); err == nil {
+			at := model.NewOpenAt(t)
+			return &at, nil
+		}
+	}
+	for _, layout := range comeTimeOfDayLayouts {
+		if t, err := time.Parse(layout, *i.ComeTime); err == nil {
+			today := now.In(model.JST)
+			at := model.NewOpenAt(time.Date(today.Year(), today.Month(), today.Day(), t.Hour(), t.Minute(), t.Second(), 0, model.JST))
+			return &at, nil
+		}
+	}
+	return nil, []string{"来店日時は 2026-01-02T18:30 の形式で指定してください"}
 }
 
 type GetDateSpotsOutput struct {
-	DateSpots []*model.DateSpot
+	DateSpots  []*model.DateSpot
+	NextCursor *pagination.Cursor
 }
 
 type GetDateSpotsInteractor struct {
-	DateSpotRepository repository.DateSpotRepository
+	DateSpotRepository  repository.DateSpotRepository
+	DateSpotSearchIndex repository.DateSpotSearchIndex
 }
 
 func NewGetDateSpotsUsecase(
 	dateSpotRepository repository.DateSpotRepository,
+	dateSpotSearchIndex repository.DateSpotSearchIndex,
 ) GetDateSpotsInputPort {
 	return &GetDateSpotsInteractor{
-		DateSpotRepository: dateSpotRepository,
+		DateSpotRepository:  dateSpotRepository,
+		DateSpotSearchIndex: dateSpotSearchIndex,
 	}
 }
 
 func (i *GetDateSpotsInteractor) Execute(ctx context.Context, input GetDateSpotsInput) (*GetDateSpotsOutput, error) {
+	openAt, comeTimeErrs := input.openAt(time.Now())
+	sort, page, pageErrs := input.Page.resolve(input.sorts()...)
+	errs := append(input.validateNear(), comeTimeErrs...)
+	if errs = append(errs, pageErrs...); len(errs) > 0 {
+		return nil, apperror.UnprocessableEntity(errs...)
+	}
+
 	params := repository.DateSpotSearchParams{
-		Name:         input.DateSpotName,
 		PrefectureID: input.PrefectureID,
 		GenreID:      input.GenreID,
-		ComeTime:     input.ComeTime,
+		OpenAt:       openAt,
+		Latitude:     input.Latitude,
+		Longitude:    input.Longitude,
+		RadiusMeters: input.RadiusMeters,
+		Sort:         sort,
+		Page:         page,
+	}
+	if keyword := input.keyword(); keyword != "" {
+		// ヒットは件数で切らずにすべて渡す。先に切ると、都道府県などの条件や関連度以外の並び順で
+		// 本来出るはずのスポットが抜け落ちる
+		hits, err := i.DateSpotSearchIndex.Search(ctx, keyword, 0)
+		if err != nil {
+			return nil, apperror.InternalServerError(err)
+		}
+		// 1件もヒットしなかったときも、絞り込み条件として空のスライスを渡す
+		params.Matches = append([]repository.DateSpotSearchHit{}, hits...)
 	}
+
 	dateSpots, err := i.DateSpotRepository.Search(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
 	return &GetDateSpotsOutput{
-		DateSpots: dateSpots,
+		DateSpots:  dateSpots.Items,
+		NextCursor: dateSpots.NextCursor,
 	}, nil
 }
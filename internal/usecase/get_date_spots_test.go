@@ -3,11 +3,17 @@ package usecase_test
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
 	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -27,9 +33,9 @@ func TestGetDateSpotsInteractor_Execute(t *testing.T) {
 		dateSpotRepo := repositorymock.NewMockDateSpotRepository(ctrl)
 		dateSpotRepo.EXPECT().
 			Search(ctx, gomock.Any()).
-			Return(dateSpots, nil)
+			Return(pagination.Page[*model.DateSpot]{Items: dateSpots}, nil)
 
-		interactor := usecase.NewGetDateSpotsUsecase(dateSpotRepo)
+		interactor := usecase.NewGetDateSpotsUsecase(dateSpotRepo, repositorymock.NewMockDateSpotSearchIndex(ctrl))
 		output, err := interactor.Execute(ctx, usecase.GetDateSpotsInput{})
 
 		require.NoError(t, err)
@@ -37,38 +43,269 @@ func TestGetDateSpotsInteractor_Execute(t *testing.T) {
 		assert.Len(t, output.DateSpots, 2)
 	})
 
+	// キーワードは検索インデックスで引き、ヒットしたスポットだけを関連度順で取得する
 	t.Run("success_with_name_filter", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		ctx := context.Background()
-		name := "東京"
+		name := " 東京 "
+		hits := []repository.DateSpotSearchHit{{DateSpotID: 1, Score: 6}}
 		dateSpots := []*model.DateSpot{{ID: 1, Name: "東京タワー", CityName: "港区"}}
 
+		searchIndex := repositorymock.NewMockDateSpotSearchIndex(ctrl)
+		searchIndex.EXPECT().
+			Search(ctx, "東京", 0).
+			Return(hits, nil)
 		dateSpotRepo := repositorymock.NewMockDateSpotRepository(ctrl)
 		dateSpotRepo.EXPECT().
-			Search(ctx, gomock.Any()).
-			Return(dateSpots, nil)
+			Search(ctx, repository.DateSpotSearchParams{
+				Matches: hits,
+				Sort:    pagination.SortRelevance,
+				Page:    defaultPage,
+			}).
+			Return(pagination.Page[*model.DateSpot]{Items: dateSpots}, nil)
 
-		interactor := usecase.NewGetDateSpotsUsecase(dateSpotRepo)
+		interactor := usecase.NewGetDateSpotsUsecase(dateSpotRepo, searchIndex)
 		output, err := interactor.Execute(ctx, usecase.GetDateSpotsInput{DateSpotName: &name})
 
 		require.NoError(t, err)
 		assert.Len(t, output.DateSpots, 1)
 	})
 
-	t.Run("error_repository_search_failed", func(t *testing.T) {
+	// ヒットなしでも「絞り込まない」にはせず、空の条件として渡す
+	t.Run("success_with_name_filter_no_hits", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		ctx := context.Background()
+		name := "存在しないスポット"
 
+		searchIndex := repositorymock.NewMockDateSpotSearchIndex(ctrl)
+		searchIndex.EXPECT().
+			Search(ctx, name, 0).
+			Return(nil, nil)
 		dateSpotRepo := repositorymock.NewMockDateSpotRepository(ctrl)
 		dateSpotRepo.EXPECT().
 			Search(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, params repository.DateSpotSearchParams) (pagination.Page[*model.DateSpot], error) {
+				assert.NotNil(t, params.Matches)
+				assert.Empty(t, params.Matches)
+				return pagination.Page[*model.DateSpot]{}, nil
+			})
+
+		interactor := usecase.NewGetDateSpotsUsecase(dateSpotRepo, searchIndex)
+		_, err := interactor.Execute(ctx, usecase.GetDateSpotsInput{DateSpotName: &name})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("error_search_index_failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		name := "東京"
+
+		searchIndex := repositorymock.NewMockDateSpotSearchIndex(ctrl)
+		searchIndex.EXPECT().
+			Search(ctx, name, 0).
 			Return(nil, errors.New("db error"))
+		dateSpotRepo := repositorymock.NewMockDateSpotRepository(ctrl)
+
+		interactor := usecase.NewGetDateSpotsUsecase(dateSpotRepo, searchIndex)
+		output, err := interactor.Execute(ctx, usecase.GetDateSpotsInput{DateSpotName: &name})
+
+		assert.Nil(t, output)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusInternalServerError, statusCode)
+	})
+
+	t.Run("success_passes_near_condition_to_repository", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		lat, lng, radius := 35.681236, 139.767125, 1000
+		distance := 120.5
+		dateSpots := []*model.DateSpot{{ID: 1, Name: "東京駅", CityName: "千代田区", DistanceMeters: &distance}}
+
+		dateSpotRepo := repositorymock.NewMockDateSpotRepository(ctrl)
+		dateSpotRepo.EXPECT().
+			Search(ctx, repository.DateSpotSearchParams{
+				Latitude:     &lat,
+				Longitude:    &lng,
+				RadiusMeters: &radius,
+				Sort:         pagination.SortDistance,
+				Page:         defaultPage,
+			}).
+			Return(pagination.Page[*model.DateSpot]{Items: dateSpots}, nil)
+
+		interactor := usecase.NewGetDateSpotsUsecase(dateSpotRepo, repositorymock.NewMockDateSpotSearchIndex(ctrl))
+		output, err := interactor.Execute(ctx, usecase.GetDateSpotsInput{
+			Latitude:     &lat,
+			Longitude:    &lng,
+			RadiusMeters: &radius,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, dateSpots, output.DateSpots)
+	})
+
+	// オフセット付きの日時は日本時間に直してから曜日と時刻を決める
+	t.Run("success_converts_come_time_to_open_at", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			comeTime string
+			want     model.OpenAt
+		}{
+			{
+				name:     "local_time_is_jst",
+				comeTime: "2026-10-17T18:30",
+				want: model.OpenAt{
+					Weekday:     time.Saturday,
+					Minute:      18*60 + 30,
+					Date:        time.Date(2026, 10, 17, 0, 0, 0, 0, model.JST),
+					PrevWeekday: time.Friday,
+					PrevDate:    time.Date(2026, 10, 16, 0, 0, 0, 0, model.JST),
+				},
+			},
+			{
+				name:     "utc_crosses_into_next_day_in_jst",
+				comeTime: "2026-10-17T16:30:00Z",
+				want: model.OpenAt{
+					Weekday:     time.Sunday,
+					Minute:      1*60 + 30,
+					Date:        time.Date(2026, 10, 18, 0, 0, 0, 0, model.JST),
+					PrevWeekday: time.Saturday,
+					PrevDate:    time.Date(2026, 10, 17, 0, 0, 0, 0, model.JST),
+				},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				ctx := context.Background()
+				dateSpotRepo := repositorymock.NewMockDateSpotRepository(ctrl)
+				dateSpotRepo.EXPECT().
+					Search(ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, params repository.DateSpotSearchParams) (pagination.Page[*model.DateSpot], error) {
+						require.NotNil(t, params.OpenAt)
+						assert.Equal(t, tt.want.Weekday, params.OpenAt.Weekday)
+						assert.Equal(t, tt.want.Minute, params.OpenAt.Minute)
+						assert.True(t, tt.want.Date.Equal(params.OpenAt.Date))
+						assert.Equal(t, tt.want.PrevWeekday, params.OpenAt.PrevWeekday)
+						assert.True(t, tt.want.PrevDate.Equal(params.OpenAt.PrevDate))
+						return pagination.Page[*model.DateSpot]{}, nil
+					})
+
+				interactor := usecase.NewGetDateSpotsUsecase(dateSpotRepo, repositorymock.NewMockDateSpotSearchIndex(ctrl))
+				_, err := interactor.Execute(ctx, usecase.GetDateSpotsInput{ComeTime: &tt.comeTime})
+
+				require.NoError(t, err)
+			})
+		}
+	})
+
+	// 以前の時刻だけの書式は、日本時間の今日のその時刻として扱う
+	t.Run("success_accepts_time_of_day_come_time", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		today := time.Now().In(model.JST)
+		dateSpotRepo := repositorymock.NewMockDateSpotRepository(ctrl)
+		dateSpotRepo.EXPECT().
+			Search(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, params repository.DateSpotSearchParams) (pagination.Page[*model.DateSpot], error) {
+				require.NotNil(t, params.OpenAt)
+				assert.Equal(t, 18*60+30, params.OpenAt.Minute)
+				assert.Equal(t, today.Weekday(), params.OpenAt.Weekday)
+				return pagination.Page[*model.DateSpot]{}, nil
+			})
+
+		interactor := usecase.NewGetDateSpotsUsecase(dateSpotRepo, repositorymock.NewMockDateSpotSearchIndex(ctrl))
+		_, err := interactor.Execute(ctx, usecase.GetDateSpotsInput{ComeTime: lo.ToPtr("18:30:00")})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("error_validation_invalid_near_condition", func(t *testing.T) {
+		lat, lng, radius := 35.681236, 139.767125, 1000
+		outOfRangeLat, outOfRangeLng, tooLargeRadius := 91.0, -181.0, 50001
+
+		tests := []struct {
+			name     string
+			input    usecase.GetDateSpotsInput
+			messages []string
+		}{
+			{
+				name:     "missing_radius",
+				input:    usecase.GetDateSpotsInput{Latitude: &lat, Longitude: &lng},
+				messages: []string{"現在地から検索するには緯度・経度・半径をすべて指定してください"},
+			},
+			{
+				name:     "latitude_out_of_range",
+				input:    usecase.GetDateSpotsInput{Latitude: &outOfRangeLat, Longitude: &lng, RadiusMeters: &radius},
+				messages: []string{"緯度は-90から90の範囲で指定してください"},
+			},
+			{
+				name:     "longitude_out_of_range",
+				input:    usecase.GetDateSpotsInput{Latitude: &lat, Longitude: &outOfRangeLng, RadiusMeters: &radius},
+				messages: []string{"経度は-180から180の範囲で指定してください"},
+			},
+			{
+				name:     "distance_sort_without_near",
+				input:    usecase.GetDateSpotsInput{Page: usecase.PageInput{Sort: lo.ToPtr("distance")}},
+				messages: []string{"並び順には newest, rating, review_count, name のいずれかを指定してください"},
+			},
+			{
+				name:     "come_time_invalid_format",
+				input:    usecase.GetDateSpotsInput{ComeTime: lo.ToPtr("18時30分")},
+				messages: []string{"来店日時は 2026-01-02T18:30 の形式で指定してください"},
+			},
+			{
+				name:     "radius_too_large",
+				input:    usecase.GetDateSpotsInput{Latitude: &lat, Longitude: &lng, RadiusMeters: &tooLargeRadius},
+				messages: []string{"検索半径は1から50000メートルの範囲で指定してください"},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				dateSpotRepo := repositorymock.NewMockDateSpotRepository(ctrl)
+
+				interactor := usecase.NewGetDateSpotsUsecase(dateSpotRepo, repositorymock.NewMockDateSpotSearchIndex(ctrl))
+				output, err := interactor.Execute(context.Background(), tt.input)
+
+				assert.Nil(t, output)
+				statusCode, messages, _, ok := apperror.HTTPStatus(err)
+				assert.True(t, ok)
+				assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+				assert.Equal(t, tt.messages, messages)
+			})
+		}
+	})
+
+	t.Run("error_repository_search_failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+
+		dateSpotRepo := repositorymock.NewMockDateSpotRepository(ctrl)
+		dateSpotRepo.EXPECT().
+			Search(ctx, gomock.Any()).
+			Return(pagination.Page[*model.DateSpot]{}, errors.New("db error"))
 
-		interactor := usecase.NewGetDateSpotsUsecase(dateSpotRepo)
+		interactor := usecase.NewGetDateSpotsUsecase(dateSpotRepo, repositorymock.NewMockDateSpotSearchIndex(ctrl))
 		output, err := interactor.Execute(ctx, usecase.GetDateSpotsInput{})
 
 		assert.Error(t, err)
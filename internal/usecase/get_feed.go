@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/samber/lo"
+)
+
+// GetFeedInputPort はフォロー中のユーザーのフィード取得ユースケースの入力ポートです。
+type GetFeedInputPort interface {
+	Execute(context.Context, GetFeedInput) (*GetFeedOutput, error)
+}
+
+type GetFeedInput struct {
+	// UserID はフィードを読むログイン中のユーザーの ID です。
+	UserID uint
+	Page   PageInput
+}
+
+type GetFeedOutput struct {
+	Activities []*model.Activity
+	NextCursor *pagination.Cursor
+}
+
+type GetFeedInteractor struct {
+	FeedRepository           repository.FeedRepository
+	CourseFavoriteRepository repository.CourseFavoriteRepository
+	CourseRouteService       service.CourseRouteService
+}
+
+func NewGetFeedUsecase(
+	feedRepository repository.FeedRepository,
+	courseFavoriteRepository repository.CourseFavoriteRepository,
+	courseRouteService service.CourseRouteService,
+) GetFeedInputPort {
+	return &GetFeedInteractor{
+		FeedRepository:           feedRepository,
+		CourseFavoriteRepository: courseFavoriteRepository,
+		CourseRouteService:       courseRouteService,
+	}
+}
+
+func (i *GetFeedInteractor) Execute(ctx context.Context, input GetFeedInput) (*GetFeedOutput, error) {
+	_, page, errs := input.Page.resolve(pagination.SortNewest)
+	if len(errs) > 0 {
+		return nil, apperror.UnprocessableEntity(errs...)
+	}
+
+	activities, err := i.FeedRepository.Search(ctx, repository.FeedSearchParams{
+		UserID: input.UserID,
+		Page:   page,
+	})
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	// コースはコース一覧と同じく、経路とお気に入りの件数を付けて返す
+	courses := lo.FilterMap(activities.Items, func(a *model.Activity, _ int) (*model.Course, bool) {
+		return a.Course, a.Course != nil
+	})
+	if err := attachCourseRoutes(ctx, i.CourseRouteService, courses...); err != nil {
+		return nil, err
+	}
+	if err := attachCourseFavorites(ctx, i.CourseFavoriteRepository, input.UserID, courses...); err != nil {
+		return nil, err
+	}
+
+	return &GetFeedOutput{
+		Activities: activities.Items,
+		NextCursor: activities.NextCursor,
+	}, nil
+}
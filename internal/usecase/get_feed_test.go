@@ -0,0 +1,119 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	servicemock "github.com/daisuke-harada/date-courses-go/internal/domain/service/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetFeedInteractor_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	// コースの出来事だけに経路とお気に入りの件数を付ける
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		feedRepo := repositorymock.NewMockFeedRepository(ctrl)
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
+		routeService := servicemock.NewMockCourseRouteService(ctrl)
+		activities := []*model.Activity{
+			{Type: model.ActivityTypeCourseCreated, Actor: &model.User{ID: 2}, Course: &model.Course{ID: 20, UserID: 2}},
+			{Type: model.ActivityTypeReviewCreated, Actor: &model.User{ID: 2}, DateSpotReview: &model.DateSpotReview{ID: 30}},
+			{Type: model.ActivityTypeUserFollowed, Actor: &model.User{ID: 3}, FollowedUser: &model.User{ID: 4}},
+		}
+		next := pagination.NewTimeCursor(pagination.SortNewest, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), 62)
+
+		feedRepo.EXPECT().
+			Search(ctx, repository.FeedSearchParams{UserID: 1, Page: defaultPage}).
+			Return(pagination.Page[*model.Activity]{Items: activities, NextCursor: &next}, nil)
+		routeService.EXPECT().
+			BuildRoute(ctx, activities[0].Course).
+			Return(&model.CourseRoute{}, nil)
+		favoriteRepo.EXPECT().
+			SummarizeByCourseIDs(ctx, []uint{20}, uint(1)).
+			Return(map[uint]*model.CourseFavoriteSummary{20: {Count: 3, FavoritedByViewer: true}}, nil)
+
+		interactor := usecase.NewGetFeedUsecase(feedRepo, favoriteRepo, routeService)
+		output, err := interactor.Execute(ctx, usecase.GetFeedInput{UserID: 1})
+
+		require.NoError(t, err)
+		require.Len(t, output.Activities, 3)
+		assert.Equal(t, 3, output.Activities[0].Course.Favorites.Count)
+		assert.Equal(t, &next, output.NextCursor)
+	})
+
+	// フォロー中のユーザーがいない・出来事がないときは集計を呼ばない
+	t.Run("success_empty", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		feedRepo := repositorymock.NewMockFeedRepository(ctrl)
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
+		routeService := servicemock.NewMockCourseRouteService(ctrl)
+		feedRepo.EXPECT().
+			Search(ctx, repository.FeedSearchParams{UserID: 1, Page: defaultPage}).
+			Return(pagination.Page[*model.Activity]{}, nil)
+
+		interactor := usecase.NewGetFeedUsecase(feedRepo, favoriteRepo, routeService)
+		output, err := interactor.Execute(ctx, usecase.GetFeedInput{UserID: 1})
+
+		require.NoError(t, err)
+		assert.Empty(t, output.Activities)
+		assert.Nil(t, output.NextCursor)
+	})
+
+	t.Run("error_search_failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		feedRepo := repositorymock.NewMockFeedRepository(ctrl)
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
+		routeService := servicemock.NewMockCourseRouteService(ctrl)
+		feedRepo.EXPECT().
+			Search(ctx, gomock.Any()).
+			Return(pagination.Page[*model.Activity]{}, errors.New("db error"))
+
+		interactor := usecase.NewGetFeedUsecase(feedRepo, favoriteRepo, routeService)
+		output, err := interactor.Execute(ctx, usecase.GetFeedInput{UserID: 1})
+
+		assert.Nil(t, output)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusInternalServerError, statusCode)
+	})
+
+	t.Run("error_invalid_limit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		feedRepo := repositorymock.NewMockFeedRepository(ctrl)
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
+		routeService := servicemock.NewMockCourseRouteService(ctrl)
+
+		interactor := usecase.NewGetFeedUsecase(feedRepo, favoriteRepo, routeService)
+		output, err := interactor.Execute(ctx, usecase.GetFeedInput{
+			UserID: 1,
+			Page:   usecase.PageInput{Limit: lo.ToPtr(0)},
+		})
+
+		assert.Nil(t, output)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+}
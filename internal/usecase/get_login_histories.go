@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+)
+
+type GetLoginHistoriesInputPort interface {
+	Execute(context.Context, GetLoginHistoriesInput) (*GetLoginHistoriesOutput, error)
+}
+
+// GetLoginHistoriesInput はログイン履歴の取得条件です。
+// IP アドレスを含むため、UserID にはハンドラーでトークンのユーザーを渡します。
+type GetLoginHistoriesInput struct {
+	UserID uint
+	Page   PageInput
+}
+
+type GetLoginHistoriesOutput struct {
+	Histories  []*model.LoginHistory
+	NextCursor *pagination.Cursor
+}
+
+type GetLoginHistoriesInteractor struct {
+	LoginHistoryRepository repository.LoginHistoryRepository
+}
+
+func NewGetLoginHistoriesUsecase(
+	loginHistoryRepository repository.LoginHistoryRepository,
+) GetLoginHistoriesInputPort {
+	return &GetLoginHistoriesInteractor{
+		LoginHistoryRepository: loginHistoryRepository,
+	}
+}
+
+func (i *GetLoginHistoriesInteractor) Execute(ctx context.Context, input GetLoginHistoriesInput) (*GetLoginHistoriesOutput, error) {
+	_, page, errs := input.Page.resolve(pagination.SortNewest)
+	if len(errs) > 0 {
+		return nil, apperror.UnprocessableEntity(errs...)
+	}
+
+	histories, err := i.LoginHistoryRepository.SearchByUserID(ctx, repository.LoginHistorySearchParams{
+		UserID: input.UserID,
+		Page:   page,
+	})
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return &GetLoginHistoriesOutput{
+		Histories:  histories.Items,
+		NextCursor: histories.NextCursor,
+	}, nil
+}
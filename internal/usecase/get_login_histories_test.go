@@ -0,0 +1,78 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	repomock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetLoginHistoriesInteractor_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success_returns_own_histories", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		histories := []*model.LoginHistory{{ID: 2, UserID: 1, Result: model.LoginResultSucceeded}}
+		historyRepo := repomock.NewMockLoginHistoryRepository(ctrl)
+		historyRepo.EXPECT().
+			SearchByUserID(ctx, repository.LoginHistorySearchParams{
+				UserID: 1,
+				Page:   pagination.Params{Limit: 10},
+			}).
+			Return(pagination.Page[*model.LoginHistory]{Items: histories}, nil)
+
+		interactor := usecase.NewGetLoginHistoriesUsecase(historyRepo)
+		output, err := interactor.Execute(ctx, usecase.GetLoginHistoriesInput{
+			UserID: 1,
+			Page:   usecase.PageInput{Limit: lo.ToPtr(10)},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, histories, output.Histories)
+		assert.Nil(t, output.NextCursor)
+	})
+
+	t.Run("error_invalid_cursor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		interactor := usecase.NewGetLoginHistoriesUsecase(repomock.NewMockLoginHistoryRepository(ctrl))
+		_, err := interactor.Execute(ctx, usecase.GetLoginHistoriesInput{
+			UserID: 1,
+			Page:   usecase.PageInput{Cursor: lo.ToPtr("broken")},
+		})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+
+	t.Run("error_db_error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		historyRepo := repomock.NewMockLoginHistoryRepository(ctrl)
+		historyRepo.EXPECT().SearchByUserID(ctx, gomock.Any()).
+			Return(pagination.Page[*model.LoginHistory]{}, errors.New("db error"))
+
+		interactor := usecase.NewGetLoginHistoriesUsecase(historyRepo)
+		_, err := interactor.Execute(ctx, usecase.GetLoginHistoriesInput{UserID: 1})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusInternalServerError, statusCode)
+	})
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/samber/lo"
+)
+
+var reviewStatuses = []model.ReviewStatus{
+	model.ReviewStatusPublished,
+	model.ReviewStatusPending,
+	model.ReviewStatusApproved,
+	model.ReviewStatusHidden,
+}
+
+type GetModerationReviewsInputPort interface {
+	Execute(context.Context, GetModerationReviewsInput) (*GetModerationReviewsOutput, error)
+}
+
+// GetModerationReviewsInput は管理者向けのレビュー一覧の条件です。
+// 管理者かどうかはハンドラーで確かめます。
+type GetModerationReviewsInput struct {
+	// Status を省略すると、保留中または未対応の通報があるレビュー（確認待ち）を返します。
+	Status *string
+	Page   PageInput
+}
+
+func (i *GetModerationReviewsInput) Validate() error {
+	if i.Status != nil && !lo.Contains(reviewStatuses, model.ReviewStatus(*i.Status)) {
+		return apperror.UnprocessableEntity("status は published, pending, approved, hidden のいずれかを指定してください")
+	}
+	return nil
+}
+
+type GetModerationReviewsOutput struct {
+	Reviews    []*model.DateSpotReview
+	NextCursor *pagination.Cursor
+}
+
+type GetModerationReviewsInteractor struct {
+	DateSpotReviewRepository repository.DateSpotReviewRepository
+}
+
+func NewGetModerationReviewsUsecase(
+	dateSpotReviewRepository repository.DateSpotReviewRepository,
+) GetModerationReviewsInputPort {
+	return &GetModerationReviewsInteractor{
+		DateSpotReviewRepository: dateSpotReviewRepository,
+	}
+}
+
+func (i *GetModerationReviewsInteractor) Execute(ctx context.Context, input GetModerationReviewsInput) (*GetModerationReviewsOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	// 確認待ちは先に来たものから処理できるよう、古い順を既定にする
+	sort, page, errs := input.Page.resolve(pagination.SortOldest, pagination.SortNewest)
+	if len(errs) > 0 {
+		return nil, apperror.UnprocessableEntity(errs...)
+	}
+
+	var status *model.ReviewStatus
+	if input.Status != nil {
+		status = lo.ToPtr(model.ReviewStatus(*input.Status))
+	}
+	reviews, err := i.DateSpotReviewRepository.SearchForModeration(ctx, repository.ReviewModerationParams{
+		Status: status,
+		Sort:   sort,
+		Page:   page,
+	})
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return &GetModerationReviewsOutput{
+		Reviews:    reviews.Items,
+		NextCursor: reviews.NextCursor,
+	}, nil
+}
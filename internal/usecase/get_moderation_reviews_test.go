@@ -0,0 +1,92 @@
+package usecase_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	repomock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetModerationReviewsInteractor_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	// status 省略時は確認待ちの一覧を古い順に返す
+	t.Run("success_queue_defaults_to_oldest", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reviews := []*model.DateSpotReview{{ID: 1, Status: model.ReviewStatusPending}}
+		reviewRepo := repomock.NewMockDateSpotReviewRepository(ctrl)
+		reviewRepo.EXPECT().
+			SearchForModeration(ctx, repository.ReviewModerationParams{
+				Sort: pagination.SortOldest,
+				Page: pagination.Params{Limit: pagination.DefaultLimit},
+			}).
+			Return(pagination.Page[*model.DateSpotReview]{Items: reviews}, nil)
+
+		interactor := usecase.NewGetModerationReviewsUsecase(reviewRepo)
+		output, err := interactor.Execute(ctx, usecase.GetModerationReviewsInput{})
+
+		require.NoError(t, err)
+		assert.Equal(t, reviews, output.Reviews)
+		assert.Nil(t, output.NextCursor)
+	})
+
+	t.Run("success_with_status", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		hidden := model.ReviewStatusHidden
+		reviewRepo := repomock.NewMockDateSpotReviewRepository(ctrl)
+		reviewRepo.EXPECT().
+			SearchForModeration(ctx, repository.ReviewModerationParams{
+				Status: &hidden,
+				Sort:   pagination.SortNewest,
+				Page:   pagination.Params{Limit: 5},
+			}).
+			Return(pagination.Page[*model.DateSpotReview]{Items: []*model.DateSpotReview{}}, nil)
+
+		interactor := usecase.NewGetModerationReviewsUsecase(reviewRepo)
+		_, err := interactor.Execute(ctx, usecase.GetModerationReviewsInput{
+			Status: lo.ToPtr("hidden"),
+			Page:   usecase.PageInput{Sort: lo.ToPtr("newest"), Limit: lo.ToPtr(5)},
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("error_invalid_status", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		interactor := usecase.NewGetModerationReviewsUsecase(repomock.NewMockDateSpotReviewRepository(ctrl))
+		output, err := interactor.Execute(ctx, usecase.GetModerationReviewsInput{Status: lo.ToPtr("deleted")})
+
+		assert.Nil(t, output)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+
+	t.Run("error_invalid_sort", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		interactor := usecase.NewGetModerationReviewsUsecase(repomock.NewMockDateSpotReviewRepository(ctrl))
+		_, err := interactor.Execute(ctx, usecase.GetModerationReviewsInput{Page: usecase.PageInput{Sort: lo.ToPtr("rating")}})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+)
+
+// GetNotificationsInputPort は通知一覧取得ユースケースの入力ポートです。
+type GetNotificationsInputPort interface {
+	Execute(context.Context, GetNotificationsInput) (*GetNotificationsOutput, error)
+}
+
+// GetNotificationsInput は通知一覧の取得条件です。UserID にはハンドラーでトークンのユーザーを渡します。
+type GetNotificationsInput struct {
+	UserID uint
+	Page   PageInput
+}
+
+type GetNotificationsOutput struct {
+	Notifications []*model.Notification
+	NextCursor    *pagination.Cursor
+}
+
+type GetNotificationsInteractor struct {
+	NotificationRepository repository.NotificationRepository
+}
+
+func NewGetNotificationsUsecase(
+	notificationRepository repository.NotificationRepository,
+) GetNotificationsInputPort {
+	return &GetNotificationsInteractor{
+		NotificationRepository: notificationRepository,
+	}
+}
+
+func (i *GetNotificationsInteractor) Execute(ctx context.Context, input GetNotificationsInput) (*GetNotificationsOutput, error) {
+	_, page, errs := input.Page.resolve(pagination.SortNewest)
+	if len(errs) > 0 {
+		return nil, apperror.UnprocessableEntity(errs...)
+	}
+
+	notifications, err := i.NotificationRepository.Search(ctx, repository.NotificationSearchParams{
+		UserID: input.UserID,
+		Page:   page,
+	})
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return &GetNotificationsOutput{
+		Notifications: notifications.Items,
+		NextCursor:    notifications.NextCursor,
+	}, nil
+}
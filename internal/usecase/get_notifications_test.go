@@ -0,0 +1,113 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetNotificationsInteractor_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		notificationRepo := repositorymock.NewMockNotificationRepository(ctrl)
+		notifications := []*model.Notification{{ID: 5, UserID: 1, ActorID: 2, Type: model.NotificationTypeFollowed}}
+		next := pagination.NewTimeCursor(pagination.SortNewest, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), 5)
+		notificationRepo.EXPECT().
+			Search(ctx, repository.NotificationSearchParams{UserID: 1, Page: defaultPage}).
+			Return(pagination.Page[*model.Notification]{Items: notifications, NextCursor: &next}, nil)
+
+		interactor := usecase.NewGetNotificationsUsecase(notificationRepo)
+		output, err := interactor.Execute(ctx, usecase.GetNotificationsInput{UserID: 1})
+
+		require.NoError(t, err)
+		assert.Equal(t, notifications, output.Notifications)
+		assert.Equal(t, &next, output.NextCursor)
+	})
+
+	t.Run("error_search_failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		notificationRepo := repositorymock.NewMockNotificationRepository(ctrl)
+		notificationRepo.EXPECT().
+			Search(ctx, gomock.Any()).
+			Return(pagination.Page[*model.Notification]{}, errors.New("db error"))
+
+		interactor := usecase.NewGetNotificationsUsecase(notificationRepo)
+		output, err := interactor.Execute(ctx, usecase.GetNotificationsInput{UserID: 1})
+
+		assert.Nil(t, output)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusInternalServerError, statusCode)
+	})
+}
+
+func TestGetUnreadNotificationCountInteractor_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		notificationRepo := repositorymock.NewMockNotificationRepository(ctrl)
+		notificationRepo.EXPECT().CountUnread(ctx, uint(1)).Return(3, nil)
+
+		interactor := usecase.NewGetUnreadNotificationCountUsecase(notificationRepo)
+		output, err := interactor.Execute(ctx, usecase.GetUnreadNotificationCountInput{UserID: 1})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, output.UnreadCount)
+	})
+}
+
+func TestMarkNotificationsReadInteractor_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success_returns_remaining_unread_count", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		notificationRepo := repositorymock.NewMockNotificationRepository(ctrl)
+		notificationRepo.EXPECT().MarkRead(ctx, uint(1), []uint{5, 6}, gomock.Any()).Return(nil)
+		notificationRepo.EXPECT().CountUnread(ctx, uint(1)).Return(2, nil)
+
+		interactor := usecase.NewMarkNotificationsReadUsecase(notificationRepo)
+		output, err := interactor.Execute(ctx, usecase.MarkNotificationsReadInput{UserID: 1, NotificationIDs: []uint{5, 6}})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, output.UnreadCount)
+	})
+
+	t.Run("error_mark_failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		notificationRepo := repositorymock.NewMockNotificationRepository(ctrl)
+		notificationRepo.EXPECT().MarkRead(ctx, uint(1), []uint(nil), gomock.Any()).Return(errors.New("db error"))
+
+		interactor := usecase.NewMarkNotificationsReadUsecase(notificationRepo)
+		output, err := interactor.Execute(ctx, usecase.MarkNotificationsReadInput{UserID: 1})
+
+		assert.Nil(t, output)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusInternalServerError, statusCode)
+	})
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+)
+
+type GetSpotMatchesInputPort interface {
+	Execute(context.Context, GetSpotMatchesInput) (*GetSpotMatchesOutput, error)
+}
+
+// GetSpotMatchesInput は管理者向けの、既存スポットと同じ場所か確認待ちの取り込み結果の一覧の条件です。
+// 管理者かどうかはハンドラーで確かめます。
+type GetSpotMatchesInput struct {
+	Page PageInput
+}
+
+type GetSpotMatchesOutput struct {
+	SpotSources []*model.SpotSource
+	NextCursor  *pagination.Cursor
+}
+
+type GetSpotMatchesInteractor struct {
+	SpotSourceRepository repository.SpotSourceRepository
+}
+
+func NewGetSpotMatchesUsecase(
+	spotSourceRepository repository.SpotSourceRepository,
+) GetSpotMatchesInputPort {
+	return &GetSpotMatchesInteractor{
+		SpotSourceRepository: spotSourceRepository,
+	}
+}
+
+func (i *GetSpotMatchesInteractor) Execute(ctx context.Context, input GetSpotMatchesInput) (*GetSpotMatchesOutput, error) {
+	// 確認待ちは先に来たものから処理できるよう、古い順を既定にする
+	sort, page, errs := input.Page.resolve(pagination.SortOldest, pagination.SortNewest)
+	if len(errs) > 0 {
+		return nil, apperror.UnprocessableEntity(errs...)
+	}
+
+	sources, err := i.SpotSourceRepository.Search(ctx, repository.SpotSourceSearchParams{
+		Status: model.SpotSourceStatusPending,
+		Sort:   sort,
+		Page:   page,
+	})
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return &GetSpotMatchesOutput{
+		SpotSources: sources.Items,
+		NextCursor:  sources.NextCursor,
+	}, nil
+}
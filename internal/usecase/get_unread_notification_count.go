@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+)
+
+// GetUnreadNotificationCountInputPort は未読の通知の件数取得ユースケースの入力ポートです。
+type GetUnreadNotificationCountInputPort interface {
+	Execute(context.Context, GetUnreadNotificationCountInput) (*GetUnreadNotificationCountOutput, error)
+}
+
+type GetUnreadNotificationCountInput struct {
+	UserID uint
+}
+
+type GetUnreadNotificationCountOutput struct {
+	UnreadCount int
+}
+
+type GetUnreadNotificationCountInteractor struct {
+	NotificationRepository repository.NotificationRepository
+}
+
+func NewGetUnreadNotificationCountUsecase(
+	notificationRepository repository.NotificationRepository,
+) GetUnreadNotificationCountInputPort {
+	return &GetUnreadNotificationCountInteractor{
+		NotificationRepository: notificationRepository,
+	}
+}
+
+func (i *GetUnreadNotificationCountInteractor) Execute(ctx context.Context, input GetUnreadNotificationCountInput) (*GetUnreadNotificationCountOutput, error) {
+	count, err := i.NotificationRepository.CountUnread(ctx, input.UserID)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	return &GetUnreadNotificationCountOutput{UnreadCount: count}, nil
+}
@@ -7,8 +7,25 @@ import (
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 )
 
+// ensureNotBlocked は viewerID と ownerID の間にブロックがあれば NotFound を返します。
+// 一覧と同じく詳細もブロックした相手・された相手からは見えなくし、どちらがブロックしたかも知らせません。
+func ensureNotBlocked(ctx context.Context, userBlockRepository repository.UserBlockRepository, viewerID, ownerID uint) error {
+	if viewerID == 0 || viewerID == ownerID {
+		return nil
+	}
+	blocked, err := userBlockRepository.ExistsBetween(ctx, viewerID, ownerID)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+	if blocked {
+		return apperror.NotFound()
+	}
+	return nil
+}
+
 // GetUserInputPort はユーザー単体取得ユースケースの入力ポートです。
 type GetUserInputPort interface {
 	Execute(context.Context, GetUserInput) (*GetUserOutput, error)
@@ -25,20 +42,26 @@ type GetUserOutput struct {
 }
 
 type GetUserInteractor struct {
-	UserRepository   repository.UserRepository
-	CourseRepository repository.CourseRepository
-	UserService      service.UserService
+	UserRepository           repository.UserRepository
+	CourseRepository         repository.CourseRepository
+	CourseFavoriteRepository repository.CourseFavoriteRepository
+	UserBlockRepository      repository.UserBlockRepository
+	UserService              service.UserService
 }
 
 func NewGetUserUsecase(
 	userRepository repository.UserRepository,
 	courseRepository repository.CourseRepository,
+	courseFavoriteRepository repository.CourseFavoriteRepository,
+	userBlockRepository repository.UserBlockRepository,
 	userService service.UserService,
 ) GetUserInputPort {
 	return &GetUserInteractor{
-		UserRepository:   userRepository,
-		CourseRepository: courseRepository,
-		UserService:      userService,
+		UserRepository:           userRepository,
+		CourseRepository:         courseRepository,
+		CourseFavoriteRepository: courseFavoriteRepository,
+		UserBlockRepository:      userBlockRepository,
+		UserService:              userService,
 	}
 }
 
@@ -47,6 +70,9 @@ func (i *GetUserInteractor) Execute(ctx context.Context, input GetUserInput) (*G
 	if err != nil {
 		return nil, apperror.NotFound()
 	}
+	if err := ensureNotBlocked(ctx, i.UserBlockRepository, input.ViewerID, user.ID); err != nil {
+		return nil, err
+	}
 
 	uwr, err := i.UserService.BuildUserWithRelations(ctx, user)
 	if err != nil {
@@ -63,5 +89,21 @@ func (i *GetUserInteractor) Execute(ctx context.Context, input GetUserInput) (*G
 		uwr.Courses = courses
 	}
 
+	// お気に入りタブには先頭ページだけを載せ、続きは一覧 API で取得してもらう
+	favorites, err := i.CourseFavoriteRepository.SearchCoursesByUserID(ctx, repository.CourseFavoriteSearchParams{
+		UserID: input.ID,
+		Page:   pagination.Params{Limit: pagination.DefaultLimit},
+	})
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	// 0件でもタブを出せるよう、nil（レスポンスでは項目の省略）にはしない
+	uwr.FavoriteCourses = append([]*model.Course{}, favorites.Items...)
+
+	courses := append(append([]*model.Course{}, uwr.Courses...), uwr.FavoriteCourses...)
+	if err := attachCourseFavorites(ctx, i.CourseFavoriteRepository, input.ViewerID, courses...); err != nil {
+		return nil, err
+	}
+
 	return &GetUserOutput{UserWithRelations: uwr}, nil
 }
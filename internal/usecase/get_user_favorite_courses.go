@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+)
+
+// GetUserFavoriteCoursesInputPort はユーザーのお気に入りコース一覧取得ユースケースの入力ポートです。
+type GetUserFavoriteCoursesInputPort interface {
+	Execute(context.Context, GetUserFavoriteCoursesInput) (*GetUserFavoriteCoursesOutput, error)
+}
+
+type GetUserFavoriteCoursesInput struct {
+	UserID uint
+	// ViewerID は閲覧しているユーザーの ID です。未ログインの場合は 0 になります。
+	ViewerID uint
+	Page     PageInput
+}
+
+type GetUserFavoriteCoursesOutput struct {
+	Courses    []*model.Course
+	NextCursor *pagination.Cursor
+}
+
+type GetUserFavoriteCoursesInteractor struct {
+	UserRepository           repository.UserRepository
+	CourseFavoriteRepository repository.CourseFavoriteRepository
+	CourseRouteService       service.CourseRouteService
+}
+
+func NewGetUserFavoriteCoursesUsecase(
+	userRepository repository.UserRepository,
+	courseFavoriteRepository repository.CourseFavoriteRepository,
+	courseRouteService service.CourseRouteService,
+) GetUserFavoriteCoursesInputPort {
+	return &GetUserFavoriteCoursesInteractor{
+		UserRepository:           userRepository,
+		CourseFavoriteRepository: courseFavoriteRepository,
+		CourseRouteService:       courseRouteService,
+	}
+}
+
+func (i *GetUserFavoriteCoursesInteractor) Execute(ctx context.Context, input GetUserFavoriteCoursesInput) (*GetUserFavoriteCoursesOutput, error) {
+	_, page, errs := input.Page.resolve(pagination.SortNewest)
+	if len(errs) > 0 {
+		return nil, apperror.UnprocessableEntity(errs...)
+	}
+
+	user, err := i.UserRepository.FindByID(ctx, input.UserID)
+	if err != nil {
+		return nil, apperror.NotFound()
+	}
+
+	courses, err := i.CourseFavoriteRepository.SearchCoursesByUserID(ctx, repository.CourseFavoriteSearchParams{
+		UserID: user.ID,
+		Page:   page,
+	})
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if err := attachCourseRoutes(ctx, i.CourseRouteService, courses.Items...); err != nil {
+		return nil, err
+	}
+	if err := attachCourseFavorites(ctx, i.CourseFavoriteRepository, input.ViewerID, courses.Items...); err != nil {
+		return nil, err
+	}
+
+	return &GetUserFavoriteCoursesOutput{
+		Courses:    courses.Items,
+		NextCursor: courses.NextCursor,
+	}, nil
+}
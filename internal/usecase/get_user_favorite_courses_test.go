@@ -0,0 +1,95 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	servicemock "github.com/daisuke-harada/date-courses-go/internal/domain/service/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetUserFavoriteCoursesInteractor_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
+		routeService := servicemock.NewMockCourseRouteService(ctrl)
+		courses := []*model.Course{{ID: 20, UserID: 2}, {ID: 21, UserID: 3}}
+		next := pagination.NewTimeCursor(pagination.SortNewest, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), 8)
+
+		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(&model.User{ID: 1}, nil)
+		favoriteRepo.EXPECT().
+			SearchCoursesByUserID(ctx, repository.CourseFavoriteSearchParams{UserID: 1, Page: defaultPage}).
+			Return(pagination.Page[*model.Course]{Items: courses, NextCursor: &next}, nil)
+		routeService.EXPECT().
+			BuildRoute(ctx, gomock.Any()).
+			Return(&model.CourseRoute{}, nil).
+			Times(2)
+		favoriteRepo.EXPECT().
+			SummarizeByCourseIDs(ctx, []uint{20, 21}, uint(7)).
+			Return(map[uint]*model.CourseFavoriteSummary{20: {Count: 1}, 21: {Count: 2, FavoritedByViewer: true}}, nil)
+
+		interactor := usecase.NewGetUserFavoriteCoursesUsecase(userRepo, favoriteRepo, routeService)
+		output, err := interactor.Execute(ctx, usecase.GetUserFavoriteCoursesInput{UserID: 1, ViewerID: 7})
+
+		require.NoError(t, err)
+		require.Len(t, output.Courses, 2)
+		assert.True(t, output.Courses[1].Favorites.FavoritedByViewer)
+		assert.Equal(t, &next, output.NextCursor)
+	})
+
+	t.Run("error_user_not_found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
+		routeService := servicemock.NewMockCourseRouteService(ctrl)
+		userRepo.EXPECT().FindByID(ctx, uint(999)).Return(nil, errors.New("not found"))
+
+		interactor := usecase.NewGetUserFavoriteCoursesUsecase(userRepo, favoriteRepo, routeService)
+		output, err := interactor.Execute(ctx, usecase.GetUserFavoriteCoursesInput{UserID: 999})
+
+		assert.Nil(t, output)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, statusCode)
+	})
+
+	// 並び順は1つだけなので、別の並び順のカーソルは受け付けない
+	t.Run("error_invalid_cursor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
+		routeService := servicemock.NewMockCourseRouteService(ctrl)
+
+		interactor := usecase.NewGetUserFavoriteCoursesUsecase(userRepo, favoriteRepo, routeService)
+		output, err := interactor.Execute(ctx, usecase.GetUserFavoriteCoursesInput{
+			UserID: 1,
+			Page:   usecase.PageInput{Cursor: lo.ToPtr(pagination.NewStringCursor(pagination.SortName, "a", 1).Encode())},
+		})
+
+		assert.Nil(t, output)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 )
 
 // GetUserFollowersInputPort はユーザーのフォロワー一覧取得ユースケースの入力ポートです。
@@ -16,10 +17,16 @@ type GetUserFollowersInputPort interface {
 
 type GetUserFollowersInput struct {
 	UserID uint
+	Page   PageInput
+	// ViewerID は閲覧しているユーザー（トークンの currentUser）の ID です。
+	ViewerID uint
 }
 
 type GetUserFollowersOutput struct {
-	Users []*model.UserWithRelations
+	// UserName は一覧の持ち主のユーザー名です。
+	UserName   string
+	Users      []*model.UserWithRelations
+	NextCursor *pagination.Cursor
 }
 
 type GetUserFollowersInteractor struct {
@@ -41,20 +48,29 @@ func NewGetUserFollowersUsecase(
 }
 
 func (i *GetUserFollowersInteractor) Execute(ctx context.Context, input GetUserFollowersInput) (*GetUserFollowersOutput, error) {
+	sort, page, errs := input.Page.resolve(pagination.SortNewest, pagination.SortName)
+	if len(errs) > 0 {
+		return nil, apperror.UnprocessableEntity(errs...)
+	}
+
 	user, err := i.UserRepository.FindByID(ctx, input.UserID)
 	if err != nil {
 		return nil, apperror.NotFound()
 	}
 
-	followers, err := i.RelationshipRepository.FindFollowersByUserID(ctx, user.ID)
+	followers, err := i.RelationshipRepository.FindFollowersByUserID(ctx, user.ID, repository.FollowSearchParams{
+		Sort:     sort,
+		Page:     page,
+		ViewerID: input.ViewerID,
+	})
 	if err != nil {
 		return nil, apperror.InternalServerError(err)
 	}
 
-	uwr, err := i.UserService.BuildUsersWithRelations(ctx, followers)
+	uwr, err := i.UserService.BuildUsersWithRelations(ctx, followers.Items)
 	if err != nil {
 		return nil, err
 	}
 
-	return &GetUserFollowersOutput{Users: uwr}, nil
+	return &GetUserFollowersOutput{UserName: user.Name, Users: uwr, NextCursor: followers.NextCursor}, nil
 }
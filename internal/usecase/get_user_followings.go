@@ -7,6 +7,7 @@ import (
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 )
 
 // GetUserFollowingsInputPort はユーザーのフォロー一覧取得ユースケースの入力ポートです。
@@ -16,10 +17,16 @@ type GetUserFollowingsInputPort interface {
 
 type GetUserFollowingsInput struct {
 	UserID uint
+	Page   PageInput
+	// ViewerID は閲覧しているユーザー（トークンの currentUser）の ID です。
+	ViewerID uint
 }
 
 type GetUserFollowingsOutput struct {
-	Users []*model.UserWithRelations
+	// UserName は一覧の持ち主のユーザー名です。
+	UserName   string
+	Users      []*model.UserWithRelations
+	NextCursor *pagination.Cursor
 }
 
 type GetUserFollowingsInteractor struct {
@@ -41,21 +48,30 @@ func NewGetUserFollowingsUsecase(
 }
 
 func (i *GetUserFollowingsInteractor) Execute(ctx context.Context, input GetUserFollowingsInput) (*GetUserFollowingsOutput, error) {
+	sort, page, errs := input.Page.resolve(pagination.SortNewest, pagination.SortName)
+	if len(errs) > 0 {
+		return nil, apperror.UnprocessableEntity(errs...)
+	}
+
 	// ユーザーの存在確認
 	user, err := i.UserRepository.FindByID(ctx, input.UserID)
 	if err != nil {
 		return nil, apperror.NotFound()
 	}
 
-	followings, err := i.RelationshipRepository.FindFollowingsByUserID(ctx, user.ID)
+	followings, err := i.RelationshipRepository.FindFollowingsByUserID(ctx, user.ID, repository.FollowSearchParams{
+		Sort:     sort,
+		Page:     page,
+		ViewerID: input.ViewerID,
+	})
 	if err != nil {
 		return nil, apperror.InternalServerError(err)
 	}
 
-	result, err := i.UserService.BuildUsersWithRelations(ctx, followings)
+	result, err := i.UserService.BuildUsersWithRelations(ctx, followings.Items)
 	if err != nil {
 		return nil, err
 	}
 
-	return &GetUserFollowingsOutput{Users: result}, nil
+	return &GetUserFollowingsOutput{UserName: user.Name, Users: result, NextCursor: followings.NextCursor}, nil
 }
@@ -7,8 +7,10 @@ import (
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
 	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
 	servicemock "github.com/daisuke-harada/date-courses-go/internal/domain/service/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -29,7 +31,8 @@ func TestGetUserFollowingsInteractor_Execute(t *testing.T) {
 		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(user, nil)
 
 		relRepo := repositorymock.NewMockRelationshipRepository(ctrl)
-		relRepo.EXPECT().FindFollowingsByUserID(ctx, uint(1)).Return([]*model.User{following}, nil)
+		relRepo.EXPECT().FindFollowingsByUserID(ctx, uint(1), repository.FollowSearchParams{Sort: pagination.SortNewest, Page: defaultPage}).
+			Return(pagination.Page[*model.User]{Items: []*model.User{following}}, nil)
 
 		userService := servicemock.NewMockUserService(ctrl)
 		userService.EXPECT().BuildUsersWithRelations(ctx, []*model.User{following}).Return([]*model.UserWithRelations{uwr}, nil)
@@ -39,6 +42,7 @@ func TestGetUserFollowingsInteractor_Execute(t *testing.T) {
 
 		require.NoError(t, err)
 		require.NotNil(t, output)
+		assert.Equal(t, "テストユーザー", output.UserName)
 		assert.Len(t, output.Users, 1)
 	})
 
@@ -75,7 +79,7 @@ func TestGetUserFollowingsInteractor_Execute(t *testing.T) {
 		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(user, nil)
 
 		relRepo := repositorymock.NewMockRelationshipRepository(ctrl)
-		relRepo.EXPECT().FindFollowingsByUserID(ctx, uint(1)).Return(nil, errors.New("db error"))
+		relRepo.EXPECT().FindFollowingsByUserID(ctx, uint(1), gomock.Any()).Return(pagination.Page[*model.User]{}, errors.New("db error"))
 
 		userService := servicemock.NewMockUserService(ctrl)
 
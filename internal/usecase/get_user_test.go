@@ -3,12 +3,15 @@ package usecase_test
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
 	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
 	servicemock "github.com/daisuke-harada/date-courses-go/internal/domain/service/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -28,15 +31,60 @@ func TestGetUserInteractor_Execute(t *testing.T) {
 		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(user, nil)
 
 		courseRepo := repositorymock.NewMockCourseRepository(ctrl)
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
 		userService := servicemock.NewMockUserService(ctrl)
 		userService.EXPECT().BuildUserWithRelations(ctx, user).Return(uwr, nil)
+		favoriteRepo.EXPECT().
+			SearchCoursesByUserID(ctx, repository.CourseFavoriteSearchParams{UserID: 1, Page: defaultPage}).
+			Return(pagination.Page[*model.Course]{}, nil)
 
-		interactor := usecase.NewGetUserUsecase(userRepo, courseRepo, userService)
+		blockRepo := repositorymock.NewMockUserBlockRepository(ctrl)
+		interactor := usecase.NewGetUserUsecase(userRepo, courseRepo, favoriteRepo, blockRepo, userService)
 		output, err := interactor.Execute(ctx, usecase.GetUserInput{ID: 1})
 
 		require.NoError(t, err)
 		require.NotNil(t, output)
 		assert.Equal(t, uwr, output.UserWithRelations)
+		assert.NotNil(t, output.UserWithRelations.FavoriteCourses, "0件でもお気に入りタブを出せるよう空スライスにする")
+	})
+
+	// お気に入りタブの先頭ページを載せ、コースごとの件数は閲覧者から見た値で集計する
+	t.Run("success_attaches_favorite_courses", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		user := &model.User{ID: 1, Name: "テストユーザー"}
+		ownCourses := []*model.Course{{ID: 10, UserID: 1, Authority: model.CourseAuthorityPublic}}
+		favoriteCourses := []*model.Course{{ID: 20, UserID: 2, Authority: model.CourseAuthorityPublic}}
+		uwr := &model.UserWithRelations{User: user, FollowerIDs: []int{}, FollowingIDs: []int{}, Courses: ownCourses, Reviews: []*model.DateSpotReview{}}
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(user, nil)
+
+		courseRepo := repositorymock.NewMockCourseRepository(ctrl)
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
+		favoriteRepo.EXPECT().
+			SearchCoursesByUserID(ctx, repository.CourseFavoriteSearchParams{UserID: 1, Page: defaultPage}).
+			Return(pagination.Page[*model.Course]{Items: favoriteCourses}, nil)
+		favoriteRepo.EXPECT().
+			SummarizeByCourseIDs(ctx, []uint{10, 20}, uint(99)).
+			Return(map[uint]*model.CourseFavoriteSummary{10: {Count: 4}, 20: {Count: 1, FavoritedByViewer: true}}, nil)
+
+		userService := servicemock.NewMockUserService(ctrl)
+		userService.EXPECT().BuildUserWithRelations(ctx, user).Return(uwr, nil)
+
+		blockRepo := repositorymock.NewMockUserBlockRepository(ctrl)
+		blockRepo.EXPECT().ExistsBetween(ctx, uint(99), uint(1)).Return(false, nil)
+
+		interactor := usecase.NewGetUserUsecase(userRepo, courseRepo, favoriteRepo, blockRepo, userService)
+		output, err := interactor.Execute(ctx, usecase.GetUserInput{ID: 1, ViewerID: 99})
+
+		require.NoError(t, err)
+		require.Len(t, output.UserWithRelations.FavoriteCourses, 1)
+		assert.Equal(t, uint(20), output.UserWithRelations.FavoriteCourses[0].ID)
+		assert.True(t, output.UserWithRelations.FavoriteCourses[0].Favorites.FavoritedByViewer)
+		assert.Equal(t, 4, output.UserWithRelations.Courses[0].Favorites.Count)
 	})
 
 	// 本人がマイページを開いたときだけ、非公開コース込みで取り直す
@@ -57,12 +105,21 @@ func TestGetUserInteractor_Execute(t *testing.T) {
 		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(user, nil)
 
 		courseRepo := repositorymock.NewMockCourseRepository(ctrl)
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
 		courseRepo.EXPECT().FindAllByUserID(ctx, uint(1)).Return(allCourses, nil)
 
 		userService := servicemock.NewMockUserService(ctrl)
 		userService.EXPECT().BuildUserWithRelations(ctx, user).Return(uwr, nil)
 
-		interactor := usecase.NewGetUserUsecase(userRepo, courseRepo, userService)
+		favoriteRepo.EXPECT().
+			SearchCoursesByUserID(ctx, gomock.Any()).
+			Return(pagination.Page[*model.Course]{}, nil)
+		favoriteRepo.EXPECT().
+			SummarizeByCourseIDs(ctx, []uint{10, 11}, uint(1)).
+			Return(map[uint]*model.CourseFavoriteSummary{10: {}, 11: {}}, nil)
+
+		blockRepo := repositorymock.NewMockUserBlockRepository(ctrl)
+		interactor := usecase.NewGetUserUsecase(userRepo, courseRepo, favoriteRepo, blockRepo, userService)
 		output, err := interactor.Execute(ctx, usecase.GetUserInput{ID: 1, ViewerID: 1})
 
 		require.NoError(t, err)
@@ -83,11 +140,22 @@ func TestGetUserInteractor_Execute(t *testing.T) {
 		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(user, nil)
 
 		courseRepo := repositorymock.NewMockCourseRepository(ctrl)
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
 
 		userService := servicemock.NewMockUserService(ctrl)
 		userService.EXPECT().BuildUserWithRelations(ctx, user).Return(uwr, nil)
 
-		interactor := usecase.NewGetUserUsecase(userRepo, courseRepo, userService)
+		favoriteRepo.EXPECT().
+			SearchCoursesByUserID(ctx, gomock.Any()).
+			Return(pagination.Page[*model.Course]{}, nil)
+		favoriteRepo.EXPECT().
+			SummarizeByCourseIDs(ctx, []uint{10}, uint(99)).
+			Return(map[uint]*model.CourseFavoriteSummary{10: {}}, nil)
+
+		blockRepo := repositorymock.NewMockUserBlockRepository(ctrl)
+		blockRepo.EXPECT().ExistsBetween(ctx, uint(99), uint(1)).Return(false, nil)
+
+		interactor := usecase.NewGetUserUsecase(userRepo, courseRepo, favoriteRepo, blockRepo, userService)
 		output, err := interactor.Execute(ctx, usecase.GetUserInput{ID: 1, ViewerID: 99})
 
 		require.NoError(t, err)
@@ -104,9 +172,11 @@ func TestGetUserInteractor_Execute(t *testing.T) {
 		userRepo.EXPECT().FindByID(ctx, uint(999)).Return(nil, errors.New("not found"))
 
 		courseRepo := repositorymock.NewMockCourseRepository(ctrl)
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
 		userService := servicemock.NewMockUserService(ctrl)
 
-		interactor := usecase.NewGetUserUsecase(userRepo, courseRepo, userService)
+		blockRepo := repositorymock.NewMockUserBlockRepository(ctrl)
+		interactor := usecase.NewGetUserUsecase(userRepo, courseRepo, favoriteRepo, blockRepo, userService)
 		output, err := interactor.Execute(ctx, usecase.GetUserInput{ID: 999})
 
 		assert.Error(t, err)
@@ -127,13 +197,41 @@ func TestGetUserInteractor_Execute(t *testing.T) {
 		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(user, nil)
 
 		courseRepo := repositorymock.NewMockCourseRepository(ctrl)
+		favoriteRepo := repositorymock.NewMockCourseFavoriteRepository(ctrl)
 		userService := servicemock.NewMockUserService(ctrl)
 		userService.EXPECT().BuildUserWithRelations(ctx, user).Return(nil, errors.New("service error"))
 
-		interactor := usecase.NewGetUserUsecase(userRepo, courseRepo, userService)
+		blockRepo := repositorymock.NewMockUserBlockRepository(ctrl)
+		interactor := usecase.NewGetUserUsecase(userRepo, courseRepo, favoriteRepo, blockRepo, userService)
 		output, err := interactor.Execute(ctx, usecase.GetUserInput{ID: 1})
 
 		assert.Error(t, err)
 		assert.Nil(t, output)
 	})
+
+	// ブロックした相手・された相手のページは、ユーザーがいないときと同じく 404 にする
+	t.Run("error_not_found_when_blocked", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(&model.User{ID: 1}, nil)
+		blockRepo := repositorymock.NewMockUserBlockRepository(ctrl)
+		blockRepo.EXPECT().ExistsBetween(ctx, uint(99), uint(1)).Return(true, nil)
+
+		interactor := usecase.NewGetUserUsecase(
+			userRepo,
+			repositorymock.NewMockCourseRepository(ctrl),
+			repositorymock.NewMockCourseFavoriteRepository(ctrl),
+			blockRepo,
+			servicemock.NewMockUserService(ctrl),
+		)
+		output, err := interactor.Execute(ctx, usecase.GetUserInput{ID: 1, ViewerID: 99})
+
+		assert.Nil(t, output)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, statusCode)
+	})
 }
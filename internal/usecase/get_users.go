@@ -7,6 +7,7 @@ import (
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 )
 
 // GetUsersInputPort はユーザー一覧取得ユースケースの入力ポートです。
@@ -16,10 +17,14 @@ type GetUsersInputPort interface {
 
 type GetUsersInput struct {
 	Name *string
+	Page PageInput
+	// ViewerID は閲覧しているユーザーの ID です。未ログインの場合は 0 になります。
+	ViewerID uint
 }
 
 type GetUsersOutput struct {
-	Users []*model.UserWithRelations
+	Users      []*model.UserWithRelations
+	NextCursor *pagination.Cursor
 }
 
 type GetUsersInteractor struct {
@@ -38,15 +43,25 @@ func NewGetUsersUsecase(
 }
 
 func (i *GetUsersInteractor) Execute(ctx context.Context, input GetUsersInput) (*GetUsersOutput, error) {
-	users, err := i.UserRepository.Search(ctx, input.Name)
+	sort, page, errs := input.Page.resolve(pagination.SortNewest, pagination.SortName)
+	if len(errs) > 0 {
+		return nil, apperror.UnprocessableEntity(errs...)
+	}
+
+	users, err := i.UserRepository.Search(ctx, repository.UserSearchParams{
+		Name:     input.Name,
+		Sort:     sort,
+		Page:     page,
+		ViewerID: input.ViewerID,
+	})
 	if err != nil {
 		return nil, apperror.InternalServerError(err)
 	}
 
-	result, err := i.UserService.BuildUsersWithRelations(ctx, users)
+	result, err := i.UserService.BuildUsersWithRelations(ctx, users.Items)
 	if err != nil {
 		return nil, err
 	}
 
-	return &GetUsersOutput{Users: result}, nil
+	return &GetUsersOutput{Users: result, NextCursor: users.NextCursor}, nil
 }
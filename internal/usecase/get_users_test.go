@@ -3,17 +3,24 @@ package usecase_test
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
 
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
 	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
 	servicemock "github.com/daisuke-harada/date-courses-go/internal/domain/service/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
+// defaultPage は sort・limit・cursor を省略したときにリポジトリへ渡るページ指定です。
+var defaultPage = pagination.Params{Limit: pagination.DefaultLimit}
+
 func TestGetUsersInteractor_Execute(t *testing.T) {
 	t.Run("success_returns_users", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
@@ -30,7 +37,9 @@ func TestGetUsersInteractor_Execute(t *testing.T) {
 		}
 
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
-		userRepo.EXPECT().Search(ctx, (*string)(nil)).Return(users, nil)
+		userRepo.EXPECT().
+			Search(ctx, repository.UserSearchParams{Sort: pagination.SortNewest, Page: defaultPage}).
+			Return(pagination.Page[*model.User]{Items: users}, nil)
 
 		userService := servicemock.NewMockUserService(ctrl)
 		userService.EXPECT().BuildUsersWithRelations(ctx, users).Return(uwrs, nil)
@@ -43,6 +52,59 @@ func TestGetUsersInteractor_Execute(t *testing.T) {
 		assert.Len(t, output.Users, 2)
 	})
 
+	t.Run("success_passes_page_and_returns_next_cursor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		sortName := "name"
+		limit := 1
+		cursor := pagination.NewStringCursor(pagination.SortName, "ユーザー1", 1)
+		encoded := cursor.Encode()
+		next := pagination.NewStringCursor(pagination.SortName, "ユーザー2", 2)
+		users := []*model.User{{ID: 2, Name: "ユーザー2"}}
+		uwrs := []*model.UserWithRelations{{User: users[0]}}
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().
+			Search(ctx, repository.UserSearchParams{
+				Sort: pagination.SortName,
+				Page: pagination.Params{Limit: 1, Cursor: &cursor},
+			}).
+			Return(pagination.Page[*model.User]{Items: users, NextCursor: &next}, nil)
+
+		userService := servicemock.NewMockUserService(ctrl)
+		userService.EXPECT().BuildUsersWithRelations(ctx, users).Return(uwrs, nil)
+
+		interactor := usecase.NewGetUsersUsecase(userRepo, userService)
+		output, err := interactor.Execute(ctx, usecase.GetUsersInput{
+			Page: usecase.PageInput{Sort: &sortName, Limit: &limit, Cursor: &encoded},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, uwrs, output.Users)
+		assert.Equal(t, &next, output.NextCursor)
+	})
+
+	t.Run("error_validation_unsupported_sort", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rating := "rating"
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userService := servicemock.NewMockUserService(ctrl)
+
+		interactor := usecase.NewGetUsersUsecase(userRepo, userService)
+		output, err := interactor.Execute(context.Background(), usecase.GetUsersInput{
+			Page: usecase.PageInput{Sort: &rating},
+		})
+
+		assert.Nil(t, output)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+
 	t.Run("error_repository_search_failed", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -50,7 +112,7 @@ func TestGetUsersInteractor_Execute(t *testing.T) {
 		ctx := context.Background()
 
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
-		userRepo.EXPECT().Search(ctx, (*string)(nil)).Return(nil, errors.New("db error"))
+		userRepo.EXPECT().Search(ctx, gomock.Any()).Return(pagination.Page[*model.User]{}, errors.New("db error"))
 
 		userService := servicemock.NewMockUserService(ctrl)
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
@@ -17,16 +18,22 @@ type LoginInputPort interface {
 	Execute(context.Context, LoginInput) (*LoginOutput, error)
 }
 
+// LoginInput はログインの入力です。名前とメールアドレスはどちらか一方で本人を特定します。
+// 両方あるときはメールアドレスを優先します。
 type LoginInput struct {
 	Name     string
+	Email    string
 	Password string
+	// IPAddress と UserAgent はログイン履歴に残すための接続元の情報です。
+	IPAddress string
+	UserAgent string
 }
 
 func (i *LoginInput) Validate() error {
 	var errs []string
 
-	if strings.TrimSpace(i.Name) == "" {
-		errs = append(errs, "名前を入力してください")
+	if strings.TrimSpace(i.Name) == "" && strings.TrimSpace(i.Email) == "" {
+		errs = append(errs, "名前またはメールアドレスを入力してください")
 	}
 	if i.Password == "" {
 		errs = append(errs, "パスワードを入力してください")
@@ -40,27 +47,63 @@ func (i *LoginInput) Validate() error {
 }
 
 type LoginOutput struct {
-	User  *model.User
-	Token string
+	User         *model.User
+	Token        string
+	RefreshToken string
 }
 
-type JWTSecretKey string
+// LoginLockoutPolicy はログインの失敗が続いたアカウントをロックする条件です。
+// 設定から DI 経由で注入します。MaxFailedAttempts が 0 のときはロックしません。
+type LoginLockoutPolicy struct {
+	MaxFailedAttempts uint
+	Duration          time.Duration
+}
+
+func (p LoginLockoutPolicy) enabled() bool {
+	return p.MaxFailedAttempts > 0 && p.Duration > 0
+}
+
+// errLoginFailed は名前・メールアドレスかパスワードが誤っているときのエラーです。
+// どちらが誤っているかは、アカウントの有無を探られないよう区別しません。
+var errLoginFailed = apperror.Unauthorized(
+	"認証に失敗しました。",
+	"正しい名前またはメールアドレスとパスワードを入力し直すか、新規登録を行ってください。",
+)
+
+// dummyPasswordDigest は存在しない・ロック中のアカウントでも、パスワードを確かめるのと同じだけ時間をかけるためのハッシュです。
+// 応答時間の差からアカウントの有無やロックを探られないようにします。
+const dummyPasswordDigest = "$2a$10$floS6GzlXoyxI/ZTsTSR3OlWLTcvRbBgcxFDS0dTRlDzywneGNDYu"
 
 type LoginInteractor struct {
-	UserRepository repository.UserRepository
-	AuthService    service.AuthService
-	JWTSecretKey   JWTSecretKey
+	UserRepository         repository.UserRepository
+	LoginHistoryRepository repository.LoginHistoryRepository
+	RefreshTokenRepository repository.RefreshTokenRepository
+	AuthService            service.AuthService
+	Keyring                *jwtpkg.Keyring
+	SessionTTL             SessionTTL
+	LockoutPolicy          LoginLockoutPolicy
+	DemoUserName           DemoUserName
 }
 
 func NewLoginUsecase(
 	userRepository repository.UserRepository,
+	loginHistoryRepository repository.LoginHistoryRepository,
+	refreshTokenRepository repository.RefreshTokenRepository,
 	authService service.AuthService,
-	jwtSecretKey JWTSecretKey,
+	keyring *jwtpkg.Keyring,
+	sessionTTL SessionTTL,
+	lockoutPolicy LoginLockoutPolicy,
+	demoUserName DemoUserName,
 ) LoginInputPort {
 	return &LoginInteractor{
-		UserRepository: userRepository,
-		AuthService:    authService,
-		JWTSecretKey:   jwtSecretKey,
+		UserRepository:         userRepository,
+		LoginHistoryRepository: loginHistoryRepository,
+		RefreshTokenRepository: refreshTokenRepository,
+		AuthService:            authService,
+		Keyring:                keyring,
+		SessionTTL:             sessionTTL,
+		LockoutPolicy:          lockoutPolicy,
+		DemoUserName:           demoUserName,
 	}
 }
 
@@ -69,28 +112,82 @@ func (i *LoginInteractor) Execute(ctx context.Context, input LoginInput) (*Login
 		return nil, err
 	}
 
-	user, err := i.UserRepository.FindByName(ctx, input.Name)
+	user, err := i.findUser(ctx, input)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, apperror.Unauthorized(
-				"認証に失敗しました。",
-				"正しい名前・パスワードを入力し直すか、新規登録を行ってください。",
-			)
+			i.AuthService.CheckPassword(dummyPasswordDigest, input.Password)
+			return nil, errLoginFailed
 		}
 		return nil, apperror.InternalServerError(err)
 	}
 
+	// デモ用アカウントは誰でもログインできる共有アカウントのため、ロックも記録もしない。
+	// ロックすると第三者がわざと失敗してデモを使えなくできてしまい、
+	// 履歴を残すと他の閲覧者の IP アドレスを見せてしまう。
+	if i.DemoUserName != "" && user.Name == string(i.DemoUserName) {
+		if !i.AuthService.CheckPassword(user.PasswordDigest, input.Password) {
+			return nil, errLoginFailed
+		}
+		return i.issueSession(ctx, user)
+	}
+
+	now := time.Now()
+	// ロック中は本当のパスワードを確かめない。正しいパスワードで通してしまうと、ロック中も総当たりを続けられる。
+	// 応答はパスワードの誤りと同じにし、ロックしたことからアカウントの有無を探られないようにする
+	if user.IsLocked(now) {
+		i.AuthService.CheckPassword(dummyPasswordDigest, input.Password)
+		i.recordHistory(ctx, user, model.LoginResultLocked, input)
+		return nil, errLoginFailed
+	}
+
 	if !i.AuthService.CheckPassword(user.PasswordDigest, input.Password) {
-		return nil, apperror.Unauthorized(
-			"認証に失敗しました。",
-			"正しい名前・パスワードを入力し直すか、新規登録を行ってください。",
-		)
+		return nil, i.recordFailure(ctx, user, input, now)
 	}
 
-	token, err := jwtpkg.Encode(user.ID, string(i.JWTSecretKey))
-	if err != nil {
+	if err := i.UserRepository.RecordLoginSuccess(ctx, user.ID, now, input.IPAddress); err != nil {
 		return nil, apperror.InternalServerError(err)
 	}
+	user.FailedLoginCount = 0
+	user.LockedUntil = nil
+	user.LastLoginAt = &now
+	user.LastLoginIP = &input.IPAddress
+	i.recordHistory(ctx, user, model.LoginResultSucceeded, input)
+
+	return i.issueSession(ctx, user)
+}
 
-	return &LoginOutput{User: user, Token: token}, nil
+func (i *LoginInteractor) findUser(ctx context.Context, input LoginInput) (*model.User, error) {
+	if email := strings.TrimSpace(input.Email); email != "" {
+		return i.UserRepository.FindByEmail(ctx, email)
+	}
+	return i.UserRepository.FindByName(ctx, input.Name)
+}
+
+// recordFailure はパスワードの誤りを数え、続いたらロックします。
+// ロックしたかどうかは応答では区別しません。
+// 失敗回数は DB に持つため、Lambda のコールドスタートやスケールアウトをまたいでも数え続けます。
+func (i *LoginInteractor) recordFailure(ctx context.Context, user *model.User, input LoginInput, now time.Time) error {
+	i.recordHistory(ctx, user, model.LoginResultFailed, input)
+	if !i.LockoutPolicy.enabled() {
+		return errLoginFailed
+	}
+
+	if _, err := i.UserRepository.RecordLoginFailure(ctx, user.ID, i.LockoutPolicy.MaxFailedAttempts, now.Add(i.LockoutPolicy.Duration)); err != nil {
+		return apperror.InternalServerError(err)
+	}
+	return errLoginFailed
+}
+
+// recordHistory はログインの試行を履歴に残します。
+// 履歴は本人が確かめるためのもので、書き込みに失敗してもログインの成否は変えません（失敗はリポジトリがログに残します）。
+func (i *LoginInteractor) recordHistory(ctx context.Context, user *model.User, result model.LoginResult, input LoginInput) {
+	_ = i.LoginHistoryRepository.Create(ctx, model.NewLoginHistory(user.ID, result, input.IPAddress, input.UserAgent))
+}
+
+func (i *LoginInteractor) issueSession(ctx context.Context, user *model.User) (*LoginOutput, error) {
+	token, refreshToken, err := issueSession(ctx, i.RefreshTokenRepository, user, i.Keyring, i.SessionTTL)
+	if err != nil {
+		return nil, err
+	}
+	return &LoginOutput{User: user, Token: token, RefreshToken: refreshToken}, nil
 }
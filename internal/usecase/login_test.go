@@ -3,19 +3,61 @@ package usecase_test
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
 	servicemock "github.com/daisuke-harada/date-courses-go/internal/domain/service/mock"
+	jwtpkg "github.com/daisuke-harada/date-courses-go/internal/pkg/jwt"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"gorm.io/gorm"
 )
 
-const testJWTSecret usecase.JWTSecretKey = "test-secret"
+// testKeyring はテスト用に HS256 で署名する鍵束です。
+var testKeyring = newTestKeyring()
+
+func newTestKeyring() *jwtpkg.Keyring {
+	key, err := jwtpkg.NewHMACKey("test", []byte("test-secret"))
+	if err != nil {
+		panic(err)
+	}
+	keyring, err := jwtpkg.NewKeyring("https://api.example.com", "date-courses", key)
+	if err != nil {
+		panic(err)
+	}
+	return keyring
+}
+
+var testSessionTTL = usecase.SessionTTL{AccessToken: 15 * time.Minute, RefreshToken: 30 * 24 * time.Hour}
+
+var testLockoutPolicy = usecase.LoginLockoutPolicy{MaxFailedAttempts: 5, Duration: 15 * time.Minute}
+
+// newLoginUsecase は既定の設定でログインのユースケースを組み立てます。
+func newLoginUsecase(
+	userRepo *repositorymock.MockUserRepository,
+	historyRepo *repositorymock.MockLoginHistoryRepository,
+	refreshTokenRepo *repositorymock.MockRefreshTokenRepository,
+	authService *servicemock.MockAuthService,
+) usecase.LoginInputPort {
+	return usecase.NewLoginUsecase(userRepo, historyRepo, refreshTokenRepo, authService, testKeyring, testSessionTTL, testLockoutPolicy, "guest")
+}
+
+// expectLoginHistory は result のログイン履歴が1件記録されることを期待します。
+func expectLoginHistory(historyRepo *repositorymock.MockLoginHistoryRepository, result model.LoginResult) {
+	historyRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, h *model.LoginHistory) error {
+		if h.Result != result {
+			return errors.New("unexpected login result: " + string(h.Result))
+		}
+		return nil
+	})
+}
 
 func newLoginUser() *model.User {
 	gender := model.GenderMale
@@ -37,43 +79,129 @@ func TestLoginInteractor_Execute(t *testing.T) {
 		user := newLoginUser()
 
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		historyRepo := repositorymock.NewMockLoginHistoryRepository(ctrl)
 		authService := servicemock.NewMockAuthService(ctrl)
 
 		userRepo.EXPECT().FindByName(ctx, "alice").Return(user, nil)
 		authService.EXPECT().CheckPassword(user.PasswordDigest, "password").Return(true)
-
-		interactor := usecase.NewLoginUsecase(userRepo, authService, testJWTSecret)
-		output, err := interactor.Execute(ctx, usecase.LoginInput{Name: "alice", Password: "password"})
+		userRepo.EXPECT().RecordLoginSuccess(ctx, user.ID, gomock.Any(), "192.0.2.1").Return(nil)
+
+		var history *model.LoginHistory
+		historyRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, h *model.LoginHistory) error {
+			history = h
+			return nil
+		})
+
+		// DB にはハッシュだけを保存し、平文はレスポンスにだけ載せる
+		var stored *model.RefreshToken
+		refreshTokenRepo := repositorymock.NewMockRefreshTokenRepository(ctrl)
+		refreshTokenRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, token *model.RefreshToken) error {
+			stored = token
+			return nil
+		})
+
+		interactor := newLoginUsecase(userRepo, historyRepo, refreshTokenRepo, authService)
+		output, err := interactor.Execute(ctx, usecase.LoginInput{
+			Name:      "alice",
+			Password:  "password",
+			IPAddress: "192.0.2.1",
+			UserAgent: "Mozilla/5.0",
+		})
 
 		require.NoError(t, err)
 		require.NotNil(t, output)
 		assert.Equal(t, user, output.User)
 		assert.NotEmpty(t, output.Token)
+		require.NotEmpty(t, output.RefreshToken)
+		assert.Equal(t, model.HashRefreshToken(output.RefreshToken), stored.TokenHash)
+		assert.NotEqual(t, output.RefreshToken, stored.TokenHash)
+		assert.Equal(t, user.ID, stored.UserID)
+
+		require.NotNil(t, history)
+		assert.Equal(t, model.LoginResultSucceeded, history.Result)
+		assert.Equal(t, "192.0.2.1", history.IPAddress)
+		assert.Equal(t, "Mozilla/5.0", history.UserAgent)
+		require.NotNil(t, output.User.LastLoginIP)
+		assert.Equal(t, "192.0.2.1", *output.User.LastLoginIP)
+	})
+
+	t.Run("success_login_by_email", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		user := newLoginUser()
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		historyRepo := repositorymock.NewMockLoginHistoryRepository(ctrl)
+		authService := servicemock.NewMockAuthService(ctrl)
+		refreshTokenRepo := repositorymock.NewMockRefreshTokenRepository(ctrl)
+
+		userRepo.EXPECT().FindByEmail(ctx, "alice@example.com").Return(user, nil)
+		authService.EXPECT().CheckPassword(user.PasswordDigest, "password").Return(true)
+		userRepo.EXPECT().RecordLoginSuccess(ctx, user.ID, gomock.Any(), gomock.Any()).Return(nil)
+		expectLoginHistory(historyRepo, model.LoginResultSucceeded)
+		refreshTokenRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		interactor := newLoginUsecase(userRepo, historyRepo, refreshTokenRepo, authService)
+		output, err := interactor.Execute(ctx, usecase.LoginInput{Email: " alice@example.com ", Password: "password"})
+
+		require.NoError(t, err)
+		assert.Equal(t, user, output.User)
 	})
 
-	t.Run("error_empty_name", func(t *testing.T) {
+	t.Run("success_even_if_history_fails", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
+		ctx := context.Background()
+		user := newLoginUser()
+
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		historyRepo := repositorymock.NewMockLoginHistoryRepository(ctrl)
 		authService := servicemock.NewMockAuthService(ctrl)
+		refreshTokenRepo := repositorymock.NewMockRefreshTokenRepository(ctrl)
 
-		interactor := usecase.NewLoginUsecase(userRepo, authService, testJWTSecret)
+		userRepo.EXPECT().FindByName(ctx, "alice").Return(user, nil)
+		authService.EXPECT().CheckPassword(user.PasswordDigest, "password").Return(true)
+		userRepo.EXPECT().RecordLoginSuccess(ctx, user.ID, gomock.Any(), gomock.Any()).Return(nil)
+		historyRepo.EXPECT().Create(ctx, gomock.Any()).Return(errors.New("db error"))
+		refreshTokenRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		interactor := newLoginUsecase(userRepo, historyRepo, refreshTokenRepo, authService)
+		output, err := interactor.Execute(ctx, usecase.LoginInput{Name: "alice", Password: "password"})
+
+		require.NoError(t, err)
+		assert.NotNil(t, output)
+	})
+
+	t.Run("error_empty_name_and_email", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		interactor := newLoginUsecase(
+			repositorymock.NewMockUserRepository(ctrl),
+			repositorymock.NewMockLoginHistoryRepository(ctrl),
+			repositorymock.NewMockRefreshTokenRepository(ctrl),
+			servicemock.NewMockAuthService(ctrl),
+		)
 		output, err := interactor.Execute(context.Background(), usecase.LoginInput{Name: "", Password: "password"})
 
 		assert.Error(t, err)
 		assert.Nil(t, output)
-		assert.Contains(t, err.Error(), "名前を入力してください")
+		assert.Contains(t, err.Error(), "名前またはメールアドレスを入力してください")
 	})
 
 	t.Run("error_empty_password", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		userRepo := repositorymock.NewMockUserRepository(ctrl)
-		authService := servicemock.NewMockAuthService(ctrl)
-
-		interactor := usecase.NewLoginUsecase(userRepo, authService, testJWTSecret)
+		interactor := newLoginUsecase(
+			repositorymock.NewMockUserRepository(ctrl),
+			repositorymock.NewMockLoginHistoryRepository(ctrl),
+			repositorymock.NewMockRefreshTokenRepository(ctrl),
+			servicemock.NewMockAuthService(ctrl),
+		)
 		output, err := interactor.Execute(context.Background(), usecase.LoginInput{Name: "alice", Password: ""})
 
 		assert.Error(t, err)
@@ -87,11 +215,18 @@ func TestLoginInteractor_Execute(t *testing.T) {
 
 		ctx := context.Background()
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
-		authService := servicemock.NewMockAuthService(ctrl)
 
 		userRepo.EXPECT().FindByName(ctx, "unknown").Return(nil, gorm.ErrRecordNotFound)
-
-		interactor := usecase.NewLoginUsecase(userRepo, authService, testJWTSecret)
+		// アカウントがあるときと応答時間が変わらないよう、ダミーのハッシュでパスワードを確かめる
+		authService := servicemock.NewMockAuthService(ctrl)
+		authService.EXPECT().CheckPassword(gomock.Any(), "password").Return(false)
+
+		interactor := newLoginUsecase(
+			userRepo,
+			repositorymock.NewMockLoginHistoryRepository(ctrl),
+			repositorymock.NewMockRefreshTokenRepository(ctrl),
+			authService,
+		)
 		output, err := interactor.Execute(ctx, usecase.LoginInput{Name: "unknown", Password: "password"})
 
 		assert.Error(t, err)
@@ -99,7 +234,7 @@ func TestLoginInteractor_Execute(t *testing.T) {
 		assert.Contains(t, err.Error(), "認証に失敗しました。")
 	})
 
-	t.Run("error_wrong_password", func(t *testing.T) {
+	t.Run("error_wrong_password_counts_failure", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -107,30 +242,169 @@ func TestLoginInteractor_Execute(t *testing.T) {
 		user := newLoginUser()
 
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		historyRepo := repositorymock.NewMockLoginHistoryRepository(ctrl)
 		authService := servicemock.NewMockAuthService(ctrl)
 
 		userRepo.EXPECT().FindByName(ctx, "alice").Return(user, nil)
 		authService.EXPECT().CheckPassword(user.PasswordDigest, "wrong").Return(false)
+		userRepo.EXPECT().RecordLoginFailure(ctx, user.ID, uint(5), gomock.Any()).Return(false, nil)
+		expectLoginHistory(historyRepo, model.LoginResultFailed)
 
-		interactor := usecase.NewLoginUsecase(userRepo, authService, testJWTSecret)
+		interactor := newLoginUsecase(userRepo, historyRepo, repositorymock.NewMockRefreshTokenRepository(ctrl), authService)
 		output, err := interactor.Execute(ctx, usecase.LoginInput{Name: "alice", Password: "wrong"})
 
-		assert.Error(t, err)
 		assert.Nil(t, output)
-		assert.Contains(t, err.Error(), "認証に失敗しました。")
+		statusCode, messages, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, statusCode)
+		assert.Contains(t, messages, "認証に失敗しました。")
 	})
 
-	t.Run("error_db_error", func(t *testing.T) {
+	t.Run("error_locked_when_failures_reach_limit", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		ctx := context.Background()
+		user := newLoginUser()
+
 		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		historyRepo := repositorymock.NewMockLoginHistoryRepository(ctrl)
 		authService := servicemock.NewMockAuthService(ctrl)
 
+		userRepo.EXPECT().FindByName(ctx, "alice").Return(user, nil)
+		authService.EXPECT().CheckPassword(user.PasswordDigest, "wrong").Return(false)
+		before := time.Now()
+		userRepo.EXPECT().RecordLoginFailure(ctx, user.ID, uint(5), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint, _ uint, lockedUntil time.Time) (bool, error) {
+				assert.WithinDuration(t, before.Add(15*time.Minute), lockedUntil, time.Minute)
+				return true, nil
+			})
+		expectLoginHistory(historyRepo, model.LoginResultFailed)
+
+		interactor := newLoginUsecase(userRepo, historyRepo, repositorymock.NewMockRefreshTokenRepository(ctrl), authService)
+		_, err := interactor.Execute(ctx, usecase.LoginInput{Name: "alice", Password: "wrong"})
+
+		// ロックしたことは応答で区別しない
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, statusCode)
+	})
+
+	t.Run("error_locked_user_is_rejected_like_unknown_user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		user := newLoginUser()
+		user.LockedUntil = lo.ToPtr(time.Now().Add(10 * time.Minute))
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		historyRepo := repositorymock.NewMockLoginHistoryRepository(ctrl)
+		// 正しいパスワードでも通さないため、本人のハッシュでは確かめない
+		authService := servicemock.NewMockAuthService(ctrl)
+		authService.EXPECT().CheckPassword(gomock.Not(user.PasswordDigest), "password").Return(false)
+
+		userRepo.EXPECT().FindByName(ctx, "alice").Return(user, nil)
+		expectLoginHistory(historyRepo, model.LoginResultLocked)
+
+		interactor := newLoginUsecase(userRepo, historyRepo, repositorymock.NewMockRefreshTokenRepository(ctrl), authService)
+		output, err := interactor.Execute(ctx, usecase.LoginInput{Name: "alice", Password: "password"})
+
+		// 存在しないアカウントと同じ応答にし、アカウントの有無を探られないようにする
+		assert.Nil(t, output)
+		statusCode, messages, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, statusCode)
+		assert.Contains(t, messages, "認証に失敗しました。")
+	})
+
+	t.Run("success_after_lock_expired", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		user := newLoginUser()
+		user.LockedUntil = lo.ToPtr(time.Now().Add(-time.Minute))
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		historyRepo := repositorymock.NewMockLoginHistoryRepository(ctrl)
+		authService := servicemock.NewMockAuthService(ctrl)
+		refreshTokenRepo := repositorymock.NewMockRefreshTokenRepository(ctrl)
+
+		userRepo.EXPECT().FindByName(ctx, "alice").Return(user, nil)
+		authService.EXPECT().CheckPassword(user.PasswordDigest, "password").Return(true)
+		userRepo.EXPECT().RecordLoginSuccess(ctx, user.ID, gomock.Any(), gomock.Any()).Return(nil)
+		expectLoginHistory(historyRepo, model.LoginResultSucceeded)
+		refreshTokenRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		interactor := newLoginUsecase(userRepo, historyRepo, refreshTokenRepo, authService)
+		output, err := interactor.Execute(ctx, usecase.LoginInput{Name: "alice", Password: "password"})
+
+		require.NoError(t, err)
+		assert.Nil(t, output.User.LockedUntil)
+	})
+
+	// デモ用アカウントは共有のため、わざと失敗してロックさせられないようにし、閲覧者の IP も残さない
+	t.Run("demo_user_is_neither_locked_nor_recorded", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		user := newLoginUser()
+		user.Name = "guest"
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		authService := servicemock.NewMockAuthService(ctrl)
+
+		userRepo.EXPECT().FindByName(ctx, "guest").Return(user, nil)
+		authService.EXPECT().CheckPassword(user.PasswordDigest, "wrong").Return(false)
+
+		interactor := newLoginUsecase(userRepo, repositorymock.NewMockLoginHistoryRepository(ctrl), repositorymock.NewMockRefreshTokenRepository(ctrl), authService)
+		_, err := interactor.Execute(ctx, usecase.LoginInput{Name: "guest", Password: "wrong"})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, statusCode)
+	})
+
+	t.Run("lockout_disabled_does_not_count_failures", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		user := newLoginUser()
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		historyRepo := repositorymock.NewMockLoginHistoryRepository(ctrl)
+		authService := servicemock.NewMockAuthService(ctrl)
+
+		userRepo.EXPECT().FindByName(ctx, "alice").Return(user, nil)
+		authService.EXPECT().CheckPassword(user.PasswordDigest, "wrong").Return(false)
+		expectLoginHistory(historyRepo, model.LoginResultFailed)
+
+		interactor := usecase.NewLoginUsecase(userRepo, historyRepo, repositorymock.NewMockRefreshTokenRepository(ctrl), authService, testKeyring, testSessionTTL, usecase.LoginLockoutPolicy{}, "")
+		_, err := interactor.Execute(ctx, usecase.LoginInput{Name: "alice", Password: "wrong"})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, statusCode)
+	})
+
+	t.Run("error_db_error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+
 		userRepo.EXPECT().FindByName(ctx, "alice").Return(nil, errors.New("db error"))
 
-		interactor := usecase.NewLoginUsecase(userRepo, authService, testJWTSecret)
+		interactor := newLoginUsecase(
+			userRepo,
+			repositorymock.NewMockLoginHistoryRepository(ctrl),
+			repositorymock.NewMockRefreshTokenRepository(ctrl),
+			servicemock.NewMockAuthService(ctrl),
+		)
 		output, err := interactor.Execute(ctx, usecase.LoginInput{Name: "alice", Password: "password"})
 
 		assert.Error(t, err)
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// LogoutInputPort はログアウトユースケースの入力ポートです。
+type LogoutInputPort interface {
+	Execute(context.Context, LogoutInput) error
+}
+
+type LogoutInput struct {
+	RefreshToken string
+	// All が true のときは、この端末だけでなくユーザーのすべてのセッションを無効にします。
+	// 発行済みのアクセストークンも使えなくなるため、盗まれたトークンを止めるときに使います。
+	All bool
+}
+
+func (i *LogoutInput) Validate() error {
+	if i.RefreshToken == "" {
+		return apperror.UnprocessableEntity("リフレッシュトークンを指定してください")
+	}
+	return nil
+}
+
+type LogoutInteractor struct {
+	UnitOfWork             repository.UnitOfWork
+	UserRepository         repository.UserRepository
+	RefreshTokenRepository repository.RefreshTokenRepository
+}
+
+func NewLogoutUsecase(
+	unitOfWork repository.UnitOfWork,
+	userRepository repository.UserRepository,
+	refreshTokenRepository repository.RefreshTokenRepository,
+) LogoutInputPort {
+	return &LogoutInteractor{
+		UnitOfWork:             unitOfWork,
+		UserRepository:         userRepository,
+		RefreshTokenRepository: refreshTokenRepository,
+	}
+}
+
+// Execute はリフレッシュトークンを失効させます。
+// アクセストークンの期限が切れていてもログアウトできるよう、本人確認はリフレッシュトークンの所持で行います。
+// 知らないトークンや失効済みのトークンでもエラーにせず、何もしません（何度呼んでも同じ結果）。
+//
+// All が false のとき、発行済みのアクセストークンは有効期限まで使えます。
+// トークンバージョンはユーザー単位のため、1つの端末のアクセストークンだけを無効にはできません。
+func (i *LogoutInteractor) Execute(ctx context.Context, input LogoutInput) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	stored, err := i.RefreshTokenRepository.FindByHash(ctx, model.HashRefreshToken(input.RefreshToken))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return apperror.InternalServerError(err)
+	}
+	if stored.IsRevoked() {
+		return nil
+	}
+
+	if input.All {
+		return i.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+			return revokeAllSessions(ctx, i.UserRepository, i.RefreshTokenRepository, stored.UserID)
+		})
+	}
+
+	if _, err := i.RefreshTokenRepository.Revoke(ctx, stored.ID, time.Now()); err != nil {
+		return apperror.InternalServerError(err)
+	}
+	return nil
+}
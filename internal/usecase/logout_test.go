@@ -0,0 +1,85 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestLogoutInteractor_Execute(t *testing.T) {
+	const plain = "plain-refresh-token"
+	activeToken := func() *model.RefreshToken {
+		return &model.RefreshToken{ID: 3, UserID: 1, TokenHash: model.HashRefreshToken(plain), ExpiresAt: time.Now().Add(time.Hour)}
+	}
+
+	t.Run("success_revokes_token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		refreshTokenRepo := repositorymock.NewMockRefreshTokenRepository(ctrl)
+		refreshTokenRepo.EXPECT().FindByHash(ctx, model.HashRefreshToken(plain)).Return(activeToken(), nil)
+		refreshTokenRepo.EXPECT().Revoke(ctx, uint(3), gomock.Any()).Return(true, nil)
+
+		interactor := usecase.NewLogoutUsecase(nil, repositorymock.NewMockUserRepository(ctrl), refreshTokenRepo)
+		err := interactor.Execute(ctx, usecase.LogoutInput{RefreshToken: plain})
+
+		assert.NoError(t, err)
+	})
+
+	// すべての端末からログアウトするときは、発行済みのアクセストークンも使えなくする
+	t.Run("success_all_revokes_every_session", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		refreshTokenRepo := repositorymock.NewMockRefreshTokenRepository(ctrl)
+		refreshTokenRepo.EXPECT().FindByHash(ctx, gomock.Any()).Return(activeToken(), nil)
+		refreshTokenRepo.EXPECT().RevokeAllByUserID(ctx, uint(1), gomock.Any()).Return(nil)
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().IncrementTokenVersion(ctx, uint(1)).Return(nil)
+
+		interactor := usecase.NewLogoutUsecase(newPassThroughUnitOfWork(ctrl), userRepo, refreshTokenRepo)
+		err := interactor.Execute(ctx, usecase.LogoutInput{RefreshToken: plain, All: true})
+
+		assert.NoError(t, err)
+	})
+
+	// 知らないトークンや失効済みのトークンでもエラーにしない。何度ログアウトしても同じ結果にするため
+	t.Run("success_unknown_or_revoked_token_is_noop", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		revoked := activeToken()
+		revoked.RevokedAt = lo.ToPtr(time.Now())
+
+		refreshTokenRepo := repositorymock.NewMockRefreshTokenRepository(ctrl)
+		refreshTokenRepo.EXPECT().FindByHash(ctx, gomock.Any()).Return(nil, gorm.ErrRecordNotFound)
+		refreshTokenRepo.EXPECT().FindByHash(ctx, gomock.Any()).Return(revoked, nil)
+
+		interactor := usecase.NewLogoutUsecase(nil, repositorymock.NewMockUserRepository(ctrl), refreshTokenRepo)
+
+		assert.NoError(t, interactor.Execute(ctx, usecase.LogoutInput{RefreshToken: "unknown"}))
+		assert.NoError(t, interactor.Execute(ctx, usecase.LogoutInput{RefreshToken: plain, All: true}))
+	})
+
+	t.Run("error_empty_token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		interactor := usecase.NewLogoutUsecase(nil, repositorymock.NewMockUserRepository(ctrl), repositorymock.NewMockRefreshTokenRepository(ctrl))
+		err := interactor.Execute(context.Background(), usecase.LogoutInput{})
+
+		assert.ErrorContains(t, err, "リフレッシュトークンを指定してください")
+	})
+}
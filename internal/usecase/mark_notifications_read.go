@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+)
+
+// MarkNotificationsReadInputPort は通知を既読にするユースケースの入力ポートです。
+type MarkNotificationsReadInputPort interface {
+	Execute(context.Context, MarkNotificationsReadInput) (*MarkNotificationsReadOutput, error)
+}
+
+type MarkNotificationsReadInput struct {
+	UserID uint
+	// NotificationIDs は既読にする通知の ID です。空のときは未読の通知をすべて既読にします。
+	NotificationIDs []uint
+}
+
+// MarkNotificationsReadOutput は既読にした後の未読件数です。バッジの表示をそのまま更新できるよう返します。
+type MarkNotificationsReadOutput struct {
+	UnreadCount int
+}
+
+type MarkNotificationsReadInteractor struct {
+	NotificationRepository repository.NotificationRepository
+}
+
+func NewMarkNotificationsReadUsecase(
+	notificationRepository repository.NotificationRepository,
+) MarkNotificationsReadInputPort {
+	return &MarkNotificationsReadInteractor{
+		NotificationRepository: notificationRepository,
+	}
+}
+
+func (i *MarkNotificationsReadInteractor) Execute(ctx context.Context, input MarkNotificationsReadInput) (*MarkNotificationsReadOutput, error) {
+	// 他のユーザー宛ての ID が混ざっていても repository が本人宛てに絞るため、ここでは確かめない
+	if err := i.NotificationRepository.MarkRead(ctx, input.UserID, input.NotificationIDs, time.Now()); err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	count, err := i.NotificationRepository.CountUnread(ctx, input.UserID)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	return &MarkNotificationsReadOutput{UnreadCount: count}, nil
+}
@@ -41,6 +41,18 @@ func (m *MockSpotFetcher) EXPECT() *MockSpotFetcherMockRecorder {
 	return m.recorder
 }
 
+// FetchOpeningHours mocks base method.
+func (m *MockSpotFetcher) FetchOpeningHours(ctx context.Context, c *usecase.SpotCandidate) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FetchOpeningHours", ctx, c)
+}
+
+// FetchOpeningHours indicates an expected call of FetchOpeningHours.
+func (mr *MockSpotFetcherMockRecorder) FetchOpeningHours(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchOpeningHours", reflect.TypeOf((*MockSpotFetcher)(nil).FetchOpeningHours), ctx, c)
+}
+
 // FetchSpots mocks base method.
 func (m *MockSpotFetcher) FetchSpots(ctx context.Context, prefCode, prefectureName string, genreID, count int) ([]usecase.SpotCandidate, error) {
 	m.ctrl.T.Helper()
@@ -55,3 +67,42 @@ func (mr *MockSpotFetcherMockRecorder) FetchSpots(ctx, prefCode, prefectureName,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchSpots", reflect.TypeOf((*MockSpotFetcher)(nil).FetchSpots), ctx, prefCode, prefectureName, genreID, count)
 }
+
+// MockBatchCreateDateSpotsInputPort is a mock of BatchCreateDateSpotsInputPort interface.
+type MockBatchCreateDateSpotsInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchCreateDateSpotsInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockBatchCreateDateSpotsInputPortMockRecorder is the mock recorder for MockBatchCreateDateSpotsInputPort.
+type MockBatchCreateDateSpotsInputPortMockRecorder struct {
+	mock *MockBatchCreateDateSpotsInputPort
+}
+
+// NewMockBatchCreateDateSpotsInputPort creates a new mock instance.
+func NewMockBatchCreateDateSpotsInputPort(ctrl *gomock.Controller) *MockBatchCreateDateSpotsInputPort {
+	mock := &MockBatchCreateDateSpotsInputPort{ctrl: ctrl}
+	mock.recorder = &MockBatchCreateDateSpotsInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchCreateDateSpotsInputPort) EXPECT() *MockBatchCreateDateSpotsInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockBatchCreateDateSpotsInputPort) Execute(arg0 context.Context, arg1 usecase.BatchCreateDateSpotsInput) (*usecase.BatchCreateDateSpotsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(*usecase.BatchCreateDateSpotsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockBatchCreateDateSpotsInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockBatchCreateDateSpotsInputPort)(nil).Execute), arg0, arg1)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/create_course_favorite.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/create_course_favorite.go -destination=internal/usecase/mock/create_course_favorite.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCreateCourseFavoriteInputPort is a mock of CreateCourseFavoriteInputPort interface.
+type MockCreateCourseFavoriteInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateCourseFavoriteInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockCreateCourseFavoriteInputPortMockRecorder is the mock recorder for MockCreateCourseFavoriteInputPort.
+type MockCreateCourseFavoriteInputPortMockRecorder struct {
+	mock *MockCreateCourseFavoriteInputPort
+}
+
+// NewMockCreateCourseFavoriteInputPort creates a new mock instance.
+func NewMockCreateCourseFavoriteInputPort(ctrl *gomock.Controller) *MockCreateCourseFavoriteInputPort {
+	mock := &MockCreateCourseFavoriteInputPort{ctrl: ctrl}
+	mock.recorder = &MockCreateCourseFavoriteInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateCourseFavoriteInputPort) EXPECT() *MockCreateCourseFavoriteInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCreateCourseFavoriteInputPort) Execute(arg0 context.Context, arg1 usecase.CreateCourseFavoriteInput) (*usecase.CreateCourseFavoriteOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(*usecase.CreateCourseFavoriteOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCreateCourseFavoriteInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateCourseFavoriteInputPort)(nil).Execute), arg0, arg1)
+}
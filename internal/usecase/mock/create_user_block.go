@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/create_user_block.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/create_user_block.go -destination=internal/usecase/mock/create_user_block.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCreateUserBlockInputPort is a mock of CreateUserBlockInputPort interface.
+type MockCreateUserBlockInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateUserBlockInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockCreateUserBlockInputPortMockRecorder is the mock recorder for MockCreateUserBlockInputPort.
+type MockCreateUserBlockInputPortMockRecorder struct {
+	mock *MockCreateUserBlockInputPort
+}
+
+// NewMockCreateUserBlockInputPort creates a new mock instance.
+func NewMockCreateUserBlockInputPort(ctrl *gomock.Controller) *MockCreateUserBlockInputPort {
+	mock := &MockCreateUserBlockInputPort{ctrl: ctrl}
+	mock.recorder = &MockCreateUserBlockInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateUserBlockInputPort) EXPECT() *MockCreateUserBlockInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCreateUserBlockInputPort) Execute(arg0 context.Context, arg1 usecase.CreateUserBlockInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCreateUserBlockInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateUserBlockInputPort)(nil).Execute), arg0, arg1)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/create_user_mute.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/create_user_mute.go -destination=internal/usecase/mock/create_user_mute.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCreateUserMuteInputPort is a mock of CreateUserMuteInputPort interface.
+type MockCreateUserMuteInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateUserMuteInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockCreateUserMuteInputPortMockRecorder is the mock recorder for MockCreateUserMuteInputPort.
+type MockCreateUserMuteInputPortMockRecorder struct {
+	mock *MockCreateUserMuteInputPort
+}
+
+// NewMockCreateUserMuteInputPort creates a new mock instance.
+func NewMockCreateUserMuteInputPort(ctrl *gomock.Controller) *MockCreateUserMuteInputPort {
+	mock := &MockCreateUserMuteInputPort{ctrl: ctrl}
+	mock.recorder = &MockCreateUserMuteInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateUserMuteInputPort) EXPECT() *MockCreateUserMuteInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCreateUserMuteInputPort) Execute(arg0 context.Context, arg1 usecase.CreateUserMuteInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCreateUserMuteInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateUserMuteInputPort)(nil).Execute), arg0, arg1)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/delete_course_favorite.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/delete_course_favorite.go -destination=internal/usecase/mock/delete_course_favorite.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeleteCourseFavoriteInputPort is a mock of DeleteCourseFavoriteInputPort interface.
+type MockDeleteCourseFavoriteInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteCourseFavoriteInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockDeleteCourseFavoriteInputPortMockRecorder is the mock recorder for MockDeleteCourseFavoriteInputPort.
+type MockDeleteCourseFavoriteInputPortMockRecorder struct {
+	mock *MockDeleteCourseFavoriteInputPort
+}
+
+// NewMockDeleteCourseFavoriteInputPort creates a new mock instance.
+func NewMockDeleteCourseFavoriteInputPort(ctrl *gomock.Controller) *MockDeleteCourseFavoriteInputPort {
+	mock := &MockDeleteCourseFavoriteInputPort{ctrl: ctrl}
+	mock.recorder = &MockDeleteCourseFavoriteInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteCourseFavoriteInputPort) EXPECT() *MockDeleteCourseFavoriteInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockDeleteCourseFavoriteInputPort) Execute(arg0 context.Context, arg1 usecase.DeleteCourseFavoriteInput) (*usecase.DeleteCourseFavoriteOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(*usecase.DeleteCourseFavoriteOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockDeleteCourseFavoriteInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeleteCourseFavoriteInputPort)(nil).Execute), arg0, arg1)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/delete_user_block.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/delete_user_block.go -destination=internal/usecase/mock/delete_user_block.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeleteUserBlockInputPort is a mock of DeleteUserBlockInputPort interface.
+type MockDeleteUserBlockInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteUserBlockInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockDeleteUserBlockInputPortMockRecorder is the mock recorder for MockDeleteUserBlockInputPort.
+type MockDeleteUserBlockInputPortMockRecorder struct {
+	mock *MockDeleteUserBlockInputPort
+}
+
+// NewMockDeleteUserBlockInputPort creates a new mock instance.
+func NewMockDeleteUserBlockInputPort(ctrl *gomock.Controller) *MockDeleteUserBlockInputPort {
+	mock := &MockDeleteUserBlockInputPort{ctrl: ctrl}
+	mock.recorder = &MockDeleteUserBlockInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteUserBlockInputPort) EXPECT() *MockDeleteUserBlockInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockDeleteUserBlockInputPort) Execute(arg0 context.Context, arg1 usecase.DeleteUserBlockInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockDeleteUserBlockInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeleteUserBlockInputPort)(nil).Execute), arg0, arg1)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/delete_user_mute.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/delete_user_mute.go -destination=internal/usecase/mock/delete_user_mute.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeleteUserMuteInputPort is a mock of DeleteUserMuteInputPort interface.
+type MockDeleteUserMuteInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteUserMuteInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockDeleteUserMuteInputPortMockRecorder is the mock recorder for MockDeleteUserMuteInputPort.
+type MockDeleteUserMuteInputPortMockRecorder struct {
+	mock *MockDeleteUserMuteInputPort
+}
+
+// NewMockDeleteUserMuteInputPort creates a new mock instance.
+func NewMockDeleteUserMuteInputPort(ctrl *gomock.Controller) *MockDeleteUserMuteInputPort {
+	mock := &MockDeleteUserMuteInputPort{ctrl: ctrl}
+	mock.recorder = &MockDeleteUserMuteInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteUserMuteInputPort) EXPECT() *MockDeleteUserMuteInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockDeleteUserMuteInputPort) Execute(arg0 context.Context, arg1 usecase.DeleteUserMuteInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockDeleteUserMuteInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeleteUserMuteInputPort)(nil).Execute), arg0, arg1)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/get_feed.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/get_feed.go -destination=internal/usecase/mock/get_feed.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetFeedInputPort is a mock of GetFeedInputPort interface.
+type MockGetFeedInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetFeedInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockGetFeedInputPortMockRecorder is the mock recorder for MockGetFeedInputPort.
+type MockGetFeedInputPortMockRecorder struct {
+	mock *MockGetFeedInputPort
+}
+
+// NewMockGetFeedInputPort creates a new mock instance.
+func NewMockGetFeedInputPort(ctrl *gomock.Controller) *MockGetFeedInputPort {
+	mock := &MockGetFeedInputPort{ctrl: ctrl}
+	mock.recorder = &MockGetFeedInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetFeedInputPort) EXPECT() *MockGetFeedInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetFeedInputPort) Execute(arg0 context.Context, arg1 usecase.GetFeedInput) (*usecase.GetFeedOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(*usecase.GetFeedOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetFeedInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetFeedInputPort)(nil).Execute), arg0, arg1)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/get_login_histories.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/get_login_histories.go -destination=internal/usecase/mock/get_login_histories.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetLoginHistoriesInputPort is a mock of GetLoginHistoriesInputPort interface.
+type MockGetLoginHistoriesInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetLoginHistoriesInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockGetLoginHistoriesInputPortMockRecorder is the mock recorder for MockGetLoginHistoriesInputPort.
+type MockGetLoginHistoriesInputPortMockRecorder struct {
+	mock *MockGetLoginHistoriesInputPort
+}
+
+// NewMockGetLoginHistoriesInputPort creates a new mock instance.
+func NewMockGetLoginHistoriesInputPort(ctrl *gomock.Controller) *MockGetLoginHistoriesInputPort {
+	mock := &MockGetLoginHistoriesInputPort{ctrl: ctrl}
+	mock.recorder = &MockGetLoginHistoriesInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetLoginHistoriesInputPort) EXPECT() *MockGetLoginHistoriesInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetLoginHistoriesInputPort) Execute(arg0 context.Context, arg1 usecase.GetLoginHistoriesInput) (*usecase.GetLoginHistoriesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(*usecase.GetLoginHistoriesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetLoginHistoriesInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetLoginHistoriesInputPort)(nil).Execute), arg0, arg1)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/get_moderation_reviews.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/get_moderation_reviews.go -destination=internal/usecase/mock/get_moderation_reviews.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetModerationReviewsInputPort is a mock of GetModerationReviewsInputPort interface.
+type MockGetModerationReviewsInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetModerationReviewsInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockGetModerationReviewsInputPortMockRecorder is the mock recorder for MockGetModerationReviewsInputPort.
+type MockGetModerationReviewsInputPortMockRecorder struct {
+	mock *MockGetModerationReviewsInputPort
+}
+
+// NewMockGetModerationReviewsInputPort creates a new mock instance.
+func NewMockGetModerationReviewsInputPort(ctrl *gomock.Controller) *MockGetModerationReviewsInputPort {
+	mock := &MockGetModerationReviewsInputPort{ctrl: ctrl}
+	mock.recorder = &MockGetModerationReviewsInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetModerationReviewsInputPort) EXPECT() *MockGetModerationReviewsInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetModerationReviewsInputPort) Execute(arg0 context.Context, arg1 usecase.GetModerationReviewsInput) (*usecase.GetModerationReviewsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(*usecase.GetModerationReviewsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetModerationReviewsInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetModerationReviewsInputPort)(nil).Execute), arg0, arg1)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/get_notifications.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/get_notifications.go -destination=internal/usecase/mock/get_notifications.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetNotificationsInputPort is a mock of GetNotificationsInputPort interface.
+type MockGetNotificationsInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetNotificationsInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockGetNotificationsInputPortMockRecorder is the mock recorder for MockGetNotificationsInputPort.
+type MockGetNotificationsInputPortMockRecorder struct {
+	mock *MockGetNotificationsInputPort
+}
+
+// NewMockGetNotificationsInputPort creates a new mock instance.
+func NewMockGetNotificationsInputPort(ctrl *gomock.Controller) *MockGetNotificationsInputPort {
+	mock := &MockGetNotificationsInputPort{ctrl: ctrl}
+	mock.recorder = &MockGetNotificationsInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetNotificationsInputPort) EXPECT() *MockGetNotificationsInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetNotificationsInputPort) Execute(arg0 context.Context, arg1 usecase.GetNotificationsInput) (*usecase.GetNotificationsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(*usecase.GetNotificationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetNotificationsInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetNotificationsInputPort)(nil).Execute), arg0, arg1)
+}
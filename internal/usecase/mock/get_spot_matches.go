@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/get_spot_matches.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/get_spot_matches.go -destination=internal/usecase/mock/get_spot_matches.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetSpotMatchesInputPort is a mock of GetSpotMatchesInputPort interface.
+type MockGetSpotMatchesInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetSpotMatchesInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockGetSpotMatchesInputPortMockRecorder is the mock recorder for MockGetSpotMatchesInputPort.
+type MockGetSpotMatchesInputPortMockRecorder struct {
+	mock *MockGetSpotMatchesInputPort
+}
+
+// NewMockGetSpotMatchesInputPort creates a new mock instance.
+func NewMockGetSpotMatchesInputPort(ctrl *gomock.Controller) *MockGetSpotMatchesInputPort {
+	mock := &MockGetSpotMatchesInputPort{ctrl: ctrl}
+	mock.recorder = &MockGetSpotMatchesInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetSpotMatchesInputPort) EXPECT() *MockGetSpotMatchesInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetSpotMatchesInputPort) Execute(arg0 context.Context, arg1 usecase.GetSpotMatchesInput) (*usecase.GetSpotMatchesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(*usecase.GetSpotMatchesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetSpotMatchesInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetSpotMatchesInputPort)(nil).Execute), arg0, arg1)
+}
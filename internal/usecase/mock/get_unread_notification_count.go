@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/get_unread_notification_count.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/get_unread_notification_count.go -destination=internal/usecase/mock/get_unread_notification_count.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetUnreadNotificationCountInputPort is a mock of GetUnreadNotificationCountInputPort interface.
+type MockGetUnreadNotificationCountInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUnreadNotificationCountInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockGetUnreadNotificationCountInputPortMockRecorder is the mock recorder for MockGetUnreadNotificationCountInputPort.
+type MockGetUnreadNotificationCountInputPortMockRecorder struct {
+	mock *MockGetUnreadNotificationCountInputPort
+}
+
+// NewMockGetUnreadNotificationCountInputPort creates a new mock instance.
+func NewMockGetUnreadNotificationCountInputPort(ctrl *gomock.Controller) *MockGetUnreadNotificationCountInputPort {
+	mock := &MockGetUnreadNotificationCountInputPort{ctrl: ctrl}
+	mock.recorder = &MockGetUnreadNotificationCountInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUnreadNotificationCountInputPort) EXPECT() *MockGetUnreadNotificationCountInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetUnreadNotificationCountInputPort) Execute(arg0 context.Context, arg1 usecase.GetUnreadNotificationCountInput) (*usecase.GetUnreadNotificationCountOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(*usecase.GetUnreadNotificationCountOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetUnreadNotificationCountInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetUnreadNotificationCountInputPort)(nil).Execute), arg0, arg1)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/get_user_favorite_courses.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/get_user_favorite_courses.go -destination=internal/usecase/mock/get_user_favorite_courses.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetUserFavoriteCoursesInputPort is a mock of GetUserFavoriteCoursesInputPort interface.
+type MockGetUserFavoriteCoursesInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUserFavoriteCoursesInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockGetUserFavoriteCoursesInputPortMockRecorder is the mock recorder for MockGetUserFavoriteCoursesInputPort.
+type MockGetUserFavoriteCoursesInputPortMockRecorder struct {
+	mock *MockGetUserFavoriteCoursesInputPort
+}
+
+// NewMockGetUserFavoriteCoursesInputPort creates a new mock instance.
+func NewMockGetUserFavoriteCoursesInputPort(ctrl *gomock.Controller) *MockGetUserFavoriteCoursesInputPort {
+	mock := &MockGetUserFavoriteCoursesInputPort{ctrl: ctrl}
+	mock.recorder = &MockGetUserFavoriteCoursesInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUserFavoriteCoursesInputPort) EXPECT() *MockGetUserFavoriteCoursesInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetUserFavoriteCoursesInputPort) Execute(arg0 context.Context, arg1 usecase.GetUserFavoriteCoursesInput) (*usecase.GetUserFavoriteCoursesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(*usecase.GetUserFavoriteCoursesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetUserFavoriteCoursesInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetUserFavoriteCoursesInputPort)(nil).Execute), arg0, arg1)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/logout.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/logout.go -destination=internal/usecase/mock/logout.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLogoutInputPort is a mock of LogoutInputPort interface.
+type MockLogoutInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogoutInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockLogoutInputPortMockRecorder is the mock recorder for MockLogoutInputPort.
+type MockLogoutInputPortMockRecorder struct {
+	mock *MockLogoutInputPort
+}
+
+// NewMockLogoutInputPort creates a new mock instance.
+func NewMockLogoutInputPort(ctrl *gomock.Controller) *MockLogoutInputPort {
+	mock := &MockLogoutInputPort{ctrl: ctrl}
+	mock.recorder = &MockLogoutInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogoutInputPort) EXPECT() *MockLogoutInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockLogoutInputPort) Execute(arg0 context.Context, arg1 usecase.LogoutInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockLogoutInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockLogoutInputPort)(nil).Execute), arg0, arg1)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/mark_notifications_read.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/mark_notifications_read.go -destination=internal/usecase/mock/mark_notifications_read.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMarkNotificationsReadInputPort is a mock of MarkNotificationsReadInputPort interface.
+type MockMarkNotificationsReadInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarkNotificationsReadInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockMarkNotificationsReadInputPortMockRecorder is the mock recorder for MockMarkNotificationsReadInputPort.
+type MockMarkNotificationsReadInputPortMockRecorder struct {
+	mock *MockMarkNotificationsReadInputPort
+}
+
+// NewMockMarkNotificationsReadInputPort creates a new mock instance.
+func NewMockMarkNotificationsReadInputPort(ctrl *gomock.Controller) *MockMarkNotificationsReadInputPort {
+	mock := &MockMarkNotificationsReadInputPort{ctrl: ctrl}
+	mock.recorder = &MockMarkNotificationsReadInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarkNotificationsReadInputPort) EXPECT() *MockMarkNotificationsReadInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockMarkNotificationsReadInputPort) Execute(arg0 context.Context, arg1 usecase.MarkNotificationsReadInput) (*usecase.MarkNotificationsReadOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(*usecase.MarkNotificationsReadOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockMarkNotificationsReadInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockMarkNotificationsReadInputPort)(nil).Execute), arg0, arg1)
+}
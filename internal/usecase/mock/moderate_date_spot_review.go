@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/moderate_date_spot_review.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/moderate_date_spot_review.go -destination=internal/usecase/mock/moderate_date_spot_review.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockModerateDateSpotReviewInputPort is a mock of ModerateDateSpotReviewInputPort interface.
+type MockModerateDateSpotReviewInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockModerateDateSpotReviewInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockModerateDateSpotReviewInputPortMockRecorder is the mock recorder for MockModerateDateSpotReviewInputPort.
+type MockModerateDateSpotReviewInputPortMockRecorder struct {
+	mock *MockModerateDateSpotReviewInputPort
+}
+
+// NewMockModerateDateSpotReviewInputPort creates a new mock instance.
+func NewMockModerateDateSpotReviewInputPort(ctrl *gomock.Controller) *MockModerateDateSpotReviewInputPort {
+	mock := &MockModerateDateSpotReviewInputPort{ctrl: ctrl}
+	mock.recorder = &MockModerateDateSpotReviewInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModerateDateSpotReviewInputPort) EXPECT() *MockModerateDateSpotReviewInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockModerateDateSpotReviewInputPort) Execute(arg0 context.Context, arg1 usecase.ModerateDateSpotReviewInput) (*usecase.ModerateDateSpotReviewOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(*usecase.ModerateDateSpotReviewOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockModerateDateSpotReviewInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockModerateDateSpotReviewInputPort)(nil).Execute), arg0, arg1)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/refresh_date_spots.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/refresh_date_spots.go -destination=internal/usecase/mock/refresh_date_spots.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSpotRefresher is a mock of SpotRefresher interface.
+type MockSpotRefresher struct {
+	ctrl     *gomock.Controller
+	recorder *MockSpotRefresherMockRecorder
+	isgomock struct{}
+}
+
+// MockSpotRefresherMockRecorder is the mock recorder for MockSpotRefresher.
+type MockSpotRefresherMockRecorder struct {
+	mock *MockSpotRefresher
+}
+
+// NewMockSpotRefresher creates a new mock instance.
+func NewMockSpotRefresher(ctrl *gomock.Controller) *MockSpotRefresher {
+	mock := &MockSpotRefresher{ctrl: ctrl}
+	mock.recorder = &MockSpotRefresherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSpotRefresher) EXPECT() *MockSpotRefresherMockRecorder {
+	return m.recorder
+}
+
+// Lookup mocks base method.
+func (m *MockSpotRefresher) Lookup(ctx context.Context, externalIDs []string) ([]usecase.SpotCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lookup", ctx, externalIDs)
+	ret0, _ := ret[0].([]usecase.SpotCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Lookup indicates an expected call of Lookup.
+func (mr *MockSpotRefresherMockRecorder) Lookup(ctx, externalIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lookup", reflect.TypeOf((*MockSpotRefresher)(nil).Lookup), ctx, externalIDs)
+}
+
+// Source mocks base method.
+func (m *MockSpotRefresher) Source() model.DateSpotSource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Source")
+	ret0, _ := ret[0].(model.DateSpotSource)
+	return ret0
+}
+
+// Source indicates an expected call of Source.
+func (mr *MockSpotRefresherMockRecorder) Source() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Source", reflect.TypeOf((*MockSpotRefresher)(nil).Source))
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/refresh_token.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/refresh_token.go -destination=internal/usecase/mock/refresh_token.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRefreshTokenInputPort is a mock of RefreshTokenInputPort interface.
+type MockRefreshTokenInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefreshTokenInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockRefreshTokenInputPortMockRecorder is the mock recorder for MockRefreshTokenInputPort.
+type MockRefreshTokenInputPortMockRecorder struct {
+	mock *MockRefreshTokenInputPort
+}
+
+// NewMockRefreshTokenInputPort creates a new mock instance.
+func NewMockRefreshTokenInputPort(ctrl *gomock.Controller) *MockRefreshTokenInputPort {
+	mock := &MockRefreshTokenInputPort{ctrl: ctrl}
+	mock.recorder = &MockRefreshTokenInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefreshTokenInputPort) EXPECT() *MockRefreshTokenInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRefreshTokenInputPort) Execute(arg0 context.Context, arg1 usecase.RefreshTokenInput) (*usecase.RefreshTokenOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(*usecase.RefreshTokenOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRefreshTokenInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRefreshTokenInputPort)(nil).Execute), arg0, arg1)
+}
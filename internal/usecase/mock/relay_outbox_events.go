@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/relay_outbox_events.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/relay_outbox_events.go -destination=internal/usecase/mock/relay_outbox_events.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRelayOutboxEventsInputPort is a mock of RelayOutboxEventsInputPort interface.
+type MockRelayOutboxEventsInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelayOutboxEventsInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockRelayOutboxEventsInputPortMockRecorder is the mock recorder for MockRelayOutboxEventsInputPort.
+type MockRelayOutboxEventsInputPortMockRecorder struct {
+	mock *MockRelayOutboxEventsInputPort
+}
+
+// NewMockRelayOutboxEventsInputPort creates a new mock instance.
+func NewMockRelayOutboxEventsInputPort(ctrl *gomock.Controller) *MockRelayOutboxEventsInputPort {
+	mock := &MockRelayOutboxEventsInputPort{ctrl: ctrl}
+	mock.recorder = &MockRelayOutboxEventsInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelayOutboxEventsInputPort) EXPECT() *MockRelayOutboxEventsInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRelayOutboxEventsInputPort) Execute(arg0 context.Context, arg1 usecase.RelayOutboxEventsInput) (*usecase.RelayOutboxEventsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(*usecase.RelayOutboxEventsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRelayOutboxEventsInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRelayOutboxEventsInputPort)(nil).Execute), arg0, arg1)
+}
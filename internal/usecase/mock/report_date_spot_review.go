@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/report_date_spot_review.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/report_date_spot_review.go -destination=internal/usecase/mock/report_date_spot_review.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReportDateSpotReviewInputPort is a mock of ReportDateSpotReviewInputPort interface.
+type MockReportDateSpotReviewInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportDateSpotReviewInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockReportDateSpotReviewInputPortMockRecorder is the mock recorder for MockReportDateSpotReviewInputPort.
+type MockReportDateSpotReviewInputPortMockRecorder struct {
+	mock *MockReportDateSpotReviewInputPort
+}
+
+// NewMockReportDateSpotReviewInputPort creates a new mock instance.
+func NewMockReportDateSpotReviewInputPort(ctrl *gomock.Controller) *MockReportDateSpotReviewInputPort {
+	mock := &MockReportDateSpotReviewInputPort{ctrl: ctrl}
+	mock.recorder = &MockReportDateSpotReviewInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportDateSpotReviewInputPort) EXPECT() *MockReportDateSpotReviewInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockReportDateSpotReviewInputPort) Execute(arg0 context.Context, arg1 usecase.ReportDateSpotReviewInput) (*usecase.ReportDateSpotReviewOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(*usecase.ReportDateSpotReviewOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockReportDateSpotReviewInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockReportDateSpotReviewInputPort)(nil).Execute), arg0, arg1)
+}
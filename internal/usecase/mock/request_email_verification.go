@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/request_email_verification.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/request_email_verification.go -destination=internal/usecase/mock/request_email_verification.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRequestEmailVerificationInputPort is a mock of RequestEmailVerificationInputPort interface.
+type MockRequestEmailVerificationInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequestEmailVerificationInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockRequestEmailVerificationInputPortMockRecorder is the mock recorder for MockRequestEmailVerificationInputPort.
+type MockRequestEmailVerificationInputPortMockRecorder struct {
+	mock *MockRequestEmailVerificationInputPort
+}
+
+// NewMockRequestEmailVerificationInputPort creates a new mock instance.
+func NewMockRequestEmailVerificationInputPort(ctrl *gomock.Controller) *MockRequestEmailVerificationInputPort {
+	mock := &MockRequestEmailVerificationInputPort{ctrl: ctrl}
+	mock.recorder = &MockRequestEmailVerificationInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequestEmailVerificationInputPort) EXPECT() *MockRequestEmailVerificationInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRequestEmailVerificationInputPort) Execute(arg0 context.Context, arg1 usecase.RequestEmailVerificationInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRequestEmailVerificationInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRequestEmailVerificationInputPort)(nil).Execute), arg0, arg1)
+}
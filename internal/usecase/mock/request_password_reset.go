@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/request_password_reset.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/request_password_reset.go -destination=internal/usecase/mock/request_password_reset.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRequestPasswordResetInputPort is a mock of RequestPasswordResetInputPort interface.
+type MockRequestPasswordResetInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequestPasswordResetInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockRequestPasswordResetInputPortMockRecorder is the mock recorder for MockRequestPasswordResetInputPort.
+type MockRequestPasswordResetInputPortMockRecorder struct {
+	mock *MockRequestPasswordResetInputPort
+}
+
+// NewMockRequestPasswordResetInputPort creates a new mock instance.
+func NewMockRequestPasswordResetInputPort(ctrl *gomock.Controller) *MockRequestPasswordResetInputPort {
+	mock := &MockRequestPasswordResetInputPort{ctrl: ctrl}
+	mock.recorder = &MockRequestPasswordResetInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequestPasswordResetInputPort) EXPECT() *MockRequestPasswordResetInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRequestPasswordResetInputPort) Execute(arg0 context.Context, arg1 usecase.RequestPasswordResetInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRequestPasswordResetInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRequestPasswordResetInputPort)(nil).Execute), arg0, arg1)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/reset_password.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/reset_password.go -destination=internal/usecase/mock/reset_password.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockResetPasswordInputPort is a mock of ResetPasswordInputPort interface.
+type MockResetPasswordInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockResetPasswordInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockResetPasswordInputPortMockRecorder is the mock recorder for MockResetPasswordInputPort.
+type MockResetPasswordInputPortMockRecorder struct {
+	mock *MockResetPasswordInputPort
+}
+
+// NewMockResetPasswordInputPort creates a new mock instance.
+func NewMockResetPasswordInputPort(ctrl *gomock.Controller) *MockResetPasswordInputPort {
+	mock := &MockResetPasswordInputPort{ctrl: ctrl}
+	mock.recorder = &MockResetPasswordInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResetPasswordInputPort) EXPECT() *MockResetPasswordInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockResetPasswordInputPort) Execute(arg0 context.Context, arg1 usecase.ResetPasswordInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockResetPasswordInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockResetPasswordInputPort)(nil).Execute), arg0, arg1)
+}
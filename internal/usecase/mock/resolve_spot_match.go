@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/resolve_spot_match.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/resolve_spot_match.go -destination=internal/usecase/mock/resolve_spot_match.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockResolveSpotMatchInputPort is a mock of ResolveSpotMatchInputPort interface.
+type MockResolveSpotMatchInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockResolveSpotMatchInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockResolveSpotMatchInputPortMockRecorder is the mock recorder for MockResolveSpotMatchInputPort.
+type MockResolveSpotMatchInputPortMockRecorder struct {
+	mock *MockResolveSpotMatchInputPort
+}
+
+// NewMockResolveSpotMatchInputPort creates a new mock instance.
+func NewMockResolveSpotMatchInputPort(ctrl *gomock.Controller) *MockResolveSpotMatchInputPort {
+	mock := &MockResolveSpotMatchInputPort{ctrl: ctrl}
+	mock.recorder = &MockResolveSpotMatchInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResolveSpotMatchInputPort) EXPECT() *MockResolveSpotMatchInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockResolveSpotMatchInputPort) Execute(arg0 context.Context, arg1 usecase.ResolveSpotMatchInput) (*usecase.ResolveSpotMatchOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(*usecase.ResolveSpotMatchOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockResolveSpotMatchInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockResolveSpotMatchInputPort)(nil).Execute), arg0, arg1)
+}
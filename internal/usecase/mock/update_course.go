@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/update_course.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/update_course.go -destination=internal/usecase/mock/update_course.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUpdateCourseInputPort is a mock of UpdateCourseInputPort interface.
+type MockUpdateCourseInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateCourseInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockUpdateCourseInputPortMockRecorder is the mock recorder for MockUpdateCourseInputPort.
+type MockUpdateCourseInputPortMockRecorder struct {
+	mock *MockUpdateCourseInputPort
+}
+
+// NewMockUpdateCourseInputPort creates a new mock instance.
+func NewMockUpdateCourseInputPort(ctrl *gomock.Controller) *MockUpdateCourseInputPort {
+	mock := &MockUpdateCourseInputPort{ctrl: ctrl}
+	mock.recorder = &MockUpdateCourseInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateCourseInputPort) EXPECT() *MockUpdateCourseInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockUpdateCourseInputPort) Execute(arg0 context.Context, arg1 usecase.UpdateCourseInput) (*usecase.UpdateCourseOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(*usecase.UpdateCourseOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockUpdateCourseInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUpdateCourseInputPort)(nil).Execute), arg0, arg1)
+}
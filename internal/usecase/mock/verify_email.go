@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/verify_email.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/usecase/verify_email.go -destination=internal/usecase/mock/verify_email.go -package=usecasemock
+//
+
+// Package usecasemock is a generated GoMock package.
+package usecasemock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/daisuke-harada/date-courses-go/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockVerifyEmailInputPort is a mock of VerifyEmailInputPort interface.
+type MockVerifyEmailInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyEmailInputPortMockRecorder
+	isgomock struct{}
+}
+
+// MockVerifyEmailInputPortMockRecorder is the mock recorder for MockVerifyEmailInputPort.
+type MockVerifyEmailInputPortMockRecorder struct {
+	mock *MockVerifyEmailInputPort
+}
+
+// NewMockVerifyEmailInputPort creates a new mock instance.
+func NewMockVerifyEmailInputPort(ctrl *gomock.Controller) *MockVerifyEmailInputPort {
+	mock := &MockVerifyEmailInputPort{ctrl: ctrl}
+	mock.recorder = &MockVerifyEmailInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyEmailInputPort) EXPECT() *MockVerifyEmailInputPortMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockVerifyEmailInputPort) Execute(arg0 context.Context, arg1 usecase.VerifyEmailInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockVerifyEmailInputPortMockRecorder) Execute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockVerifyEmailInputPort)(nil).Execute), arg0, arg1)
+}
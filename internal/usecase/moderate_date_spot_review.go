@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+)
+
+type ModerateDateSpotReviewInputPort interface {
+	Execute(context.Context, ModerateDateSpotReviewInput) (*ModerateDateSpotReviewOutput, error)
+}
+
+// ModerateDateSpotReviewInput は管理者がレビューを承認・非公開にする指定です。
+// 管理者かどうかはハンドラーで確かめます。
+type ModerateDateSpotReviewInput struct {
+	ReviewID uint
+	// Status は approved か hidden です。
+	Status model.ReviewStatus
+}
+
+func (i *ModerateDateSpotReviewInput) Validate() error {
+	if i.Status != model.ReviewStatusApproved && i.Status != model.ReviewStatusHidden {
+		return apperror.UnprocessableEntity("status は approved, hidden のいずれかを指定してください")
+	}
+	return nil
+}
+
+type ModerateDateSpotReviewOutput struct {
+	Review *model.DateSpotReview
+}
+
+type ModerateDateSpotReviewInteractor struct {
+	UnitOfWork               repository.UnitOfWork
+	DateSpotReviewRepository repository.DateSpotReviewRepository
+}
+
+func NewModerateDateSpotReviewUsecase(
+	unitOfWork repository.UnitOfWork,
+	dateSpotReviewRepository repository.DateSpotReviewRepository,
+) ModerateDateSpotReviewInputPort {
+	return &ModerateDateSpotReviewInteractor{
+		UnitOfWork:               unitOfWork,
+		DateSpotReviewRepository: dateSpotReviewRepository,
+	}
+}
+
+func (i *ModerateDateSpotReviewInteractor) Execute(ctx context.Context, input ModerateDateSpotReviewInput) (*ModerateDateSpotReviewOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	var review *model.DateSpotReview
+	err := i.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		var err error
+		review, err = i.DateSpotReviewRepository.FindByID(ctx, input.ReviewID)
+		if err != nil {
+			return apperror.NotFound()
+		}
+		if err := i.DateSpotReviewRepository.UpdateStatus(ctx, input.ReviewID, input.Status); err != nil {
+			return apperror.InternalServerError(err)
+		}
+		// 判断した時点までの通報は対応済みにし、確認待ちの一覧から外す
+		if err := i.DateSpotReviewRepository.ResolveReports(ctx, input.ReviewID, time.Now()); err != nil {
+			return apperror.InternalServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	review.Status = input.Status
+	return &ModerateDateSpotReviewOutput{Review: review}, nil
+}
@@ -0,0 +1,65 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repomock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestModerateDateSpotReviewInteractor_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	// 判断したら、それまでの通報は対応済みにして確認待ちから外す
+	t.Run("success_hides_and_resolves_reports", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reviewRepo := repomock.NewMockDateSpotReviewRepository(ctrl)
+		reviewRepo.EXPECT().FindByID(ctx, uint(5)).Return(&model.DateSpotReview{ID: 5, Status: model.ReviewStatusPending}, nil)
+		reviewRepo.EXPECT().UpdateStatus(ctx, uint(5), model.ReviewStatusHidden).Return(nil)
+		reviewRepo.EXPECT().ResolveReports(ctx, uint(5), gomock.Any()).Return(nil)
+
+		interactor := usecase.NewModerateDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo)
+		output, err := interactor.Execute(ctx, usecase.ModerateDateSpotReviewInput{ReviewID: 5, Status: model.ReviewStatusHidden})
+
+		require.NoError(t, err)
+		assert.Equal(t, model.ReviewStatusHidden, output.Review.Status)
+	})
+
+	t.Run("error_status_not_allowed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		interactor := usecase.NewModerateDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), repomock.NewMockDateSpotReviewRepository(ctrl))
+		output, err := interactor.Execute(ctx, usecase.ModerateDateSpotReviewInput{ReviewID: 5, Status: model.ReviewStatusPending})
+
+		assert.Nil(t, output)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+
+	t.Run("error_not_found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reviewRepo := repomock.NewMockDateSpotReviewRepository(ctrl)
+		reviewRepo.EXPECT().FindByID(ctx, uint(404)).Return(nil, errors.New("record not found"))
+
+		interactor := usecase.NewModerateDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo)
+		_, err := interactor.Execute(ctx, usecase.ModerateDateSpotReviewInput{ReviewID: 404, Status: model.ReviewStatusApproved})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, statusCode)
+	})
+}
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// NotificationEventHandler はドメインイベントを購読し、知らせるべき相手にアプリ内通知を作ります。
+// 保存した後は Channels の各手段（メール・Web Push など）にも届けます。
+type NotificationEventHandler struct {
+	NotificationRepository repository.NotificationRepository
+	CourseRepository       repository.CourseRepository
+	Channels               []repository.NotificationChannel
+}
+
+// NotificationEventNames は NotificationEventHandler が購読するイベントです。
+var NotificationEventNames = []string{
+	event.NameUserFollowed,
+	event.NameCourseFavorited,
+	event.NameDateSpotReviewed,
+}
+
+func NewNotificationEventHandler(
+	notificationRepository repository.NotificationRepository,
+	courseRepository repository.CourseRepository,
+	channels []repository.NotificationChannel,
+) *NotificationEventHandler {
+	return &NotificationEventHandler{
+		NotificationRepository: notificationRepository,
+		CourseRepository:       courseRepository,
+		Channels:               channels,
+	}
+}
+
+func (h *NotificationEventHandler) Handle(ctx context.Context, e event.Event) error {
+	notifications, err := h.buildNotifications(ctx, e)
+	if err != nil {
+		return err
+	}
+	if len(notifications) == 0 {
+		return nil
+	}
+	if id, ok := event.OutboxIDFromContext(ctx); ok {
+		for _, n := range notifications {
+			n.OutboxEventID = lo.ToPtr(id)
+		}
+	}
+	if err := h.NotificationRepository.CreateBatch(ctx, notifications); err != nil {
+		// 配るまでの間に操作した人か受け取る人が退会していると外部キーで保存できない。
+		// 配り直しても通らないため、通知を作らずにイベントを配り終えたことにする
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			slog.WarnContext(ctx, "NotificationEventHandler.Handle skipped: user no longer exists", "event", e.Name(), "err", err)
+			return nil
+		}
+		return err
+	}
+
+	// アプリ内通知は保存できているため、他の手段で届けられなくてもエラーにしない
+	for _, channel := range h.Channels {
+		if err := channel.Deliver(ctx, notifications); err != nil {
+			slog.ErrorContext(ctx, "NotificationEventHandler.Handle delivery failed", "event", e.Name(), "err", err)
+		}
+	}
+	return nil
+}
+
+// buildNotifications はイベントごとに通知する相手を決めます。自分の操作で自分に通知は作りません。
+func (h *NotificationEventHandler) buildNotifications(ctx context.Context, e event.Event) ([]*model.Notification, error) {
+	switch e := e.(type) {
+	case event.UserFollowed:
+		return []*model.Notification{{
+			UserID:  e.FollowedUserID,
+			ActorID: e.FollowerID,
+			Type:    model.NotificationTypeFollowed,
+		}}, nil
+
+	case event.CourseFavorited:
+		if e.CourseOwnerID == e.UserID {
+			return nil, nil
+		}
+		return []*model.Notification{{
+			UserID:   e.CourseOwnerID,
+			ActorID:  e.UserID,
+			Type:     model.NotificationTypeCourseFavorited,
+			CourseID: lo.ToPtr(e.CourseID),
+		}}, nil
+
+	case event.DateSpotReviewed:
+		// 管理者の確認待ちのレビューはまだ誰にも見えないため知らせない
+		if !e.Status.IsVisible() {
+			return nil, nil
+		}
+		ownerIDs, err := h.CourseRepository.FindOwnerIDsByDateSpotID(ctx, e.DateSpotID)
+		if err != nil {
+			return nil, err
+		}
+		return lo.FilterMap(ownerIDs, func(ownerID uint, _ int) (*model.Notification, bool) {
+			return &model.Notification{
+				UserID:           ownerID,
+				ActorID:          e.ReviewerID,
+				Type:             model.NotificationTypeCourseSpotReviewed,
+				DateSpotID:       lo.ToPtr(e.DateSpotID),
+				DateSpotReviewID: lo.ToPtr(e.ReviewID),
+			}, ownerID != e.ReviewerID
+		}), nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,157 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestNotificationEventHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("user_followed_notifies_followed_user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		notificationRepo := repositorymock.NewMockNotificationRepository(ctrl)
+		courseRepo := repositorymock.NewMockCourseRepository(ctrl)
+		notificationRepo.EXPECT().
+			CreateBatch(ctx, []*model.Notification{{UserID: 2, ActorID: 1, Type: model.NotificationTypeFollowed}}).
+			Return(nil)
+
+		h := usecase.NewNotificationEventHandler(notificationRepo, courseRepo, nil)
+		err := h.Handle(ctx, event.UserFollowed{FollowerID: 1, FollowedUserID: 2})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("course_favorited_notifies_course_owner", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		notificationRepo := repositorymock.NewMockNotificationRepository(ctrl)
+		courseRepo := repositorymock.NewMockCourseRepository(ctrl)
+		notificationRepo.EXPECT().
+			CreateBatch(ctx, []*model.Notification{{UserID: 2, ActorID: 1, Type: model.NotificationTypeCourseFavorited, CourseID: lo.ToPtr(uint(10))}}).
+			Return(nil)
+
+		h := usecase.NewNotificationEventHandler(notificationRepo, courseRepo, nil)
+		err := h.Handle(ctx, event.CourseFavorited{CourseID: 10, CourseOwnerID: 2, UserID: 1})
+
+		require.NoError(t, err)
+	})
+
+	// 自分のコースのスポットを自分でレビューしたときは、本人には知らせない
+	t.Run("date_spot_reviewed_notifies_other_course_owners", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		notificationRepo := repositorymock.NewMockNotificationRepository(ctrl)
+		courseRepo := repositorymock.NewMockCourseRepository(ctrl)
+		courseRepo.EXPECT().FindOwnerIDsByDateSpotID(ctx, uint(3)).Return([]uint{1, 4}, nil)
+		notificationRepo.EXPECT().
+			CreateBatch(ctx, []*model.Notification{{
+				UserID:           4,
+				ActorID:          1,
+				Type:             model.NotificationTypeCourseSpotReviewed,
+				DateSpotID:       lo.ToPtr(uint(3)),
+				DateSpotReviewID: lo.ToPtr(uint(30)),
+			}}).
+			Return(nil)
+
+		h := usecase.NewNotificationEventHandler(notificationRepo, courseRepo, nil)
+		err := h.Handle(ctx, event.DateSpotReviewed{ReviewID: 30, DateSpotID: 3, ReviewerID: 1, Status: model.ReviewStatusPublished})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("date_spot_reviewed_skips_pending_review", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		notificationRepo := repositorymock.NewMockNotificationRepository(ctrl)
+		courseRepo := repositorymock.NewMockCourseRepository(ctrl)
+
+		h := usecase.NewNotificationEventHandler(notificationRepo, courseRepo, nil)
+		err := h.Handle(ctx, event.DateSpotReviewed{ReviewID: 30, DateSpotID: 3, ReviewerID: 1, Status: model.ReviewStatusPending})
+
+		require.NoError(t, err)
+	})
+
+	// 他の手段で届けられなくても、保存済みのアプリ内通知は成功として扱い、残りの手段にも届ける
+	t.Run("delivers_to_every_channel_even_if_one_fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		notificationRepo := repositorymock.NewMockNotificationRepository(ctrl)
+		courseRepo := repositorymock.NewMockCourseRepository(ctrl)
+		failing := repositorymock.NewMockNotificationChannel(ctrl)
+		working := repositorymock.NewMockNotificationChannel(ctrl)
+		notificationRepo.EXPECT().CreateBatch(ctx, gomock.Any()).Return(nil)
+		failing.EXPECT().Deliver(ctx, gomock.Len(1)).Return(errors.New("smtp down"))
+		working.EXPECT().Deliver(ctx, gomock.Len(1)).Return(nil)
+
+		h := usecase.NewNotificationEventHandler(notificationRepo, courseRepo, []repository.NotificationChannel{failing, working})
+		err := h.Handle(ctx, event.UserFollowed{FollowerID: 1, FollowedUserID: 2})
+
+		require.NoError(t, err)
+	})
+
+	// 配り直しても同じ通知を2件作らないよう、outbox の ID を付けて保存する
+	t.Run("sets_outbox_event_id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		notificationRepo := repositorymock.NewMockNotificationRepository(ctrl)
+		courseRepo := repositorymock.NewMockCourseRepository(ctrl)
+		notificationRepo.EXPECT().
+			CreateBatch(gomock.Any(), []*model.Notification{{UserID: 2, ActorID: 1, Type: model.NotificationTypeFollowed, OutboxEventID: lo.ToPtr(uint(9))}}).
+			Return(nil)
+
+		h := usecase.NewNotificationEventHandler(notificationRepo, courseRepo, nil)
+		err := h.Handle(event.WithOutboxID(ctx, 9), event.UserFollowed{FollowerID: 1, FollowedUserID: 2})
+
+		require.NoError(t, err)
+	})
+
+	// 配るまでに退会したユーザーの通知は作れない。配り直しても通らないため、エラーにせず配り終える
+	t.Run("skips_event_of_deleted_user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		notificationRepo := repositorymock.NewMockNotificationRepository(ctrl)
+		courseRepo := repositorymock.NewMockCourseRepository(ctrl)
+		notificationRepo.EXPECT().CreateBatch(ctx, gomock.Any()).Return(gorm.ErrForeignKeyViolated)
+		channel := repositorymock.NewMockNotificationChannel(ctrl)
+
+		h := usecase.NewNotificationEventHandler(notificationRepo, courseRepo, []repository.NotificationChannel{channel})
+		err := h.Handle(ctx, event.UserFollowed{FollowerID: 1, FollowedUserID: 2})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("error_create_failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		notificationRepo := repositorymock.NewMockNotificationRepository(ctrl)
+		courseRepo := repositorymock.NewMockCourseRepository(ctrl)
+		notificationRepo.EXPECT().CreateBatch(ctx, gomock.Any()).Return(errors.New("db error"))
+
+		h := usecase.NewNotificationEventHandler(notificationRepo, courseRepo, nil)
+		err := h.Handle(ctx, event.UserFollowed{FollowerID: 1, FollowedUserID: 2})
+
+		assert.Error(t, err)
+	})
+}
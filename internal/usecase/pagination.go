@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/samber/lo"
+)
+
+// PageInput は一覧系ユースケースに共通する並び順とページ指定です。
+// Cursor には前のページのレスポンスで返した next_cursor をそのまま渡します。
+type PageInput struct {
+	Sort   *string
+	Limit  *int
+	Cursor *string
+}
+
+// resolve は並び順とページ指定を検証し、リポジトリへ渡す形にします。
+// sort 省略時は allowed の先頭の並び順を使います。
+func (p PageInput) resolve(allowed ...pagination.Sort) (pagination.Sort, pagination.Params, []string) {
+	var errs []string
+
+	sort := allowed[0]
+	if p.Sort != nil && *p.Sort != "" {
+		sort = pagination.Sort(*p.Sort)
+		if !lo.Contains(allowed, sort) {
+			names := lo.Map(allowed, func(s pagination.Sort, _ int) string { return string(s) })
+			errs = append(errs, fmt.Sprintf("並び順には %s のいずれかを指定してください", strings.Join(names, ", ")))
+		}
+	}
+
+	page := pagination.Params{Limit: pagination.DefaultLimit}
+	if p.Limit != nil {
+		if *p.Limit < 1 || *p.Limit > pagination.MaxLimit {
+			errs = append(errs, fmt.Sprintf("取得件数は1から%dの範囲で指定してください", pagination.MaxLimit))
+		} else {
+			page.Limit = *p.Limit
+		}
+	}
+
+	if p.Cursor != nil && *p.Cursor != "" {
+		cursor, err := pagination.Decode(*p.Cursor)
+		switch {
+		case err != nil:
+			errs = append(errs, "カーソルが不正です")
+		case cursor.Sort != sort:
+			// 並び順を変えたら先頭から取り直してもらう
+			errs = append(errs, "カーソルと並び順が一致しません")
+		default:
+			page.Cursor = cursor
+		}
+	}
+
+	return sort, page, errs
+}
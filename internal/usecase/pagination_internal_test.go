@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageInput_resolve(t *testing.T) {
+	t.Run("defaults_to_first_sort_and_default_limit", func(t *testing.T) {
+		sort, page, errs := PageInput{}.resolve(pagination.SortNewest, pagination.SortName)
+
+		assert.Empty(t, errs)
+		assert.Equal(t, pagination.SortNewest, sort)
+		assert.Equal(t, pagination.Params{Limit: pagination.DefaultLimit}, page)
+	})
+
+	t.Run("uses_given_sort_limit_and_cursor", func(t *testing.T) {
+		sortName := "name"
+		limit := 5
+		cursor := pagination.NewStringCursor(pagination.SortName, "たろう", 3)
+		encoded := cursor.Encode()
+
+		sort, page, errs := PageInput{Sort: &sortName, Limit: &limit, Cursor: &encoded}.
+			resolve(pagination.SortNewest, pagination.SortName)
+
+		assert.Empty(t, errs)
+		assert.Equal(t, pagination.SortName, sort)
+		assert.Equal(t, 5, page.Limit)
+		require.NotNil(t, page.Cursor)
+		assert.Equal(t, cursor, *page.Cursor)
+	})
+
+	t.Run("error_unsupported_sort", func(t *testing.T) {
+		rating := "rating"
+
+		_, _, errs := PageInput{Sort: &rating}.resolve(pagination.SortNewest, pagination.SortName)
+
+		assert.Equal(t, []string{"並び順には newest, name のいずれかを指定してください"}, errs)
+	})
+
+	t.Run("error_limit_out_of_range", func(t *testing.T) {
+		for _, limit := range []int{0, pagination.MaxLimit + 1} {
+			_, _, errs := PageInput{Limit: &limit}.resolve(pagination.SortNewest)
+
+			assert.Equal(t, []string{"取得件数は1から100の範囲で指定してください"}, errs)
+		}
+	})
+
+	t.Run("error_broken_cursor", func(t *testing.T) {
+		broken := "not-a-cursor"
+
+		_, _, errs := PageInput{Cursor: &broken}.resolve(pagination.SortNewest)
+
+		assert.Equal(t, []string{"カーソルが不正です"}, errs)
+	})
+
+	// 並び順を変えたのに前のカーソルを使うと、キーの意味が変わって行を読み飛ばす
+	t.Run("error_cursor_from_other_sort", func(t *testing.T) {
+		encoded := pagination.NewStringCursor(pagination.SortName, "たろう", 3).Encode()
+
+		_, _, errs := PageInput{Cursor: &encoded}.resolve(pagination.SortNewest, pagination.SortName)
+
+		assert.Equal(t, []string{"カーソルと並び順が一致しません"}, errs)
+	})
+}
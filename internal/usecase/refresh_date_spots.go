@@ -0,0 +1,236 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/samber/lo"
+)
+
+// SpotRefresher は取り込み済みのスポットを取得元の ID で取り直すインターフェースです。
+type SpotRefresher interface {
+	// Source は取り直す取得元です。
+	Source() model.DateSpotSource
+	// Lookup は externalIDs のうち、取得元に今も載っているスポットだけを返します。
+	// 呼び出しに失敗したときは、載っていないのと区別できるようエラーを返します。
+	Lookup(ctx context.Context, externalIDs []string) ([]SpotCandidate, error)
+}
+
+// RefreshDateSpotsInput は取り直しバッチの入力パラメータです。
+type RefreshDateSpotsInput struct {
+	// Now は実行の開始日時で、最終確認日時・閉店日時にもこの値を書き込みます。
+	Now time.Time
+}
+
+// refreshPageSize は1回に読み込んで取り直すスポットの件数です。
+const refreshPageSize = 100
+
+// RefreshDateSpotsInteractor は取り込み済みのスポットを取得元から取り直すバッチのユースケースです。
+// 変わった属性を書き込み、closeAfterMisses 回続けて取得元に見つからなかったスポットは閉店とみなして検索から外します。
+// コースから参照されているため削除はせず、また見つかれば閉店を取り消します。
+// 変わった属性はすべて、実行ごとに SpotRefreshChange として残します。
+type RefreshDateSpotsInteractor struct {
+	unitOfWork       repository.UnitOfWork
+	repo             repository.DateSpotRepository
+	sourceRepo       repository.SpotSourceRepository
+	refreshRepo      repository.SpotRefreshRepository
+	refresher        SpotRefresher
+	closeAfterMisses int
+}
+
+func NewRefreshDateSpotsInteractor(
+	unitOfWork repository.UnitOfWork,
+	repo repository.DateSpotRepository,
+	sourceRepo repository.SpotSourceRepository,
+	refreshRepo repository.SpotRefreshRepository,
+	refresher SpotRefresher,
+	closeAfterMisses int,
+) *RefreshDateSpotsInteractor {
+	return &RefreshDateSpotsInteractor{
+		unitOfWork:       unitOfWork,
+		repo:             repo,
+		sourceRepo:       sourceRepo,
+		refreshRepo:      refreshRepo,
+		refresher:        refresher,
+		closeAfterMisses: closeAfterMisses,
+	}
+}
+
+func (i *RefreshDateSpotsInteractor) Execute(ctx context.Context, input RefreshDateSpotsInput) error {
+	run := &model.SpotRefreshRun{Source: i.refresher.Source(), StartedAt: input.Now}
+	if err := i.refreshRepo.CreateRun(ctx, run); err != nil {
+		return fmt.Errorf("refresh: create run: %w", err)
+	}
+
+	var afterDateSpotID uint
+	for {
+		sources, err := i.sourceRepo.FindRefreshTargets(ctx, run.Source, afterDateSpotID, refreshPageSize)
+		if err != nil {
+			return fmt.Errorf("refresh: find targets: %w", err)
+		}
+		if len(sources) == 0 {
+			break
+		}
+		afterDateSpotID = sources[len(sources)-1].DateSpotID
+
+		if err := i.refreshPage(ctx, run, sources, input.Now); err != nil {
+			return fmt.Errorf("refresh: save spots: %w", err)
+		}
+		if len(lo.UniqBy(sources, func(s *model.SpotSource) uint { return s.DateSpotID })) < refreshPageSize {
+			break
+		}
+	}
+
+	finishedAt := time.Now()
+	run.FinishedAt = &finishedAt
+	if err := i.refreshRepo.FinishRun(ctx, run); err != nil {
+		return fmt.Errorf("refresh: finish run: %w", err)
+	}
+
+	slog.InfoContext(ctx, "refresh: completed",
+		"run_id", run.ID,
+		"source", run.Source,
+		"checked", run.Checked,
+		"updated", run.Updated,
+		"missing", run.Missing,
+		"closed", run.Closed,
+		"reopened", run.Reopened,
+		"failed", run.Failed,
+	)
+	return nil
+}
+
+// refreshPage は sources のつなぎ先のスポットを取り直して書き込みます。
+// 1つのスポットに同じ取得元の記録が複数あるときは、どれか1つでも見つかれば載っているとみなします。
+func (i *RefreshDateSpotsInteractor) refreshPage(ctx context.Context, run *model.SpotRefreshRun, sources []*model.SpotSource, now time.Time) error {
+	var (
+		spots       []*model.DateSpot
+		externalIDs = map[uint][]string{}
+	)
+	for _, s := range sources {
+		if _, ok := externalIDs[s.DateSpotID]; !ok {
+			spots = append(spots, s.DateSpot)
+		}
+		externalIDs[s.DateSpotID] = append(externalIDs[s.DateSpotID], *s.ExternalID)
+	}
+
+	found, err := i.refresher.Lookup(ctx, lo.Map(sources, func(s *model.SpotSource, _ int) string { return *s.ExternalID }))
+	if err != nil {
+		// 呼び出しに失敗した分は見つからなかった回数に数えず、次の実行で確かめ直す
+		slog.WarnContext(ctx, "refresh: lookup failed, skipping page", "err", err, "spots", len(spots))
+		run.Failed += len(spots)
+		return nil
+	}
+	candidates := lo.KeyBy(found, func(c SpotCandidate) string { return c.ExternalID })
+
+	var changes []*model.SpotRefreshChange
+	for _, spot := range spots {
+		run.Checked++
+		id, ok := lo.Find(externalIDs[spot.ID], func(id string) bool { _, ok := candidates[id]; return ok })
+		if ok {
+			spotChanges := applyRefresh(spot, candidates[id])
+			if len(spotChanges) > 0 {
+				run.Updated++
+			}
+			changes = append(changes, spotChanges...)
+			if spot.ClosedAt != nil {
+				slog.InfoContext(ctx, "refresh: reopen spot", "date_spot_id", spot.ID, "name", spot.Name)
+				changes = append(changes, newSpotRefreshChange(spot.ID, "closed_at", formatTime(spot.ClosedAt), nil))
+				spot.ClosedAt = nil
+				run.Reopened++
+			}
+			spot.LastSeenAt = &now
+			spot.MissedRefreshes = 0
+			continue
+		}
+
+		run.Missing++
+		spot.MissedRefreshes++
+		if spot.ClosedAt == nil && spot.MissedRefreshes >= i.closeAfterMisses {
+			slog.InfoContext(ctx, "refresh: close spot", "date_spot_id", spot.ID, "name", spot.Name, "missed", spot.MissedRefreshes)
+			spot.ClosedAt = &now
+			changes = append(changes, newSpotRefreshChange(spot.ID, "closed_at", nil, formatTime(spot.ClosedAt)))
+			run.Closed++
+		}
+	}
+
+	return i.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		for _, spot := range spots {
+			if err := i.repo.UpdateRefreshed(ctx, spot); err != nil {
+				return err
+			}
+		}
+		for _, c := range changes {
+			c.RunID = run.ID
+		}
+		return i.refreshRepo.CreateChanges(ctx, changes)
+	})
+}
+
+// applyRefresh は spot の属性を取得元の値 c に書き換え、変わった属性の差分を返します。
+// 取得元が値を返さなかった属性は、Wikimedia などで補った値を消さないようそのまま残します。
+func applyRefresh(spot *model.DateSpot, c SpotCandidate) []*model.SpotRefreshChange {
+	var changes []*model.SpotRefreshChange
+	if c.Name != "" && c.Name != spot.Name {
+		changes = append(changes, newSpotRefreshChange(spot.ID, "name", &spot.Name, &c.Name))
+		spot.Name = c.Name
+		spot.NormalizedName = NormalizeName(c.Name)
+	}
+	if c.CityName != "" && c.CityName != spot.CityName {
+		changes = append(changes, newSpotRefreshChange(spot.ID, "city_name", &spot.CityName, &c.CityName))
+		spot.CityName = c.CityName
+	}
+	if c.ImageURL != nil && *c.ImageURL != lo.FromPtr(spot.Image) {
+		changes = append(changes, newSpotRefreshChange(spot.ID, "image", spot.Image, c.ImageURL))
+		spot.Image = c.ImageURL
+	}
+	if c.Latitude != nil && c.Longitude != nil &&
+		(*c.Latitude != lo.FromPtr(spot.Latitude) || *c.Longitude != lo.FromPtr(spot.Longitude)) {
+		changes = append(changes,
+			newSpotRefreshChange(spot.ID, "latitude", formatCoordinate(spot.Latitude), formatCoordinate(c.Latitude)),
+			newSpotRefreshChange(spot.ID, "longitude", formatCoordinate(spot.Longitude), formatCoordinate(c.Longitude)),
+		)
+		spot.Latitude, spot.Longitude = c.Latitude, c.Longitude
+	}
+	if c.PageURL != "" && c.PageURL != lo.FromPtr(spot.MapsURL) {
+		changes = append(changes, newSpotRefreshChange(spot.ID, "maps_url", spot.MapsURL, &c.PageURL))
+		spot.MapsURL = &c.PageURL
+	}
+	return changes
+}
+
+// newSpotRefreshChange は before・after を書き換えても差分が変わらないよう、値を写して持ちます。
+func newSpotRefreshChange(dateSpotID uint, field string, before, after *string) *model.SpotRefreshChange {
+	return &model.SpotRefreshChange{
+		DateSpotID: dateSpotID,
+		Field:      field,
+		Before:     copyString(before),
+		After:      copyString(after),
+	}
+}
+
+func copyString(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	return lo.ToPtr(*s)
+}
+
+func formatCoordinate(v *float64) *string {
+	if v == nil {
+		return nil
+	}
+	return lo.ToPtr(strconv.FormatFloat(*v, 'f', -1, 64))
+}
+
+func formatTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	return lo.ToPtr(t.Format(time.DateTime))
+}
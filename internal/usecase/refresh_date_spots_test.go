@@ -0,0 +1,245 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repomock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRefreshDateSpotsInteractor_Execute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 1, 3, 0, 0, 0, time.UTC)
+	input := usecase.RefreshDateSpotsInput{Now: now}
+
+	type mocks struct {
+		repo        *repomock.MockDateSpotRepository
+		sourceRepo  *repomock.MockSpotSourceRepository
+		refreshRepo *repomock.MockSpotRefreshRepository
+	}
+	setup := func(t *testing.T, sources []*model.SpotSource) (*gomock.Controller, mocks) {
+		ctrl := gomock.NewController(t)
+		m := mocks{
+			repo:        repomock.NewMockDateSpotRepository(ctrl),
+			sourceRepo:  repomock.NewMockSpotSourceRepository(ctrl),
+			refreshRepo: repomock.NewMockSpotRefreshRepository(ctrl),
+		}
+		m.refreshRepo.EXPECT().
+			CreateRun(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, run *model.SpotRefreshRun) error {
+				run.ID = 9
+				return nil
+			})
+		m.sourceRepo.EXPECT().
+			FindRefreshTargets(gomock.Any(), model.DateSpotSourceHotPepper, uint(0), gomock.Any()).
+			Return(sources, nil)
+		return ctrl, m
+	}
+	newInteractor := func(ctrl *gomock.Controller, m mocks, refresher usecase.SpotRefresher) *usecase.RefreshDateSpotsInteractor {
+		return usecase.NewRefreshDateSpotsInteractor(
+			newPassThroughUnitOfWork(ctrl),
+			m.repo,
+			m.sourceRepo,
+			m.refreshRepo,
+			refresher,
+			3,
+		)
+	}
+	expectFinish := func(m mocks, run *model.SpotRefreshRun) {
+		m.refreshRepo.EXPECT().
+			FinishRun(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, r *model.SpotRefreshRun) error {
+				*run = *r
+				return nil
+			})
+	}
+
+	t.Run("success_updates_changed_fields_and_records_diff", func(t *testing.T) {
+		spot := &model.DateSpot{ID: 1, Name: "カフェ ルミエール", CityName: "福岡市中央区", MissedRefreshes: 1}
+		ctrl, m := setup(t, []*model.SpotSource{
+			{ID: 11, DateSpotID: 1, ExternalID: lo.ToPtr("J001"), DateSpot: spot},
+		})
+		refresher := &mockSpotRefresher{found: []usecase.SpotCandidate{
+			{ExternalID: "J001", Name: "カフェ ルミエール 天神店", CityName: "福岡市中央区", ImageURL: lo.ToPtr("https://example.com/a.jpg")},
+		}}
+
+		m.repo.EXPECT().
+			UpdateRefreshed(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, ds *model.DateSpot) error {
+				assert.Equal(t, "カフェ ルミエール 天神店", ds.Name)
+				assert.Equal(t, "カフェルミエール天神店", ds.NormalizedName)
+				assert.Equal(t, "https://example.com/a.jpg", lo.FromPtr(ds.Image))
+				assert.Equal(t, now, lo.FromPtr(ds.LastSeenAt))
+				assert.Zero(t, ds.MissedRefreshes)
+				assert.Nil(t, ds.ClosedAt)
+				return nil
+			})
+		m.refreshRepo.EXPECT().
+			CreateChanges(gomock.Any(), gomock.Len(2)).
+			DoAndReturn(func(_ context.Context, changes []*model.SpotRefreshChange) error {
+				assert.Equal(t, "name", changes[0].Field)
+				assert.Equal(t, "カフェ ルミエール", lo.FromPtr(changes[0].Before))
+				assert.Equal(t, "カフェ ルミエール 天神店", lo.FromPtr(changes[0].After))
+				assert.Equal(t, "image", changes[1].Field)
+				assert.Nil(t, changes[1].Before)
+				for _, c := range changes {
+					assert.Equal(t, uint(9), c.RunID)
+					assert.Equal(t, uint(1), c.DateSpotID)
+				}
+				return nil
+			})
+		var run model.SpotRefreshRun
+		expectFinish(m, &run)
+
+		err := newInteractor(ctrl, m, refresher).Execute(ctx, input)
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{{"J001"}}, refresher.lookedUp)
+		assert.Equal(t, 1, run.Checked)
+		assert.Equal(t, 1, run.Updated)
+		assert.NotNil(t, run.FinishedAt)
+	})
+
+	// 見つからなかった回数がしきい値に届いたスポットだけを閉店とみなす
+	t.Run("success_closes_spots_missing_from_consecutive_runs", func(t *testing.T) {
+		almost := &model.DateSpot{ID: 1, Name: "閉店間近", MissedRefreshes: 2}
+		first := &model.DateSpot{ID: 2, Name: "初めて見つからない"}
+		ctrl, m := setup(t, []*model.SpotSource{
+			{ID: 11, DateSpotID: 1, ExternalID: lo.ToPtr("J001"), DateSpot: almost},
+			{ID: 12, DateSpotID: 2, ExternalID: lo.ToPtr("J002"), DateSpot: first},
+		})
+		refresher := &mockSpotRefresher{}
+
+		m.repo.EXPECT().UpdateRefreshed(gomock.Any(), almost).Return(nil)
+		m.repo.EXPECT().UpdateRefreshed(gomock.Any(), first).Return(nil)
+		m.refreshRepo.EXPECT().
+			CreateChanges(gomock.Any(), gomock.Len(1)).
+			DoAndReturn(func(_ context.Context, changes []*model.SpotRefreshChange) error {
+				assert.Equal(t, uint(1), changes[0].DateSpotID)
+				assert.Equal(t, "closed_at", changes[0].Field)
+				assert.Nil(t, changes[0].Before)
+				assert.Equal(t, "2026-10-01 03:00:00", lo.FromPtr(changes[0].After))
+				return nil
+			})
+		var run model.SpotRefreshRun
+		expectFinish(m, &run)
+
+		err := newInteractor(ctrl, m, refresher).Execute(ctx, input)
+		require.NoError(t, err)
+		assert.Equal(t, 3, almost.MissedRefreshes)
+		assert.Equal(t, now, lo.FromPtr(almost.ClosedAt))
+		assert.Equal(t, 1, first.MissedRefreshes)
+		assert.Nil(t, first.ClosedAt)
+		assert.Equal(t, 2, run.Missing)
+		assert.Equal(t, 1, run.Closed)
+	})
+
+	t.Run("success_reopens_closed_spot_found_again", func(t *testing.T) {
+		closedAt := now.AddDate(0, 0, -7)
+		spot := &model.DateSpot{ID: 1, Name: "再開", MissedRefreshes: 4, ClosedAt: &closedAt}
+		ctrl, m := setup(t, []*model.SpotSource{
+			{ID: 11, DateSpotID: 1, ExternalID: lo.ToPtr("J001"), DateSpot: spot},
+		})
+		refresher := &mockSpotRefresher{found: []usecase.SpotCandidate{{ExternalID: "J001", Name: "再開"}}}
+
+		m.repo.EXPECT().UpdateRefreshed(gomock.Any(), spot).Return(nil)
+		m.refreshRepo.EXPECT().
+			CreateChanges(gomock.Any(), gomock.Len(1)).
+			DoAndReturn(func(_ context.Context, changes []*model.SpotRefreshChange) error {
+				assert.Equal(t, "closed_at", changes[0].Field)
+				assert.Equal(t, "2026-09-24 03:00:00", lo.FromPtr(changes[0].Before))
+				assert.Nil(t, changes[0].After)
+				return nil
+			})
+		var run model.SpotRefreshRun
+		expectFinish(m, &run)
+
+		err := newInteractor(ctrl, m, refresher).Execute(ctx, input)
+		require.NoError(t, err)
+		assert.Nil(t, spot.ClosedAt)
+		assert.Zero(t, spot.MissedRefreshes)
+		assert.Equal(t, 1, run.Reopened)
+		assert.Equal(t, 0, run.Updated)
+	})
+
+	// ページはスポットの件数で区切り、次のページは最後のスポットの ID から読む。
+	// 1つのスポットに記録が複数あっても、スポットとしては1件に数える
+	t.Run("success_pages_by_date_spot", func(t *testing.T) {
+		var sources []*model.SpotSource
+		for id := uint(1); id <= 100; id++ {
+			spot := &model.DateSpot{ID: id, Name: "スポット"}
+			sources = append(sources, &model.SpotSource{ID: id, DateSpotID: id, ExternalID: lo.ToPtr(fmt.Sprintf("J%03d", id)), DateSpot: spot})
+		}
+		sources = append(sources, &model.SpotSource{ID: 101, DateSpotID: 100, ExternalID: lo.ToPtr("J999"), DateSpot: sources[99].DateSpot})
+		ctrl, m := setup(t, sources)
+		refresher := &mockSpotRefresher{}
+
+		m.sourceRepo.EXPECT().
+			FindRefreshTargets(gomock.Any(), model.DateSpotSourceHotPepper, uint(100), gomock.Any()).
+			Return(nil, nil)
+		m.repo.EXPECT().UpdateRefreshed(gomock.Any(), gomock.Any()).Return(nil).Times(100)
+		m.refreshRepo.EXPECT().CreateChanges(gomock.Any(), gomock.Any()).Return(nil)
+		var run model.SpotRefreshRun
+		expectFinish(m, &run)
+
+		err := newInteractor(ctrl, m, refresher).Execute(ctx, input)
+		require.NoError(t, err)
+		assert.Equal(t, 100, run.Checked)
+		require.Len(t, refresher.lookedUp, 1)
+		assert.Len(t, refresher.lookedUp[0], 101)
+	})
+
+	// 取得元の呼び出しに失敗したときは、見つからなかったとは数えずに書き込みもしない
+	t.Run("success_skips_page_when_lookup_fails", func(t *testing.T) {
+		spot := &model.DateSpot{ID: 1, Name: "確認できない", MissedRefreshes: 2}
+		ctrl, m := setup(t, []*model.SpotSource{
+			{ID: 11, DateSpotID: 1, ExternalID: lo.ToPtr("J001"), DateSpot: spot},
+		})
+		refresher := &mockSpotRefresher{err: errors.New("hotpepper: api error")}
+
+		var run model.SpotRefreshRun
+		expectFinish(m, &run)
+
+		err := newInteractor(ctrl, m, refresher).Execute(ctx, input)
+		require.NoError(t, err)
+		assert.Equal(t, 2, spot.MissedRefreshes)
+		assert.Equal(t, 1, run.Failed)
+		assert.Zero(t, run.Missing)
+	})
+
+	t.Run("error_save_fails", func(t *testing.T) {
+		spot := &model.DateSpot{ID: 1, Name: "保存失敗"}
+		ctrl, m := setup(t, []*model.SpotSource{
+			{ID: 11, DateSpotID: 1, ExternalID: lo.ToPtr("J001"), DateSpot: spot},
+		})
+		refresher := &mockSpotRefresher{found: []usecase.SpotCandidate{{ExternalID: "J001", Name: "保存失敗"}}}
+
+		m.repo.EXPECT().UpdateRefreshed(gomock.Any(), spot).Return(errors.New("db error"))
+
+		err := newInteractor(ctrl, m, refresher).Execute(ctx, input)
+		require.Error(t, err)
+	})
+}
+
+type mockSpotRefresher struct {
+	found    []usecase.SpotCandidate
+	err      error
+	lookedUp [][]string
+}
+
+func (m *mockSpotRefresher) Source() model.DateSpotSource {
+	return model.DateSpotSourceHotPepper
+}
+
+func (m *mockSpotRefresher) Lookup(_ context.Context, externalIDs []string) ([]usecase.SpotCandidate, error) {
+	m.lookedUp = append(m.lookedUp, externalIDs)
+	return m.found, m.err
+}
@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	jwtpkg "github.com/daisuke-harada/date-courses-go/internal/pkg/jwt"
+	"gorm.io/gorm"
+)
+
+// RefreshTokenInputPort はリフレッシュトークンでアクセストークンを再発行するユースケースの入力ポートです。
+type RefreshTokenInputPort interface {
+	Execute(context.Context, RefreshTokenInput) (*RefreshTokenOutput, error)
+}
+
+type RefreshTokenInput struct {
+	RefreshToken string
+}
+
+func (i *RefreshTokenInput) Validate() error {
+	if i.RefreshToken == "" {
+		return apperror.UnprocessableEntity("リフレッシュトークンを指定してください")
+	}
+	return nil
+}
+
+type RefreshTokenOutput struct {
+	Token        string
+	RefreshToken string
+}
+
+type RefreshTokenInteractor struct {
+	UnitOfWork             repository.UnitOfWork
+	UserRepository         repository.UserRepository
+	RefreshTokenRepository repository.RefreshTokenRepository
+	Keyring                *jwtpkg.Keyring
+	SessionTTL             SessionTTL
+}
+
+func NewRefreshTokenUsecase(
+	unitOfWork repository.UnitOfWork,
+	userRepository repository.UserRepository,
+	refreshTokenRepository repository.RefreshTokenRepository,
+	keyring *jwtpkg.Keyring,
+	sessionTTL SessionTTL,
+) RefreshTokenInputPort {
+	return &RefreshTokenInteractor{
+		UnitOfWork:             unitOfWork,
+		UserRepository:         userRepository,
+		RefreshTokenRepository: refreshTokenRepository,
+		Keyring:                keyring,
+		SessionTTL:             sessionTTL,
+	}
+}
+
+func (i *RefreshTokenInteractor) Execute(ctx context.Context, input RefreshTokenInput) (*RefreshTokenOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	stored, err := i.RefreshTokenRepository.FindByHash(ctx, model.HashRefreshToken(input.RefreshToken))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperror.Unauthorized("認証に失敗しました。再度ログインしてください。")
+		}
+		return nil, apperror.InternalServerError(err)
+	}
+
+	// 使用済みのトークンがもう一度使われたのは、盗まれたトークンを攻撃者と本人の両方が使っている可能性がある。
+	// どちらが本物か区別できないため、そのユーザーのセッションをすべて無効にして再ログインさせる
+	if stored.IsRevoked() {
+		slog.WarnContext(ctx, "refresh token reuse detected", "user_id", stored.UserID, "refresh_token_id", stored.ID)
+		if err := i.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+			return revokeAllSessions(ctx, i.UserRepository, i.RefreshTokenRepository, stored.UserID)
+		}); err != nil {
+			return nil, err
+		}
+		return nil, apperror.Unauthorized("セッションが無効になりました。再度ログインしてください。")
+	}
+
+	now := time.Now()
+	if stored.IsExpired(now) {
+		return nil, apperror.Unauthorized("ログインの有効期限が切れています。再度ログインしてください。")
+	}
+
+	// 古いトークンの失効と新しいトークンの発行を1トランザクションで行い、片方だけが残らないようにする
+	var output *RefreshTokenOutput
+	err = i.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		revoked, err := i.RefreshTokenRepository.Revoke(ctx, stored.ID, now)
+		if err != nil {
+			return apperror.InternalServerError(err)
+		}
+		// 同じトークンでの同時のリクエストに負けた。先に成功した側のトークンを使ってもらう
+		if !revoked {
+			return apperror.Unauthorized("認証に失敗しました。再度ログインしてください。")
+		}
+
+		user, err := i.UserRepository.FindByID(ctx, stored.UserID)
+		if err != nil {
+			return apperror.Unauthorized("認証に失敗しました。再度ログインしてください。")
+		}
+
+		token, refreshToken, err := issueSession(ctx, i.RefreshTokenRepository, user, i.Keyring, i.SessionTTL)
+		if err != nil {
+			return err
+		}
+		output = &RefreshTokenOutput{Token: token, RefreshToken: refreshToken}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
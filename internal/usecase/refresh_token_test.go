@@ -0,0 +1,159 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	jwtpkg "github.com/daisuke-harada/date-courses-go/internal/pkg/jwt"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+// newStoringRefreshTokenRepository は発行したリフレッシュトークンの保存を何度でも受け付けるモックです。
+func newStoringRefreshTokenRepository(ctrl *gomock.Controller) *repositorymock.MockRefreshTokenRepository {
+	repo := repositorymock.NewMockRefreshTokenRepository(ctrl)
+	repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return repo
+}
+
+func TestRefreshTokenInteractor_Execute(t *testing.T) {
+	const plain = "plain-refresh-token"
+	activeToken := func() *model.RefreshToken {
+		return &model.RefreshToken{ID: 3, UserID: 1, TokenHash: model.HashRefreshToken(plain), ExpiresAt: time.Now().Add(time.Hour)}
+	}
+
+	t.Run("success_rotates_token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		user := &model.User{ID: 1, Name: "alice", TokenVersion: 4}
+
+		refreshTokenRepo := repositorymock.NewMockRefreshTokenRepository(ctrl)
+		refreshTokenRepo.EXPECT().FindByHash(ctx, model.HashRefreshToken(plain)).Return(activeToken(), nil)
+		refreshTokenRepo.EXPECT().Revoke(ctx, uint(3), gomock.Any()).Return(true, nil)
+		refreshTokenRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(user, nil)
+
+		interactor := usecase.NewRefreshTokenUsecase(newPassThroughUnitOfWork(ctrl), userRepo, refreshTokenRepo, testKeyring, testSessionTTL)
+		output, err := interactor.Execute(ctx, usecase.RefreshTokenInput{RefreshToken: plain})
+
+		require.NoError(t, err)
+		assert.NotEqual(t, plain, output.RefreshToken, "使ったトークンとは別のトークンを返す")
+		claims, err := testKeyring.Decode(output.Token)
+		require.NoError(t, err)
+		assert.Equal(t, jwtpkg.Claims{UserID: 1, TokenVersion: 4}, claims)
+	})
+
+	t.Run("error_empty_token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		interactor := usecase.NewRefreshTokenUsecase(nil, repositorymock.NewMockUserRepository(ctrl), repositorymock.NewMockRefreshTokenRepository(ctrl), testKeyring, testSessionTTL)
+		_, err := interactor.Execute(context.Background(), usecase.RefreshTokenInput{})
+
+		statusCode, _, _, _ := apperror.HTTPStatus(err)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+
+	t.Run("error_unknown_token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		refreshTokenRepo := repositorymock.NewMockRefreshTokenRepository(ctrl)
+		refreshTokenRepo.EXPECT().FindByHash(ctx, gomock.Any()).Return(nil, gorm.ErrRecordNotFound)
+
+		interactor := usecase.NewRefreshTokenUsecase(nil, repositorymock.NewMockUserRepository(ctrl), refreshTokenRepo, testKeyring, testSessionTTL)
+		_, err := interactor.Execute(ctx, usecase.RefreshTokenInput{RefreshToken: plain})
+
+		statusCode, _, _, _ := apperror.HTTPStatus(err)
+		assert.Equal(t, http.StatusUnauthorized, statusCode)
+	})
+
+	t.Run("error_expired_token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		expired := activeToken()
+		expired.ExpiresAt = time.Now().Add(-time.Minute)
+		refreshTokenRepo := repositorymock.NewMockRefreshTokenRepository(ctrl)
+		refreshTokenRepo.EXPECT().FindByHash(ctx, gomock.Any()).Return(expired, nil)
+
+		interactor := usecase.NewRefreshTokenUsecase(nil, repositorymock.NewMockUserRepository(ctrl), refreshTokenRepo, testKeyring, testSessionTTL)
+		_, err := interactor.Execute(ctx, usecase.RefreshTokenInput{RefreshToken: plain})
+
+		statusCode, msgs, _, _ := apperror.HTTPStatus(err)
+		assert.Equal(t, http.StatusUnauthorized, statusCode)
+		assert.Contains(t, msgs, "ログインの有効期限が切れています。再度ログインしてください。")
+	})
+
+	// 使用済みのトークンが再び使われたら、盗まれたとみなしてそのユーザーのセッションをすべて無効にする
+	t.Run("error_reused_token_revokes_all_sessions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		used := activeToken()
+		used.RevokedAt = lo.ToPtr(time.Now().Add(-time.Minute))
+
+		refreshTokenRepo := repositorymock.NewMockRefreshTokenRepository(ctrl)
+		refreshTokenRepo.EXPECT().FindByHash(ctx, gomock.Any()).Return(used, nil)
+		refreshTokenRepo.EXPECT().RevokeAllByUserID(ctx, uint(1), gomock.Any()).Return(nil)
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().IncrementTokenVersion(ctx, uint(1)).Return(nil)
+
+		interactor := usecase.NewRefreshTokenUsecase(newPassThroughUnitOfWork(ctrl), userRepo, refreshTokenRepo, testKeyring, testSessionTTL)
+		output, err := interactor.Execute(ctx, usecase.RefreshTokenInput{RefreshToken: plain})
+
+		assert.Nil(t, output)
+		statusCode, _, _, _ := apperror.HTTPStatus(err)
+		assert.Equal(t, http.StatusUnauthorized, statusCode)
+	})
+
+	// 同じトークンで同時に再発行されたときは、後から失効させようとした側を失敗させる
+	t.Run("error_lost_concurrent_rotation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		refreshTokenRepo := repositorymock.NewMockRefreshTokenRepository(ctrl)
+		refreshTokenRepo.EXPECT().FindByHash(ctx, gomock.Any()).Return(activeToken(), nil)
+		refreshTokenRepo.EXPECT().Revoke(ctx, uint(3), gomock.Any()).Return(false, nil)
+
+		interactor := usecase.NewRefreshTokenUsecase(newPassThroughUnitOfWork(ctrl), repositorymock.NewMockUserRepository(ctrl), refreshTokenRepo, testKeyring, testSessionTTL)
+		_, err := interactor.Execute(ctx, usecase.RefreshTokenInput{RefreshToken: plain})
+
+		statusCode, _, _, _ := apperror.HTTPStatus(err)
+		assert.Equal(t, http.StatusUnauthorized, statusCode)
+	})
+
+	t.Run("error_db_error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		refreshTokenRepo := repositorymock.NewMockRefreshTokenRepository(ctrl)
+		refreshTokenRepo.EXPECT().FindByHash(ctx, gomock.Any()).Return(nil, errors.New("db error"))
+
+		interactor := usecase.NewRefreshTokenUsecase(nil, repositorymock.NewMockUserRepository(ctrl), refreshTokenRepo, testKeyring, testSessionTTL)
+		_, err := interactor.Execute(ctx, usecase.RefreshTokenInput{RefreshToken: plain})
+
+		statusCode, _, _, _ := apperror.HTTPStatus(err)
+		assert.Equal(t, http.StatusInternalServerError, statusCode)
+	})
+}
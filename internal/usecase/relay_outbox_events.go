@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+)
+
+// RelayOutboxEventsInputPort は outbox に溜まったイベントを購読者へ配るユースケースの入力ポートです。
+type RelayOutboxEventsInputPort interface {
+	Execute(context.Context, RelayOutboxEventsInput) (*RelayOutboxEventsOutput, error)
+}
+
+// OutboxRelayPolicy は1回に配るイベントの件数と、配れなかったときに配り直す間隔・回数です。
+type OutboxRelayPolicy struct {
+	BatchSize int
+	// MaxAttempts はイベントを dead にするまでに配ろうとする回数です。
+	MaxAttempts uint
+	// RetryBaseDelay は1回目の失敗の後に待つ時間です。失敗するたびに倍にし、RetryMaxDelay で頭打ちにします。
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+}
+
+// retryDelay は attempts 回失敗したイベントを配り直すまでに待つ時間です。
+func (p OutboxRelayPolicy) retryDelay(attempts uint) time.Duration {
+	delay := p.RetryBaseDelay
+	for n := uint(1); n < attempts && delay < p.RetryMaxDelay; n++ {
+		delay *= 2
+	}
+	return min(delay, p.RetryMaxDelay)
+}
+
+type RelayOutboxEventsInput struct {
+	// Now はこの時刻までに配るべきイベントを読む基準で、配り直す時刻もここから決めます。
+	Now time.Time
+}
+
+// RelayOutboxEventsOutput は1回の実行で読んだイベントの行き先ごとの件数です。
+type RelayOutboxEventsOutput struct {
+	Delivered    int
+	Retried      int
+	DeadLettered int
+}
+
+// Processed は1回の実行で読んだイベントの件数です。0 になるまで繰り返せば、今配るべきイベントを配り切れます。
+func (o *RelayOutboxEventsOutput) Processed() int {
+	return o.Delivered + o.Retried + o.DeadLettered
+}
+
+// RelayOutboxEventsInteractor は outbox のイベントを古い順に読み、EventDispatcher で購読者へ配ります。
+// 配り終えたと記録する前に止まると次の実行でもう一度配るため、購読者へは少なくとも1回届きます。
+type RelayOutboxEventsInteractor struct {
+	OutboxRepository repository.OutboxRepository
+	EventDispatcher  event.Dispatcher
+	Policy           OutboxRelayPolicy
+}
+
+func NewRelayOutboxEventsUsecase(
+	outboxRepository repository.OutboxRepository,
+	eventDispatcher event.Dispatcher,
+	policy OutboxRelayPolicy,
+) RelayOutboxEventsInputPort {
+	return &RelayOutboxEventsInteractor{
+		OutboxRepository: outboxRepository,
+		EventDispatcher:  eventDispatcher,
+		Policy:           policy,
+	}
+}
+
+func (i *RelayOutboxEventsInteractor) Execute(ctx context.Context, input RelayOutboxEventsInput) (*RelayOutboxEventsOutput, error) {
+	rows, err := i.OutboxRepository.FindDue(ctx, input.Now, i.Policy.BatchSize)
+	if err != nil {
+		return nil, fmt.Errorf("relay: find due events: %w", err)
+	}
+
+	output := &RelayOutboxEventsOutput{}
+	for _, row := range rows {
+		// 結果を記録できないときは DB に届かない状態なので、残りも配らずに止める
+		if err := i.relay(ctx, row, input.Now, output); err != nil {
+			return nil, fmt.Errorf("relay: record result of event %d: %w", row.ID, err)
+		}
+	}
+	return output, nil
+}
+
+// relay は1件のイベントを配り、配れたか・配り直すか・あきらめるかを outbox に記録します。
+func (i *RelayOutboxEventsInteractor) relay(ctx context.Context, row *model.OutboxEvent, now time.Time, output *RelayOutboxEventsOutput) error {
+	attempts := row.Attempts + 1
+
+	e, err := event.Decode(row.Name, row.Payload)
+	if err != nil {
+		// 型に戻せないイベントは何度配り直しても失敗するため、すぐにあきらめる
+		slog.ErrorContext(ctx, "relay: event dead-lettered", "id", row.ID, "event", row.Name, "err", err)
+		output.DeadLettered++
+		return i.OutboxRepository.MarkDead(ctx, row.ID, attempts, err.Error())
+	}
+
+	if err := i.EventDispatcher.Dispatch(event.WithOutboxID(ctx, row.ID), e); err != nil {
+		if attempts >= i.Policy.MaxAttempts {
+			slog.ErrorContext(ctx, "relay: event dead-lettered", "id", row.ID, "event", row.Name, "attempts", attempts, "err", err)
+			output.DeadLettered++
+			return i.OutboxRepository.MarkDead(ctx, row.ID, attempts, err.Error())
+		}
+		nextAttemptAt := now.Add(i.Policy.retryDelay(attempts))
+		slog.WarnContext(ctx, "relay: event will be retried", "id", row.ID, "event", row.Name, "attempts", attempts, "next_attempt_at", nextAttemptAt, "err", err)
+		output.Retried++
+		return i.OutboxRepository.MarkRetry(ctx, row.ID, attempts, nextAttemptAt, err.Error())
+	}
+
+	output.Delivered++
+	return i.OutboxRepository.MarkDelivered(ctx, row.ID, now)
+}
+
+// DrainOutboxEvents は読んだイベントが0件になるまで relay を繰り返し、配った件数の合計を返します。
+// 配り直しになったイベントは next_attempt_at が先に延びるため、同じイベントを読み続けることはありません。
+func DrainOutboxEvents(ctx context.Context, relay RelayOutboxEventsInputPort, now func() time.Time) (RelayOutboxEventsOutput, error) {
+	var total RelayOutboxEventsOutput
+	for ctx.Err() == nil {
+		output, err := relay.Execute(ctx, RelayOutboxEventsInput{Now: now()})
+		if err != nil {
+			return total, err
+		}
+		if output.Processed() == 0 {
+			break
+		}
+		total.Delivered += output.Delivered
+		total.Retried += output.Retried
+		total.DeadLettered += output.DeadLettered
+	}
+	return total, nil
+}
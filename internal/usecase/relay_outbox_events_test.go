@@ -0,0 +1,229 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
+	eventmock "github.com/daisuke-harada/date-courses-go/internal/domain/event/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	usecasemock "github.com/daisuke-harada/date-courses-go/internal/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRelayOutboxEventsInteractor_Execute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	policy := usecase.OutboxRelayPolicy{
+		BatchSize:      50,
+		MaxAttempts:    5,
+		RetryBaseDelay: 30 * time.Second,
+		RetryMaxDelay:  2 * time.Minute,
+	}
+	followed := &model.OutboxEvent{ID: 1, Name: event.NameUserFollowed, Payload: []byte(`{"follower_id":1,"followed_user_id":2}`)}
+
+	t.Run("success_marks_delivered", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		outbox := repositorymock.NewMockOutboxRepository(ctrl)
+		dispatcher := eventmock.NewMockDispatcher(ctrl)
+		outbox.EXPECT().FindDue(ctx, now, 50).Return([]*model.OutboxEvent{followed}, nil)
+		// ハンドラーが配り直しを見分けられるよう、outbox の ID を ctx に載せて配る
+		dispatcher.EXPECT().Dispatch(gomock.Any(), event.UserFollowed{FollowerID: 1, FollowedUserID: 2}).
+			DoAndReturn(func(ctx context.Context, _ event.Event) error {
+				id, ok := event.OutboxIDFromContext(ctx)
+				assert.True(t, ok)
+				assert.Equal(t, uint(1), id)
+				return nil
+			})
+		outbox.EXPECT().MarkDelivered(ctx, uint(1), now).Return(nil)
+
+		interactor := usecase.NewRelayOutboxEventsUsecase(outbox, dispatcher, policy)
+		output, err := interactor.Execute(ctx, usecase.RelayOutboxEventsInput{Now: now})
+
+		require.NoError(t, err)
+		assert.Equal(t, &usecase.RelayOutboxEventsOutput{Delivered: 1}, output)
+		assert.Equal(t, 1, output.Processed())
+	})
+
+	// 失敗するたびに待つ時間を倍にし、上限で頭打ちにする
+	t.Run("success_retries_with_backoff", func(t *testing.T) {
+		tests := []struct {
+			name          string
+			attempts      uint
+			wantAttempts  uint
+			wantNextDelay time.Duration
+		}{
+			{name: "first_failure", attempts: 0, wantAttempts: 1, wantNextDelay: 30 * time.Second},
+			{name: "second_failure", attempts: 1, wantAttempts: 2, wantNextDelay: time.Minute},
+			{name: "capped_at_max_delay", attempts: 3, wantAttempts: 4, wantNextDelay: 2 * time.Minute},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				row := *followed
+				row.Attempts = tt.attempts
+				outbox := repositorymock.NewMockOutboxRepository(ctrl)
+				dispatcher := eventmock.NewMockDispatcher(ctrl)
+				outbox.EXPECT().FindDue(ctx, now, 50).Return([]*model.OutboxEvent{&row}, nil)
+				dispatcher.EXPECT().Dispatch(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+				outbox.EXPECT().MarkRetry(ctx, uint(1), tt.wantAttempts, now.Add(tt.wantNextDelay), "db error").Return(nil)
+
+				interactor := usecase.NewRelayOutboxEventsUsecase(outbox, dispatcher, policy)
+				output, err := interactor.Execute(ctx, usecase.RelayOutboxEventsInput{Now: now})
+
+				require.NoError(t, err)
+				assert.Equal(t, &usecase.RelayOutboxEventsOutput{Retried: 1}, output)
+			})
+		}
+	})
+
+	t.Run("success_dead_letters_after_max_attempts", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		row := *followed
+		row.Attempts = 4
+		outbox := repositorymock.NewMockOutboxRepository(ctrl)
+		dispatcher := eventmock.NewMockDispatcher(ctrl)
+		outbox.EXPECT().FindDue(ctx, now, 50).Return([]*model.OutboxEvent{&row}, nil)
+		dispatcher.EXPECT().Dispatch(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+		outbox.EXPECT().MarkDead(ctx, uint(1), uint(5), "db error").Return(nil)
+
+		interactor := usecase.NewRelayOutboxEventsUsecase(outbox, dispatcher, policy)
+		output, err := interactor.Execute(ctx, usecase.RelayOutboxEventsInput{Now: now})
+
+		require.NoError(t, err)
+		assert.Equal(t, &usecase.RelayOutboxEventsOutput{DeadLettered: 1}, output)
+	})
+
+	// 型に戻せないイベントは配り直しても直らないため、購読者へ配らずにすぐ dead にする
+	t.Run("success_dead_letters_unknown_event_immediately", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		outbox := repositorymock.NewMockOutboxRepository(ctrl)
+		dispatcher := eventmock.NewMockDispatcher(ctrl)
+		outbox.EXPECT().
+			FindDue(ctx, now, 50).
+			Return([]*model.OutboxEvent{{ID: 2, Name: "course.archived", Payload: []byte(`{}`)}}, nil)
+		outbox.EXPECT().MarkDead(ctx, uint(2), uint(1), gomock.Any()).Return(nil)
+
+		interactor := usecase.NewRelayOutboxEventsUsecase(outbox, dispatcher, policy)
+		output, err := interactor.Execute(ctx, usecase.RelayOutboxEventsInput{Now: now})
+
+		require.NoError(t, err)
+		assert.Equal(t, &usecase.RelayOutboxEventsOutput{DeadLettered: 1}, output)
+	})
+
+	t.Run("success_no_due_events", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		outbox := repositorymock.NewMockOutboxRepository(ctrl)
+		outbox.EXPECT().FindDue(ctx, now, 50).Return(nil, nil)
+
+		interactor := usecase.NewRelayOutboxEventsUsecase(outbox, eventmock.NewMockDispatcher(ctrl), policy)
+		output, err := interactor.Execute(ctx, usecase.RelayOutboxEventsInput{Now: now})
+
+		require.NoError(t, err)
+		assert.Zero(t, output.Processed())
+	})
+
+	// 結果を記録できないときは、残りのイベントを配らずに止める
+	t.Run("error_mark_failed_stops_relay", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		second := *followed
+		second.ID = 3
+		outbox := repositorymock.NewMockOutboxRepository(ctrl)
+		dispatcher := eventmock.NewMockDispatcher(ctrl)
+		outbox.EXPECT().FindDue(ctx, now, 50).Return([]*model.OutboxEvent{followed, &second}, nil)
+		dispatcher.EXPECT().Dispatch(gomock.Any(), gomock.Any()).Return(nil)
+		outbox.EXPECT().MarkDelivered(ctx, uint(1), now).Return(errors.New("db error"))
+
+		interactor := usecase.NewRelayOutboxEventsUsecase(outbox, dispatcher, policy)
+		output, err := interactor.Execute(ctx, usecase.RelayOutboxEventsInput{Now: now})
+
+		assert.Nil(t, output)
+		require.Error(t, err)
+	})
+
+	t.Run("error_find_due_failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		outbox := repositorymock.NewMockOutboxRepository(ctrl)
+		outbox.EXPECT().FindDue(ctx, now, 50).Return(nil, errors.New("db error"))
+
+		interactor := usecase.NewRelayOutboxEventsUsecase(outbox, eventmock.NewMockDispatcher(ctrl), policy)
+		output, err := interactor.Execute(ctx, usecase.RelayOutboxEventsInput{Now: now})
+
+		assert.Nil(t, output)
+		require.Error(t, err)
+	})
+}
+
+func TestDrainOutboxEvents(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	// 読んだイベントが0件になるまで繰り返し、件数を足し合わせる
+	t.Run("success_repeats_until_nothing_is_due", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		relay := usecasemock.NewMockRelayOutboxEventsInputPort(ctrl)
+		gomock.InOrder(
+			relay.EXPECT().Execute(ctx, usecase.RelayOutboxEventsInput{Now: now}).Return(&usecase.RelayOutboxEventsOutput{Delivered: 50}, nil),
+			relay.EXPECT().Execute(ctx, usecase.RelayOutboxEventsInput{Now: now}).Return(&usecase.RelayOutboxEventsOutput{Delivered: 3, Retried: 1, DeadLettered: 1}, nil),
+			relay.EXPECT().Execute(ctx, usecase.RelayOutboxEventsInput{Now: now}).Return(&usecase.RelayOutboxEventsOutput{}, nil),
+		)
+
+		total, err := usecase.DrainOutboxEvents(ctx, relay, clock)
+
+		require.NoError(t, err)
+		assert.Equal(t, usecase.RelayOutboxEventsOutput{Delivered: 53, Retried: 1, DeadLettered: 1}, total)
+	})
+
+	// 途中で失敗しても、それまでに配った件数は返す
+	t.Run("error_returns_relayed_so_far", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		relay := usecasemock.NewMockRelayOutboxEventsInputPort(ctrl)
+		gomock.InOrder(
+			relay.EXPECT().Execute(ctx, gomock.Any()).Return(&usecase.RelayOutboxEventsOutput{Delivered: 2}, nil),
+			relay.EXPECT().Execute(ctx, gomock.Any()).Return(nil, errors.New("db error")),
+		)
+
+		total, err := usecase.DrainOutboxEvents(ctx, relay, clock)
+
+		require.Error(t, err)
+		assert.Equal(t, 2, total.Delivered)
+	})
+
+	t.Run("success_stops_when_context_is_done", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		total, err := usecase.DrainOutboxEvents(canceled, usecasemock.NewMockRelayOutboxEventsInputPort(ctrl), clock)
+
+		require.NoError(t, err)
+		assert.Zero(t, total.Processed())
+	})
+}
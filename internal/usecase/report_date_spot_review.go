@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// ReviewReportThreshold は公開中のレビューを自動で保留にする、未対応の通報の件数です。
+// 設定から DI 経由で注入します。
+type ReviewReportThreshold int
+
+// maxReportCommentLen は通報に添えるコメントの最大文字数です。
+const maxReportCommentLen = 500
+
+var reportReasons = []model.ReportReason{
+	model.ReportReasonSpam,
+	model.ReportReasonAbuse,
+	model.ReportReasonInappropriate,
+	model.ReportReasonOther,
+}
+
+type ReportDateSpotReviewInputPort interface {
+	Execute(context.Context, ReportDateSpotReviewInput) (*ReportDateSpotReviewOutput, error)
+}
+
+type ReportDateSpotReviewInput struct {
+	ReviewID uint
+	// UserID は通報するユーザー（トークンの currentUser）の ID です。
+	UserID  uint
+	Reason  model.ReportReason
+	Comment *string
+}
+
+func (i *ReportDateSpotReviewInput) Validate() error {
+	var errs []string
+	if !lo.Contains(reportReasons, i.Reason) {
+		errs = append(errs, "reason は spam, abuse, inappropriate, other のいずれかを指定してください")
+	}
+	if i.Comment != nil && utf8.RuneCountInString(*i.Comment) > maxReportCommentLen {
+		errs = append(errs, "comment は500文字以内で入力してください")
+	}
+	if len(errs) > 0 {
+		return apperror.UnprocessableEntity(errs...)
+	}
+	return nil
+}
+
+type ReportDateSpotReviewOutput struct {
+	Report *model.DateSpotReviewReport
+}
+
+type ReportDateSpotReviewInteractor struct {
+	UnitOfWork               repository.UnitOfWork
+	DateSpotReviewRepository repository.DateSpotReviewRepository
+	ReviewReportThreshold    ReviewReportThreshold
+}
+
+func NewReportDateSpotReviewUsecase(
+	unitOfWork repository.UnitOfWork,
+	dateSpotReviewRepository repository.DateSpotReviewRepository,
+	reviewReportThreshold ReviewReportThreshold,
+) ReportDateSpotReviewInputPort {
+	return &ReportDateSpotReviewInteractor{
+		UnitOfWork:               unitOfWork,
+		DateSpotReviewRepository: dateSpotReviewRepository,
+		ReviewReportThreshold:    reviewReportThreshold,
+	}
+}
+
+func (i *ReportDateSpotReviewInteractor) Execute(ctx context.Context, input ReportDateSpotReviewInput) (*ReportDateSpotReviewOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	report := &model.DateSpotReviewReport{
+		DateSpotReviewID: input.ReviewID,
+		UserID:           input.UserID,
+		Reason:           input.Reason,
+		Comment:          input.Comment,
+	}
+	err := i.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		// 公開していないレビューは利用者から見えないため、存在しないものとして扱う
+		review, err := i.DateSpotReviewRepository.FindByID(ctx, input.ReviewID)
+		if err != nil || !review.Status.IsVisible() {
+			return apperror.NotFound()
+		}
+		if review.UserID == input.UserID {
+			return apperror.UnprocessableEntity("自分のレビューは通報できません")
+		}
+		exists, err := i.DateSpotReviewRepository.ExistsReport(ctx, input.ReviewID, input.UserID)
+		if err != nil {
+			return apperror.InternalServerError(err)
+		}
+		if exists {
+			return apperror.UnprocessableEntity("このレビューはすでに通報しています")
+		}
+		if err := i.DateSpotReviewRepository.CreateReport(ctx, report); err != nil {
+			// 事前の確認をすり抜けた同時の通報は一意キーで弾かれるため、同じ 422 を返す
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				return apperror.UnprocessableEntityWithCause(err, "このレビューはすでに通報しています")
+			}
+			return apperror.InternalServerError(err)
+		}
+
+		// 承認済みのレビューは管理者が判断済みのため、通報が続いても自動では保留にしない
+		if review.Status != model.ReviewStatusPublished {
+			return nil
+		}
+		count, err := i.DateSpotReviewRepository.CountOpenReports(ctx, input.ReviewID)
+		if err != nil {
+			return apperror.InternalServerError(err)
+		}
+		if count >= int64(i.ReviewReportThreshold) {
+			if err := i.DateSpotReviewRepository.UpdateStatus(ctx, input.ReviewID, model.ReviewStatusPending); err != nil {
+				return apperror.InternalServerError(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReportDateSpotReviewOutput{Report: report}, nil
+}
+
+// NewReportDateSpotReviewInput はフォーム値から Input を組み立てます。
+// 通報者はトークンから決めるため、userID は呼び出し側が渡します。
+func NewReportDateSpotReviewInput(reviewID int, userID uint, reasonStr, commentStr string) ReportDateSpotReviewInput {
+	var comment *string
+	if c := strings.TrimSpace(commentStr); c != "" {
+		comment = &c
+	}
+	return ReportDateSpotReviewInput{
+		ReviewID: uint(reviewID),
+		UserID:   userID,
+		Reason:   model.ReportReason(reasonStr),
+		Comment:  comment,
+	}
+}
@@ -0,0 +1,174 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repomock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestReportDateSpotReviewInteractor_Execute(t *testing.T) {
+	ctx := context.Background()
+	input := usecase.ReportDateSpotReviewInput{ReviewID: 5, UserID: 2, Reason: model.ReportReasonSpam}
+
+	t.Run("success_below_threshold_keeps_published", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reviewRepo := repomock.NewMockDateSpotReviewRepository(ctrl)
+		reviewRepo.EXPECT().FindByID(ctx, uint(5)).Return(&model.DateSpotReview{ID: 5, UserID: 1, Status: model.ReviewStatusPublished}, nil)
+		reviewRepo.EXPECT().ExistsReport(ctx, uint(5), uint(2)).Return(false, nil)
+		reviewRepo.EXPECT().
+			CreateReport(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, r *model.DateSpotReviewReport) error {
+				r.ID = 9
+				return nil
+			})
+		reviewRepo.EXPECT().CountOpenReports(ctx, uint(5)).Return(int64(2), nil)
+
+		interactor := usecase.NewReportDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo, 3)
+		output, err := interactor.Execute(ctx, input)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(9), output.Report.ID)
+		assert.Equal(t, model.ReportReasonSpam, output.Report.Reason)
+	})
+
+	// 未対応の通報がしきい値に達したら、管理者が確認するまで公開を止める
+	t.Run("success_reaching_threshold_holds_review", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reviewRepo := repomock.NewMockDateSpotReviewRepository(ctrl)
+		reviewRepo.EXPECT().FindByID(ctx, uint(5)).Return(&model.DateSpotReview{ID: 5, UserID: 1, Status: model.ReviewStatusPublished}, nil)
+		reviewRepo.EXPECT().ExistsReport(ctx, uint(5), uint(2)).Return(false, nil)
+		reviewRepo.EXPECT().CreateReport(ctx, gomock.Any()).Return(nil)
+		reviewRepo.EXPECT().CountOpenReports(ctx, uint(5)).Return(int64(3), nil)
+		reviewRepo.EXPECT().UpdateStatus(ctx, uint(5), model.ReviewStatusPending).Return(nil)
+
+		interactor := usecase.NewReportDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo, 3)
+		_, err := interactor.Execute(ctx, input)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("success_approved_review_is_not_held", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reviewRepo := repomock.NewMockDateSpotReviewRepository(ctrl)
+		reviewRepo.EXPECT().FindByID(ctx, uint(5)).Return(&model.DateSpotReview{ID: 5, UserID: 1, Status: model.ReviewStatusApproved}, nil)
+		reviewRepo.EXPECT().ExistsReport(ctx, uint(5), uint(2)).Return(false, nil)
+		reviewRepo.EXPECT().CreateReport(ctx, gomock.Any()).Return(nil)
+
+		interactor := usecase.NewReportDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo, 1)
+		_, err := interactor.Execute(ctx, input)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("error_invalid_reason", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reviewRepo := repomock.NewMockDateSpotReviewRepository(ctrl)
+
+		interactor := usecase.NewReportDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo, 3)
+		output, err := interactor.Execute(ctx, usecase.ReportDateSpotReviewInput{ReviewID: 5, UserID: 2, Reason: "boring"})
+
+		assert.Nil(t, output)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+
+	t.Run("error_not_found_when_hidden", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reviewRepo := repomock.NewMockDateSpotReviewRepository(ctrl)
+		reviewRepo.EXPECT().FindByID(ctx, uint(5)).Return(&model.DateSpotReview{ID: 5, UserID: 1, Status: model.ReviewStatusHidden}, nil)
+
+		interactor := usecase.NewReportDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo, 3)
+		_, err := interactor.Execute(ctx, input)
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, statusCode)
+	})
+
+	t.Run("error_not_found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reviewRepo := repomock.NewMockDateSpotReviewRepository(ctrl)
+		reviewRepo.EXPECT().FindByID(ctx, uint(5)).Return(nil, errors.New("record not found"))
+
+		interactor := usecase.NewReportDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo, 3)
+		_, err := interactor.Execute(ctx, input)
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, statusCode)
+	})
+
+	t.Run("error_own_review", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reviewRepo := repomock.NewMockDateSpotReviewRepository(ctrl)
+		reviewRepo.EXPECT().FindByID(ctx, uint(5)).Return(&model.DateSpotReview{ID: 5, UserID: 2, Status: model.ReviewStatusPublished}, nil)
+
+		interactor := usecase.NewReportDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo, 3)
+		_, err := interactor.Execute(ctx, input)
+
+		statusCode, messages, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+		assert.Equal(t, []string{"自分のレビューは通報できません"}, messages)
+	})
+
+	t.Run("error_already_reported", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reviewRepo := repomock.NewMockDateSpotReviewRepository(ctrl)
+		reviewRepo.EXPECT().FindByID(ctx, uint(5)).Return(&model.DateSpotReview{ID: 5, UserID: 1, Status: model.ReviewStatusPublished}, nil)
+		reviewRepo.EXPECT().ExistsReport(ctx, uint(5), uint(2)).Return(true, nil)
+
+		interactor := usecase.NewReportDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo, 3)
+		_, err := interactor.Execute(ctx, input)
+
+		statusCode, messages, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+		assert.Equal(t, []string{"このレビューはすでに通報しています"}, messages)
+	})
+	// 事前の確認をすり抜けた同時の通報は、一意キーの違反を 422 にする
+	t.Run("error_reported_concurrently", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reviewRepo := repomock.NewMockDateSpotReviewRepository(ctrl)
+		reviewRepo.EXPECT().FindByID(ctx, uint(5)).Return(&model.DateSpotReview{ID: 5, UserID: 1, Status: model.ReviewStatusPublished}, nil)
+		reviewRepo.EXPECT().ExistsReport(ctx, uint(5), uint(2)).Return(false, nil)
+		reviewRepo.EXPECT().CreateReport(ctx, gomock.Any()).Return(gorm.ErrDuplicatedKey)
+
+		interactor := usecase.NewReportDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo, 3)
+		_, err := interactor.Execute(ctx, input)
+
+		statusCode, messages, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+		assert.Equal(t, []string{"このレビューはすでに通報しています"}, messages)
+	})
+}
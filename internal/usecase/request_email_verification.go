@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+)
+
+// RequestEmailVerificationInputPort はメールアドレス確認メールの再送ユースケースの入力ポートです。
+type RequestEmailVerificationInputPort interface {
+	Execute(context.Context, RequestEmailVerificationInput) error
+}
+
+type RequestEmailVerificationInput struct {
+	UserID uint
+}
+
+type RequestEmailVerificationInteractor struct {
+	UnitOfWork           repository.UnitOfWork
+	UserRepository       repository.UserRepository
+	EmailTokenRepository repository.EmailTokenRepository
+	Mailer               repository.Mailer
+	EmailTokenSettings   EmailTokenSettings
+}
+
+func NewRequestEmailVerificationUsecase(
+	unitOfWork repository.UnitOfWork,
+	userRepository repository.UserRepository,
+	emailTokenRepository repository.EmailTokenRepository,
+	mailer repository.Mailer,
+	emailTokenSettings EmailTokenSettings,
+) RequestEmailVerificationInputPort {
+	return &RequestEmailVerificationInteractor{
+		UnitOfWork:           unitOfWork,
+		UserRepository:       userRepository,
+		EmailTokenRepository: emailTokenRepository,
+		Mailer:               mailer,
+		EmailTokenSettings:   emailTokenSettings,
+	}
+}
+
+// Execute は確認メールを送り直します。前に送ったメールのリンクは使えなくなります。
+// 確認済みのときは何もしません。
+func (i *RequestEmailVerificationInteractor) Execute(ctx context.Context, input RequestEmailVerificationInput) error {
+	user, err := i.UserRepository.FindByID(ctx, input.UserID)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+	if user.IsEmailVerified() {
+		return nil
+	}
+
+	var token string
+	err = i.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		var err error
+		token, err = issueEmailToken(ctx, i.EmailTokenRepository, user, model.EmailTokenPurposeVerifyEmail, i.EmailTokenSettings.VerifyEmailTTL)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// 利用者が明示的に送り直しを求めているため、送れなかったことはエラーで伝える
+	if err := i.Mailer.Send(ctx, newVerifyEmailMessage(user, i.EmailTokenSettings, token)); err != nil {
+		return apperror.InternalServerError(err)
+	}
+	return nil
+}
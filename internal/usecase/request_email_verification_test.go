@@ -0,0 +1,82 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRequestEmailVerificationInteractor_Execute(t *testing.T) {
+	t.Run("success_sends_new_link", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ctx := context.Background()
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(&model.User{ID: 1, Name: "alice", Email: "alice@example.com"}, nil)
+
+		// 前に送ったリンクを無効にしてから新しいトークンを保存する
+		tokenRepo := repositorymock.NewMockEmailTokenRepository(ctrl)
+		gomock.InOrder(
+			tokenRepo.EXPECT().InvalidateByUserID(ctx, uint(1), model.EmailTokenPurposeVerifyEmail, gomock.Any()).Return(nil),
+			tokenRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, token *model.EmailToken) error {
+				assert.Equal(t, "alice@example.com", token.Email)
+				assert.WithinDuration(t, time.Now().Add(24*time.Hour), token.ExpiresAt, time.Minute)
+				return nil
+			}),
+		)
+		mailer, sent := newRecordingMailer(ctrl)
+
+		interactor := usecase.NewRequestEmailVerificationUsecase(newPassThroughUnitOfWork(ctrl), userRepo, tokenRepo, mailer, testEmailTokenSettings)
+		err := interactor.Execute(ctx, usecase.RequestEmailVerificationInput{UserID: 1})
+
+		require.NoError(t, err)
+		require.Len(t, *sent, 1)
+		assert.Equal(t, "alice@example.com", (*sent)[0].To)
+		assert.Contains(t, (*sent)[0].Body, "http://localhost:3000/verify-email?token=")
+		assert.Contains(t, (*sent)[0].Body, "24時間")
+	})
+
+	t.Run("success_noop_when_already_verified", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ctx := context.Background()
+
+		verifiedAt := time.Now()
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(&model.User{ID: 1, Email: "alice@example.com", EmailVerifiedAt: &verifiedAt}, nil)
+
+		interactor := usecase.NewRequestEmailVerificationUsecase(nil, userRepo, repositorymock.NewMockEmailTokenRepository(ctrl), repositorymock.NewMockMailer(ctrl), testEmailTokenSettings)
+		err := interactor.Execute(ctx, usecase.RequestEmailVerificationInput{UserID: 1})
+
+		require.NoError(t, err)
+	})
+
+	// 利用者が明示的に送り直しを求めているため、送れなかったことはエラーで伝える
+	t.Run("error_mail_failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ctx := context.Background()
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(&model.User{ID: 1, Email: "alice@example.com"}, nil)
+		mailer := repositorymock.NewMockMailer(ctrl)
+		mailer.EXPECT().Send(ctx, gomock.Any()).Return(errors.New("smtp down"))
+
+		interactor := usecase.NewRequestEmailVerificationUsecase(newPassThroughUnitOfWork(ctrl), userRepo, newStoringEmailTokenRepository(ctrl), mailer, testEmailTokenSettings)
+		err := interactor.Execute(ctx, usecase.RequestEmailVerificationInput{UserID: 1})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, 500, statusCode)
+	})
+}
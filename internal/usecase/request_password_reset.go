@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// RequestPasswordResetInputPort はパスワード再設定メールの送信ユースケースの入力ポートです。
+type RequestPasswordResetInputPort interface {
+	Execute(context.Context, RequestPasswordResetInput) error
+}
+
+type RequestPasswordResetInput struct {
+	Email string
+}
+
+func (i *RequestPasswordResetInput) Validate() error {
+	if strings.TrimSpace(i.Email) == "" {
+		return apperror.UnprocessableEntity("メールアドレスを入力してください")
+	}
+	if !emailRegex.MatchString(i.Email) {
+		return apperror.UnprocessableEntity("メールアドレスは正しい形式で入力してください")
+	}
+	return nil
+}
+
+type RequestPasswordResetInteractor struct {
+	UnitOfWork           repository.UnitOfWork
+	UserRepository       repository.UserRepository
+	EmailTokenRepository repository.EmailTokenRepository
+	Mailer               repository.Mailer
+	EmailTokenSettings   EmailTokenSettings
+	DemoUserName         DemoUserName
+}
+
+func NewRequestPasswordResetUsecase(
+	unitOfWork repository.UnitOfWork,
+	userRepository repository.UserRepository,
+	emailTokenRepository repository.EmailTokenRepository,
+	mailer repository.Mailer,
+	emailTokenSettings EmailTokenSettings,
+	demoUserName DemoUserName,
+) RequestPasswordResetInputPort {
+	return &RequestPasswordResetInteractor{
+		UnitOfWork:           unitOfWork,
+		UserRepository:       userRepository,
+		EmailTokenRepository: emailTokenRepository,
+		Mailer:               mailer,
+		EmailTokenSettings:   emailTokenSettings,
+		DemoUserName:         demoUserName,
+	}
+}
+
+// Execute は email のユーザーにパスワード再設定のメールを送ります。
+//
+// 登録されていないメールアドレスでも、送信に失敗しても、同じく成功として返します。
+// 結果で区別できると、このエンドポイントでメールアドレスが登録済みかを調べられてしまうためです。
+// デモ用アカウントはパスワードを変えられると他の閲覧者がログインできなくなるため、メールを送りません。
+func (i *RequestPasswordResetInteractor) Execute(ctx context.Context, input RequestPasswordResetInput) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	user, err := i.UserRepository.FindByEmail(ctx, input.Email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			slog.InfoContext(ctx, "password reset requested for unknown email")
+			return nil
+		}
+		return apperror.InternalServerError(err)
+	}
+	if verifyNotDemoUser(user, i.DemoUserName) != nil {
+		return nil
+	}
+
+	var token string
+	err = i.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		var err error
+		token, err = issueEmailToken(ctx, i.EmailTokenRepository, user, model.EmailTokenPurposeResetPassword, i.EmailTokenSettings.ResetPasswordTTL)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	sendMailQuietly(ctx, i.Mailer, newResetPasswordMessage(user, i.EmailTokenSettings, token))
+	return nil
+}
@@ -0,0 +1,84 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestRequestPasswordResetInteractor_Execute(t *testing.T) {
+	t.Run("success_sends_reset_link", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ctx := context.Background()
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().FindByEmail(ctx, "alice@example.com").Return(&model.User{ID: 1, Name: "alice", Email: "alice@example.com"}, nil)
+		tokenRepo := repositorymock.NewMockEmailTokenRepository(ctrl)
+		tokenRepo.EXPECT().InvalidateByUserID(ctx, uint(1), model.EmailTokenPurposeResetPassword, gomock.Any()).Return(nil)
+		tokenRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, token *model.EmailToken) error {
+			assert.Equal(t, model.EmailTokenPurposeResetPassword, token.Purpose)
+			assert.WithinDuration(t, time.Now().Add(time.Hour), token.ExpiresAt, time.Minute)
+			return nil
+		})
+		mailer, sent := newRecordingMailer(ctrl)
+
+		interactor := usecase.NewRequestPasswordResetUsecase(newPassThroughUnitOfWork(ctrl), userRepo, tokenRepo, mailer, testEmailTokenSettings, "guest")
+		err := interactor.Execute(ctx, usecase.RequestPasswordResetInput{Email: "alice@example.com"})
+
+		require.NoError(t, err)
+		require.Len(t, *sent, 1)
+		assert.Contains(t, (*sent)[0].Body, "http://localhost:3000/password/reset?token=")
+		assert.Contains(t, (*sent)[0].Body, "1時間")
+	})
+
+	// 登録済みかどうかを調べられないよう、知らないアドレスでも成功として返す
+	t.Run("success_unknown_email_sends_nothing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ctx := context.Background()
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().FindByEmail(ctx, "nobody@example.com").Return(nil, gorm.ErrRecordNotFound)
+
+		interactor := usecase.NewRequestPasswordResetUsecase(nil, userRepo, repositorymock.NewMockEmailTokenRepository(ctrl), repositorymock.NewMockMailer(ctrl), testEmailTokenSettings, "guest")
+		err := interactor.Execute(ctx, usecase.RequestPasswordResetInput{Email: "nobody@example.com"})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("success_demo_user_sends_nothing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ctx := context.Background()
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().FindByEmail(ctx, "guest@example.com").Return(&model.User{ID: 2, Name: "guest", Email: "guest@example.com"}, nil)
+
+		interactor := usecase.NewRequestPasswordResetUsecase(nil, userRepo, repositorymock.NewMockEmailTokenRepository(ctrl), repositorymock.NewMockMailer(ctrl), testEmailTokenSettings, "guest")
+		err := interactor.Execute(ctx, usecase.RequestPasswordResetInput{Email: "guest@example.com"})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("error_validation_invalid_email", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		interactor := usecase.NewRequestPasswordResetUsecase(nil, repositorymock.NewMockUserRepository(ctrl), repositorymock.NewMockEmailTokenRepository(ctrl), repositorymock.NewMockMailer(ctrl), testEmailTokenSettings, "guest")
+		err := interactor.Execute(context.Background(), usecase.RequestPasswordResetInput{Email: "not-an-email"})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, 422, statusCode)
+	})
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+)
+
+// ResetPasswordInputPort はパスワード再設定ユースケースの入力ポートです。
+type ResetPasswordInputPort interface {
+	Execute(context.Context, ResetPasswordInput) error
+}
+
+type ResetPasswordInput struct {
+	Token                string
+	Password             string
+	PasswordConfirmation string
+}
+
+func (i *ResetPasswordInput) Validate() error {
+	var errs []string
+	if i.Token == "" {
+		errs = append(errs, "トークンを指定してください")
+	}
+	if i.Password == "" {
+		errs = append(errs, "パスワードを入力してください")
+	} else if len(i.Password) < 6 {
+		errs = append(errs, "パスワードは6文字以上で入力してください")
+	}
+	if i.Password != i.PasswordConfirmation {
+		errs = append(errs, "パスワード（確認）が一致しません")
+	}
+	if len(errs) > 0 {
+		return apperror.UnprocessableEntity(errs...)
+	}
+	return nil
+}
+
+type ResetPasswordInteractor struct {
+	UnitOfWork             repository.UnitOfWork
+	UserRepository         repository.UserRepository
+	EmailTokenRepository   repository.EmailTokenRepository
+	RefreshTokenRepository repository.RefreshTokenRepository
+}
+
+func NewResetPasswordUsecase(
+	unitOfWork repository.UnitOfWork,
+	userRepository repository.UserRepository,
+	emailTokenRepository repository.EmailTokenRepository,
+	refreshTokenRepository repository.RefreshTokenRepository,
+) ResetPasswordInputPort {
+	return &ResetPasswordInteractor{
+		UnitOfWork:             unitOfWork,
+		UserRepository:         userRepository,
+		EmailTokenRepository:   emailTokenRepository,
+		RefreshTokenRepository: refreshTokenRepository,
+	}
+}
+
+// Execute は再設定メールのトークンを使い、パスワードを変えます。
+// パスワードを忘れた端末以外に残っているセッションは、乗っ取られている可能性もあるためすべて無効にします。
+// メールのリンクを開けたことでメールアドレスの持ち主だと確かめられるため、未確認なら確認済みにします。
+func (i *ResetPasswordInteractor) Execute(ctx context.Context, input ResetPasswordInput) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	return i.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		user, err := consumeEmailToken(ctx, i.EmailTokenRepository, i.UserRepository, model.EmailTokenPurposeResetPassword, input.Token)
+		if err != nil {
+			return err
+		}
+
+		if err := user.SetPassword(input.Password); err != nil {
+			return apperror.InternalServerError(err)
+		}
+		now := time.Now()
+		if !user.IsEmailVerified() {
+			user.EmailVerifiedAt = &now
+		}
+		if err := i.UserRepository.Update(ctx, user); err != nil {
+			return apperror.InternalServerError(err)
+		}
+		if err := i.EmailTokenRepository.InvalidateByUserID(ctx, user.ID, model.EmailTokenPurposeResetPassword, now); err != nil {
+			return apperror.InternalServerError(err)
+		}
+		// トークンバージョンも上げるため、発行済みのアクセストークンも使えなくなる
+		return revokeAllSessions(ctx, i.UserRepository, i.RefreshTokenRepository, user.ID)
+	})
+}
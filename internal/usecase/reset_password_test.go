@@ -0,0 +1,83 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+func TestResetPasswordInteractor_Execute(t *testing.T) {
+	const plain = "plain-reset-token"
+	validInput := usecase.ResetPasswordInput{Token: plain, Password: "newpassword", PasswordConfirmation: "newpassword"}
+
+	t.Run("success_changes_password_and_revokes_sessions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ctx := context.Background()
+
+		tokenRepo := repositorymock.NewMockEmailTokenRepository(ctrl)
+		tokenRepo.EXPECT().FindByHash(ctx, model.EmailTokenPurposeResetPassword, model.HashEmailToken(plain)).
+			Return(&model.EmailToken{ID: 3, UserID: 1, Purpose: model.EmailTokenPurposeResetPassword, Email: "alice@example.com", ExpiresAt: time.Now().Add(time.Hour)}, nil)
+		tokenRepo.EXPECT().MarkUsed(ctx, uint(3), gomock.Any()).Return(true, nil)
+		// 同時に発行した別の再設定リンクも使えなくする
+		tokenRepo.EXPECT().InvalidateByUserID(ctx, uint(1), model.EmailTokenPurposeResetPassword, gomock.Any()).Return(nil)
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(&model.User{ID: 1, Email: "alice@example.com", PasswordDigest: "old", TokenVersion: 2}, nil)
+		userRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, u *model.User) error {
+			assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(u.PasswordDigest), []byte("newpassword")))
+			assert.True(t, u.IsEmailVerified(), "リンクを開けたのでアドレスの持ち主と確かめられる")
+			return nil
+		})
+		// 読んでから保存すると同時の更新で消えるため、DB 上で上げて発行済みのアクセストークンを無効にする
+		userRepo.EXPECT().IncrementTokenVersion(ctx, uint(1)).Return(nil)
+
+		refreshTokenRepo := repositorymock.NewMockRefreshTokenRepository(ctrl)
+		refreshTokenRepo.EXPECT().RevokeAllByUserID(ctx, uint(1), gomock.Any()).Return(nil)
+
+		interactor := usecase.NewResetPasswordUsecase(newPassThroughUnitOfWork(ctrl), userRepo, tokenRepo, refreshTokenRepo)
+		err := interactor.Execute(ctx, validInput)
+
+		require.NoError(t, err)
+	})
+
+	// 確認用のトークンではパスワードを変えられない
+	t.Run("error_token_of_other_purpose", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ctx := context.Background()
+
+		tokenRepo := repositorymock.NewMockEmailTokenRepository(ctrl)
+		tokenRepo.EXPECT().FindByHash(ctx, model.EmailTokenPurposeResetPassword, gomock.Any()).Return(nil, gorm.ErrRecordNotFound)
+
+		interactor := usecase.NewResetPasswordUsecase(newPassThroughUnitOfWork(ctrl), repositorymock.NewMockUserRepository(ctrl), tokenRepo, repositorymock.NewMockRefreshTokenRepository(ctrl))
+		err := interactor.Execute(ctx, validInput)
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, 422, statusCode)
+	})
+
+	t.Run("error_validation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		interactor := usecase.NewResetPasswordUsecase(nil, repositorymock.NewMockUserRepository(ctrl), repositorymock.NewMockEmailTokenRepository(ctrl), repositorymock.NewMockRefreshTokenRepository(ctrl))
+		err := interactor.Execute(context.Background(), usecase.ResetPasswordInput{Token: plain, Password: "short", PasswordConfirmation: "other"})
+
+		statusCode, messages, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, 422, statusCode)
+		assert.Len(t, messages, 2)
+	})
+}
@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/master"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+)
+
+// SpotMatchDecision は確認待ちの取り込み結果に対する管理者の判断です。
+type SpotMatchDecision string
+
+const (
+	// SpotMatchDecisionMerge は照合相手と同じ場所と判断し、照合相手のスポットに統合します。
+	SpotMatchDecisionMerge SpotMatchDecision = "merge"
+	// SpotMatchDecisionSeparate は別の場所と判断し、取り込み結果から新しいスポットを作ります。
+	SpotMatchDecisionSeparate SpotMatchDecision = "separate"
+)
+
+type ResolveSpotMatchInputPort interface {
+	Execute(context.Context, ResolveSpotMatchInput) (*ResolveSpotMatchOutput, error)
+}
+
+// ResolveSpotMatchInput は管理者が確認待ちの取り込み結果を統合するか別のスポットにするかの指定です。
+// 管理者かどうかはハンドラーで確かめます。
+type ResolveSpotMatchInput struct {
+	SpotSourceID uint
+	Decision     SpotMatchDecision
+}
+
+func (i *ResolveSpotMatchInput) Validate() error {
+	if i.Decision != SpotMatchDecisionMerge && i.Decision != SpotMatchDecisionSeparate {
+		return apperror.UnprocessableEntity("decision は merge, separate のいずれかを指定してください")
+	}
+	return nil
+}
+
+var errSpotSourceNotPending = apperror.UnprocessableEntity("この取り込み結果は確認待ちではありません")
+
+type ResolveSpotMatchOutput struct {
+	SpotSource *model.SpotSource
+}
+
+type ResolveSpotMatchInteractor struct {
+	UnitOfWork           repository.UnitOfWork
+	SpotSourceRepository repository.SpotSourceRepository
+	DateSpotRepository   repository.DateSpotRepository
+	DateSpotSearchIndex  repository.DateSpotSearchIndex
+}
+
+func NewResolveSpotMatchUsecase(
+	unitOfWork repository.UnitOfWork,
+	spotSourceRepository repository.SpotSourceRepository,
+	dateSpotRepository repository.DateSpotRepository,
+	dateSpotSearchIndex repository.DateSpotSearchIndex,
+) ResolveSpotMatchInputPort {
+	return &ResolveSpotMatchInteractor{
+		UnitOfWork:           unitOfWork,
+		SpotSourceRepository: spotSourceRepository,
+		DateSpotRepository:   dateSpotRepository,
+		DateSpotSearchIndex:  dateSpotSearchIndex,
+	}
+}
+
+func (i *ResolveSpotMatchInteractor) Execute(ctx context.Context, input ResolveSpotMatchInput) (*ResolveSpotMatchOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	var source *model.SpotSource
+	err := i.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		var err error
+		source, err = i.SpotSourceRepository.FindByID(ctx, input.SpotSourceID)
+		if err != nil {
+			return err
+		}
+		if source.Status != model.SpotSourceStatusPending {
+			return errSpotSourceNotPending
+		}
+
+		spot := newDateSpotFromSource(source)
+		switch input.Decision {
+		case SpotMatchDecisionMerge:
+			if err := i.DateSpotRepository.FillMissing(ctx, source.DateSpotID, spot); err != nil {
+				return apperror.InternalServerError(err)
+			}
+		case SpotMatchDecisionSeparate:
+			if err := i.DateSpotRepository.Create(ctx, spot); err != nil {
+				return apperror.InternalServerError(err)
+			}
+			source.DateSpotID = spot.ID
+			source.DateSpot = spot
+		}
+		// 読んでから書くまでに別の管理者が判断していたら、作ったスポットごと取り消す
+		if err := i.SpotSourceRepository.Link(ctx, source.ID, source.DateSpotID); err != nil {
+			if errors.Is(err, repository.ErrSpotSourceNotPending) {
+				return errSpotSourceNotPending
+			}
+			return apperror.InternalServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 検索インデックスは定期的に DB から作り直されるため、反映に失敗しても判断自体は成功とする
+	if input.Decision == SpotMatchDecisionSeparate {
+		if err := i.DateSpotSearchIndex.Put(ctx, source.DateSpot); err != nil {
+			slog.WarnContext(ctx, "dateSpotSearchIndex.Put failed", "err", err, "date_spot_id", source.DateSpotID)
+		}
+	}
+
+	source.Status = model.SpotSourceStatusLinked
+	return &ResolveSpotMatchOutput{SpotSource: source}, nil
+}
+
+// newDateSpotFromSource は取り込み記録からスポットを組み立てます。
+// 営業時間・休業日は取り込み記録に残していないため、別のスポットにしたときは空のまま登録します。
+func newDateSpotFromSource(source *model.SpotSource) *model.DateSpot {
+	mapsURL := source.PageURL
+	if mapsURL == nil {
+		u := BuildMapsURL(source.Name, master.PrefectureNameByID(source.PrefectureID))
+		mapsURL = &u
+	}
+	return &model.DateSpot{
+		Name:           source.Name,
+		CityName:       source.CityName,
+		GenreID:        &source.GenreID,
+		PrefectureID:   &source.PrefectureID,
+		Source:         source.Source,
+		MapsURL:        mapsURL,
+		NormalizedName: NormalizeName(source.Name),
+		Image:          source.Image,
+		Latitude:       source.Latitude,
+		Longitude:      source.Longitude,
+	}
+}
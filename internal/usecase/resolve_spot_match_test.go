@@ -0,0 +1,169 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	repomock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResolveSpotMatchInteractor_Execute(t *testing.T) {
+	ctx := context.Background()
+	pageURL := "https://www.hotpepper.jp/strJ001/"
+	pendingSource := func() *model.SpotSource {
+		return &model.SpotSource{
+			ID:           3,
+			DateSpotID:   7,
+			GenreID:      3,
+			PrefectureID: 13,
+			Source:       model.DateSpotSourceHotPepper,
+			Name:         "スターバックスコーヒー渋谷店",
+			CityName:     "東京都渋谷区道玄坂1-1",
+			PageURL:      &pageURL,
+			Status:       model.SpotSourceStatusPending,
+		}
+	}
+
+	t.Run("success_merge_fills_matched_spot", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sourceRepo := repomock.NewMockSpotSourceRepository(ctrl)
+		dateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
+		sourceRepo.EXPECT().FindByID(ctx, uint(3)).Return(pendingSource(), nil)
+		dateSpotRepo.EXPECT().
+			FillMissing(ctx, uint(7), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint, from *model.DateSpot) error {
+				assert.Equal(t, &pageURL, from.MapsURL)
+				return nil
+			})
+		sourceRepo.EXPECT().Link(ctx, uint(3), uint(7)).Return(nil)
+		searchIndex := repomock.NewMockDateSpotSearchIndex(ctrl)
+
+		interactor := usecase.NewResolveSpotMatchUsecase(newPassThroughUnitOfWork(ctrl), sourceRepo, dateSpotRepo, searchIndex)
+		output, err := interactor.Execute(ctx, usecase.ResolveSpotMatchInput{SpotSourceID: 3, Decision: usecase.SpotMatchDecisionMerge})
+
+		require.NoError(t, err)
+		assert.Equal(t, model.SpotSourceStatusLinked, output.SpotSource.Status)
+		assert.Equal(t, uint(7), output.SpotSource.DateSpotID)
+	})
+
+	t.Run("success_separate_creates_new_spot", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sourceRepo := repomock.NewMockSpotSourceRepository(ctrl)
+		dateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
+		sourceRepo.EXPECT().FindByID(ctx, uint(3)).Return(pendingSource(), nil)
+		dateSpotRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, spot *model.DateSpot) error {
+				assert.Equal(t, "スターバックスコーヒー渋谷店", spot.Name)
+				assert.Equal(t, usecase.NormalizeName(spot.Name), spot.NormalizedName)
+				assert.Equal(t, model.DateSpotSourceHotPepper, spot.Source)
+				spot.ID = 20
+				return nil
+			})
+		sourceRepo.EXPECT().Link(ctx, uint(3), uint(20)).Return(nil)
+		// 新しく作ったスポットは検索インデックスにも載せる
+		searchIndex := repomock.NewMockDateSpotSearchIndex(ctrl)
+		searchIndex.EXPECT().Put(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, spot *model.DateSpot) error {
+			assert.Equal(t, uint(20), spot.ID)
+			return nil
+		})
+
+		interactor := usecase.NewResolveSpotMatchUsecase(newPassThroughUnitOfWork(ctrl), sourceRepo, dateSpotRepo, searchIndex)
+		output, err := interactor.Execute(ctx, usecase.ResolveSpotMatchInput{SpotSourceID: 3, Decision: usecase.SpotMatchDecisionSeparate})
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(20), output.SpotSource.DateSpotID)
+	})
+
+	t.Run("error_decision_not_allowed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		interactor := usecase.NewResolveSpotMatchUsecase(newPassThroughUnitOfWork(ctrl), repomock.NewMockSpotSourceRepository(ctrl), repomock.NewMockDateSpotRepository(ctrl), repomock.NewMockDateSpotSearchIndex(ctrl))
+		_, err := interactor.Execute(ctx, usecase.ResolveSpotMatchInput{SpotSourceID: 3, Decision: "delete"})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+
+	t.Run("error_already_linked", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sourceRepo := repomock.NewMockSpotSourceRepository(ctrl)
+		linked := pendingSource()
+		linked.Status = model.SpotSourceStatusLinked
+		sourceRepo.EXPECT().FindByID(ctx, uint(3)).Return(linked, nil)
+
+		interactor := usecase.NewResolveSpotMatchUsecase(newPassThroughUnitOfWork(ctrl), sourceRepo, repomock.NewMockDateSpotRepository(ctrl), repomock.NewMockDateSpotSearchIndex(ctrl))
+		_, err := interactor.Execute(ctx, usecase.ResolveSpotMatchInput{SpotSourceID: 3, Decision: usecase.SpotMatchDecisionMerge})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+
+	t.Run("error_not_found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sourceRepo := repomock.NewMockSpotSourceRepository(ctrl)
+		sourceRepo.EXPECT().FindByID(ctx, uint(404)).Return(nil, apperror.NotFound())
+
+		interactor := usecase.NewResolveSpotMatchUsecase(newPassThroughUnitOfWork(ctrl), sourceRepo, repomock.NewMockDateSpotRepository(ctrl), repomock.NewMockDateSpotSearchIndex(ctrl))
+		_, err := interactor.Execute(ctx, usecase.ResolveSpotMatchInput{SpotSourceID: 404, Decision: usecase.SpotMatchDecisionMerge})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, statusCode)
+	})
+
+	// 見つからない以外の失敗は 404 にせず、そのまま返す
+	t.Run("error_find_failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sourceRepo := repomock.NewMockSpotSourceRepository(ctrl)
+		sourceRepo.EXPECT().FindByID(ctx, uint(3)).Return(nil, apperror.InternalServerError(errors.New("db error")))
+
+		interactor := usecase.NewResolveSpotMatchUsecase(newPassThroughUnitOfWork(ctrl), sourceRepo, repomock.NewMockDateSpotRepository(ctrl), repomock.NewMockDateSpotSearchIndex(ctrl))
+		_, err := interactor.Execute(ctx, usecase.ResolveSpotMatchInput{SpotSourceID: 3, Decision: usecase.SpotMatchDecisionMerge})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusInternalServerError, statusCode)
+	})
+
+	// 読んだ後に別の管理者が先に判断していたら、確認待ちでないものとして扱い検索インデックスにも載せない
+	t.Run("error_resolved_concurrently", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sourceRepo := repomock.NewMockSpotSourceRepository(ctrl)
+		dateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
+		sourceRepo.EXPECT().FindByID(ctx, uint(3)).Return(pendingSource(), nil)
+		dateSpotRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		sourceRepo.EXPECT().Link(ctx, uint(3), gomock.Any()).Return(repository.ErrSpotSourceNotPending)
+
+		interactor := usecase.NewResolveSpotMatchUsecase(newPassThroughUnitOfWork(ctrl), sourceRepo, dateSpotRepo, repomock.NewMockDateSpotSearchIndex(ctrl))
+		_, err := interactor.Execute(ctx, usecase.ResolveSpotMatchInput{SpotSourceID: 3, Decision: usecase.SpotMatchDecisionSeparate})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+}
@@ -0,0 +1,278 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/master"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/samber/lo"
+)
+
+// DateSpotBatchTarget は収集する都道府県 × ジャンルの組み合わせです。
+type DateSpotBatchTarget struct {
+	Prefecture master.Prefecture
+	Genre      master.Genre
+}
+
+// DateSpotBatchPolicy は1回に実行する組み合わせの数と、実行済みの組み合わせをやり直すまでの間隔です。
+type DateSpotBatchPolicy struct {
+	MaxTasks int
+	// RerunAfter を過ぎるまでは、成功・スキップした組み合わせを実行しません。
+	// 閉店で減ったスポットは、この間隔ごとに補充されます。
+	RerunAfter time.Duration
+}
+
+// RunDateSpotBatchInput はスポット収集バッチの1回の実行の入力パラメータです。
+type RunDateSpotBatchInput struct {
+	// Now は実行の開始日時で、やり直す組み合わせもここから RerunAfter を遡って決めます。
+	Now time.Time
+	// PrefectureIDs・GenreIDs は対象を絞る条件です。空ならすべての都道府県・ジャンルを対象にします。
+	PrefectureIDs []int
+	GenreIDs      []int
+	// Force は台帳を見ずに、対象の組み合わせを先頭から実行します。
+	Force bool
+	// DryRun は登録も台帳への記録もせず、登録するはずのスポットをログに出すだけにします。
+	DryRun bool
+}
+
+func (in RunDateSpotBatchInput) Validate() error {
+	for _, id := range in.PrefectureIDs {
+		if master.PrefectureByID(id) == nil {
+			return fmt.Errorf("batch: unknown prefecture id %d", id)
+		}
+	}
+	for _, id := range in.GenreIDs {
+		if master.GenreNameByID(id) == "" {
+			return fmt.Errorf("batch: unknown genre id %d", id)
+		}
+	}
+	return nil
+}
+
+// RunDateSpotBatchOutput は実行の記録と、実行した組み合わせごとの結果です。
+// DryRun のときは ID の入っていない、記録するはずだった値です。
+type RunDateSpotBatchOutput struct {
+	Run   *model.BatchRun
+	Tasks []*model.BatchTask
+}
+
+// maxBatchTaskErrorLength は台帳に残すエラーメッセージの長さ（文字数）の上限です。
+const maxBatchTaskErrorLength = 1000
+
+// RunDateSpotBatchInteractor は都道府県 × ジャンルの組み合わせを順に BatchCreateDateSpotsInputPort で処理し、
+// 実行と組み合わせごとの結果を台帳（batch_runs・batch_tasks）に残します。
+// 次の実行は台帳を見て、まだ成功していない組み合わせから続けます。
+type RunDateSpotBatchInteractor struct {
+	runRepo   repository.BatchRunRepository
+	collector BatchCreateDateSpotsInputPort
+	policy    DateSpotBatchPolicy
+}
+
+func NewRunDateSpotBatchInteractor(
+	runRepo repository.BatchRunRepository,
+	collector BatchCreateDateSpotsInputPort,
+	policy DateSpotBatchPolicy,
+) *RunDateSpotBatchInteractor {
+	return &RunDateSpotBatchInteractor{
+		runRepo:   runRepo,
+		collector: collector,
+		policy:    policy,
+	}
+}
+
+// Execute は ctx が取り消されると、実行中の組み合わせを終えたところで止めます。
+// 止めた実行も台帳に記録するため、台帳への書き込みには取り消されない ctx を使います。
+func (i *RunDateSpotBatchInteractor) Execute(ctx context.Context, input RunDateSpotBatchInput) (*RunDateSpotBatchOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	targets, err := i.plan(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("batch: plan tasks: %w", err)
+	}
+
+	ledgerCtx := context.WithoutCancel(ctx)
+	run := &model.BatchRun{Status: model.BatchRunStatusRunning, StartedAt: input.Now}
+	if !input.DryRun {
+		if err := i.runRepo.CreateRun(ledgerCtx, run); err != nil {
+			return nil, fmt.Errorf("batch: create run: %w", err)
+		}
+	}
+	slog.InfoContext(ctx, "batch: start", "run_id", run.ID, "tasks", len(targets), "force", input.Force, "dry_run", input.DryRun)
+
+	output := &RunDateSpotBatchOutput{Run: run}
+	for _, target := range targets {
+		if ctx.Err() != nil {
+			slog.WarnContext(ctx, "batch: interrupted, remaining tasks resume in the next run", "remaining", len(targets)-run.TaskCount)
+			run.Status = model.BatchRunStatusInterrupted
+			break
+		}
+
+		task := i.runTask(ctx, run, target, input.DryRun)
+		run.AddTask(task)
+		output.Tasks = append(output.Tasks, task)
+		if !input.DryRun {
+			if err := i.runRepo.CreateTask(ledgerCtx, task); err != nil {
+				return nil, fmt.Errorf("batch: record task: %w", err)
+			}
+		}
+	}
+
+	if run.Status == model.BatchRunStatusRunning {
+		run.Status = model.BatchRunStatusCompleted
+	}
+	finishedAt := time.Now()
+	run.FinishedAt = &finishedAt
+	if !input.DryRun {
+		if err := i.runRepo.FinishRun(ledgerCtx, run); err != nil {
+			return nil, fmt.Errorf("batch: finish run: %w", err)
+		}
+	}
+
+	slog.InfoContext(ctx, "batch: completed",
+		"run_id", run.ID,
+		"status", run.Status,
+		"tasks", run.TaskCount,
+		"succeeded", run.SucceededCount,
+		"skipped", run.SkippedCount,
+		"failed", run.FailedCount,
+		"created", run.CreatedCount,
+		"merged", run.MergedCount,
+		"pending", run.PendingCount,
+		"dry_run", input.DryRun,
+	)
+	return output, nil
+}
+
+// plan は今回実行する組み合わせを、実行する順に MaxTasks 件まで返します。
+// まだ実行していない組み合わせ（途中で止めた実行の残りを含む）を先に並べ、前回失敗した組み合わせを失敗した日時の古い順に続けます。
+// 失敗し続ける組み合わせが毎回先頭に来て、まだ実行していない組み合わせを押し出さないようにするためです。
+// 成功・スキップした組み合わせは RerunAfter を過ぎたものだけを、最後に終えた日時の古い順に後ろへ並べます。
+func (i *RunDateSpotBatchInteractor) plan(ctx context.Context, input RunDateSpotBatchInput) ([]DateSpotBatchTarget, error) {
+	var targets []DateSpotBatchTarget
+	for _, pref := range master.Prefectures() {
+		if len(input.PrefectureIDs) > 0 && !lo.Contains(input.PrefectureIDs, pref.ID) {
+			continue
+		}
+		for _, genre := range master.Genres() {
+			if len(input.GenreIDs) > 0 && !lo.Contains(input.GenreIDs, genre.ID) {
+				continue
+			}
+			targets = append(targets, DateSpotBatchTarget{Prefecture: pref, Genre: genre})
+		}
+	}
+
+	if !input.Force {
+		completed, err := i.runRepo.FindLastCompletedTasks(ctx)
+		if err != nil {
+			return nil, err
+		}
+		failed, err := i.runRepo.FindLastFailedTasks(ctx)
+		if err != nil {
+			return nil, err
+		}
+		type combination struct{ prefectureID, genreID int }
+		lastFinished := func(tasks []*model.BatchTask) map[combination]time.Time {
+			last := make(map[combination]time.Time, len(tasks))
+			for _, t := range tasks {
+				last[combination{t.PrefectureID, t.GenreID}] = t.FinishedAt
+			}
+			return last
+		}
+		lastDone, lastFailed := lastFinished(completed), lastFinished(failed)
+		doneAt := func(t DateSpotBatchTarget) time.Time {
+			return lastDone[combination{t.Prefecture.ID, t.Genre.ID}]
+		}
+		// order は並べる順の組（段・段の中の日時）です。未実行は 0、前回失敗は 1、成功・スキップは 2 の段に入れます
+		order := func(t DateSpotBatchTarget) (int, time.Time) {
+			done, failedAt := doneAt(t), lastFailed[combination{t.Prefecture.ID, t.Genre.ID}]
+			switch {
+			case failedAt.After(done):
+				return 1, failedAt
+			case done.IsZero():
+				return 0, time.Time{}
+			default:
+				return 2, done
+			}
+		}
+
+		rerunBefore := input.Now.Add(-i.policy.RerunAfter)
+		targets = lo.Filter(targets, func(t DateSpotBatchTarget, _ int) bool {
+			return doneAt(t).Before(rerunBefore)
+		})
+		sort.SliceStable(targets, func(a, b int) bool {
+			rankA, atA := order(targets[a])
+			rankB, atB := order(targets[b])
+			if rankA != rankB {
+				return rankA < rankB
+			}
+			return atA.Before(atB)
+		})
+	}
+
+	if len(targets) > i.policy.MaxTasks {
+		targets = targets[:i.policy.MaxTasks]
+	}
+	return targets, nil
+}
+
+// runTask は1つの組み合わせを処理し、その結果を返します。失敗してもエラーにはせず、失敗として記録して次へ進みます。
+func (i *RunDateSpotBatchInteractor) runTask(ctx context.Context, run *model.BatchRun, target DateSpotBatchTarget, dryRun bool) *model.BatchTask {
+	task := &model.BatchTask{
+		RunID:        run.ID,
+		PrefectureID: target.Prefecture.ID,
+		GenreID:      target.Genre.ID,
+		StartedAt:    time.Now(),
+	}
+	// 止める合図は組み合わせの間でだけ見る。途中で取り消すと、取得や保存が失敗として台帳に残ってしまうため
+	out, err := i.collector.Execute(context.WithoutCancel(ctx), BatchCreateDateSpotsInput{
+		PrefectureID:   target.Prefecture.ID,
+		PrefectureName: target.Prefecture.Name,
+		PrefCode:       target.Prefecture.PrefCode,
+		GenreID:        target.Genre.ID,
+		GenreName:      target.Genre.Name,
+		DryRun:         dryRun,
+	})
+	task.FinishedAt = time.Now()
+
+	switch {
+	case err != nil:
+		slog.ErrorContext(ctx, "batch: execute failed, skipping",
+			"prefecture", target.Prefecture.Name,
+			"genre", target.Genre.Name,
+			"err", err,
+		)
+		task.Status = model.BatchTaskStatusFailed
+		task.Error = lo.ToPtr(truncateRunes(err.Error(), maxBatchTaskErrorLength))
+	case out.Skipped:
+		task.Status = model.BatchTaskStatusSkipped
+	default:
+		task.Status = model.BatchTaskStatusSucceeded
+		task.CreatedCount = len(out.NewSpots)
+		task.MergedCount = out.Merged
+		task.PendingCount = out.Pending
+		if dryRun {
+			slog.InfoContext(ctx, "batch: dry run",
+				"prefecture", target.Prefecture.Name,
+				"genre", target.Genre.Name,
+				"would_create", lo.Map(out.NewSpots, func(s *model.DateSpot, _ int) string { return s.Name }),
+				"would_merge", out.Merged,
+				"would_hold", out.Pending,
+			)
+		}
+	}
+	return task
+}
+
+func truncateRunes(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n])
+}
@@ -0,0 +1,217 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repomock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRunDateSpotBatchInteractor_Execute(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 1, 3, 0, 0, 0, time.UTC)
+	policy := usecase.DateSpotBatchPolicy{MaxTasks: 10, RerunAfter: 30 * 24 * time.Hour}
+
+	expectRun := func(runRepo *repomock.MockBatchRunRepository, finished *model.BatchRun) {
+		runRepo.EXPECT().
+			CreateRun(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, run *model.BatchRun) error {
+				run.ID = 5
+				return nil
+			})
+		runRepo.EXPECT().
+			FinishRun(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, run *model.BatchRun) error {
+				*finished = *run
+				return nil
+			})
+	}
+
+	// 未実行の組み合わせ、前回失敗した組み合わせ、RerunAfter を過ぎた組み合わせの順に実行し、最近終えた組み合わせは飛ばす
+	t.Run("success_resumes_from_unfinished_combinations", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		runRepo := repomock.NewMockBatchRunRepository(ctrl)
+		collector := &mockBatchCollector{output: &usecase.BatchCreateDateSpotsOutput{
+			NewSpots: []*model.DateSpot{{Name: "新宿マルイ"}},
+			Merged:   1,
+		}}
+
+		runRepo.EXPECT().FindLastCompletedTasks(gomock.Any()).Return([]*model.BatchTask{
+			{PrefectureID: 13, GenreID: 1, FinishedAt: now.AddDate(0, 0, -1)},
+			{PrefectureID: 13, GenreID: 2, FinishedAt: now.AddDate(0, 0, -60)},
+		}, nil)
+		runRepo.EXPECT().FindLastFailedTasks(gomock.Any()).Return([]*model.BatchTask{
+			{PrefectureID: 13, GenreID: 4, FinishedAt: now.AddDate(0, 0, -1)},
+		}, nil)
+		var finished model.BatchRun
+		expectRun(runRepo, &finished)
+		var tasks []*model.BatchTask
+		runRepo.EXPECT().
+			CreateTask(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, task *model.BatchTask) error {
+				tasks = append(tasks, task)
+				return nil
+			}).Times(3)
+
+		_, err := usecase.NewRunDateSpotBatchInteractor(runRepo, collector, policy).Execute(ctx, usecase.RunDateSpotBatchInput{
+			Now:           now,
+			PrefectureIDs: []int{13},
+			GenreIDs:      []int{1, 2, 3, 4},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []int{3, 4, 2}, lo.Map(collector.inputs, func(in usecase.BatchCreateDateSpotsInput, _ int) int { return in.GenreID }))
+		assert.Equal(t, "東京都", collector.inputs[0].PrefectureName)
+		require.Len(t, tasks, 3)
+		assert.Equal(t, uint(5), tasks[0].RunID)
+		assert.Equal(t, model.BatchTaskStatusSucceeded, tasks[0].Status)
+		assert.Equal(t, 1, tasks[0].CreatedCount)
+		assert.Equal(t, model.BatchRunStatusCompleted, finished.Status)
+		assert.Equal(t, 3, finished.TaskCount)
+		assert.Equal(t, 3, finished.CreatedCount)
+		assert.Equal(t, 3, finished.MergedCount)
+		assert.NotNil(t, finished.FinishedAt)
+	})
+
+	t.Run("success_force_ignores_ledger", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		runRepo := repomock.NewMockBatchRunRepository(ctrl)
+		collector := &mockBatchCollector{output: &usecase.BatchCreateDateSpotsOutput{Skipped: true}}
+
+		var finished model.BatchRun
+		expectRun(runRepo, &finished)
+		runRepo.EXPECT().CreateTask(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+		_, err := usecase.NewRunDateSpotBatchInteractor(runRepo, collector, usecase.DateSpotBatchPolicy{MaxTasks: 2}).
+			Execute(ctx, usecase.RunDateSpotBatchInput{Now: now, PrefectureIDs: []int{13}, Force: true})
+		require.NoError(t, err)
+
+		assert.Equal(t, []int{1, 2}, lo.Map(collector.inputs, func(in usecase.BatchCreateDateSpotsInput, _ int) int { return in.GenreID }))
+		assert.Equal(t, 2, finished.SkippedCount)
+	})
+
+	// ドライランは台帳を読むだけで、実行も組み合わせも記録しない
+	t.Run("success_dry_run_writes_nothing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		runRepo := repomock.NewMockBatchRunRepository(ctrl)
+		collector := &mockBatchCollector{output: &usecase.BatchCreateDateSpotsOutput{
+			NewSpots: []*model.DateSpot{{Name: "新宿マルイ"}},
+		}}
+
+		runRepo.EXPECT().FindLastCompletedTasks(gomock.Any()).Return(nil, nil)
+		runRepo.EXPECT().FindLastFailedTasks(gomock.Any()).Return(nil, nil)
+
+		got, err := usecase.NewRunDateSpotBatchInteractor(runRepo, collector, policy).Execute(ctx, usecase.RunDateSpotBatchInput{
+			Now:           now,
+			PrefectureIDs: []int{13},
+			GenreIDs:      []int{3},
+			DryRun:        true,
+		})
+		require.NoError(t, err)
+
+		require.Len(t, collector.inputs, 1)
+		assert.True(t, collector.inputs[0].DryRun)
+		require.Len(t, got.Tasks, 1)
+		assert.Equal(t, 1, got.Tasks[0].CreatedCount)
+		assert.Equal(t, 1, got.Run.CreatedCount)
+	})
+
+	t.Run("success_records_failed_task_and_continues", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		runRepo := repomock.NewMockBatchRunRepository(ctrl)
+		collector := &mockBatchCollector{
+			output: &usecase.BatchCreateDateSpotsOutput{},
+			errs:   map[int]error{1: errors.New("hotpepper: api error")},
+		}
+
+		runRepo.EXPECT().FindLastCompletedTasks(gomock.Any()).Return(nil, nil)
+		runRepo.EXPECT().FindLastFailedTasks(gomock.Any()).Return(nil, nil)
+		var finished model.BatchRun
+		expectRun(runRepo, &finished)
+		var tasks []*model.BatchTask
+		runRepo.EXPECT().
+			CreateTask(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, task *model.BatchTask) error {
+				tasks = append(tasks, task)
+				return nil
+			}).Times(2)
+
+		_, err := usecase.NewRunDateSpotBatchInteractor(runRepo, collector, policy).Execute(ctx, usecase.RunDateSpotBatchInput{
+			Now:           now,
+			PrefectureIDs: []int{13},
+			GenreIDs:      []int{1, 2},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, tasks, 2)
+		assert.Equal(t, model.BatchTaskStatusFailed, tasks[0].Status)
+		assert.Equal(t, "hotpepper: api error", lo.FromPtr(tasks[0].Error))
+		assert.Equal(t, model.BatchTaskStatusSucceeded, tasks[1].Status)
+		assert.Equal(t, 1, finished.FailedCount)
+		assert.Equal(t, model.BatchRunStatusCompleted, finished.Status)
+	})
+
+	// 止められたら実行中の組み合わせを終えたところで抜け、止めたことを記録する
+	t.Run("success_stops_when_interrupted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		runRepo := repomock.NewMockBatchRunRepository(ctrl)
+		runCtx, cancel := context.WithCancel(ctx)
+		collector := &mockBatchCollector{output: &usecase.BatchCreateDateSpotsOutput{}, onExecute: cancel}
+
+		runRepo.EXPECT().FindLastCompletedTasks(gomock.Any()).Return(nil, nil)
+		runRepo.EXPECT().FindLastFailedTasks(gomock.Any()).Return(nil, nil)
+		var finished model.BatchRun
+		expectRun(runRepo, &finished)
+		runRepo.EXPECT().CreateTask(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+		_, err := usecase.NewRunDateSpotBatchInteractor(runRepo, collector, policy).Execute(runCtx, usecase.RunDateSpotBatchInput{
+			Now:           now,
+			PrefectureIDs: []int{13},
+		})
+		require.NoError(t, err)
+
+		assert.Len(t, collector.inputs, 1)
+		assert.NoError(t, collector.ctxErr)
+		assert.Equal(t, model.BatchRunStatusInterrupted, finished.Status)
+		assert.Equal(t, 1, finished.TaskCount)
+	})
+
+	t.Run("error_unknown_prefecture", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		runRepo := repomock.NewMockBatchRunRepository(ctrl)
+
+		_, err := usecase.NewRunDateSpotBatchInteractor(runRepo, &mockBatchCollector{}, policy).
+			Execute(ctx, usecase.RunDateSpotBatchInput{Now: now, PrefectureIDs: []int{48}})
+		require.Error(t, err)
+	})
+}
+
+type mockBatchCollector struct {
+	output *usecase.BatchCreateDateSpotsOutput
+	// errs はジャンル ID ごとに返すエラーです。
+	errs      map[int]error
+	onExecute func()
+	inputs    []usecase.BatchCreateDateSpotsInput
+	// ctxErr は onExecute の後に見た ctx.Err() です。
+	ctxErr error
+}
+
+func (m *mockBatchCollector) Execute(ctx context.Context, input usecase.BatchCreateDateSpotsInput) (*usecase.BatchCreateDateSpotsOutput, error) {
+	m.inputs = append(m.inputs, input)
+	if m.onExecute != nil {
+		m.onExecute()
+	}
+	m.ctxErr = ctx.Err()
+	if err := m.errs[input.GenreID]; err != nil {
+		return nil, err
+	}
+	return m.output, nil
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	jwtpkg "github.com/daisuke-harada/date-courses-go/internal/pkg/jwt"
+)
+
+// SessionTTL はアクセストークンとリフレッシュトークンの有効期間です。
+// 設定から DI 経由で注入します。
+type SessionTTL struct {
+	AccessToken  time.Duration
+	RefreshToken time.Duration
+}
+
+// issueSession はアクセストークンと、DB に保存したリフレッシュトークンを発行します。
+// ログイン・新規登録・リフレッシュで共通して使います。
+func issueSession(
+	ctx context.Context,
+	refreshTokenRepository repository.RefreshTokenRepository,
+	user *model.User,
+	keyring *jwtpkg.Keyring,
+	ttl SessionTTL,
+) (accessToken string, refreshToken string, err error) {
+	accessToken, err = keyring.Encode(jwtpkg.Claims{UserID: user.ID, TokenVersion: user.TokenVersion}, ttl.AccessToken)
+	if err != nil {
+		return "", "", apperror.InternalServerError(err)
+	}
+
+	stored, refreshToken, err := model.NewRefreshToken(user.ID, time.Now().Add(ttl.RefreshToken))
+	if err != nil {
+		return "", "", apperror.InternalServerError(err)
+	}
+	if err := refreshTokenRepository.Create(ctx, stored); err != nil {
+		return "", "", apperror.InternalServerError(err)
+	}
+	return accessToken, refreshToken, nil
+}
+
+// revokeAllSessions はユーザーのトークンバージョンを上げ、リフレッシュトークンをすべて失効させます。
+// 発行済みのアクセストークンも、次のリクエストから JWTAuthMiddleware で拒否されます。
+func revokeAllSessions(
+	ctx context.Context,
+	userRepository repository.UserRepository,
+	refreshTokenRepository repository.RefreshTokenRepository,
+	userID uint,
+) error {
+	if err := userRepository.IncrementTokenVersion(ctx, userID); err != nil {
+		return apperror.InternalServerError(err)
+	}
+	if err := refreshTokenRepository.RevokeAllByUserID(ctx, userID, time.Now()); err != nil {
+		return apperror.InternalServerError(err)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"regexp"
 	"strings"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
 	jwtpkg "github.com/daisuke-harada/date-courses-go/internal/pkg/jwt"
+	"gorm.io/gorm"
 )
 
 // emailRegex は Rails の validates_format_of :email と同等の正規表現です。
@@ -28,7 +30,8 @@ type SignupInput struct {
 	Gender               model.Gender
 	Password             string
 	PasswordConfirmation string
-	Image                *string
+	// Image はアップロードされた画像ファイルの中身です。nil のときは画像なしで登録します。
+	Image []byte
 }
 
 // Validate はサインアップの入力データをバリデーションします。
@@ -77,27 +80,47 @@ func (i *SignupInput) Validate() error {
 
 // SignupOutput はサインアップの出力データです。
 type SignupOutput struct {
-	User  *model.User
-	Token string
+	User         *model.User
+	Token        string
+	RefreshToken string
 }
 
 type SignupInteractor struct {
-	UserRepository repository.UserRepository
-	AuthService    service.AuthService
-	JWTSecretKey   JWTSecretKey
+	UnitOfWork             repository.UnitOfWork
+	UserRepository         repository.UserRepository
+	RefreshTokenRepository repository.RefreshTokenRepository
+	AuthService            service.AuthService
+	ImageService           service.ImageService
+	EmailTokenRepository   repository.EmailTokenRepository
+	Mailer                 repository.Mailer
+	Keyring                *jwtpkg.Keyring
+	SessionTTL             SessionTTL
+	EmailTokenSettings     EmailTokenSettings
 }
 
-// jwtSecretKey は可変長引数ではなく JWTSecretKey 型で受け取る。
-// 可変長引数だと DI コンテナ（dig）が値を渡せず、常に空文字になってしまう。
 func NewSignupUsecase(
+	unitOfWork repository.UnitOfWork,
 	userRepository repository.UserRepository,
+	refreshTokenRepository repository.RefreshTokenRepository,
 	authService service.AuthService,
-	jwtSecretKey JWTSecretKey,
+	imageService service.ImageService,
+	emailTokenRepository repository.EmailTokenRepository,
+	mailer repository.Mailer,
+	keyring *jwtpkg.Keyring,
+	sessionTTL SessionTTL,
+	emailTokenSettings EmailTokenSettings,
 ) SignupInputPort {
 	return &SignupInteractor{
-		UserRepository: userRepository,
-		AuthService:    authService,
-		JWTSecretKey:   jwtSecretKey,
+		UnitOfWork:             unitOfWork,
+		UserRepository:         userRepository,
+		RefreshTokenRepository: refreshTokenRepository,
+		AuthService:            authService,
+		ImageService:           imageService,
+		EmailTokenRepository:   emailTokenRepository,
+		Mailer:                 mailer,
+		Keyring:                keyring,
+		SessionTTL:             sessionTTL,
+		EmailTokenSettings:     emailTokenSettings,
 	}
 }
 
@@ -107,6 +130,8 @@ func (i *SignupInteractor) Execute(ctx context.Context, input SignupInput) (*Sig
 		return nil, err
 	}
 
+	// 登録済みのメールアドレスなら、画像を保存する前に断る。先に保存すると、断った登録の画像がストレージに残る。
+	// この確認はロックを取らないため、同時に届いた登録は一意インデックスで弾き、同じ 422 を返す
 	emailExists, err := i.UserRepository.ExistsByEmail(ctx, input.Email)
 	if err != nil {
 		return nil, apperror.InternalServerError(err)
@@ -115,31 +140,57 @@ func (i *SignupInteractor) Execute(ctx context.Context, input SignupInput) (*Sig
 		return nil, apperror.UnprocessableEntity("メールアドレスはすでに存在します")
 	}
 
-	// ─── パスワードハッシュ化（Rails の has_secure_password / bcrypt に対応）
-	passwordDigest, err := i.AuthService.HashPassword(input.Password)
-	if err != nil {
-		return nil, apperror.InternalServerError(err)
+	// 画像の変換と保存は時間がかかるため、トランザクションを開く前に済ませる
+	var image *model.Image
+	if input.Image != nil {
+		stored, err := i.ImageService.Store(ctx, model.ImageKindUser, input.Image)
+		if err != nil {
+			return nil, err
+		}
+		image = stored
 	}
 
-	user := model.NewUser(input.Name, input.Email, input.Gender, input.Image, passwordDigest)
+	var user *model.User
+	var verifyToken string
+	err = i.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		// ─── パスワードハッシュ化（Rails の has_secure_password / bcrypt に対応）
+		passwordDigest, err := i.AuthService.HashPassword(input.Password)
+		if err != nil {
+			return apperror.InternalServerError(err)
+		}
+
+		user = model.NewUser(input.Name, input.Email, input.Gender, image, passwordDigest)
 
-	if err := i.UserRepository.Create(ctx, user); err != nil {
-		return nil, apperror.InternalServerError(err)
+		if err := i.UserRepository.Create(ctx, user); err != nil {
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				return apperror.UnprocessableEntityWithCause(err, "メールアドレスはすでに存在します")
+			}
+			return apperror.InternalServerError(err)
+		}
+
+		verifyToken, err = issueEmailToken(ctx, i.EmailTokenRepository, user, model.EmailTokenPurposeVerifyEmail, i.EmailTokenSettings.VerifyEmailTTL)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	// 確認メールが届かなくても登録は済ませ、ログイン後に送り直してもらう
+	sendMailQuietly(ctx, i.Mailer, newVerifyEmailMessage(user, i.EmailTokenSettings, verifyToken))
+
 	// 登録直後にそのままログイン状態にできるよう、ログインと同じくトークンを発行する
-	token, err := jwtpkg.Encode(user.ID, string(i.JWTSecretKey))
+	token, refreshToken, err := issueSession(ctx, i.RefreshTokenRepository, user, i.Keyring, i.SessionTTL)
 	if err != nil {
-		return nil, apperror.InternalServerError(err)
+		return nil, err
 	}
 
-	return &SignupOutput{User: user, Token: token}, nil
+	return &SignupOutput{User: user, Token: token, RefreshToken: refreshToken}, nil
 }
 
-// NewSignupInput builds SignupInput from raw string form values.
+// NewSignupInput builds SignupInput from raw string form values and the uploaded image bytes.
 // It converts gender string to model.Gender when possible. If genderStr is empty or invalid,
 // the zero value is used and validation will catch it.
-func NewSignupInput(name, email, genderStr, password, passwordConfirmation, imageStr string) (SignupInput, error) {
+func NewSignupInput(name, email, genderStr, password, passwordConfirmation string, image []byte) (SignupInput, error) {
 	var gender model.Gender
 	if genderStr != "" {
 		g := model.Gender(genderStr)
@@ -151,11 +202,6 @@ func NewSignupInput(name, email, genderStr, password, passwordConfirmation, imag
 		}
 	}
 
-	var image *string
-	if imageStr != "" {
-		image = &imageStr
-	}
-
 	return SignupInput{
 		Name:                 name,
 		Email:                email,
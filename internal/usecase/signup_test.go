@@ -9,11 +9,11 @@ import (
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
 	servicemock "github.com/daisuke-harada/date-courses-go/internal/domain/service/mock"
-	jwtpkg "github.com/daisuke-harada/date-courses-go/internal/pkg/jwt"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
 )
 
 func validSignupInput() usecase.SignupInput {
@@ -43,7 +43,7 @@ func TestSignupInteractor_Execute(t *testing.T) {
 		authService := servicemock.NewMockAuthService(ctrl)
 		authService.EXPECT().HashPassword("password123").Return("hashed_password", nil)
 
-		interactor := usecase.NewSignupUsecase(userRepo, authService, "test_jwt_secret")
+		interactor := usecase.NewSignupUsecase(newPassThroughUnitOfWork(ctrl), userRepo, newStoringRefreshTokenRepository(ctrl), authService, servicemock.NewMockImageService(ctrl), newStoringEmailTokenRepository(ctrl), newDiscardMailer(ctrl), testKeyring, testSessionTTL, testEmailTokenSettings)
 		output, err := interactor.Execute(ctx, validSignupInput())
 
 		require.NoError(t, err)
@@ -51,6 +51,35 @@ func TestSignupInteractor_Execute(t *testing.T) {
 		assert.Equal(t, uint(7), output.User.ID)
 		assert.NotEmpty(t, output.Token)
 	})
+	t.Run("success_with_image", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+
+		imageService := servicemock.NewMockImageService(ctrl)
+		imageService.EXPECT().
+			Store(ctx, model.ImageKindUser, []byte("image bytes")).
+			Return(&model.Image{URL: "http://localhost/uploads/u.jpg", ThumbnailURL: "http://localhost/uploads/u_thumb.jpg"}, nil)
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().ExistsByEmail(ctx, "newuser@example.com").Return(false, nil)
+		userRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		authService := servicemock.NewMockAuthService(ctrl)
+		authService.EXPECT().HashPassword("password123").Return("hashed_password", nil)
+
+		input := validSignupInput()
+		input.Image = []byte("image bytes")
+
+		interactor := usecase.NewSignupUsecase(newPassThroughUnitOfWork(ctrl), userRepo, newStoringRefreshTokenRepository(ctrl), authService, imageService, newStoringEmailTokenRepository(ctrl), newDiscardMailer(ctrl), testKeyring, testSessionTTL, testEmailTokenSettings)
+		output, err := interactor.Execute(ctx, input)
+
+		require.NoError(t, err)
+		assert.Equal(t, "http://localhost/uploads/u.jpg", *output.User.Image)
+		assert.Equal(t, "http://localhost/uploads/u_thumb.jpg", *output.User.ImageThumbnail)
+	})
+
 	t.Run("success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -67,19 +96,52 @@ func TestSignupInteractor_Execute(t *testing.T) {
 		authService := servicemock.NewMockAuthService(ctrl)
 		authService.EXPECT().HashPassword("password123").Return("hashed_password", nil)
 
-		interactor := usecase.NewSignupUsecase(userRepo, authService, "test-secret")
+		mailer, sent := newRecordingMailer(ctrl)
+
+		interactor := usecase.NewSignupUsecase(newPassThroughUnitOfWork(ctrl), userRepo, newStoringRefreshTokenRepository(ctrl), authService, servicemock.NewMockImageService(ctrl), newStoringEmailTokenRepository(ctrl), mailer, testKeyring, testSessionTTL, testEmailTokenSettings)
 		output, err := interactor.Execute(ctx, validSignupInput())
 
 		require.NoError(t, err)
 		require.NotNil(t, output)
 		assert.Equal(t, uint(5), output.User.ID)
+		assert.False(t, output.User.IsEmailVerified(), "登録直後は未確認")
+
+		// 登録したアドレスに確認メールを送る
+		require.Len(t, *sent, 1)
+		assert.Equal(t, "newuser@example.com", (*sent)[0].To)
+		assert.Contains(t, (*sent)[0].Body, "http://localhost:3000/verify-email?token=")
 
 		// 登録直後にログイン状態にできるよう、必ずトークンを発行する。
 		// 発行されないと、フロントはログイン済みに見えて何も操作できない状態になる。
 		require.NotEmpty(t, output.Token)
-		userID, err := jwtpkg.Decode(output.Token, "test-secret")
+		claims, err := testKeyring.Decode(output.Token)
+		require.NoError(t, err)
+		assert.Equal(t, uint(5), claims.UserID)
+		assert.NotEmpty(t, output.RefreshToken)
+	})
+
+	// 確認メールが届かなくても登録は済ませ、ログイン後に送り直してもらう
+	t.Run("success_even_if_mail_fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().ExistsByEmail(ctx, "newuser@example.com").Return(false, nil)
+		userRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		authService := servicemock.NewMockAuthService(ctrl)
+		authService.EXPECT().HashPassword("password123").Return("hashed_password", nil)
+
+		mailer := repositorymock.NewMockMailer(ctrl)
+		mailer.EXPECT().Send(ctx, gomock.Any()).Return(errors.New("smtp down"))
+
+		interactor := usecase.NewSignupUsecase(newPassThroughUnitOfWork(ctrl), userRepo, newStoringRefreshTokenRepository(ctrl), authService, servicemock.NewMockImageService(ctrl), newStoringEmailTokenRepository(ctrl), mailer, testKeyring, testSessionTTL, testEmailTokenSettings)
+		output, err := interactor.Execute(ctx, validSignupInput())
+
 		require.NoError(t, err)
-		assert.Equal(t, uint(5), userID)
+		assert.NotEmpty(t, output.Token)
 	})
 
 	t.Run("error_validation_invalid_gender", func(t *testing.T) {
@@ -94,7 +156,7 @@ func TestSignupInteractor_Execute(t *testing.T) {
 		input := validSignupInput()
 		input.Gender = "その他" // invalid
 
-		interactor := usecase.NewSignupUsecase(userRepo, authService, "test-secret")
+		interactor := usecase.NewSignupUsecase(newPassThroughUnitOfWork(ctrl), userRepo, newStoringRefreshTokenRepository(ctrl), authService, servicemock.NewMockImageService(ctrl), newStoringEmailTokenRepository(ctrl), newDiscardMailer(ctrl), testKeyring, testSessionTTL, testEmailTokenSettings)
 		output, err := interactor.Execute(ctx, input)
 
 		assert.Error(t, err)
@@ -115,8 +177,12 @@ func TestSignupInteractor_Execute(t *testing.T) {
 
 		authService := servicemock.NewMockAuthService(ctrl)
 
-		interactor := usecase.NewSignupUsecase(userRepo, authService, "test-secret")
-		output, err := interactor.Execute(ctx, validSignupInput())
+		// 断る登録の画像は保存しない（ImageService.Store を呼ぶとモックが失敗する）
+		input := validSignupInput()
+		input.Image = []byte("image bytes")
+
+		interactor := usecase.NewSignupUsecase(newPassThroughUnitOfWork(ctrl), userRepo, newStoringRefreshTokenRepository(ctrl), authService, servicemock.NewMockImageService(ctrl), newStoringEmailTokenRepository(ctrl), newDiscardMailer(ctrl), testKeyring, testSessionTTL, testEmailTokenSettings)
+		output, err := interactor.Execute(ctx, input)
 
 		assert.Error(t, err)
 		assert.Nil(t, output)
@@ -125,6 +191,29 @@ func TestSignupInteractor_Execute(t *testing.T) {
 		assert.Equal(t, 422, statusCode)
 	})
 
+	// 事前チェックをすり抜けた同時登録は、一意インデックスの違反を 422 にする
+	t.Run("error_email_taken_concurrently", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().ExistsByEmail(ctx, "newuser@example.com").Return(false, nil)
+		userRepo.EXPECT().Create(ctx, gomock.Any()).Return(gorm.ErrDuplicatedKey)
+
+		authService := servicemock.NewMockAuthService(ctrl)
+		authService.EXPECT().HashPassword("password123").Return("hashed_password", nil)
+
+		interactor := usecase.NewSignupUsecase(newPassThroughUnitOfWork(ctrl), userRepo, newStoringRefreshTokenRepository(ctrl), authService, servicemock.NewMockImageService(ctrl), newStoringEmailTokenRepository(ctrl), newDiscardMailer(ctrl), testKeyring, testSessionTTL, testEmailTokenSettings)
+		output, err := interactor.Execute(ctx, validSignupInput())
+
+		assert.Nil(t, output)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, 422, statusCode)
+	})
+
 	t.Run("error_repository_create_failed", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -138,7 +227,7 @@ func TestSignupInteractor_Execute(t *testing.T) {
 		authService := servicemock.NewMockAuthService(ctrl)
 		authService.EXPECT().HashPassword("password123").Return("hashed_password", nil)
 
-		interactor := usecase.NewSignupUsecase(userRepo, authService, "test-secret")
+		interactor := usecase.NewSignupUsecase(newPassThroughUnitOfWork(ctrl), userRepo, newStoringRefreshTokenRepository(ctrl), authService, servicemock.NewMockImageService(ctrl), newStoringEmailTokenRepository(ctrl), newDiscardMailer(ctrl), testKeyring, testSessionTTL, testEmailTokenSettings)
 		output, err := interactor.Execute(ctx, validSignupInput())
 
 		assert.Error(t, err)
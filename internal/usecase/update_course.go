@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
+)
+
+type UpdateCourseInputPort interface {
+	Execute(context.Context, UpdateCourseInput) (*UpdateCourseOutput, error)
+}
+
+// UpdateCourseInput はコース編集の入力です。
+// 立ち寄り先は差分ではなく編集後の全件を受け取り、並び順がそのまま訪問順になります。
+type UpdateCourseInput struct {
+	CourseID uint
+	// OperatorID は編集を実行するユーザー（トークンの currentUser）の ID です。
+	OperatorID uint
+	Stops      []CourseStopInput
+	TravelMode string
+	Authority  string
+}
+
+func (i *UpdateCourseInput) Validate() error {
+	if errs := validateCourseForm(i.Stops, i.TravelMode, i.Authority); len(errs) > 0 {
+		return apperror.UnprocessableEntity(errs...)
+	}
+	return nil
+}
+
+type UpdateCourseOutput struct {
+	Course *model.Course
+}
+
+type UpdateCourseInteractor struct {
+	UnitOfWork         repository.UnitOfWork
+	CourseRepository   repository.CourseRepository
+	DateSpotRepository repository.DateSpotRepository
+	CourseRouteService service.CourseRouteService
+	EventPublisher     event.Publisher
+}
+
+func NewUpdateCourseUsecase(
+	unitOfWork repository.UnitOfWork,
+	courseRepository repository.CourseRepository,
+	dateSpotRepository repository.DateSpotRepository,
+	courseRouteService service.CourseRouteService,
+	eventPublisher event.Publisher,
+) UpdateCourseInputPort {
+	return &UpdateCourseInteractor{
+		UnitOfWork:         unitOfWork,
+		CourseRepository:   courseRepository,
+		DateSpotRepository: dateSpotRepository,
+		CourseRouteService: courseRouteService,
+		EventPublisher:     eventPublisher,
+	}
+}
+
+func (i *UpdateCourseInteractor) Execute(ctx context.Context, input UpdateCourseInput) (*UpdateCourseOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	// 編集者から見えないコース（他人の非公開コース）は存在しないものとして扱う
+	course, err := i.CourseRepository.FindByID(ctx, input.CourseID, input.OperatorID)
+	if err != nil {
+		return nil, apperror.NotFoundWithCause(err)
+	}
+
+	// デートコースを編集できるのは作成者だけ
+	if course.UserID != input.OperatorID {
+		return nil, apperror.Forbidden("他のユーザーのデートコースは編集できません")
+	}
+
+	course.TravelMode = input.TravelMode
+	course.Authority = model.CourseAuthority(input.Authority)
+	// スポットの存在確認も同じトランザクションで行い、確認後に消されたスポットを参照しないようにする
+	err = i.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := ensureDateSpotsExist(ctx, i.DateSpotRepository, courseStopDateSpotIDs(input.Stops)); err != nil {
+			return err
+		}
+		if err := i.CourseRepository.Update(ctx, course, newDuringSpots(course.ID, input.Stops)); err != nil {
+			return apperror.InternalServerError(err)
+		}
+		if err := i.EventPublisher.Publish(ctx, event.CourseUpdated{
+			CourseID:  course.ID,
+			UserID:    course.UserID,
+			Authority: course.Authority,
+		}); err != nil {
+			return apperror.InternalServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 並び替え後の立ち寄り先とスポット情報を返すため、保存後の状態を読み直す
+	updated, err := i.CourseRepository.FindByID(ctx, input.CourseID, input.OperatorID)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if err := attachCourseRoutes(ctx, i.CourseRouteService, updated); err != nil {
+		return nil, err
+	}
+	return &UpdateCourseOutput{Course: updated}, nil
+}
@@ -0,0 +1,202 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/event"
+	eventmock "github.com/daisuke-harada/date-courses-go/internal/domain/event/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	repomock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	servicemock "github.com/daisuke-harada/date-courses-go/internal/domain/service/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUpdateCourseUsecase(t *testing.T) {
+	validInput := func() usecase.UpdateCourseInput {
+		return usecase.UpdateCourseInput{
+			CourseID:   1,
+			OperatorID: 10,
+			Stops:      []usecase.CourseStopInput{{DateSpotID: 3}, {DateSpotID: 2}},
+			TravelMode: "WALKING",
+			Authority:  "非公開",
+		}
+	}
+
+	t.Run("success_replaces_stops_in_new_order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCourseRepo := repomock.NewMockCourseRepository(ctrl)
+		mockDateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
+		mockRouteService := servicemock.NewMockCourseRouteService(ctrl)
+		publisher := eventmock.NewMockPublisher(ctrl)
+		gomock.InOrder(
+			mockCourseRepo.EXPECT().
+				FindByID(gomock.Any(), uint(1), uint(10)).
+				Return(&model.Course{ID: 1, UserID: 10, TravelMode: "DRIVING", Authority: model.CourseAuthorityPublic}, nil),
+			mockDateSpotRepo.EXPECT().
+				FindExistingIDs(gomock.Any(), []uint{3, 2}).
+				DoAndReturn(allDateSpotsExist),
+			mockCourseRepo.EXPECT().
+				Update(gomock.Any(),
+					&model.Course{ID: 1, UserID: 10, TravelMode: "WALKING", Authority: model.CourseAuthorityPrivate},
+					[]*model.DuringSpot{
+						{CourseID: 1, DateSpotID: 3, Position: 1},
+						{CourseID: 1, DateSpotID: 2, Position: 2},
+					}).
+				Return(nil),
+			publisher.EXPECT().
+				Publish(gomock.Any(), event.CourseUpdated{CourseID: 1, UserID: 10, Authority: model.CourseAuthorityPrivate}).
+				Return(nil),
+			mockCourseRepo.EXPECT().
+				FindByID(gomock.Any(), uint(1), uint(10)).
+				Return(&model.Course{ID: 1, UserID: 10, TravelMode: "WALKING", Authority: model.CourseAuthorityPrivate}, nil),
+			mockRouteService.EXPECT().
+				BuildRoute(gomock.Any(), gomock.Any()).
+				Return(&model.CourseRoute{}, nil),
+		)
+
+		uc := usecase.NewUpdateCourseUsecase(newPassThroughUnitOfWork(ctrl), mockCourseRepo, mockDateSpotRepo, mockRouteService, publisher)
+		out, err := uc.Execute(context.Background(), validInput())
+
+		require.NoError(t, err)
+		assert.Equal(t, "WALKING", out.Course.TravelMode)
+		assert.NotNil(t, out.Course.Route)
+	})
+
+	// 他人のコースを編集できないことを保証する
+	t.Run("error_forbidden_when_operator_is_not_owner", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCourseRepo := repomock.NewMockCourseRepository(ctrl)
+		mockDateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
+		mockRouteService := servicemock.NewMockCourseRouteService(ctrl)
+		mockCourseRepo.EXPECT().
+			FindByID(gomock.Any(), uint(1), gomock.Any()).
+			Return(&model.Course{ID: 1, UserID: 99}, nil)
+
+		uc := usecase.NewUpdateCourseUsecase(newPassThroughUnitOfWork(ctrl), mockCourseRepo, mockDateSpotRepo, mockRouteService, eventmock.NewMockPublisher(ctrl))
+		_, err := uc.Execute(context.Background(), validInput())
+
+		require.Error(t, err)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusForbidden, statusCode)
+	})
+
+	t.Run("error_not_found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCourseRepo := repomock.NewMockCourseRepository(ctrl)
+		mockDateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
+		mockRouteService := servicemock.NewMockCourseRouteService(ctrl)
+		mockCourseRepo.EXPECT().
+			FindByID(gomock.Any(), uint(1), gomock.Any()).
+			Return(nil, errors.New("record not found"))
+
+		uc := usecase.NewUpdateCourseUsecase(newPassThroughUnitOfWork(ctrl), mockCourseRepo, mockDateSpotRepo, mockRouteService, eventmock.NewMockPublisher(ctrl))
+		_, err := uc.Execute(context.Background(), validInput())
+
+		require.Error(t, err)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, statusCode)
+	})
+
+	t.Run("error_validation_no_date_spots", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCourseRepo := repomock.NewMockCourseRepository(ctrl)
+		mockDateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
+		mockRouteService := servicemock.NewMockCourseRouteService(ctrl)
+
+		input := validInput()
+		input.Stops = nil
+		uc := usecase.NewUpdateCourseUsecase(newPassThroughUnitOfWork(ctrl), mockCourseRepo, mockDateSpotRepo, mockRouteService, eventmock.NewMockPublisher(ctrl))
+		_, err := uc.Execute(context.Background(), input)
+
+		require.Error(t, err)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+
+	t.Run("error_validation_invalid_travel_mode", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCourseRepo := repomock.NewMockCourseRepository(ctrl)
+		mockDateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
+		mockRouteService := servicemock.NewMockCourseRouteService(ctrl)
+
+		input := validInput()
+		input.TravelMode = "FLYING"
+		uc := usecase.NewUpdateCourseUsecase(newPassThroughUnitOfWork(ctrl), mockCourseRepo, mockDateSpotRepo, mockRouteService, eventmock.NewMockPublisher(ctrl))
+		_, err := uc.Execute(context.Background(), input)
+
+		require.Error(t, err)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+
+	t.Run("error_unknown_date_spot_ids", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCourseRepo := repomock.NewMockCourseRepository(ctrl)
+		mockDateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
+		mockRouteService := servicemock.NewMockCourseRouteService(ctrl)
+		mockCourseRepo.EXPECT().
+			FindByID(gomock.Any(), uint(1), gomock.Any()).
+			Return(&model.Course{ID: 1, UserID: 10}, nil)
+		mockDateSpotRepo.EXPECT().
+			FindExistingIDs(gomock.Any(), []uint{3, 2}).
+			Return([]uint{2}, nil)
+
+		uc := usecase.NewUpdateCourseUsecase(newPassThroughUnitOfWork(ctrl), mockCourseRepo, mockDateSpotRepo, mockRouteService, eventmock.NewMockPublisher(ctrl))
+		_, err := uc.Execute(context.Background(), validInput())
+
+		require.Error(t, err)
+		statusCode, messages, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+		assert.Equal(t, []string{"存在しないデートスポットが指定されています（ID: 3）"}, messages)
+	})
+
+	t.Run("error_update_failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockCourseRepo := repomock.NewMockCourseRepository(ctrl)
+		mockDateSpotRepo := repomock.NewMockDateSpotRepository(ctrl)
+		mockRouteService := servicemock.NewMockCourseRouteService(ctrl)
+		mockCourseRepo.EXPECT().
+			FindByID(gomock.Any(), uint(1), gomock.Any()).
+			Return(&model.Course{ID: 1, UserID: 10}, nil)
+		mockDateSpotRepo.EXPECT().
+			FindExistingIDs(gomock.Any(), gomock.Any()).
+			DoAndReturn(allDateSpotsExist)
+		mockCourseRepo.EXPECT().
+			Update(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(errors.New("db error"))
+
+		uc := usecase.NewUpdateCourseUsecase(newPassThroughUnitOfWork(ctrl), mockCourseRepo, mockDateSpotRepo, mockRouteService, eventmock.NewMockPublisher(ctrl))
+		_, err := uc.Execute(context.Background(), validInput())
+
+		require.Error(t, err)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusInternalServerError, statusCode)
+	})
+}
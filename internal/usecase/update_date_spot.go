@@ -2,11 +2,13 @@ package usecase
 
 import (
 	"context"
+	"log/slog"
 	"strings"
 
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
 )
 
 // UpdateDateSpotInputPort はデートスポット更新ユースケースの入力ポートです。
@@ -21,7 +23,8 @@ type UpdateDateSpotInput struct {
 	GenreID      int
 	PrefectureID int
 	CityName     string
-	Image        *string
+	// Image はアップロードされた画像ファイルの中身です。nil のときは画像を変更しません。
+	Image []byte
 }
 
 // Validate はデートスポット更新の入力データをバリデーションします。
@@ -48,14 +51,20 @@ func (i *UpdateDateSpotInput) Validate() error {
 }
 
 type UpdateDateSpotInteractor struct {
-	DateSpotRepository repository.DateSpotRepository
+	DateSpotRepository  repository.DateSpotRepository
+	DateSpotSearchIndex repository.DateSpotSearchIndex
+	ImageService        service.ImageService
 }
 
 func NewUpdateDateSpotUsecase(
 	dateSpotRepository repository.DateSpotRepository,
+	dateSpotSearchIndex repository.DateSpotSearchIndex,
+	imageService service.ImageService,
 ) UpdateDateSpotInputPort {
 	return &UpdateDateSpotInteractor{
-		DateSpotRepository: dateSpotRepository,
+		DateSpotRepository:  dateSpotRepository,
+		DateSpotSearchIndex: dateSpotSearchIndex,
+		ImageService:        imageService,
 	}
 }
 
@@ -65,16 +74,28 @@ func (i *UpdateDateSpotInteractor) Execute(ctx context.Context, input UpdateDate
 	}
 
 	dateSpot := &model.DateSpot{
+		ID:           input.DateSpotID,
 		Name:         input.Name,
 		GenreID:      &input.GenreID,
 		PrefectureID: &input.PrefectureID,
 		CityName:     input.CityName,
-		Image:        input.Image,
+	}
+	// 画像を指定しなかったときは Image が nil のまま更新され、既存の画像が残る
+	if input.Image != nil {
+		image, err := i.ImageService.Store(ctx, model.ImageKindDateSpot, input.Image)
+		if err != nil {
+			return err
+		}
+		dateSpot.SetImage(image)
 	}
 
 	if err := i.DateSpotRepository.Update(ctx, input.DateSpotID, dateSpot); err != nil {
 		return apperror.InternalServerError(err)
 	}
+	// 検索インデックスは定期的に DB から作り直されるため、反映に失敗しても書き込み自体は成功とする
+	if err := i.DateSpotSearchIndex.Put(ctx, dateSpot); err != nil {
+		slog.WarnContext(ctx, "dateSpotSearchIndex.Put failed", "err", err, "date_spot_id", dateSpot.ID)
+	}
 
 	return nil
 }
@@ -7,6 +7,8 @@ import (
 	"github.com/daisuke-harada/date-courses-go/internal/apperror"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
+	"github.com/samber/lo"
 )
 
 type UpdateDateSpotReviewInputPort interface {
@@ -30,51 +32,94 @@ func (i *UpdateDateSpotReviewInput) Validate() error {
 }
 
 type UpdateDateSpotReviewOutput struct {
-	ReviewID        uint
+	ReviewID uint
+	// Status は更新後のレビューの公開状態です。
+	Status          model.ReviewStatus
 	DateSpotReviews []*model.DateSpotReview
 }
 
 type UpdateDateSpotReviewInteractor struct {
+	UnitOfWork               repository.UnitOfWork
 	DateSpotReviewRepository repository.DateSpotReviewRepository
+	ContentFilter            service.ContentFilter
 }
 
-func NewUpdateDateSpotReviewUsecase(dateSpotReviewRepository repository.DateSpotReviewRepository) UpdateDateSpotReviewInputPort {
-	return &UpdateDateSpotReviewInteractor{DateSpotReviewRepository: dateSpotReviewRepository}
+func NewUpdateDateSpotReviewUsecase(
+	unitOfWork repository.UnitOfWork,
+	dateSpotReviewRepository repository.DateSpotReviewRepository,
+	contentFilter service.ContentFilter,
+) UpdateDateSpotReviewInputPort {
+	return &UpdateDateSpotReviewInteractor{
+		UnitOfWork:               unitOfWork,
+		DateSpotReviewRepository: dateSpotReviewRepository,
+		ContentFilter:            contentFilter,
+	}
 }
 
 func (i *UpdateDateSpotReviewInteractor) Execute(ctx context.Context, input UpdateDateSpotReviewInput) (*UpdateDateSpotReviewOutput, error) {
 	if err := input.Validate(); err != nil {
 		return nil, err
 	}
-
-	// レビューを編集できるのは投稿者だけ
-	existing, err := i.DateSpotReviewRepository.FindByID(ctx, input.ReviewID)
+	verdict, err := checkReviewContent(ctx, i.ContentFilter, input.Content)
 	if err != nil {
-		return nil, apperror.NotFound()
-	}
-	if existing.UserID != input.OperatorID {
-		return nil, apperror.Forbidden("他のユーザーのレビューは編集できません")
+		return nil, err
 	}
 
-	review := &model.DateSpotReview{
-		Rate:    input.Rate,
-		Content: input.Content,
-	}
-	if err := i.DateSpotReviewRepository.UpdateByID(ctx, input.ReviewID, review); err != nil {
-		return nil, apperror.InternalServerError(err)
-	}
+	var status model.ReviewStatus
+	var reviews []*model.DateSpotReview
+	err = i.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		// レビューを編集できるのは投稿者だけ
+		existing, err := i.DateSpotReviewRepository.FindByID(ctx, input.ReviewID)
+		if err != nil {
+			return apperror.NotFound()
+		}
+		if existing.UserID != input.OperatorID {
+			return apperror.Forbidden("他のユーザーのレビューは編集できません")
+		}
 
-	reviews, err := i.DateSpotReviewRepository.FindByDateSpotID(ctx, input.DateSpotID)
+		status = nextReviewStatus(existing, input.Content, verdict)
+		review := &model.DateSpotReview{
+			Rate:    input.Rate,
+			Content: input.Content,
+			Status:  status,
+		}
+		if err := i.DateSpotReviewRepository.UpdateByID(ctx, input.ReviewID, review); err != nil {
+			return apperror.InternalServerError(err)
+		}
+
+		reviews, err = i.DateSpotReviewRepository.FindByDateSpotID(ctx, input.DateSpotID, input.OperatorID)
+		if err != nil {
+			return apperror.InternalServerError(err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, apperror.InternalServerError(err)
+		return nil, err
 	}
 
 	return &UpdateDateSpotReviewOutput{
 		ReviewID:        input.ReviewID,
+		Status:          status,
 		DateSpotReviews: reviews,
 	}, nil
 }
 
+// nextReviewStatus は編集後の公開状態を決めます。
+// 管理者が非公開にしたレビューは編集しても非公開のまま、保留中のレビューは確認が済むまで保留のままにします。
+// 承認済みのレビューは承認したときの本文に対する判断なので、本文を書き換えたら通常の公開に戻します。
+func nextReviewStatus(existing *model.DateSpotReview, content *string, verdict service.ContentVerdict) model.ReviewStatus {
+	switch {
+	case existing.Status == model.ReviewStatusHidden, existing.Status == model.ReviewStatusPending:
+		return existing.Status
+	case verdict == service.ContentHeld:
+		return model.ReviewStatusPending
+	case existing.Status == model.ReviewStatusApproved && content != nil && *content != lo.FromPtr(existing.Content):
+		return model.ReviewStatusPublished
+	default:
+		return existing.Status
+	}
+}
+
 // NewUpdateDateSpotReviewInput parses string form values and constructs UpdateDateSpotReviewInput.
 // reviewID is provided as int (from route param). dateSpotIDStr, rateStr, contentStr are raw form values.
 func NewUpdateDateSpotReviewInput(reviewID int, dateSpotIDStr, rateStr, contentStr string) (UpdateDateSpotReviewInput, error) {
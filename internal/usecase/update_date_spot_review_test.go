@@ -29,7 +29,7 @@ func TestUpdateDateSpotReviewInteractor_Execute(t *testing.T) {
 			FindByID(ctx, uint(1)).
 			Return(&model.DateSpotReview{ID: 1, UserID: 1, DateSpotID: 3}, nil)
 
-		interactor := usecase.NewUpdateDateSpotReviewUsecase(reviewRepo)
+		interactor := usecase.NewUpdateDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo, newTestContentFilter())
 		output, err := interactor.Execute(ctx, usecase.UpdateDateSpotReviewInput{
 			ReviewID:   1,
 			DateSpotID: 3,
@@ -61,10 +61,10 @@ func TestUpdateDateSpotReviewInteractor_Execute(t *testing.T) {
 			UpdateByID(ctx, uint(1), gomock.Any()).
 			Return(nil)
 		reviewRepo.EXPECT().
-			FindByDateSpotID(ctx, uint(3)).
+			FindByDateSpotID(ctx, uint(3), uint(1)).
 			Return([]*model.DateSpotReview{}, nil)
 
-		interactor := usecase.NewUpdateDateSpotReviewUsecase(reviewRepo)
+		interactor := usecase.NewUpdateDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo, newTestContentFilter())
 		output, err := interactor.Execute(ctx, usecase.UpdateDateSpotReviewInput{
 			ReviewID:   1,
 			OperatorID: 1,
@@ -90,10 +90,10 @@ func TestUpdateDateSpotReviewInteractor_Execute(t *testing.T) {
 			UpdateByID(ctx, uint(1), gomock.Any()).
 			Return(nil)
 		reviewRepo.EXPECT().
-			FindByDateSpotID(ctx, uint(3)).
+			FindByDateSpotID(ctx, uint(3), uint(1)).
 			Return([]*model.DateSpotReview{}, nil)
 
-		interactor := usecase.NewUpdateDateSpotReviewUsecase(reviewRepo)
+		interactor := usecase.NewUpdateDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo, newTestContentFilter())
 		output, err := interactor.Execute(ctx, usecase.UpdateDateSpotReviewInput{
 			ReviewID:   1,
 			OperatorID: 1,
@@ -105,13 +105,87 @@ func TestUpdateDateSpotReviewInteractor_Execute(t *testing.T) {
 		assert.Equal(t, uint(1), output.ReviewID)
 	})
 
+	t.Run("status_transitions", func(t *testing.T) {
+		held := "接客が最悪でした"
+		approvedContent := "承認された本文"
+		tests := []struct {
+			name     string
+			existing model.ReviewStatus
+			content  *string
+			want     model.ReviewStatus
+		}{
+			{"published_stays_published", model.ReviewStatusPublished, &content, model.ReviewStatusPublished},
+			{"held_content_becomes_pending", model.ReviewStatusPublished, &held, model.ReviewStatusPending},
+			// 非公開にされたレビューは本文を書き換えても公開に戻らない
+			{"hidden_stays_hidden", model.ReviewStatusHidden, &content, model.ReviewStatusHidden},
+			{"pending_stays_pending", model.ReviewStatusPending, &content, model.ReviewStatusPending},
+			// 承認は承認時の本文に対するものなので、本文を変えたら通常の公開に戻す
+			{"approved_with_new_content_becomes_published", model.ReviewStatusApproved, &content, model.ReviewStatusPublished},
+			{"approved_with_same_content_stays_approved", model.ReviewStatusApproved, &approvedContent, model.ReviewStatusApproved},
+			{"approved_rate_only_stays_approved", model.ReviewStatusApproved, nil, model.ReviewStatusApproved},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				reviewRepo := repomock.NewMockDateSpotReviewRepository(ctrl)
+				reviewRepo.EXPECT().
+					FindByID(ctx, uint(1)).
+					Return(&model.DateSpotReview{ID: 1, UserID: 1, DateSpotID: 3, Content: &approvedContent, Status: tt.existing}, nil)
+				reviewRepo.EXPECT().
+					UpdateByID(ctx, uint(1), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uint, r *model.DateSpotReview) error {
+						assert.Equal(t, tt.want, r.Status)
+						return nil
+					})
+				reviewRepo.EXPECT().
+					FindByDateSpotID(ctx, uint(3), uint(1)).
+					Return([]*model.DateSpotReview{}, nil)
+
+				interactor := usecase.NewUpdateDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo, newTestContentFilter())
+				output, err := interactor.Execute(ctx, usecase.UpdateDateSpotReviewInput{
+					ReviewID:   1,
+					OperatorID: 1,
+					DateSpotID: 3,
+					Rate:       &rate,
+					Content:    tt.content,
+				})
+
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, output.Status)
+			})
+		}
+	})
+
+	t.Run("error_rejected_content", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rejected := "バカ"
+		reviewRepo := repomock.NewMockDateSpotReviewRepository(ctrl)
+
+		interactor := usecase.NewUpdateDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo, newTestContentFilter())
+		output, err := interactor.Execute(ctx, usecase.UpdateDateSpotReviewInput{
+			ReviewID:   1,
+			OperatorID: 1,
+			DateSpotID: 3,
+			Content:    &rejected,
+		})
+
+		assert.Nil(t, output)
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, statusCode)
+	})
+
 	t.Run("error_validation_no_fields", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		reviewRepo := repomock.NewMockDateSpotReviewRepository(ctrl)
 
-		interactor := usecase.NewUpdateDateSpotReviewUsecase(reviewRepo)
+		interactor := usecase.NewUpdateDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo, newTestContentFilter())
 		output, err := interactor.Execute(ctx, usecase.UpdateDateSpotReviewInput{
 			ReviewID:   1,
 			OperatorID: 1,
@@ -137,7 +211,7 @@ func TestUpdateDateSpotReviewInteractor_Execute(t *testing.T) {
 			UpdateByID(ctx, uint(1), gomock.Any()).
 			Return(errors.New("db error"))
 
-		interactor := usecase.NewUpdateDateSpotReviewUsecase(reviewRepo)
+		interactor := usecase.NewUpdateDateSpotReviewUsecase(newPassThroughUnitOfWork(ctrl), reviewRepo, newTestContentFilter())
 		output, err := interactor.Execute(ctx, usecase.UpdateDateSpotReviewInput{
 			ReviewID:   1,
 			OperatorID: 1,
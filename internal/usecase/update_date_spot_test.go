@@ -5,7 +5,9 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	servicemock "github.com/daisuke-harada/date-courses-go/internal/domain/service/mock"
 	"github.com/daisuke-harada/date-courses-go/internal/usecase"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -24,7 +26,15 @@ func TestUpdateDateSpotInteractor_Execute(t *testing.T) {
 			Update(ctx, uint(10), gomock.Any()).
 			Return(nil)
 
-		interactor := usecase.NewUpdateDateSpotUsecase(dateSpotRepo)
+		searchIndex := repositorymock.NewMockDateSpotSearchIndex(ctrl)
+		searchIndex.EXPECT().
+			Put(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, ds *model.DateSpot) error {
+				assert.Equal(t, uint(10), ds.ID)
+				assert.Equal(t, "更新スポット", ds.Name)
+				return nil
+			})
+		interactor := usecase.NewUpdateDateSpotUsecase(dateSpotRepo, searchIndex, servicemock.NewMockImageService(ctrl))
 		err := interactor.Execute(ctx, usecase.UpdateDateSpotInput{
 			DateSpotID:   10,
 			Name:         "更新スポット",
@@ -36,6 +46,72 @@ func TestUpdateDateSpotInteractor_Execute(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	// 画像を指定しないときは Image を nil のまま更新し、既存の画像を残す
+	t.Run("success_keeps_image_when_not_uploaded", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+
+		dateSpotRepo := repositorymock.NewMockDateSpotRepository(ctrl)
+		dateSpotRepo.EXPECT().
+			Update(ctx, uint(10), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint, ds *model.DateSpot) error {
+				assert.Nil(t, ds.Image)
+				assert.Nil(t, ds.ImageThumbnail)
+				return nil
+			})
+		searchIndex := repositorymock.NewMockDateSpotSearchIndex(ctrl)
+		searchIndex.EXPECT().Put(ctx, gomock.Any()).Return(nil)
+
+		interactor := usecase.NewUpdateDateSpotUsecase(dateSpotRepo, searchIndex, servicemock.NewMockImageService(ctrl))
+		err := interactor.Execute(ctx, usecase.UpdateDateSpotInput{
+			DateSpotID:   10,
+			Name:         "更新スポット",
+			GenreID:      2,
+			PrefectureID: 14,
+			CityName:     "新宿区",
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("success_with_image", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		data := []byte("image bytes")
+
+		imageService := servicemock.NewMockImageService(ctrl)
+		imageService.EXPECT().
+			Store(ctx, model.ImageKindDateSpot, data).
+			Return(&model.Image{URL: "http://localhost/uploads/a.jpg", ThumbnailURL: "http://localhost/uploads/a_thumb.jpg"}, nil)
+
+		dateSpotRepo := repositorymock.NewMockDateSpotRepository(ctrl)
+		dateSpotRepo.EXPECT().
+			Update(ctx, uint(10), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uint, ds *model.DateSpot) error {
+				assert.Equal(t, "http://localhost/uploads/a.jpg", *ds.Image)
+				assert.Equal(t, "http://localhost/uploads/a_thumb.jpg", *ds.ImageThumbnail)
+				return nil
+			})
+		searchIndex := repositorymock.NewMockDateSpotSearchIndex(ctrl)
+		searchIndex.EXPECT().Put(ctx, gomock.Any()).Return(nil)
+
+		interactor := usecase.NewUpdateDateSpotUsecase(dateSpotRepo, searchIndex, imageService)
+		err := interactor.Execute(ctx, usecase.UpdateDateSpotInput{
+			DateSpotID:   10,
+			Name:         "更新スポット",
+			GenreID:      2,
+			PrefectureID: 14,
+			CityName:     "新宿区",
+			Image:        data,
+		})
+
+		require.NoError(t, err)
+	})
+
 	t.Run("error_repository_update_failed", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -47,7 +123,8 @@ func TestUpdateDateSpotInteractor_Execute(t *testing.T) {
 			Update(ctx, uint(10), gomock.Any()).
 			Return(errors.New("db error"))
 
-		interactor := usecase.NewUpdateDateSpotUsecase(dateSpotRepo)
+		searchIndex := repositorymock.NewMockDateSpotSearchIndex(ctrl)
+		interactor := usecase.NewUpdateDateSpotUsecase(dateSpotRepo, searchIndex, servicemock.NewMockImageService(ctrl))
 		err := interactor.Execute(ctx, usecase.UpdateDateSpotInput{
 			DateSpotID:   10,
 			Name:         "更新スポット",
@@ -8,6 +8,7 @@ import (
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/service"
+	jwtpkg "github.com/daisuke-harada/date-courses-go/internal/pkg/jwt"
 )
 
 // UpdateUserInputPort はユーザー更新ユースケースの入力ポートです。
@@ -16,11 +17,12 @@ type UpdateUserInputPort interface {
 }
 
 type UpdateUserInput struct {
-	ID                   uint
-	Name                 string
-	Email                string
-	Gender               model.Gender
-	Image                *string
+	ID     uint
+	Name   string
+	Email  string
+	Gender model.Gender
+	// Image はアップロードされた画像ファイルの中身です。nil のときは画像を変更しません。
+	Image                []byte
 	Password             string
 	PasswordConfirmation string
 	// OperatorID は更新を実行するユーザー（トークンの currentUser）の ID です。
@@ -71,23 +73,42 @@ func (i *UpdateUserInput) Validate() error {
 
 type UpdateUserOutput struct {
 	UserWithRelations *model.UserWithRelations
+	// Token・RefreshToken はパスワードを変えたときに発行し直したトークンです。
+	// 他の端末と一緒に今の端末もログアウトさせないよう返します。パスワードを変えていなければ空です。
+	Token        string
+	RefreshToken string
 }
 
 type UpdateUserInteractor struct {
-	UserRepository repository.UserRepository
-	UserService    service.UserService
-	DemoUserName   DemoUserName
+	UnitOfWork             repository.UnitOfWork
+	UserRepository         repository.UserRepository
+	RefreshTokenRepository repository.RefreshTokenRepository
+	UserService            service.UserService
+	ImageService           service.ImageService
+	Keyring                *jwtpkg.Keyring
+	SessionTTL             SessionTTL
+	DemoUserName           DemoUserName
 }
 
 func NewUpdateUserUsecase(
+	unitOfWork repository.UnitOfWork,
 	userRepository repository.UserRepository,
+	refreshTokenRepository repository.RefreshTokenRepository,
 	userService service.UserService,
+	imageService service.ImageService,
+	keyring *jwtpkg.Keyring,
+	sessionTTL SessionTTL,
 	demoUserName DemoUserName,
 ) UpdateUserInputPort {
 	return &UpdateUserInteractor{
-		UserRepository: userRepository,
-		UserService:    userService,
-		DemoUserName:   demoUserName,
+		UnitOfWork:             unitOfWork,
+		UserRepository:         userRepository,
+		RefreshTokenRepository: refreshTokenRepository,
+		UserService:            userService,
+		ImageService:           imageService,
+		Keyring:                keyring,
+		SessionTTL:             sessionTTL,
+		DemoUserName:           demoUserName,
 	}
 }
 
@@ -112,13 +133,46 @@ func (i *UpdateUserInteractor) Execute(ctx context.Context, input UpdateUserInpu
 		return nil, err
 	}
 
+	// 他人のプロフィールやデモユーザーでは保存しないよう、権限を確かめてから画像を保存する
+	var image *model.Image
+	if input.Image != nil {
+		stored, err := i.ImageService.Store(ctx, model.ImageKindUser, input.Image)
+		if err != nil {
+			return nil, err
+		}
+		image = stored
+	}
+
 	// パスワードが指定されている場合のみ更新（Rails の allow_nil: true に対応）
-	if err := user.ApplyUpdate(input.Name, input.Email, input.Gender, input.Image, input.Password); err != nil {
+	if err := user.ApplyUpdate(input.Name, input.Email, input.Gender, image, input.Password); err != nil {
 		return nil, apperror.InternalServerError(err)
 	}
 
-	if err := i.UserRepository.Update(ctx, user); err != nil {
-		return nil, apperror.InternalServerError(err)
+	// パスワードを変えたときは、トークンバージョンの引き上げとリフレッシュトークンの失効を1トランザクションで行い、
+	// すべての端末をログアウトさせる。漏れたパスワードで作られたセッションを残さないため。
+	// 操作した端末には上げた後のトークンバージョンで発行し直したトークンを返す
+	var token, refreshToken string
+	err = i.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := i.UserRepository.Update(ctx, user); err != nil {
+			return apperror.InternalServerError(err)
+		}
+		if input.Password == "" {
+			return nil
+		}
+		if err := revokeAllSessions(ctx, i.UserRepository, i.RefreshTokenRepository, user.ID); err != nil {
+			return err
+		}
+		// 同時に上げられていても発行するトークンが使えるよう、DB 上の値を読み直す
+		updated, err := i.UserRepository.FindByID(ctx, user.ID)
+		if err != nil {
+			return apperror.InternalServerError(err)
+		}
+		user.TokenVersion = updated.TokenVersion
+		token, refreshToken, err = issueSession(ctx, i.RefreshTokenRepository, user, i.Keyring, i.SessionTTL)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	uwr, err := i.UserService.BuildUserWithRelations(ctx, user)
@@ -126,12 +180,12 @@ func (i *UpdateUserInteractor) Execute(ctx context.Context, input UpdateUserInpu
 		return nil, err
 	}
 
-	return &UpdateUserOutput{UserWithRelations: uwr}, nil
+	return &UpdateUserOutput{UserWithRelations: uwr, Token: token, RefreshToken: refreshToken}, nil
 }
 
-// NewUpdateUserInput builds UpdateUserInput from raw form string values.
+// NewUpdateUserInput builds UpdateUserInput from raw form string values and the uploaded image bytes.
 // Invalid gender values are treated as empty and left for Validate() to report.
-func NewUpdateUserInput(id int, name, email, genderStr, password, passwordConfirmation, imageStr string) (UpdateUserInput, error) {
+func NewUpdateUserInput(id int, name, email, genderStr, password, passwordConfirmation string, image []byte) (UpdateUserInput, error) {
 	var gender model.Gender
 	if genderStr != "" {
 		g := model.Gender(genderStr)
@@ -142,11 +196,6 @@ func NewUpdateUserInput(id int, name, email, genderStr, password, passwordConfir
 		}
 	}
 
-	var image *string
-	if imageStr != "" {
-		image = &imageStr
-	}
-
 	return UpdateUserInput{
 		ID:                   uint(id),
 		Name:                 name,
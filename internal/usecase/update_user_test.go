@@ -41,7 +41,7 @@ func TestUpdateUserInteractor_Execute(t *testing.T) {
 
 		userService := servicemock.NewMockUserService(ctrl)
 
-		interactor := usecase.NewUpdateUserUsecase(userRepo, userService, "guest")
+		interactor := usecase.NewUpdateUserUsecase(newPassThroughUnitOfWork(ctrl), userRepo, repositorymock.NewMockRefreshTokenRepository(ctrl), userService, servicemock.NewMockImageService(ctrl), testKeyring, testSessionTTL, "guest")
 		output, err := interactor.Execute(ctx, validUpdateUserInput())
 
 		assert.Nil(t, output)
@@ -68,7 +68,7 @@ func TestUpdateUserInteractor_Execute(t *testing.T) {
 		input := validUpdateUserInput()
 		input.OperatorID = 99
 
-		interactor := usecase.NewUpdateUserUsecase(userRepo, userService, "guest")
+		interactor := usecase.NewUpdateUserUsecase(newPassThroughUnitOfWork(ctrl), userRepo, repositorymock.NewMockRefreshTokenRepository(ctrl), userService, servicemock.NewMockImageService(ctrl), testKeyring, testSessionTTL, "guest")
 		output, err := interactor.Execute(ctx, input)
 
 		assert.Nil(t, output)
@@ -93,7 +93,7 @@ func TestUpdateUserInteractor_Execute(t *testing.T) {
 		userService := servicemock.NewMockUserService(ctrl)
 		userService.EXPECT().BuildUserWithRelations(ctx, gomock.Any()).Return(uwr, nil)
 
-		interactor := usecase.NewUpdateUserUsecase(userRepo, userService, "guest")
+		interactor := usecase.NewUpdateUserUsecase(newPassThroughUnitOfWork(ctrl), userRepo, repositorymock.NewMockRefreshTokenRepository(ctrl), userService, servicemock.NewMockImageService(ctrl), testKeyring, testSessionTTL, "guest")
 		output, err := interactor.Execute(ctx, validUpdateUserInput())
 
 		require.NoError(t, err)
@@ -101,6 +101,120 @@ func TestUpdateUserInteractor_Execute(t *testing.T) {
 		assert.Equal(t, uwr, output.UserWithRelations)
 	})
 
+	t.Run("success_with_image", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		user := &model.User{ID: 1, Name: "元ユーザー", Email: "old@example.com", Gender: model.GenderFemale}
+		uwr := &model.UserWithRelations{User: user}
+
+		imageService := servicemock.NewMockImageService(ctrl)
+		imageService.EXPECT().
+			Store(ctx, model.ImageKindUser, []byte("image bytes")).
+			Return(&model.Image{URL: "http://localhost/uploads/u.jpg", ThumbnailURL: "http://localhost/uploads/u_thumb.jpg"}, nil)
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(user, nil)
+		userRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, u *model.User) error {
+			assert.Equal(t, "http://localhost/uploads/u.jpg", *u.Image)
+			assert.Equal(t, "http://localhost/uploads/u_thumb.jpg", *u.ImageThumbnail)
+			return nil
+		})
+
+		userService := servicemock.NewMockUserService(ctrl)
+		userService.EXPECT().BuildUserWithRelations(ctx, gomock.Any()).Return(uwr, nil)
+
+		input := validUpdateUserInput()
+		input.Image = []byte("image bytes")
+
+		interactor := usecase.NewUpdateUserUsecase(newPassThroughUnitOfWork(ctrl), userRepo, repositorymock.NewMockRefreshTokenRepository(ctrl), userService, imageService, testKeyring, testSessionTTL, "guest")
+		_, err := interactor.Execute(ctx, input)
+
+		require.NoError(t, err)
+	})
+
+	// パスワードを変えたら、漏れたパスワードで作られたセッションを残さないようすべての端末をログアウトさせる。
+	// 操作した端末は、上げた後のトークンバージョンで発行し直したトークンでログイン状態を保つ
+	t.Run("success_password_change_revokes_sessions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		user := &model.User{ID: 1, Name: "元ユーザー", Email: "old@example.com", Gender: model.GenderFemale, TokenVersion: 2}
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(user, nil)
+		userRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+		// 読んでから保存すると同時の更新で消えるため、DB 上で上げる
+		userRepo.EXPECT().IncrementTokenVersion(ctx, uint(1)).Return(nil)
+		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(&model.User{ID: 1, TokenVersion: 3}, nil)
+
+		refreshTokenRepo := newStoringRefreshTokenRepository(ctrl)
+		refreshTokenRepo.EXPECT().RevokeAllByUserID(ctx, uint(1), gomock.Any()).Return(nil)
+
+		userService := servicemock.NewMockUserService(ctrl)
+		userService.EXPECT().BuildUserWithRelations(ctx, gomock.Any()).Return(&model.UserWithRelations{User: user}, nil)
+
+		input := validUpdateUserInput()
+		input.Password = "newpassword"
+		input.PasswordConfirmation = "newpassword"
+
+		interactor := usecase.NewUpdateUserUsecase(newPassThroughUnitOfWork(ctrl), userRepo, refreshTokenRepo, userService, servicemock.NewMockImageService(ctrl), testKeyring, testSessionTTL, "guest")
+		output, err := interactor.Execute(ctx, input)
+
+		require.NoError(t, err)
+		claims, err := testKeyring.Decode(output.Token)
+		require.NoError(t, err)
+		assert.Equal(t, uint(3), claims.TokenVersion, "上げた後のトークンバージョンで発行し直す")
+		assert.NotEmpty(t, output.RefreshToken)
+	})
+
+	// パスワードを変えなければセッションはそのまま。トークンも発行し直さない
+	t.Run("success_without_password_keeps_sessions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		user := &model.User{ID: 1, Name: "元ユーザー", Email: "old@example.com", Gender: model.GenderFemale, TokenVersion: 2}
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(user, nil)
+		userRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+
+		userService := servicemock.NewMockUserService(ctrl)
+		userService.EXPECT().BuildUserWithRelations(ctx, gomock.Any()).Return(&model.UserWithRelations{User: user}, nil)
+
+		interactor := usecase.NewUpdateUserUsecase(newPassThroughUnitOfWork(ctrl), userRepo, repositorymock.NewMockRefreshTokenRepository(ctrl), userService, servicemock.NewMockImageService(ctrl), testKeyring, testSessionTTL, "guest")
+		output, err := interactor.Execute(ctx, validUpdateUserInput())
+
+		require.NoError(t, err)
+		assert.Empty(t, output.Token)
+		assert.Empty(t, output.RefreshToken)
+	})
+
+	// 他人のプロフィールへの画像は保存しない
+	t.Run("error_forbidden_does_not_store_image", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		user := &model.User{ID: 1, Name: "元ユーザー", Email: "old@example.com", Gender: model.GenderFemale}
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(user, nil)
+
+		input := validUpdateUserInput()
+		input.OperatorID = 99
+		input.Image = []byte("image bytes")
+
+		interactor := usecase.NewUpdateUserUsecase(newPassThroughUnitOfWork(ctrl), userRepo, repositorymock.NewMockRefreshTokenRepository(ctrl), servicemock.NewMockUserService(ctrl), servicemock.NewMockImageService(ctrl), testKeyring, testSessionTTL, "guest")
+		_, err := interactor.Execute(ctx, input)
+
+		statusCode, _, _, _ := apperror.HTTPStatus(err)
+		assert.Equal(t, http.StatusForbidden, statusCode)
+	})
+
 	t.Run("error_validation_failed", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -113,7 +227,7 @@ func TestUpdateUserInteractor_Execute(t *testing.T) {
 		input := validUpdateUserInput()
 		input.Name = "" // invalid
 
-		interactor := usecase.NewUpdateUserUsecase(userRepo, userService, "guest")
+		interactor := usecase.NewUpdateUserUsecase(newPassThroughUnitOfWork(ctrl), userRepo, repositorymock.NewMockRefreshTokenRepository(ctrl), userService, servicemock.NewMockImageService(ctrl), testKeyring, testSessionTTL, "guest")
 		output, err := interactor.Execute(ctx, input)
 
 		assert.Error(t, err)
@@ -134,7 +248,7 @@ func TestUpdateUserInteractor_Execute(t *testing.T) {
 
 		userService := servicemock.NewMockUserService(ctrl)
 
-		interactor := usecase.NewUpdateUserUsecase(userRepo, userService, "guest")
+		interactor := usecase.NewUpdateUserUsecase(newPassThroughUnitOfWork(ctrl), userRepo, repositorymock.NewMockRefreshTokenRepository(ctrl), userService, servicemock.NewMockImageService(ctrl), testKeyring, testSessionTTL, "guest")
 		output, err := interactor.Execute(ctx, validUpdateUserInput())
 
 		assert.Error(t, err)
@@ -157,7 +271,7 @@ func TestUpdateUserInteractor_Execute(t *testing.T) {
 
 		userService := servicemock.NewMockUserService(ctrl)
 
-		interactor := usecase.NewUpdateUserUsecase(userRepo, userService, "guest")
+		interactor := usecase.NewUpdateUserUsecase(newPassThroughUnitOfWork(ctrl), userRepo, repositorymock.NewMockRefreshTokenRepository(ctrl), userService, servicemock.NewMockImageService(ctrl), testKeyring, testSessionTTL, "guest")
 		output, err := interactor.Execute(ctx, validUpdateUserInput())
 
 		assert.Error(t, err)
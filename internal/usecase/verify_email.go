@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+)
+
+// VerifyEmailInputPort はメールアドレス確認ユースケースの入力ポートです。
+type VerifyEmailInputPort interface {
+	Execute(context.Context, VerifyEmailInput) error
+}
+
+type VerifyEmailInput struct {
+	Token string
+}
+
+func (i *VerifyEmailInput) Validate() error {
+	if i.Token == "" {
+		return apperror.UnprocessableEntity("トークンを指定してください")
+	}
+	return nil
+}
+
+type VerifyEmailInteractor struct {
+	UnitOfWork           repository.UnitOfWork
+	UserRepository       repository.UserRepository
+	EmailTokenRepository repository.EmailTokenRepository
+}
+
+func NewVerifyEmailUsecase(
+	unitOfWork repository.UnitOfWork,
+	userRepository repository.UserRepository,
+	emailTokenRepository repository.EmailTokenRepository,
+) VerifyEmailInputPort {
+	return &VerifyEmailInteractor{
+		UnitOfWork:           unitOfWork,
+		UserRepository:       userRepository,
+		EmailTokenRepository: emailTokenRepository,
+	}
+}
+
+// Execute は確認メールのトークンを使い、メールアドレスを確認済みにします。
+// メールを開けるのは本人だけのため、ログインしていなくても受け付けます。
+func (i *VerifyEmailInteractor) Execute(ctx context.Context, input VerifyEmailInput) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	return i.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+		user, err := consumeEmailToken(ctx, i.EmailTokenRepository, i.UserRepository, model.EmailTokenPurposeVerifyEmail, input.Token)
+		if err != nil {
+			return err
+		}
+		if user.IsEmailVerified() {
+			return nil
+		}
+		now := time.Now()
+		user.EmailVerifiedAt = &now
+		if err := i.UserRepository.Update(ctx, user); err != nil {
+			return apperror.InternalServerError(err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,169 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daisuke-harada/date-courses-go/internal/apperror"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/repository"
+	repositorymock "github.com/daisuke-harada/date-courses-go/internal/domain/repository/mock"
+	"github.com/daisuke-harada/date-courses-go/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+var testEmailTokenSettings = usecase.EmailTokenSettings{
+	LinkBaseURL:      "http://localhost:3000",
+	VerifyEmailTTL:   24 * time.Hour,
+	ResetPasswordTTL: time.Hour,
+}
+
+// newStoringEmailTokenRepository はトークンの発行（古いトークンの無効化と保存）を受け付けるモックを返します。
+func newStoringEmailTokenRepository(ctrl *gomock.Controller) *repositorymock.MockEmailTokenRepository {
+	repo := repositorymock.NewMockEmailTokenRepository(ctrl)
+	repo.EXPECT().InvalidateByUserID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	repo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return repo
+}
+
+// newRecordingMailer は送ったメールを記録するモックを返します。
+func newRecordingMailer(ctrl *gomock.Controller) (*repositorymock.MockMailer, *[]repository.MailMessage) {
+	var sent []repository.MailMessage
+	mailer := repositorymock.NewMockMailer(ctrl)
+	mailer.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, msg repository.MailMessage) error {
+		sent = append(sent, msg)
+		return nil
+	}).AnyTimes()
+	return mailer, &sent
+}
+
+func newDiscardMailer(ctrl *gomock.Controller) *repositorymock.MockMailer {
+	mailer, _ := newRecordingMailer(ctrl)
+	return mailer
+}
+
+func TestVerifyEmailInteractor_Execute(t *testing.T) {
+	const plain = "plain-email-token"
+	activeToken := func() *model.EmailToken {
+		return &model.EmailToken{ID: 3, UserID: 1, Purpose: model.EmailTokenPurposeVerifyEmail, Email: "alice@example.com", ExpiresAt: time.Now().Add(time.Hour)}
+	}
+
+	t.Run("success_marks_email_verified", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ctx := context.Background()
+
+		tokenRepo := repositorymock.NewMockEmailTokenRepository(ctrl)
+		tokenRepo.EXPECT().FindByHash(ctx, model.EmailTokenPurposeVerifyEmail, model.HashEmailToken(plain)).Return(activeToken(), nil)
+		tokenRepo.EXPECT().MarkUsed(ctx, uint(3), gomock.Any()).Return(true, nil)
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(&model.User{ID: 1, Email: "alice@example.com"}, nil)
+		userRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, u *model.User) error {
+			assert.True(t, u.IsEmailVerified())
+			return nil
+		})
+
+		interactor := usecase.NewVerifyEmailUsecase(newPassThroughUnitOfWork(ctrl), userRepo, tokenRepo)
+		err := interactor.Execute(ctx, usecase.VerifyEmailInput{Token: plain})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("error_unknown_token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ctx := context.Background()
+
+		tokenRepo := repositorymock.NewMockEmailTokenRepository(ctrl)
+		tokenRepo.EXPECT().FindByHash(ctx, model.EmailTokenPurposeVerifyEmail, gomock.Any()).Return(nil, gorm.ErrRecordNotFound)
+
+		interactor := usecase.NewVerifyEmailUsecase(newPassThroughUnitOfWork(ctrl), repositorymock.NewMockUserRepository(ctrl), tokenRepo)
+		err := interactor.Execute(ctx, usecase.VerifyEmailInput{Token: plain})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, 422, statusCode)
+	})
+
+	t.Run("error_expired_or_used_token", func(t *testing.T) {
+		expired := activeToken()
+		expired.ExpiresAt = time.Now().Add(-time.Minute)
+		usedAt := time.Now()
+		used := activeToken()
+		used.UsedAt = &usedAt
+
+		for name, token := range map[string]*model.EmailToken{"expired": expired, "used": used} {
+			t.Run(name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+				ctx := context.Background()
+
+				tokenRepo := repositorymock.NewMockEmailTokenRepository(ctrl)
+				tokenRepo.EXPECT().FindByHash(ctx, model.EmailTokenPurposeVerifyEmail, gomock.Any()).Return(token, nil)
+
+				interactor := usecase.NewVerifyEmailUsecase(newPassThroughUnitOfWork(ctrl), repositorymock.NewMockUserRepository(ctrl), tokenRepo)
+				err := interactor.Execute(ctx, usecase.VerifyEmailInput{Token: plain})
+
+				statusCode, _, _, ok := apperror.HTTPStatus(err)
+				require.True(t, ok)
+				assert.Equal(t, 422, statusCode)
+			})
+		}
+	})
+
+	// 同じリンクが同時に開かれたときは、先に使用済みにした方だけを通す
+	t.Run("error_lost_race", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ctx := context.Background()
+
+		tokenRepo := repositorymock.NewMockEmailTokenRepository(ctrl)
+		tokenRepo.EXPECT().FindByHash(ctx, model.EmailTokenPurposeVerifyEmail, gomock.Any()).Return(activeToken(), nil)
+		tokenRepo.EXPECT().MarkUsed(ctx, uint(3), gomock.Any()).Return(false, nil)
+
+		interactor := usecase.NewVerifyEmailUsecase(newPassThroughUnitOfWork(ctrl), repositorymock.NewMockUserRepository(ctrl), tokenRepo)
+		err := interactor.Execute(ctx, usecase.VerifyEmailInput{Token: plain})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, 422, statusCode)
+	})
+
+	// 送った後にアドレスを変えていたら、古いアドレス宛てのリンクでは確認済みにしない
+	t.Run("error_email_changed_after_sent", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ctx := context.Background()
+
+		tokenRepo := repositorymock.NewMockEmailTokenRepository(ctrl)
+		tokenRepo.EXPECT().FindByHash(ctx, model.EmailTokenPurposeVerifyEmail, gomock.Any()).Return(activeToken(), nil)
+		tokenRepo.EXPECT().MarkUsed(ctx, uint(3), gomock.Any()).Return(true, nil)
+
+		userRepo := repositorymock.NewMockUserRepository(ctrl)
+		userRepo.EXPECT().FindByID(ctx, uint(1)).Return(&model.User{ID: 1, Email: "new@example.com"}, nil)
+
+		interactor := usecase.NewVerifyEmailUsecase(newPassThroughUnitOfWork(ctrl), userRepo, tokenRepo)
+		err := interactor.Execute(ctx, usecase.VerifyEmailInput{Token: plain})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, 422, statusCode)
+	})
+
+	t.Run("error_validation_empty_token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		interactor := usecase.NewVerifyEmailUsecase(nil, repositorymock.NewMockUserRepository(ctrl), repositorymock.NewMockEmailTokenRepository(ctrl))
+		err := interactor.Execute(context.Background(), usecase.VerifyEmailInput{})
+
+		statusCode, _, _, ok := apperror.HTTPStatus(err)
+		require.True(t, ok)
+		assert.Equal(t, 422, statusCode)
+	})
+}
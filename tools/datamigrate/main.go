@@ -0,0 +1,137 @@
+// datamigrate は schema.sql の適用後に、既存の行を書き換えるデータ移行を流します。
+// sqldef はスキーマの差分しか扱わないため、データの書き換えはここに足します。
+// 流した移行は data_migrations に記録し、2回目からは飛ばします。
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"regexp"
+
+	"github.com/daisuke-harada/date-courses-go/internal/config"
+	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
+	"github.com/daisuke-harada/date-courses-go/internal/infrastructure/db"
+	"github.com/daisuke-harada/date-courses-go/pkg/logger"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// migration は1回だけ流すデータ移行です。
+type migration struct {
+	// name は data_migrations に記録する名前です。日付から始め、流す順に並べます。
+	name string
+	up   func(tx *gorm.DB) error
+}
+
+var migrations = []migration{
+	{
+		// メールアドレスの確認を入れる前に登録したユーザーを確認済みにする。
+		// 確認メールを送ったユーザーは導入後に登録しているため、これまでどおりリンクを開くのを待つ
+		name: "20261018_mark_existing_users_verified",
+		up: func(tx *gorm.DB) error {
+			return tx.Exec(`UPDATE users SET email_verified_at = created_at
+				WHERE email_verified_at IS NULL
+				AND NOT EXISTS (SELECT 1 FROM email_tokens WHERE email_tokens.user_id = users.id AND email_tokens.purpose = ?)`,
+				model.EmailTokenPurposeVerifyEmail).Error
+		},
+	},
+	{
+		// 取り込み記録を残す前に hotpepper から作ったスポットは spot_sources が無く、取り直しの対象にならない。
+		// maps_url の店舗ページ（/strJ000000000/）から店舗 ID を取り出し、統合済みの取り込み記録として足す
+		name: "20261018_backfill_hotpepper_spot_sources",
+		up:   backfillHotPepperSpotSources,
+	},
+}
+
+// hotPepperShopURL は HotPepper の店舗ページの URL から店舗 ID を取り出す正規表現です。
+var hotPepperShopURL = regexp.MustCompile(`/str(J\d+)/`)
+
+func backfillHotPepperSpotSources(tx *gorm.DB) error {
+	var spots []*model.DateSpot
+	if err := tx.
+		Where("source = ? AND maps_url LIKE ?", model.DateSpotSourceHotPepper, "%/strJ%").
+		Where("NOT EXISTS (SELECT 1 FROM spot_sources WHERE spot_sources.date_spot_id = date_spots.id AND spot_sources.source = date_spots.source)").
+		Find(&spots).Error; err != nil {
+		return err
+	}
+
+	var sources []*model.SpotSource
+	for _, spot := range spots {
+		m := hotPepperShopURL.FindStringSubmatch(*spot.MapsURL)
+		// ジャンル・都道府県が無いスポットは取り込み記録にできないため、取り直しの対象から外れたままにする
+		if m == nil || spot.GenreID == nil || spot.PrefectureID == nil {
+			slog.Warn("skip spot without hotpepper shop id", "date_spot_id", spot.ID)
+			continue
+		}
+		sources = append(sources, &model.SpotSource{
+			DateSpotID:   spot.ID,
+			GenreID:      *spot.GenreID,
+			PrefectureID: *spot.PrefectureID,
+			Source:       model.DateSpotSourceHotPepper,
+			ExternalID:   &m[1],
+			Name:         spot.Name,
+			CityName:     spot.CityName,
+			Latitude:     spot.Latitude,
+			Longitude:    spot.Longitude,
+			Image:        spot.Image,
+			PageURL:      spot.MapsURL,
+			MatchScore:   1,
+			Status:       model.SpotSourceStatusLinked,
+		})
+	}
+	if len(sources) == 0 {
+		return nil
+	}
+	// 同じ店舗 ID が別のスポットに取り込み済みなら、そちらを取り直すので足さない
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Omit("DateSpot").CreateInBatches(sources, 500).Error
+}
+
+func main() {
+	logger.Init("date-courses-go-datamigrate", false)
+	defer logger.Close()
+
+	ctx := context.Background()
+	gdb, err := db.Connect(ctx, config.Get().DB)
+	if err != nil {
+		slog.Error("failed to connect to database", "err", err)
+		os.Exit(1)
+	}
+
+	if err := run(ctx, gdb); err != nil {
+		slog.Error("data migration failed", "err", err)
+		os.Exit(1)
+	}
+}
+
+// run はまだ流していない移行を順に流します。移行と記録は同じトランザクションで行い、
+// 途中で失敗した移行は記録せず、次に流したときにやり直します。
+func run(ctx context.Context, gdb *gorm.DB) error {
+	var applied []string
+	if err := gdb.WithContext(ctx).Raw("SELECT name FROM data_migrations").Scan(&applied).Error; err != nil {
+		return err
+	}
+	done := make(map[string]bool, len(applied))
+	for _, name := range applied {
+		done[name] = true
+	}
+
+	for _, m := range migrations {
+		if done[m.name] {
+			continue
+		}
+		err := gdb.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := m.up(tx); err != nil {
+				return err
+			}
+			// 同時に流したときは主キーの重複で片方が失敗し、書き換えも取り消される
+			return tx.Exec("INSERT INTO data_migrations (name) VALUES (?)", m.name).Error
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "data migration failed", "name", m.name, "err", err)
+			return err
+		}
+		slog.InfoContext(ctx, "data migration applied", "name", m.name)
+	}
+	return nil
+}
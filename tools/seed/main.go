@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"time"
 
 	"github.com/daisuke-harada/date-courses-go/internal/config"
 	"github.com/daisuke-harada/date-courses-go/internal/domain/model"
@@ -210,6 +211,8 @@ func seedUsers(ctx context.Context, gdb *gorm.DB) {
 		})
 	}
 
+	// シードユーザーは確認メールを受け取れないため、確認済みとして登録する
+	verifiedAt := time.Now()
 	for _, u := range users {
 		var existing model.User
 		if err := gdb.WithContext(ctx).Where("email = ?", u.Email).First(&existing).Error; err == nil {
@@ -218,12 +221,13 @@ func seedUsers(ctx context.Context, gdb *gorm.DB) {
 		}
 		img := u.Image
 		user := model.User{
-			Name:           u.Name,
-			Email:          u.Email,
-			Gender:         u.Gender,
-			Image:          &img,
-			Admin:          u.Admin,
-			PasswordDigest: u.Password,
+			Name:            u.Name,
+			Email:           u.Email,
+			Gender:          u.Gender,
+			Image:           &img,
+			Admin:           u.Admin,
+			PasswordDigest:  u.Password,
+			EmailVerifiedAt: &verifiedAt,
 		}
 		if err := repo.Create(ctx, &user); err != nil {
 			slog.ErrorContext(ctx, "seedUsers: create failed", "email", u.Email, "err", err)